@@ -28,6 +28,12 @@ var ErrGetValueForKey = errors.New("get value for key error")
 // ErrGetKeyValuePairs signals an error in getting the key-value pairs of a key for an account
 var ErrGetKeyValuePairs = errors.New("get key-value pairs error")
 
+// ErrGetAccountsStateDiff signals an error in getting the accounts state diff between two blocks
+var ErrGetAccountsStateDiff = errors.New("get accounts state diff error")
+
+// ErrGetEvents signals an error in getting the events emitted by an address
+var ErrGetEvents = errors.New("get events error")
+
 // ErrGetDCDTBalance signals an error in getting dcdt balance for given address
 var ErrGetDCDTBalance = errors.New("get dcdt balance for account error")
 
@@ -79,6 +85,12 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrTraceTransaction signals an error happening when trying to trace a transaction
+var ErrTraceTransaction = errors.New("tracing transaction failed")
+
+// ErrCancelTransactions signals an error happening when trying to cancel the pending transactions of a sender
+var ErrCancelTransactions = errors.New("cancelling transactions failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -109,9 +121,18 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyKeys signals that an empty keys list was provided
+var ErrValidationEmptyKeys = errors.New("keys list is empty")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
+// ErrGetTrieNode signals an error happening when trying to fetch a trie node
+var ErrGetTrieNode = errors.New("getting trie node failed")
+
+// ErrValidationEmptyTrieNodeHash signals that an empty trie node hash was provided
+var ErrValidationEmptyTrieNodeHash = errors.New("trie node hash is empty")
+
 // ErrVerifyProof signals an error happening when trying to verify a Merkle proof
 var ErrVerifyProof = errors.New("verifying proof failed")
 
@@ -174,3 +195,30 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
+
+// ErrGetIntegrityReport signals that an error occurred while getting the storage integrity report
+var ErrGetIntegrityReport = errors.New("error getting the storage integrity report")
+
+// ErrInvalidJsonRpcRequest signals that the received object is not a valid JSON-RPC 2.0 request
+var ErrInvalidJsonRpcRequest = errors.New("invalid json-rpc request")
+
+// ErrInvalidJsonRpcParams signals that the params of a JSON-RPC request are missing or malformed
+var ErrInvalidJsonRpcParams = errors.New("invalid json-rpc params")
+
+// ErrJsonRpcMethodNotFound signals that the requested JSON-RPC method is not known
+var ErrJsonRpcMethodNotFound = errors.New("json-rpc method not found")
+
+// ErrJsonRpcBatchTooLarge signals that a JSON-RPC batch contains too many requests
+var ErrJsonRpcBatchTooLarge = errors.New("json-rpc batch too large")
+
+// ErrNilCallsThrottler signals that a nil calls throttler was provided
+var ErrNilCallsThrottler = errors.New("nil calls throttler")
+
+// ErrTooManyJsonRpcWsMessages signals that a JSON-RPC websocket connection received too many messages
+var ErrTooManyJsonRpcWsMessages = errors.New("too many json-rpc messages on the same connection")
+
+// ErrCreateSubscriber signals that a websocket subscriber could not be created
+var ErrCreateSubscriber = errors.New("could not create subscriber")
+
+// ErrInvalidSubscriptionAction signals that an unknown action was received on a subscriptions connection
+var ErrInvalidSubscriptionAction = errors.New("invalid subscription action")
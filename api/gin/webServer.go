@@ -12,6 +12,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/api/errors"
 	"github.com/kalyan3104/k-chain-go/api/groups"
 	"github.com/kalyan3104/k-chain-go/api/middleware"
+	"github.com/kalyan3104/k-chain-go/api/middleware/disabled"
 	"github.com/kalyan3104/k-chain-go/api/shared"
 	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/kalyan3104/k-chain-go/facade"
@@ -40,6 +41,7 @@ type webServer struct {
 	antiFloodConfig config.WebServerAntifloodConfig
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
+	callsThrottler  shared.CallsThrottler
 	cancelFunc      func()
 }
 
@@ -54,6 +56,7 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 		facade:          args.Facade,
 		antiFloodConfig: args.AntiFloodConfig,
 		apiConfig:       args.ApiConfig,
+		callsThrottler:  disabled.NewDisabledCallsThrottler(),
 	}, nil
 }
 
@@ -174,6 +177,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["hardfork"] = hardforkGroup
 
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(ws.facade, ws.callsThrottler)
+	if err != nil {
+		return err
+	}
+	groupsMap["jsonrpc"] = jsonRpcGroup
+
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
 	networkGroup, err := groups.NewNetworkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -192,6 +207,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["subscriptions"] = subscriptionsGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -263,6 +284,11 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		}
 
 		middlewares = append(middlewares, globalLimiter)
+
+		ws.callsThrottler, err = middleware.NewCallsThrottler(sourceLimiter, globalLimiter)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return middlewares, nil
@@ -14,11 +14,13 @@ import (
 	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
 	"github.com/kalyan3104/k-chain-go/api/errors"
 	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
 )
 
 const (
 	getAccountPath                 = "/:address"
 	getAccountsPath                = "/bulk"
+	getAccountsStateDiffPath       = "/state-diff"
 	getBalancePath                 = "/:address/balance"
 	getUsernamePath                = "/:address/username"
 	getCodeHashPath                = "/:address/code-hash"
@@ -38,7 +40,17 @@ const (
 	urlParamBlockHash              = "blockHash"
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
+	urlParamTimestamp              = "timestamp"
 	urlParamWithKeys               = "withKeys"
+	urlParamFromBlockNonce         = "fromBlockNonce"
+	urlParamFromBlockHash          = "fromBlockHash"
+	urlParamFromBlockRootHash      = "fromBlockRootHash"
+	urlParamFromHintEpoch          = "fromHintEpoch"
+	urlParamToBlockNonce           = "toBlockNonce"
+	urlParamToBlockHash            = "toBlockHash"
+	urlParamToBlockRootHash        = "toBlockRootHash"
+	urlParamToHintEpoch            = "toHintEpoch"
+	urlParamAddresses              = "addresses"
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -55,8 +67,10 @@ type addressFacadeHandler interface {
 	GetDCDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllDCDTTokens(address string, options api.AccountQueryOptions) (map[string]*dcdt.DCDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetAccountsStateDiff(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string) (*common.AccountsStateDiffAPIResponse, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetBlockNonceByTimestamp(timestamp uint64) (uint64, error)
 	IsInterfaceNil() bool
 }
 
@@ -107,6 +121,11 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodPost,
 			Handler: ag.getAccounts,
 		},
+		{
+			Path:    getAccountsStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountsStateDiff,
+		},
 		{
 			Path:    getBalancePath,
 			Method:  http.MethodGet,
@@ -180,7 +199,7 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 
 // getAccount returns a response containing information about the account correlated with provided address
 func (ag *addressGroup) getAccount(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccount, err)
 		return
@@ -213,7 +232,7 @@ func (ag *addressGroup) getAccounts(c *gin.Context) {
 		return
 	}
 
-	options, err := extractAccountQueryOptions(c)
+	options, err := extractAccountQueryOptions(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccount, err)
 		return
@@ -228,9 +247,27 @@ func (ag *addressGroup) getAccounts(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"accounts": accountsResponse, "blockInfo": blockInfo})
 }
 
+// getAccountsStateDiff returns the differences between the accounts states of two blocks
+func (ag *addressGroup) getAccountsStateDiff(c *gin.Context) {
+	fromOptions, toOptions, err := extractStateDiffQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountsStateDiff, err)
+		return
+	}
+
+	addresses := parseStringSliceUrlParam(c, urlParamAddresses)
+	stateDiff, err := ag.getFacade().GetAccountsStateDiff(fromOptions, toOptions, addresses)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountsStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"stateDiff": stateDiff})
+}
+
 // getBalance returns the balance for the address parameter
 func (ag *addressGroup) getBalance(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetBalance, err)
 		return
@@ -247,7 +284,7 @@ func (ag *addressGroup) getBalance(c *gin.Context) {
 
 // getUsername returns the username for the address parameter
 func (ag *addressGroup) getUsername(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetUsername, err)
 		return
@@ -264,7 +301,7 @@ func (ag *addressGroup) getUsername(c *gin.Context) {
 
 // getCodeHash returns the code hash for the address parameter
 func (ag *addressGroup) getCodeHash(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetCodeHash, err)
 		return
@@ -287,7 +324,7 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	options, err := extractAccountQueryOptions(c)
+	options, err := extractAccountQueryOptions(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetValueForKey, err)
 		return
@@ -310,7 +347,7 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 
 // getGuardianData returns the guardian data and guarded state for a given account
 func (ag *addressGroup) getGuardianData(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetGuardianData, err)
 		return
@@ -327,7 +364,7 @@ func (ag *addressGroup) getGuardianData(c *gin.Context) {
 
 // addressGroup returns all the key-value pairs for the given address
 func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
 		return
@@ -344,7 +381,7 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 
 // getDCDTBalance returns the balance for the given address and dcdt token
 func (ag *addressGroup) getDCDTBalance(c *gin.Context) {
-	addr, tokenIdentifier, options, err := extractGetDCDTBalanceParams(c)
+	addr, tokenIdentifier, options, err := extractGetDCDTBalanceParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetDCDTBalance, err)
 		return
@@ -367,7 +404,7 @@ func (ag *addressGroup) getDCDTBalance(c *gin.Context) {
 
 // getDCDTsRoles returns the token identifiers and roles for a given address
 func (ag *addressGroup) getDCDTsRoles(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetRolesForAccount, err)
 		return
@@ -384,7 +421,7 @@ func (ag *addressGroup) getDCDTsRoles(c *gin.Context) {
 
 // getDCDTTokensWithRole returns the token identifiers where a given address has the given role
 func (ag *addressGroup) getDCDTTokensWithRole(c *gin.Context) {
-	addr, role, options, err := extractGetDCDTTokensWithRoleParams(c)
+	addr, role, options, err := extractGetDCDTTokensWithRoleParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetDCDTTokensWithRole, err)
 		return
@@ -401,7 +438,7 @@ func (ag *addressGroup) getDCDTTokensWithRole(c *gin.Context) {
 
 // getNFTTokenIDsRegisteredByAddress returns the token identifiers of the tokens where a given address is the owner
 func (ag *addressGroup) getNFTTokenIDsRegisteredByAddress(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrRegisteredNFTTokenIDs, err)
 		return
@@ -418,7 +455,7 @@ func (ag *addressGroup) getNFTTokenIDsRegisteredByAddress(c *gin.Context) {
 
 // getDCDTNFTData returns the nft data for the given token
 func (ag *addressGroup) getDCDTNFTData(c *gin.Context) {
-	addr, tokenIdentifier, nonce, options, err := extractGetDCDTNFTDataParams(c)
+	addr, tokenIdentifier, nonce, options, err := extractGetDCDTNFTDataParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetDCDTNFTData, err)
 		return
@@ -436,7 +473,7 @@ func (ag *addressGroup) getDCDTNFTData(c *gin.Context) {
 
 // getAllDCDTData returns the tokens list from this account
 func (ag *addressGroup) getAllDCDTData(c *gin.Context) {
-	addr, options, err := extractBaseParams(c)
+	addr, options, err := extractBaseParams(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetDCDTNFTData, err)
 		return
@@ -466,7 +503,7 @@ func (ag *addressGroup) isDataTrieMigrated(c *gin.Context) {
 		return
 	}
 
-	options, err := extractAccountQueryOptions(c)
+	options, err := extractAccountQueryOptions(c, ag.getFacade())
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrIsDataTrieMigrated, err)
 		return
@@ -507,13 +544,13 @@ func (ag *addressGroup) getFacade() addressFacadeHandler {
 	return ag.facade
 }
 
-func extractBaseParams(c *gin.Context) (string, api.AccountQueryOptions, error) {
+func extractBaseParams(c *gin.Context, resolver blockNonceByTimestampResolver) (string, api.AccountQueryOptions, error) {
 	addr := c.Param("address")
 	if addr == "" {
 		return "", api.AccountQueryOptions{}, errors.ErrEmptyAddress
 	}
 
-	options, err := extractAccountQueryOptions(c)
+	options, err := extractAccountQueryOptions(c, resolver)
 	if err != nil {
 		return "", api.AccountQueryOptions{}, err
 	}
@@ -521,8 +558,8 @@ func extractBaseParams(c *gin.Context) (string, api.AccountQueryOptions, error)
 	return addr, options, nil
 }
 
-func extractGetDCDTBalanceParams(c *gin.Context) (string, string, api.AccountQueryOptions, error) {
-	addr, options, err := extractBaseParams(c)
+func extractGetDCDTBalanceParams(c *gin.Context, resolver blockNonceByTimestampResolver) (string, string, api.AccountQueryOptions, error) {
+	addr, options, err := extractBaseParams(c, resolver)
 	if err != nil {
 		return "", "", api.AccountQueryOptions{}, err
 	}
@@ -535,8 +572,8 @@ func extractGetDCDTBalanceParams(c *gin.Context) (string, string, api.AccountQue
 	return addr, tokenIdentifier, options, nil
 }
 
-func extractGetDCDTTokensWithRoleParams(c *gin.Context) (string, string, api.AccountQueryOptions, error) {
-	addr, options, err := extractBaseParams(c)
+func extractGetDCDTTokensWithRoleParams(c *gin.Context, resolver blockNonceByTimestampResolver) (string, string, api.AccountQueryOptions, error) {
+	addr, options, err := extractBaseParams(c, resolver)
 	if err != nil {
 		return "", "", api.AccountQueryOptions{}, err
 	}
@@ -553,8 +590,8 @@ func extractGetDCDTTokensWithRoleParams(c *gin.Context) (string, string, api.Acc
 	return addr, role, options, nil
 }
 
-func extractGetDCDTNFTDataParams(c *gin.Context) (string, string, *big.Int, api.AccountQueryOptions, error) {
-	addr, options, err := extractBaseParams(c)
+func extractGetDCDTNFTDataParams(c *gin.Context, resolver blockNonceByTimestampResolver) (string, string, *big.Int, api.AccountQueryOptions, error) {
+	addr, options, err := extractBaseParams(c, resolver)
 	if err != nil {
 		return "", "", nil, api.AccountQueryOptions{}, err
 	}
@@ -5,11 +5,41 @@ import (
 	"fmt"
 
 	"github.com/gin-gonic/gin"
+	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/data/api"
 	customErrors "github.com/kalyan3104/k-chain-go/api/errors"
 )
 
-func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
+type blockCoordinatesUrlParams struct {
+	blockNonce    string
+	blockHash     string
+	blockRootHash string
+	hintEpoch     string
+}
+
+var errTimestampWithOtherBlockCoordinates = errors.New("timestamp is not compatible with any other block coordinates")
+
+var fromStateUrlParams = blockCoordinatesUrlParams{
+	blockNonce:    urlParamFromBlockNonce,
+	blockHash:     urlParamFromBlockHash,
+	blockRootHash: urlParamFromBlockRootHash,
+	hintEpoch:     urlParamFromHintEpoch,
+}
+
+var toStateUrlParams = blockCoordinatesUrlParams{
+	blockNonce:    urlParamToBlockNonce,
+	blockHash:     urlParamToBlockHash,
+	blockRootHash: urlParamToBlockRootHash,
+	hintEpoch:     urlParamToHintEpoch,
+}
+
+// blockNonceByTimestampResolver defines the component able to resolve a timestamp to the nonce of the last block
+// produced at or before that timestamp
+type blockNonceByTimestampResolver interface {
+	GetBlockNonceByTimestamp(timestamp uint64) (uint64, error)
+}
+
+func extractAccountQueryOptions(c *gin.Context, resolver blockNonceByTimestampResolver) (api.AccountQueryOptions, error) {
 	options, err := parseAccountQueryOptions(c)
 	if err != nil {
 		return api.AccountQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
@@ -20,9 +50,43 @@ func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error)
 		return api.AccountQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
 	}
 
+	blockNonce, err := resolveBlockNonceByTimestamp(c, resolver)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+	if !blockNonce.HasValue {
+		return options, nil
+	}
+
+	hasOtherBlockCoordinates := options.OnFinalBlock || options.OnStartOfEpoch.HasValue || options.BlockNonce.HasValue ||
+		len(options.BlockHash) > 0 || len(options.BlockRootHash) > 0 || options.HintEpoch.HasValue
+	if hasOtherBlockCoordinates {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, errTimestampWithOtherBlockCoordinates)
+	}
+
+	options.BlockNonce = blockNonce
 	return options, nil
 }
 
+// resolveBlockNonceByTimestamp returns the nonce of the last block produced at or before the timestamp url parameter,
+// if provided
+func resolveBlockNonceByTimestamp(c *gin.Context, resolver blockNonceByTimestampResolver) (core.OptionalUint64, error) {
+	timestamp, err := parseUint64UrlParam(c, urlParamTimestamp)
+	if err != nil {
+		return core.OptionalUint64{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+	if !timestamp.HasValue {
+		return core.OptionalUint64{}, nil
+	}
+
+	blockNonce, err := resolver.GetBlockNonceByTimestamp(timestamp.Value)
+	if err != nil {
+		return core.OptionalUint64{}, fmt.Errorf("%w for timestamp %d", err, timestamp.Value)
+	}
+
+	return core.OptionalUint64{Value: blockNonce, HasValue: true}, nil
+}
+
 func parseAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
 	onFinalBlock, err := parseBoolUrlParam(c, urlParamOnFinalBlock)
 	if err != nil {
@@ -93,3 +157,67 @@ func checkAccountQueryOptions(options api.AccountQueryOptions) error {
 
 	return nil
 }
+
+func extractStateDiffQueryOptions(c *gin.Context) (api.AccountQueryOptions, api.AccountQueryOptions, error) {
+	fromOptions, err := extractBlockCoordinatesQueryOptions(c, fromStateUrlParams)
+	if err != nil {
+		return api.AccountQueryOptions{}, api.AccountQueryOptions{}, err
+	}
+
+	toOptions, err := extractBlockCoordinatesQueryOptions(c, toStateUrlParams)
+	if err != nil {
+		return api.AccountQueryOptions{}, api.AccountQueryOptions{}, err
+	}
+
+	return fromOptions, toOptions, nil
+}
+
+func extractBlockCoordinatesQueryOptions(c *gin.Context, params blockCoordinatesUrlParams) (api.AccountQueryOptions, error) {
+	options, err := parseBlockCoordinatesQueryOptions(c, params)
+	if err != nil {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	err = checkAccountQueryOptions(options)
+	if err != nil {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	hasBlockCoordinates := options.BlockNonce.HasValue || len(options.BlockHash) > 0 || len(options.BlockRootHash) > 0
+	if !hasBlockCoordinates {
+		return api.AccountQueryOptions{}, fmt.Errorf("%w: one of %s, %s or %s must be specified",
+			customErrors.ErrBadUrlParams, params.blockNonce, params.blockHash, params.blockRootHash)
+	}
+
+	return options, nil
+}
+
+func parseBlockCoordinatesQueryOptions(c *gin.Context, params blockCoordinatesUrlParams) (api.AccountQueryOptions, error) {
+	blockNonce, err := parseUint64UrlParam(c, params.blockNonce)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	blockHash, err := parseHexBytesUrlParam(c, params.blockHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	blockRootHash, err := parseHexBytesUrlParam(c, params.blockRootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	hintEpoch, err := parseUint32UrlParam(c, params.hintEpoch)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	options := api.AccountQueryOptions{
+		BlockNonce:    blockNonce,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		HintEpoch:     hintEpoch,
+	}
+	return options, nil
+}
@@ -1,11 +1,13 @@
 package groups
 
 import (
+	stdErrors "errors"
 	"testing"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/data/api"
 	"github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/mock"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
@@ -16,27 +18,27 @@ func TestExtractAccountQueryOptions(t *testing.T) {
 	t.Run("good options", func(t *testing.T) {
 		t.Parallel()
 
-		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onFinalBlock=true"))
+		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onFinalBlock=true"), &mock.FacadeStub{})
 		require.Nil(t, err)
 		require.True(t, options.OnFinalBlock)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onStartOfEpoch=7"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onStartOfEpoch=7"), &mock.FacadeStub{})
 		require.Nil(t, err)
 		require.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, options.OnStartOfEpoch)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockNonce=42"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockNonce=42"), &mock.FacadeStub{})
 		require.Nil(t, err)
 		require.Equal(t, core.OptionalUint64{Value: 42, HasValue: true}, options.BlockNonce)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa"), &mock.FacadeStub{})
 		require.Nil(t, err)
 		require.Equal(t, []byte{0xaa, 0xaa}, options.BlockHash)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa"), &mock.FacadeStub{})
 		require.Nil(t, err)
 		require.Equal(t, []byte{0xaa, 0xaa}, options.BlockHash)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockRootHash=bbbb&hintEpoch=7"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockRootHash=bbbb&hintEpoch=7"), &mock.FacadeStub{})
 		require.Nil(t, err)
 		require.Equal(t, []byte{0xbb, 0xbb}, options.BlockRootHash)
 		require.Equal(t, uint32(7), options.HintEpoch.Value)
@@ -45,36 +47,89 @@ func TestExtractAccountQueryOptions(t *testing.T) {
 	t.Run("bad options", func(t *testing.T) {
 		t.Parallel()
 
-		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockNonce=42&blockHash=aaaa"))
+		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockNonce=42&blockHash=aaaa"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, "only one block coordinate")
 		require.Equal(t, api.AccountQueryOptions{}, options)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa&blockRootHash=bbbb"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa&blockRootHash=bbbb"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, "only one block coordinate")
 		require.Equal(t, api.AccountQueryOptions{}, options)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onFinalBlock=true&blockHash=aaaa"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onFinalBlock=true&blockHash=aaaa"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, "onFinalBlock is not compatible")
 		require.Equal(t, api.AccountQueryOptions{}, options)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onStartOfEpoch=7&blockRootHash=bbbb"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onStartOfEpoch=7&blockRootHash=bbbb"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, "onStartOfEpoch is not compatible")
 		require.Equal(t, api.AccountQueryOptions{}, options)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onFinalBlock=true&hintEpoch=7"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("onFinalBlock=true&hintEpoch=7"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, "hintEpoch is optional, but only compatible with blockRootHash")
 		require.Equal(t, api.AccountQueryOptions{}, options)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa&hintEpoch=7"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockHash=aaaa&hintEpoch=7"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, "hintEpoch is optional, but only compatible with blockRootHash")
 		require.Equal(t, api.AccountQueryOptions{}, options)
 
-		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockNonce=aaaa"))
+		options, err = extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("blockNonce=aaaa"), &mock.FacadeStub{})
 		require.ErrorContains(t, err, errors.ErrBadUrlParams.Error())
 		require.Equal(t, api.AccountQueryOptions{}, options)
 	})
 }
 
+func TestExtractAccountQueryOptions_Timestamp(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := stdErrors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetBlockNonceByTimestampCalled: func(timestamp uint64) (uint64, error) {
+			if timestamp < 1000 {
+				return 0, expectedErr
+			}
+
+			return timestamp / 6, nil
+		},
+	}
+
+	t.Run("should resolve the block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("timestamp=6000"), facade)
+		require.Nil(t, err)
+		require.Equal(t, api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 1000, HasValue: true}}, options)
+	})
+	t.Run("with other block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		queries := []string{
+			"timestamp=6000&onFinalBlock=true",
+			"timestamp=6000&onStartOfEpoch=7",
+			"timestamp=6000&blockNonce=42",
+			"timestamp=6000&blockHash=aaaa",
+			"timestamp=6000&blockRootHash=bbbb",
+		}
+		for _, query := range queries {
+			options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery(query), facade)
+			require.ErrorContains(t, err, "timestamp is not compatible with any other block coordinates")
+			require.Equal(t, api.AccountQueryOptions{}, options)
+		}
+	})
+	t.Run("invalid timestamp should error", func(t *testing.T) {
+		t.Parallel()
+
+		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("timestamp=test"), facade)
+		require.ErrorContains(t, err, errors.ErrBadUrlParams.Error())
+		require.Equal(t, api.AccountQueryOptions{}, options)
+	})
+	t.Run("resolver error should error", func(t *testing.T) {
+		t.Parallel()
+
+		options, err := extractAccountQueryOptions(testscommon.CreateGinContextWithRawQuery("timestamp=999"), facade)
+		require.True(t, stdErrors.Is(err, expectedErr))
+		require.Equal(t, api.AccountQueryOptions{}, options)
+	})
+}
+
 func TestParseAccountQueryOptions(t *testing.T) {
 	t.Parallel()
 
@@ -112,3 +167,42 @@ func TestParseAccountQueryOptions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, api.AccountQueryOptions{}, options)
 }
+
+func TestExtractStateDiffQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("good options", func(t *testing.T) {
+		t.Parallel()
+
+		fromOptions, toOptions, err := extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("fromBlockNonce=42&toBlockHash=aaaa"))
+		require.Nil(t, err)
+		require.Equal(t, api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 42, HasValue: true}}, fromOptions)
+		require.Equal(t, api.AccountQueryOptions{BlockHash: []byte{0xaa, 0xaa}}, toOptions)
+
+		fromOptions, toOptions, err = extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("fromBlockRootHash=bbbb&fromHintEpoch=7&toBlockRootHash=cccc"))
+		require.Nil(t, err)
+		require.Equal(t, []byte{0xbb, 0xbb}, fromOptions.BlockRootHash)
+		require.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, fromOptions.HintEpoch)
+		require.Equal(t, []byte{0xcc, 0xcc}, toOptions.BlockRootHash)
+		require.False(t, toOptions.HintEpoch.HasValue)
+	})
+
+	t.Run("bad options", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("toBlockNonce=42"))
+		require.ErrorContains(t, err, "one of fromBlockNonce, fromBlockHash or fromBlockRootHash must be specified")
+
+		_, _, err = extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("fromBlockNonce=42"))
+		require.ErrorContains(t, err, "one of toBlockNonce, toBlockHash or toBlockRootHash must be specified")
+
+		_, _, err = extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("fromBlockNonce=42&fromBlockHash=aaaa&toBlockNonce=43"))
+		require.ErrorContains(t, err, "only one block coordinate")
+
+		_, _, err = extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("fromBlockNonce=42&toBlockHash=aaaa&toHintEpoch=7"))
+		require.ErrorContains(t, err, "hintEpoch is optional, but only compatible with blockRootHash")
+
+		_, _, err = extractStateDiffQueryOptions(testscommon.CreateGinContextWithRawQuery("fromBlockNonce=test&toBlockNonce=43"))
+		require.ErrorContains(t, err, errors.ErrBadUrlParams.Error())
+	})
+}
@@ -13,12 +13,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/data/api"
 	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
 	apiErrors "github.com/kalyan3104/k-chain-go/api/errors"
 	"github.com/kalyan3104/k-chain-go/api/groups"
 	"github.com/kalyan3104/k-chain-go/api/mock"
 	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -125,6 +127,16 @@ type keyValuePairsResponse struct {
 	Code  string
 }
 
+type accountsStateDiffResponseData struct {
+	StateDiff *common.AccountsStateDiffAPIResponse `json:"stateDiff"`
+}
+
+type accountsStateDiffResponse struct {
+	Data  accountsStateDiffResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string
+}
+
 type dcdtRolesResponseData struct {
 	Roles map[string][]string `json:"roles"`
 }
@@ -297,6 +309,53 @@ func TestAddressGroup_getBalance(t *testing.T) {
 		assert.Equal(t, amount, balanceResponse)
 		assert.Equal(t, "", response.Error)
 	})
+	t.Run("should work with timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		amount := big.NewInt(10)
+		facade := &mock.FacadeStub{
+			GetBlockNonceByTimestampCalled: func(timestamp uint64) (uint64, error) {
+				assert.Equal(t, uint64(1700000000), timestamp)
+				return 37, nil
+			},
+			GetBalanceCalled: func(s string, options api.AccountQueryOptions) (i *big.Int, info api.BlockInfo, e error) {
+				assert.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, options.BlockNonce)
+				return amount, api.BlockInfo{Nonce: 37}, nil
+			},
+		}
+
+		response := &shared.GenericAPIResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/testAddress/balance?timestamp=1700000000",
+			"GET",
+			nil,
+			response,
+		)
+
+		assert.Equal(t, amount.String(), getValueForKey(response.Data, "balance"))
+		assert.Equal(t, "", response.Error)
+	})
+	t.Run("timestamp not resolved should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockNonceByTimestampCalled: func(timestamp uint64) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+
+		testAddressGroup(
+			t,
+			facade,
+			"/address/moa1alice/balance?timestamp=1700000000",
+			"GET",
+			nil,
+			http.StatusBadRequest,
+			formatExpectedErr(apiErrors.ErrGetBalance, expectedErr),
+		)
+	})
 }
 
 func getValueForKey(dataFromResponse interface{}, key string) string {
@@ -662,6 +721,69 @@ func TestAddressGroup_getKeyValuePairs(t *testing.T) {
 	})
 }
 
+func TestAddressGroup_getAccountsStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing block coordinates should error",
+		testErrorScenario("/address/state-diff?fromBlockNonce=1", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountsStateDiff, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid query options should error",
+		testErrorScenario("/address/state-diff?fromBlockNonce=not-uint64&toBlockNonce=2", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountsStateDiff, apiErrors.ErrBadUrlParams)))
+	t.Run("with node fail should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountsStateDiffCalled: func(_ api.AccountQueryOptions, _ api.AccountQueryOptions, _ []string) (*common.AccountsStateDiffAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/state-diff?fromBlockNonce=1&toBlockNonce=2",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAccountsStateDiff, expectedErr),
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		stateDiff := &common.AccountsStateDiffAPIResponse{
+			FromBlock: api.BlockInfo{Nonce: 1},
+			ToBlock:   api.BlockInfo{RootHash: "bbbb"},
+			Accounts: []*common.AccountStateDiffAPI{
+				{
+					Address: "moa1alice",
+					Status:  "modified",
+					Balance: &common.ValueDiffAPI{Old: "1", New: "2"},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountsStateDiffCalled: func(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string) (*common.AccountsStateDiffAPIResponse, error) {
+				assert.Equal(t, uint64(1), fromOptions.BlockNonce.Value)
+				assert.Equal(t, []byte{0xbb, 0xbb}, toOptions.BlockRootHash)
+				assert.Equal(t, []string{"moa1alice", "moa1bob"}, addresses)
+				return stateDiff, nil
+			},
+		}
+
+		response := &accountsStateDiffResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/state-diff?fromBlockNonce=1&toBlockRootHash=bbbb&addresses=moa1alice,moa1bob",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, stateDiff, response.Data.StateDiff)
+	})
+}
+
 func TestAddressGroup_getDCDTBalance(t *testing.T) {
 	t.Parallel()
 
@@ -1138,6 +1260,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/:address", Open: true},
 					{Name: "/bulk", Open: true},
+					{Name: "/state-diff", Open: true},
 					{Name: "/:address/guardian-data", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/username", Open: true},
@@ -1,6 +1,10 @@
 package groups
 
-import "github.com/kalyan3104/k-chain-go/process"
+import (
+	"net/http"
+
+	"github.com/kalyan3104/k-chain-go/process"
+)
 
 // ExecManualTrigger -
 const ExecManualTrigger = execManualTrigger
@@ -8,6 +12,14 @@ const ExecManualTrigger = execManualTrigger
 // ExecBroadcastTrigger -
 const ExecBroadcastTrigger = execBroadcastTrigger
 
+// MaxJsonRpcWsMessagesPerConnection -
+const MaxJsonRpcWsMessagesPerConnection = maxJsonRpcWsMessagesPerConnection
+
+// CreateWsOriginChecker -
+func CreateWsOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	return createWsOriginChecker(allowedOrigins)
+}
+
 // CreateSCQuery -
 func (vvg *vmValuesGroup) CreateSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
 	return vvg.createSCQuery(request)
@@ -0,0 +1,646 @@
+package groups
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-core-go/data/vm"
+	"github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/node/external"
+	"github.com/kalyan3104/k-chain-go/process"
+)
+
+const (
+	jsonRpcHttpPath = "/http"
+	jsonRpcWsPath   = "/ws"
+
+	jsonRpcVersion                    = "2.0"
+	maxJsonRpcBatchSize               = 100
+	maxJsonRpcWsMessageSizeInB        = 1 << 20
+	maxJsonRpcWsMessagesPerConnection = 10000
+	jsonRpcWsCloseTimeout             = time.Second
+	jsonRpcParseErrorCode             = -32700
+	jsonRpcInvalidRequestCode         = -32600
+	jsonRpcMethodNotFoundCode         = -32601
+	jsonRpcInvalidParamsCode          = -32602
+	jsonRpcInternalErrorCode          = -32603
+	jsonRpcTooManyRequestsCode        = -32005
+	jsonRpcMethodGetAccount           = "address_getAccount"
+	jsonRpcMethodGetBalance           = "address_getBalance"
+	jsonRpcMethodSendTx               = "tx_send"
+	jsonRpcMethodGetTx                = "tx_get"
+	jsonRpcMethodGetBlockNonce        = "block_byNonce"
+	jsonRpcMethodGetBlockHash         = "block_byHash"
+	jsonRpcMethodVmQuery              = "vm_query"
+	jsonRpcMethodGetProof             = "proof_get"
+	jsonRpcTooManyRequestsScope       = "method"
+)
+
+// jsonRpcFacadeHandler defines the methods to be implemented by a facade for handling JSON-RPC requests
+type jsonRpcFacadeHandler interface {
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// JsonRpcRequest defines a JSON-RPC 2.0 request object
+type JsonRpcRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// JsonRpcResponse defines a JSON-RPC 2.0 response object
+type JsonRpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *JsonRpcError   `json:"error,omitempty"`
+}
+
+// JsonRpcError defines a JSON-RPC 2.0 error object
+type JsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRpcMethodHandler func(params json.RawMessage) (interface{}, *JsonRpcError)
+
+type jsonRpcMethod struct {
+	handler           jsonRpcMethodHandler
+	throttlerEndpoint string
+}
+
+type jsonRpcAccountParams struct {
+	Address        string  `json:"address"`
+	OnFinalBlock   bool    `json:"onFinalBlock"`
+	OnStartOfEpoch *uint32 `json:"onStartOfEpoch"`
+	BlockNonce     *uint64 `json:"blockNonce"`
+	BlockHash      string  `json:"blockHash"`
+	BlockRootHash  string  `json:"blockRootHash"`
+	HintEpoch      *uint32 `json:"hintEpoch"`
+	WithKeys       bool    `json:"withKeys"`
+}
+
+type jsonRpcTransactionParams struct {
+	Hash        string `json:"hash"`
+	WithResults bool   `json:"withResults"`
+}
+
+type jsonRpcBlockParams struct {
+	Nonce    uint64 `json:"nonce"`
+	Hash     string `json:"hash"`
+	WithTxs  bool   `json:"withTxs"`
+	WithLogs bool   `json:"withLogs"`
+}
+
+type jsonRpcVmQueryParams struct {
+	VMValueRequest
+	BlockNonce *uint64 `json:"blockNonce"`
+	BlockHash  string  `json:"blockHash"`
+}
+
+type jsonRpcProofParams struct {
+	RootHash string `json:"rootHash"`
+	Address  string `json:"address"`
+}
+
+type jsonRpcGroup struct {
+	*baseGroup
+	facade         jsonRpcFacadeHandler
+	mutFacade      sync.RWMutex
+	methods        map[string]*jsonRpcMethod
+	upgrader       websocket.Upgrader
+	callsThrottler shared.CallsThrottler
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup. The calls throttler is applied to each call of a batch and
+// to each call received over a websocket connection, as the web server middlewares only see the enclosing request
+func NewJsonRpcGroup(facade jsonRpcFacadeHandler, callsThrottler shared.CallsThrottler) (*jsonRpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilFacadeHandler)
+	}
+	if check.IfNil(callsThrottler) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilCallsThrottler)
+	}
+
+	jg := &jsonRpcGroup{
+		facade:         facade,
+		baseGroup:      &baseGroup{},
+		callsThrottler: callsThrottler,
+	}
+
+	jg.methods = map[string]*jsonRpcMethod{
+		jsonRpcMethodGetAccount:    {handler: jg.getAccount},
+		jsonRpcMethodGetBalance:    {handler: jg.getBalance},
+		jsonRpcMethodSendTx:        {handler: jg.sendTransaction, throttlerEndpoint: sendTransactionEndpoint},
+		jsonRpcMethodGetTx:         {handler: jg.getTransaction, throttlerEndpoint: getTransactionEndpoint},
+		jsonRpcMethodGetBlockNonce: {handler: jg.getBlockByNonce},
+		jsonRpcMethodGetBlockHash:  {handler: jg.getBlockByHash},
+		jsonRpcMethodVmQuery:       {handler: jg.executeQuery},
+		jsonRpcMethodGetProof:      {handler: jg.getProof, throttlerEndpoint: getProofEndpoint},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    jsonRpcHttpPath,
+			Method:  http.MethodPost,
+			Handler: jg.handleHttpRequest,
+		},
+		{
+			Path:    jsonRpcWsPath,
+			Method:  http.MethodGet,
+			Handler: jg.handleWsConnection,
+		},
+	}
+	jg.endpoints = endpoints
+
+	return jg, nil
+}
+
+// RegisterRoutes will register all the endpoints, after applying the allowed websocket origins from the config
+func (jg *jsonRpcGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	jg.upgrader.CheckOrigin = createWsOriginChecker(apiConfig.WebSocket.AllowedOrigins)
+	jg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// handleHttpRequest will process a single or a batched JSON-RPC request received over HTTP
+func (jg *jsonRpcGroup) handleHttpRequest(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, jsonRpcParseErrorCode, errors.ErrInvalidJSONRequest.Error()))
+		return
+	}
+
+	// a single call was already throttled by the middlewares, as the enclosing request
+	response, hasResponse := jg.processPayload(payload, getRequestSource(c), false)
+	if !hasResponse {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// handleWsConnection will upgrade the connection and will process each received message as a JSON-RPC payload
+func (jg *jsonRpcGroup) handleWsConnection(c *gin.Context) {
+	conn, err := jg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("cannot upgrade json-rpc connection", "error", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	source := getRequestSource(c)
+	conn.SetReadLimit(maxJsonRpcWsMessageSizeInB)
+	for numMessages := 1; ; numMessages++ {
+		_, payload, errRead := conn.ReadMessage()
+		if errRead != nil {
+			log.Trace("json-rpc websocket connection closed", "error", errRead)
+			return
+		}
+		if numMessages > maxJsonRpcWsMessagesPerConnection {
+			log.Debug("closing json-rpc websocket connection", "source", source, "error", errors.ErrTooManyJsonRpcWsMessages)
+			closeMessage := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, errors.ErrTooManyJsonRpcWsMessages.Error())
+			_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(jsonRpcWsCloseTimeout))
+			return
+		}
+
+		response, hasResponse := jg.processPayload(payload, source, true)
+		if !hasResponse {
+			continue
+		}
+
+		errWrite := conn.WriteJSON(response)
+		if errWrite != nil {
+			log.Debug("cannot write json-rpc response", "error", errWrite)
+			return
+		}
+	}
+}
+
+func (jg *jsonRpcGroup) processPayload(payload []byte, source string, shouldThrottleSingleCall bool) (interface{}, bool) {
+	payload = bytes.TrimSpace(payload)
+	if len(payload) == 0 || payload[0] != '[' {
+		return jg.processRawRequest(payload, source, shouldThrottleSingleCall)
+	}
+
+	var rawRequests []json.RawMessage
+	err := json.Unmarshal(payload, &rawRequests)
+	if err != nil {
+		return newJsonRpcErrorResponse(nil, jsonRpcParseErrorCode, errors.ErrInvalidJSONRequest.Error()), true
+	}
+	if len(rawRequests) == 0 {
+		return newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, errors.ErrInvalidJsonRpcRequest.Error()), true
+	}
+	if len(rawRequests) > maxJsonRpcBatchSize {
+		message := fmt.Sprintf("%s: %d requests, maximum %d", errors.ErrJsonRpcBatchTooLarge.Error(), len(rawRequests), maxJsonRpcBatchSize)
+		return newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, message), true
+	}
+
+	responses := make([]*JsonRpcResponse, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		response, hasResponse := jg.processRawRequest(rawRequest, source, true)
+		if hasResponse {
+			responses = append(responses, response)
+		}
+	}
+
+	return responses, len(responses) > 0
+}
+
+func (jg *jsonRpcGroup) processRawRequest(rawRequest []byte, source string, shouldThrottle bool) (*JsonRpcResponse, bool) {
+	request := &JsonRpcRequest{}
+	err := json.Unmarshal(rawRequest, request)
+	if err != nil {
+		_, isSyntaxError := err.(*json.SyntaxError)
+		if isSyntaxError {
+			return newJsonRpcErrorResponse(nil, jsonRpcParseErrorCode, errors.ErrInvalidJSONRequest.Error()), true
+		}
+
+		return newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, errors.ErrInvalidJsonRpcRequest.Error()), true
+	}
+	if request.JsonRpc != jsonRpcVersion || len(request.Method) == 0 {
+		return newJsonRpcErrorResponse(request.ID, jsonRpcInvalidRequestCode, errors.ErrInvalidJsonRpcRequest.Error()), true
+	}
+
+	var result interface{}
+	var rpcErr *JsonRpcError
+	if shouldThrottle {
+		result, rpcErr = jg.executeThrottledMethod(request, source)
+	} else {
+		result, rpcErr = jg.executeMethod(request)
+	}
+
+	// requests without an id are notifications and do not expect a response
+	isNotification := len(request.ID) == 0
+	if isNotification {
+		return nil, false
+	}
+	if rpcErr != nil {
+		return &JsonRpcResponse{JsonRpc: jsonRpcVersion, ID: request.ID, Error: rpcErr}, true
+	}
+
+	return &JsonRpcResponse{JsonRpc: jsonRpcVersion, ID: request.ID, Result: result}, true
+}
+
+func (jg *jsonRpcGroup) executeThrottledMethod(request *JsonRpcRequest, source string) (interface{}, *JsonRpcError) {
+	err := jg.callsThrottler.StartProcessingCall(source, request.Method)
+	if err != nil {
+		return nil, &JsonRpcError{
+			Code:    jsonRpcTooManyRequestsCode,
+			Message: err.Error(),
+		}
+	}
+	defer jg.callsThrottler.EndProcessingCall(request.Method)
+
+	return jg.executeMethod(request)
+}
+
+func (jg *jsonRpcGroup) executeMethod(request *JsonRpcRequest) (interface{}, *JsonRpcError) {
+	method, found := jg.methods[request.Method]
+	if !found {
+		return nil, &JsonRpcError{
+			Code:    jsonRpcMethodNotFoundCode,
+			Message: fmt.Sprintf("%s: %s", errors.ErrJsonRpcMethodNotFound.Error(), request.Method),
+		}
+	}
+
+	if len(method.throttlerEndpoint) == 0 {
+		return method.handler(request.Params)
+	}
+
+	endpointThrottler, found := jg.getFacade().GetThrottlerForEndpoint(method.throttlerEndpoint)
+	if !found {
+		return method.handler(request.Params)
+	}
+	if !endpointThrottler.CanProcess() {
+		return nil, &JsonRpcError{
+			Code:    jsonRpcTooManyRequestsCode,
+			Message: fmt.Sprintf("%s for %s %s", errors.ErrTooManyRequests.Error(), jsonRpcTooManyRequestsScope, request.Method),
+		}
+	}
+
+	endpointThrottler.StartProcessing()
+	defer endpointThrottler.EndProcessing()
+
+	return method.handler(request.Params)
+}
+
+func (jg *jsonRpcGroup) getAccount(params json.RawMessage) (interface{}, *JsonRpcError) {
+	address, options, rpcErr := extractJsonRpcAccountParams(params, errors.ErrCouldNotGetAccount)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	accountResponse, blockInfo, err := jg.getFacade().GetAccount(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	accountResponse.Address = address
+	return gin.H{"account": accountResponse, "blockInfo": blockInfo}, nil
+}
+
+func (jg *jsonRpcGroup) getBalance(params json.RawMessage) (interface{}, *JsonRpcError) {
+	address, options, rpcErr := extractJsonRpcAccountParams(params, errors.ErrGetBalance)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	balance, blockInfo, err := jg.getFacade().GetBalance(address, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBalance, err)
+	}
+
+	return gin.H{"balance": balance.String(), "blockInfo": blockInfo}, nil
+}
+
+func (jg *jsonRpcGroup) sendTransaction(params json.RawMessage) (interface{}, *JsonRpcError) {
+	ftx := &transaction.FrontendTransaction{}
+	err := unmarshalJsonRpcParams(params, ftx)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrValidation, err)
+	}
+
+	tx, txHash, err := jg.getFacade().CreateTransaction(createTransactionArgs(ftx))
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrTxGenerationFailed, err)
+	}
+
+	err = jg.getFacade().ValidateTransaction(tx)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrTxGenerationFailed, err)
+	}
+
+	_, err = jg.getFacade().SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrTxGenerationFailed, err)
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func (jg *jsonRpcGroup) getTransaction(params json.RawMessage) (interface{}, *JsonRpcError) {
+	txParams := &jsonRpcTransactionParams{}
+	err := unmarshalJsonRpcParams(params, txParams)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrValidation, err)
+	}
+	if len(txParams.Hash) == 0 {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+	}
+
+	tx, err := jg.getFacade().GetTransaction(txParams.Hash, txParams.WithResults)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetTransaction, err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func (jg *jsonRpcGroup) getBlockByNonce(params json.RawMessage) (interface{}, *JsonRpcError) {
+	blockParams := &jsonRpcBlockParams{}
+	err := unmarshalJsonRpcParams(params, blockParams)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrGetBlock, err)
+	}
+
+	options := api.BlockQueryOptions{WithTransactions: blockParams.WithTxs, WithLogs: blockParams.WithLogs}
+	block, err := jg.getFacade().GetBlockByNonce(blockParams.Nonce, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (jg *jsonRpcGroup) getBlockByHash(params json.RawMessage) (interface{}, *JsonRpcError) {
+	blockParams := &jsonRpcBlockParams{}
+	err := unmarshalJsonRpcParams(params, blockParams)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrGetBlock, err)
+	}
+	if len(blockParams.Hash) == 0 {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrGetBlock, errors.ErrValidationEmptyBlockHash)
+	}
+
+	options := api.BlockQueryOptions{WithTransactions: blockParams.WithTxs, WithLogs: blockParams.WithLogs}
+	block, err := jg.getFacade().GetBlockByHash(blockParams.Hash, options)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (jg *jsonRpcGroup) executeQuery(params json.RawMessage) (interface{}, *JsonRpcError) {
+	queryParams := &jsonRpcVmQueryParams{}
+	err := unmarshalJsonRpcParams(params, queryParams)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrQueryError, err)
+	}
+
+	command, err := createSCQuery(jg.getFacade(), &queryParams.VMValueRequest)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrQueryError, err)
+	}
+
+	command.BlockHash, err = hex.DecodeString(queryParams.BlockHash)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrQueryError, fmt.Errorf("%w for block hash", err))
+	}
+	if queryParams.BlockNonce != nil {
+		command.BlockNonce = core.OptionalUint64{Value: *queryParams.BlockNonce, HasValue: true}
+	}
+
+	vmOutputApi, blockInfo, err := jg.getFacade().ExecuteSCQuery(command)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrQueryError, err)
+	}
+
+	return gin.H{"data": vmOutputApi, "blockInfo": blockInfo}, nil
+}
+
+func (jg *jsonRpcGroup) getProof(params json.RawMessage) (interface{}, *JsonRpcError) {
+	proofParams := &jsonRpcProofParams{}
+	err := unmarshalJsonRpcParams(params, proofParams)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrValidation, err)
+	}
+	if len(proofParams.RootHash) == 0 {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+	}
+	if len(proofParams.Address) == 0 {
+		return nil, newJsonRpcInvalidParamsError(errors.ErrValidation, errors.ErrValidationEmptyAddress)
+	}
+
+	response, err := jg.getFacade().GetProof(proofParams.RootHash, proofParams.Address)
+	if err != nil {
+		return nil, newJsonRpcInternalError(errors.ErrGetProof, err)
+	}
+
+	return gin.H{"proof": bytesToHex(response.Proof), "value": hex.EncodeToString(response.Value)}, nil
+}
+
+func extractJsonRpcAccountParams(params json.RawMessage, errScope error) (string, api.AccountQueryOptions, *JsonRpcError) {
+	accountParams := &jsonRpcAccountParams{}
+	err := unmarshalJsonRpcParams(params, accountParams)
+	if err != nil {
+		return "", api.AccountQueryOptions{}, newJsonRpcInvalidParamsError(errScope, err)
+	}
+	if len(accountParams.Address) == 0 {
+		return "", api.AccountQueryOptions{}, newJsonRpcInvalidParamsError(errScope, errors.ErrEmptyAddress)
+	}
+
+	options, err := accountParams.toAccountQueryOptions()
+	if err != nil {
+		return "", api.AccountQueryOptions{}, newJsonRpcInvalidParamsError(errScope, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+	}
+
+	err = checkAccountQueryOptions(options)
+	if err != nil {
+		return "", api.AccountQueryOptions{}, newJsonRpcInvalidParamsError(errScope, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+	}
+
+	return accountParams.Address, options, nil
+}
+
+func (params *jsonRpcAccountParams) toAccountQueryOptions() (api.AccountQueryOptions, error) {
+	blockHash, err := hex.DecodeString(params.BlockHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	blockRootHash, err := hex.DecodeString(params.BlockRootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	options := api.AccountQueryOptions{
+		OnFinalBlock:  params.OnFinalBlock,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		WithKeys:      params.WithKeys,
+	}
+	if params.OnStartOfEpoch != nil {
+		options.OnStartOfEpoch = core.OptionalUint32{Value: *params.OnStartOfEpoch, HasValue: true}
+	}
+	if params.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: *params.BlockNonce, HasValue: true}
+	}
+	if params.HintEpoch != nil {
+		options.HintEpoch = core.OptionalUint32{Value: *params.HintEpoch, HasValue: true}
+	}
+
+	return options, nil
+}
+
+func unmarshalJsonRpcParams(params json.RawMessage, destination interface{}) error {
+	if len(params) == 0 {
+		return errors.ErrInvalidJsonRpcParams
+	}
+
+	err := json.Unmarshal(params, destination)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrInvalidJsonRpcParams, err)
+	}
+
+	return nil
+}
+
+func getRequestSource(c *gin.Context) string {
+	remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		return c.Request.RemoteAddr
+	}
+
+	return remoteAddr
+}
+
+func newJsonRpcErrorResponse(id json.RawMessage, code int, message string) *JsonRpcResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return &JsonRpcResponse{
+		JsonRpc: jsonRpcVersion,
+		ID:      id,
+		Error: &JsonRpcError{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
+
+func newJsonRpcInvalidParamsError(errScope error, err error) *JsonRpcError {
+	return &JsonRpcError{
+		Code:    jsonRpcInvalidParamsCode,
+		Message: fmt.Sprintf("%s: %s", errScope.Error(), err.Error()),
+	}
+}
+
+func newJsonRpcInternalError(errScope error, err error) *JsonRpcError {
+	return &JsonRpcError{
+		Code:    jsonRpcInternalErrorCode,
+		Message: fmt.Sprintf("%s: %s", errScope.Error(), err.Error()),
+	}
+}
+
+func (jg *jsonRpcGroup) getFacade() jsonRpcFacadeHandler {
+	jg.mutFacade.RLock()
+	defer jg.mutFacade.RUnlock()
+
+	return jg.facade
+}
+
+// UpdateFacade will update the facade
+func (jg *jsonRpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(jsonRpcFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for json-rpc group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	jg.mutFacade.Lock()
+	jg.facade = castFacade
+	jg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jg *jsonRpcGroup) IsInterfaceNil() bool {
+	return jg == nil
+}
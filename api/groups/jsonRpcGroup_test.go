@@ -0,0 +1,769 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-core-go/data/vm"
+	apiErrors "github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/groups"
+	"github.com/kalyan3104/k-chain-go/api/mock"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/node/external"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonRpcTestResponse struct {
+	JsonRpc string                 `json:"jsonrpc"`
+	ID      json.RawMessage        `json:"id"`
+	Result  map[string]interface{} `json:"result"`
+	Error   *groups.JsonRpcError   `json:"error"`
+}
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(nil, &mock.CallsThrottlerStub{})
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("nil calls throttler", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(&mock.FacadeStub{}, nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilCallsThrottler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(&mock.FacadeStub{}, &mock.CallsThrottlerStub{})
+		require.NoError(t, err)
+		require.NotNil(t, jg)
+	})
+}
+
+func TestJsonRpcGroup_InvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("malformed json should return parse error", func(t *testing.T) {
+		t.Parallel()
+
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc": "2.0", "method"`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32700, response.Error.Code)
+		assert.Equal(t, "null", string(response.ID))
+	})
+	t.Run("wrong version should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc": "1.0", "id": 1, "method": "tx_get"}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32600, response.Error.Code)
+		assert.Equal(t, "1", string(response.ID))
+	})
+	t.Run("unknown method should return method not found", func(t *testing.T) {
+		t.Parallel()
+
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc": "2.0", "id": "a", "method": "unknown_method"}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32601, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrJsonRpcMethodNotFound.Error()))
+		assert.Equal(t, `"a"`, string(response.ID))
+	})
+	t.Run("missing params should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc": "2.0", "id": 1, "method": "address_getAccount"}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32602, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrInvalidJsonRpcParams.Error()))
+	})
+	t.Run("conflicting account options should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "address_getAccount", "params": {"address": "erd1", "onFinalBlock": true, "blockNonce": 7}}`
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32602, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("empty batch should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, `[]`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32600, response.Error.Code)
+	})
+	t.Run("batch too large should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		requests := make([]string, 0, 101)
+		for i := 0; i < 101; i++ {
+			requests = append(requests, `{"jsonrpc": "2.0", "id": 1, "method": "tx_get"}`)
+		}
+
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, "["+strings.Join(requests, ",")+"]")
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32600, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrJsonRpcBatchTooLarge.Error()))
+	})
+}
+
+func TestJsonRpcGroup_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "address_getAccount", "params": {"address": "erd1"}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32603, response.Error.Code)
+		assert.Equal(t, apiErrors.ErrCouldNotGetAccount.Error()+": "+expectedErr.Error(), response.Error.Message)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				assert.Equal(t, "erd1", address)
+				assert.Equal(t, core.OptionalUint64{Value: 7, HasValue: true}, options.BlockNonce)
+				assert.True(t, options.WithKeys)
+				return api.AccountResponse{Balance: "100", Nonce: 3}, api.BlockInfo{Nonce: 7}, nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "address_getAccount", "params": {"address": "erd1", "blockNonce": 7, "withKeys": true}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.Nil(t, response.Error)
+		assert.Equal(t, "2.0", response.JsonRpc)
+
+		account := response.Result["account"].(map[string]interface{})
+		assert.Equal(t, "erd1", account["address"])
+		assert.Equal(t, "100", account["balance"])
+		blockInfo := response.Result["blockInfo"].(map[string]interface{})
+		assert.Equal(t, float64(7), blockInfo["nonce"])
+	})
+}
+
+func TestJsonRpcGroup_GetBalance(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			assert.True(t, options.OnFinalBlock)
+			return big.NewInt(37), api.BlockInfo{}, nil
+		},
+	}
+
+	body := `{"jsonrpc": "2.0", "id": 1, "method": "address_getBalance", "params": {"address": "erd1", "onFinalBlock": true}}`
+	response := doJsonRpcRequest(t, facade, body)
+	require.Nil(t, response.Error)
+	assert.Equal(t, "37", response.Result["balance"])
+}
+
+func TestJsonRpcGroup_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validation error should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				return &transaction.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+				return expectedErr
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				require.Fail(t, "should have not been called")
+				return 0, nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "tx_send", "params": {"sender": "erd1", "receiver": "erd2", "value": "1"}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32602, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrTxGenerationFailed.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := uint32(0)
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				assert.Equal(t, "erd1", txArgs.Sender)
+				assert.Equal(t, "erd2", txArgs.Receiver)
+				return &transaction.Transaction{}, []byte("hash"), nil
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				atomic.AddUint32(&sentTxs, uint32(len(txs)))
+				return uint64(len(txs)), nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "tx_send", "params": {"sender": "erd1", "receiver": "erd2", "value": "1"}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.Nil(t, response.Error)
+		assert.Equal(t, "68617368", response.Result["txHash"])
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&sentTxs))
+	})
+	t.Run("throttled should return system busy", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "/transaction/send", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil, nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "tx_send", "params": {"sender": "erd1"}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32005, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrTooManyRequests.Error()))
+	})
+}
+
+func TestJsonRpcGroup_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty hash should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "tx_get", "params": {}}`
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32602, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrValidationEmptyTxHash.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				assert.Equal(t, "aabb", hash)
+				assert.True(t, withResults)
+				return &transaction.ApiTransactionResult{Hash: hash, Nonce: 5}, nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "tx_get", "params": {"hash": "aabb", "withResults": true}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.Nil(t, response.Error)
+		tx := response.Result["transaction"].(map[string]interface{})
+		assert.Equal(t, "aabb", tx["hash"])
+	})
+}
+
+func TestJsonRpcGroup_GetBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("by nonce should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				assert.Equal(t, uint64(37), nonce)
+				assert.Equal(t, api.BlockQueryOptions{WithTransactions: true, WithLogs: true}, options)
+				return &api.Block{Nonce: nonce}, nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "block_byNonce", "params": {"nonce": 37, "withTxs": true, "withLogs": true}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.Nil(t, response.Error)
+		block := response.Result["block"].(map[string]interface{})
+		assert.Equal(t, float64(37), block["nonce"])
+	})
+	t.Run("by hash with empty hash should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "block_byHash", "params": {}}`
+		response := doJsonRpcRequest(t, &mock.FacadeStub{}, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32602, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrValidationEmptyBlockHash.Error()))
+	})
+	t.Run("by hash facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				return nil, expectedErr
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "block_byHash", "params": {"hash": "aabb"}}`
+		response := doJsonRpcRequest(t, facade, body)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, -32603, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrGetBlock.Error()))
+	})
+}
+
+func TestJsonRpcGroup_VmQuery(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+			return []byte(pk), nil
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+			assert.Equal(t, []byte("erd1sc"), query.ScAddress)
+			assert.Equal(t, "getSum", query.FuncName)
+			assert.Equal(t, core.OptionalUint64{Value: 10, HasValue: true}, query.BlockNonce)
+			return &vm.VMOutputApi{ReturnCode: "ok"}, api.BlockInfo{Nonce: 10}, nil
+		},
+	}
+
+	body := `{"jsonrpc": "2.0", "id": 1, "method": "vm_query", "params": {"scAddress": "erd1sc", "funcName": "getSum", "blockNonce": 10}}`
+	response := doJsonRpcRequest(t, facade, body)
+	require.Nil(t, response.Error)
+	data := response.Result["data"].(map[string]interface{})
+	assert.Equal(t, "ok", data["returnCode"])
+}
+
+func TestJsonRpcGroup_GetProof(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetProofCalled: func(rootHash string, address string) (*common.GetProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			return &common.GetProofResponse{
+				Proof: [][]byte{[]byte("valid"), []byte("proof")},
+				Value: []byte("value"),
+			}, nil
+		},
+	}
+
+	body := `{"jsonrpc": "2.0", "id": 1, "method": "proof_get", "params": {"rootHash": "roothash", "address": "addr"}}`
+	response := doJsonRpcRequest(t, facade, body)
+	require.Nil(t, response.Error)
+	assert.Equal(t, []interface{}{"76616c6964", "70726f6f66"}, response.Result["proof"])
+	assert.Equal(t, "76616c7565", response.Result["value"])
+}
+
+func TestJsonRpcGroup_BatchAndNotifications(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batch should return responses for requests with ids", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return big.NewInt(int64(len(address))), api.BlockInfo{}, nil
+			},
+		}
+
+		body := `[
+			{"jsonrpc": "2.0", "id": 1, "method": "address_getBalance", "params": {"address": "a"}},
+			{"jsonrpc": "2.0", "method": "address_getBalance", "params": {"address": "ab"}},
+			{"jsonrpc": "2.0", "id": 3, "method": "address_getBalance", "params": {"address": "abc"}},
+			{"jsonrpc": "2.0", "id": 4, "method": "missing"}
+		]`
+		resp := doJsonRpcHttpRequest(t, facade, body)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		responses := make([]jsonRpcTestResponse, 0)
+		loadResponse(resp.Body, &responses)
+		require.Equal(t, 3, len(responses))
+		assert.Equal(t, "1", string(responses[0].ID))
+		assert.Equal(t, "1", responses[0].Result["balance"])
+		assert.Equal(t, "3", string(responses[1].ID))
+		assert.Equal(t, "3", responses[1].Result["balance"])
+		assert.Equal(t, "4", string(responses[2].ID))
+		require.NotNil(t, responses[2].Error)
+		assert.Equal(t, -32601, responses[2].Error.Code)
+	})
+	t.Run("only notifications should return no content", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return big.NewInt(0), api.BlockInfo{}, nil
+			},
+		}
+
+		body := `[
+			{"jsonrpc": "2.0", "method": "address_getBalance", "params": {"address": "a"}},
+			{"jsonrpc": "2.0", "method": "address_getBalance", "params": {"address": "b"}}
+		]`
+		resp := doJsonRpcHttpRequest(t, facade, body)
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Equal(t, 0, resp.Body.Len())
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestJsonRpcGroup_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(37), api.BlockInfo{}, nil
+		},
+	}
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade, &mock.CallsThrottlerStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	wsUrl := "ws" + strings.TrimPrefix(server.URL, "http") + "/jsonrpc/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(wsUrl, nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "method": "address_getBalance", "params": {"address": "a"}}`))
+	require.NoError(t, err)
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "id": 7, "method": "address_getBalance", "params": {"address": "a"}}`))
+	require.NoError(t, err)
+
+	response := jsonRpcTestResponse{}
+	err = conn.ReadJSON(&response)
+	require.NoError(t, err)
+	assert.Equal(t, "7", string(response.ID))
+	assert.Equal(t, "37", response.Result["balance"])
+}
+
+func TestJsonRpcGroup_CallsThrottling(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(37), api.BlockInfo{}, nil
+		},
+	}
+
+	t.Run("single http call should only be throttled by the middlewares", func(t *testing.T) {
+		t.Parallel()
+
+		numStarted := uint32(0)
+		callsThrottler := &mock.CallsThrottlerStub{
+			StartProcessingCallCalled: func(source string, name string) error {
+				atomic.AddUint32(&numStarted, 1)
+				return nil
+			},
+		}
+
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "address_getBalance", "params": {"address": "a"}}`
+		resp := doThrottledJsonRpcHttpRequest(t, facade, callsThrottler, body)
+
+		response := jsonRpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, "37", response.Result["balance"])
+		assert.Equal(t, uint32(0), atomic.LoadUint32(&numStarted))
+	})
+	t.Run("each call of a batch should be throttled", func(t *testing.T) {
+		t.Parallel()
+
+		numStarted := uint32(0)
+		numEnded := uint32(0)
+		callsThrottler := &mock.CallsThrottlerStub{
+			StartProcessingCallCalled: func(source string, name string) error {
+				assert.Equal(t, "10.0.0.1", source)
+				assert.Equal(t, "address_getBalance", name)
+				atomic.AddUint32(&numStarted, 1)
+				return nil
+			},
+			EndProcessingCallCalled: func(name string) {
+				atomic.AddUint32(&numEnded, 1)
+			},
+		}
+
+		body := `[
+			{"jsonrpc": "2.0", "id": 1, "method": "address_getBalance", "params": {"address": "a"}},
+			{"jsonrpc": "2.0", "method": "address_getBalance", "params": {"address": "b"}},
+			{"jsonrpc": "2.0", "id": 3, "method": "address_getBalance", "params": {"address": "c"}}
+		]`
+		resp := doThrottledJsonRpcHttpRequest(t, facade, callsThrottler, body)
+
+		responses := make([]jsonRpcTestResponse, 0)
+		loadResponse(resp.Body, &responses)
+		require.Equal(t, 2, len(responses))
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numStarted))
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numEnded))
+	})
+	t.Run("throttled call of a batch should return too many requests", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numStarted := uint32(0)
+		numEnded := uint32(0)
+		callsThrottler := &mock.CallsThrottlerStub{
+			StartProcessingCallCalled: func(source string, name string) error {
+				if atomic.AddUint32(&numStarted, 1) > 1 {
+					return expectedErr
+				}
+
+				return nil
+			},
+			EndProcessingCallCalled: func(name string) {
+				atomic.AddUint32(&numEnded, 1)
+			},
+		}
+
+		body := `[
+			{"jsonrpc": "2.0", "id": 1, "method": "address_getBalance", "params": {"address": "a"}},
+			{"jsonrpc": "2.0", "id": 2, "method": "address_getBalance", "params": {"address": "b"}}
+		]`
+		resp := doThrottledJsonRpcHttpRequest(t, facade, callsThrottler, body)
+
+		responses := make([]jsonRpcTestResponse, 0)
+		loadResponse(resp.Body, &responses)
+		require.Equal(t, 2, len(responses))
+		assert.Equal(t, "37", responses[0].Result["balance"])
+		require.NotNil(t, responses[1].Error)
+		assert.Equal(t, -32005, responses[1].Error.Code)
+		assert.Equal(t, expectedErr.Error(), responses[1].Error.Message)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numEnded))
+	})
+	t.Run("each call received over websocket should be throttled", func(t *testing.T) {
+		t.Parallel()
+
+		numStarted := uint32(0)
+		callsThrottler := &mock.CallsThrottlerStub{
+			StartProcessingCallCalled: func(source string, name string) error {
+				atomic.AddUint32(&numStarted, 1)
+				return nil
+			},
+		}
+
+		conn, closeFunc := dialJsonRpcWebSocket(t, facade, callsThrottler, getJsonRpcRoutesConfig())
+		defer closeFunc()
+
+		err := conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "method": "address_getBalance", "params": {"address": "a"}}`))
+		require.NoError(t, err)
+		err = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "id": 7, "method": "address_getBalance", "params": {"address": "a"}}`))
+		require.NoError(t, err)
+
+		response := jsonRpcTestResponse{}
+		err = conn.ReadJSON(&response)
+		require.NoError(t, err)
+		assert.Equal(t, "7", string(response.ID))
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numStarted))
+	})
+}
+
+func TestJsonRpcGroup_WebSocketTooManyMessagesShouldCloseTheConnection(t *testing.T) {
+	t.Parallel()
+
+	conn, closeFunc := dialJsonRpcWebSocket(t, &mock.FacadeStub{}, &mock.CallsThrottlerStub{}, getJsonRpcRoutesConfig())
+	defer closeFunc()
+
+	// notifications do not get responses, so the only message received is the close one
+	notification := []byte(`{"jsonrpc": "2.0", "method": "missing"}`)
+	for i := 0; i <= groups.MaxJsonRpcWsMessagesPerConnection; i++ {
+		err := conn.WriteMessage(websocket.TextMessage, notification)
+		require.NoError(t, err)
+	}
+
+	_, _, err := conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation))
+	assert.True(t, strings.Contains(err.Error(), apiErrors.ErrTooManyJsonRpcWsMessages.Error()))
+}
+
+func TestJsonRpcGroup_WebSocketAllowedOrigins(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no allowed origins should only accept the same origin", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startJsonRpcTestServer(t, &mock.FacadeStub{}, &mock.CallsThrottlerStub{}, getJsonRpcRoutesConfig())
+		defer ws.Close()
+
+		_, resp, err := websocket.DefaultDialer.Dial(getJsonRpcWsUrl(ws), http.Header{"Origin": []string{"https://other.com"}})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		conn, _, err := websocket.DefaultDialer.Dial(getJsonRpcWsUrl(ws), http.Header{"Origin": []string{ws.URL}})
+		require.NoError(t, err)
+		_ = conn.Close()
+	})
+	t.Run("allowed origins should be accepted", func(t *testing.T) {
+		t.Parallel()
+
+		routesConfig := getJsonRpcRoutesConfig()
+		routesConfig.WebSocket.AllowedOrigins = []string{"https://allowed.com"}
+		ws := startJsonRpcTestServer(t, &mock.FacadeStub{}, &mock.CallsThrottlerStub{}, routesConfig)
+		defer ws.Close()
+
+		_, resp, err := websocket.DefaultDialer.Dial(getJsonRpcWsUrl(ws), http.Header{"Origin": []string{"https://other.com"}})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		conn, _, err := websocket.DefaultDialer.Dial(getJsonRpcWsUrl(ws), http.Header{"Origin": []string{"https://allowed.com"}})
+		require.NoError(t, err)
+		_ = conn.Close()
+	})
+}
+
+func TestJsonRpcGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		jsonRpcGroup, err := groups.NewJsonRpcGroup(&mock.FacadeStub{}, &mock.CallsThrottlerStub{})
+		require.NoError(t, err)
+
+		err = jsonRpcGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		jsonRpcGroup, err := groups.NewJsonRpcGroup(&mock.FacadeStub{}, &mock.CallsThrottlerStub{})
+		require.NoError(t, err)
+
+		err = jsonRpcGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		jsonRpcGroup, err := groups.NewJsonRpcGroup(&mock.FacadeStub{}, &mock.CallsThrottlerStub{})
+		require.NoError(t, err)
+
+		newFacade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return big.NewInt(100), api.BlockInfo{}, nil
+			},
+		}
+		err = jsonRpcGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "address_getBalance", "params": {"address": "a"}}`
+		req, _ := http.NewRequest("POST", "/jsonrpc/http", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := jsonRpcTestResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, "100", response.Result["balance"])
+	})
+}
+
+func TestJsonRpcGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	jsonRpcGroup, _ := groups.NewJsonRpcGroup(nil, &mock.CallsThrottlerStub{})
+	require.True(t, jsonRpcGroup.IsInterfaceNil())
+
+	jsonRpcGroup, _ = groups.NewJsonRpcGroup(&mock.FacadeStub{}, &mock.CallsThrottlerStub{})
+	require.False(t, jsonRpcGroup.IsInterfaceNil())
+}
+
+func doJsonRpcHttpRequest(t *testing.T, facade *mock.FacadeStub, body string) *httptest.ResponseRecorder {
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade, &mock.CallsThrottlerStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/jsonrpc/http", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func doThrottledJsonRpcHttpRequest(t *testing.T, facade *mock.FacadeStub, callsThrottler *mock.CallsThrottlerStub, body string) *httptest.ResponseRecorder {
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade, callsThrottler)
+	require.NoError(t, err)
+
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/jsonrpc/http", bytes.NewBufferString(body))
+	req.RemoteAddr = "10.0.0.1:37000"
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func startJsonRpcTestServer(t *testing.T, facade *mock.FacadeStub, callsThrottler *mock.CallsThrottlerStub, routesConfig config.ApiRoutesConfig) *httptest.Server {
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade, callsThrottler)
+	require.NoError(t, err)
+
+	return httptest.NewServer(startWebServer(jsonRpcGroup, "jsonrpc", routesConfig))
+}
+
+func getJsonRpcWsUrl(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/jsonrpc/ws"
+}
+
+func dialJsonRpcWebSocket(
+	t *testing.T,
+	facade *mock.FacadeStub,
+	callsThrottler *mock.CallsThrottlerStub,
+	routesConfig config.ApiRoutesConfig,
+) (*websocket.Conn, func()) {
+	server := startJsonRpcTestServer(t, facade, callsThrottler, routesConfig)
+	conn, _, err := websocket.DefaultDialer.Dial(getJsonRpcWsUrl(server), nil)
+	require.NoError(t, err)
+
+	return conn, func() {
+		_ = conn.Close()
+		server.Close()
+	}
+}
+
+func doJsonRpcRequest(t *testing.T, facade *mock.FacadeStub, body string) jsonRpcTestResponse {
+	resp := doJsonRpcHttpRequest(t, facade, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	response := jsonRpcTestResponse{}
+	loadResponse(resp.Body, &response)
+
+	return response
+}
+
+func getJsonRpcRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"jsonrpc": {
+				Routes: []config.RouteConfig{
+					{Name: "/http", Open: true},
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
+)
+
+const (
+	getEventsPath            = "/events"
+	urlParamEventsAddress    = "address"
+	urlParamEventsIdentifier = "identifier"
+	urlParamEventsTopic      = "topic"
+	urlParamEventsFrom       = "fromBlock"
+	urlParamEventsTo         = "toBlock"
+	urlParamEventsCursor     = "cursor"
+	urlParamEventsLimit      = "limit"
+)
+
+// logsFacadeHandler defines the methods to be implemented by a facade for handling logs requests
+type logsFacadeHandler interface {
+	GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", errors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getEventsPath,
+			Method:  http.MethodGet,
+			Handler: lg.getEvents,
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// getEvents returns the events emitted by an address with the required identifier, filtered by topic and block range
+func (lg *logsGroup) getEvents(c *gin.Context) {
+	options, err := extractEventsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetEvents, err)
+		return
+	}
+
+	response, err := lg.getFacade().GetEvents(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetEvents, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"events": response.Events, "nextCursor": response.NextCursor})
+}
+
+func extractEventsQueryOptions(c *gin.Context) (common.EventsQueryOptions, error) {
+	fromBlock, err := parseUint64UrlParam(c, urlParamEventsFrom)
+	if err != nil {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w for %s: %v", errors.ErrBadUrlParams, urlParamEventsFrom, err)
+	}
+
+	toBlock, err := parseUint64UrlParam(c, urlParamEventsTo)
+	if err != nil {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w for %s: %v", errors.ErrBadUrlParams, urlParamEventsTo, err)
+	}
+
+	limit, err := parseUint32UrlParam(c, urlParamEventsLimit)
+	if err != nil {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w for %s: %v", errors.ErrBadUrlParams, urlParamEventsLimit, err)
+	}
+
+	query := c.Request.URL.Query()
+	address := query.Get(urlParamEventsAddress)
+	if len(address) == 0 {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w: %s must be specified", errors.ErrBadUrlParams, urlParamEventsAddress)
+	}
+
+	identifier := query.Get(urlParamEventsIdentifier)
+	if len(identifier) == 0 {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w: %s must be specified", errors.ErrBadUrlParams, urlParamEventsIdentifier)
+	}
+
+	return common.EventsQueryOptions{
+		Address:    address,
+		Identifier: identifier,
+		Topic:      query.Get(urlParamEventsTopic),
+		FromBlock:  fromBlock.Value,
+		ToBlock:    toBlock.Value,
+		Cursor:     query.Get(urlParamEventsCursor),
+		Limit:      limit.Value,
+	}, nil
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
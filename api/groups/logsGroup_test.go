@@ -0,0 +1,224 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/groups"
+	"github.com/kalyan3104/k-chain-go/api/mock"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventsResponseData struct {
+	Events     []*common.IndexedEventAPI `json:"events"`
+	NextCursor string                    `json:"nextCursor"`
+}
+
+type eventsResponse struct {
+	Data  eventsResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, lg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, lg)
+	})
+}
+
+func TestLogsGroup_getEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing address should error", func(t *testing.T) {
+		t.Parallel()
+
+		testLogsGroupErrorResponse(t, &mock.FacadeStub{}, "/logs/events?identifier=swap", http.StatusBadRequest, "address must be specified")
+	})
+	t.Run("missing identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		testLogsGroupErrorResponse(t, &mock.FacadeStub{}, "/logs/events?address=erd1", http.StatusBadRequest, "identifier must be specified")
+	})
+	t.Run("invalid block range should error", func(t *testing.T) {
+		t.Parallel()
+
+		testLogsGroupErrorResponse(t, &mock.FacadeStub{}, "/logs/events?address=erd1&identifier=swap&fromBlock=a", http.StatusBadRequest, "fromBlock")
+		testLogsGroupErrorResponse(t, &mock.FacadeStub{}, "/logs/events?address=erd1&identifier=swap&toBlock=-1", http.StatusBadRequest, "toBlock")
+	})
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		testLogsGroupErrorResponse(t, &mock.FacadeStub{}, "/logs/events?address=erd1&identifier=swap&limit=b", http.StatusBadRequest, "limit")
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testLogsGroupErrorResponse(t, facade, "/logs/events?address=erd1&identifier=swap", http.StatusInternalServerError, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedOptions := common.EventsQueryOptions{
+			Address:    "erd1",
+			Identifier: "swap",
+			Topic:      "0a0b",
+			FromBlock:  10,
+			ToBlock:    20,
+			Cursor:     "12-3",
+			Limit:      5,
+		}
+		expectedEvents := []*common.IndexedEventAPI{
+			{
+				Address:    "erd1",
+				Identifier: "swap",
+				Topics:     [][]byte{{0xa, 0xb}},
+				Data:       []byte("data"),
+				TxHash:     "aabb",
+				BlockNonce: 12,
+				BlockHash:  "ccdd",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				require.Equal(t, expectedOptions, options)
+				return &common.EventsQueryAPIResponse{
+					Events:     expectedEvents,
+					NextCursor: "13-0",
+				}, nil
+			},
+		}
+
+		logsGroup, err := groups.NewLogsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/logs/events?address=erd1&identifier=swap&topic=0a0b&fromBlock=10&toBlock=20&cursor=12-3&limit=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, expectedEvents, response.Data.Events)
+		assert.Equal(t, "13-0", response.Data.NextCursor)
+	})
+}
+
+func testLogsGroupErrorResponse(t *testing.T, facade *mock.FacadeStub, url string, expectedStatus int, expectedErrorSubstring string) {
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, expectedStatus, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetEvents.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErrorSubstring))
+}
+
+func TestLogsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		logsGroup, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = logsGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		logsGroup, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = logsGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				return &common.EventsQueryAPIResponse{}, nil
+			},
+		}
+
+		logsGroup, err := groups.NewLogsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/logs/events?address=erd1&identifier=swap", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		newFacade := &mock.FacadeStub{
+			GetEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		err = logsGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		req, _ = http.NewRequest("GET", "/logs/events?address=erd1&identifier=swap", nil)
+		resp = httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestLogsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	logsGroup, _ := groups.NewLogsGroup(nil)
+	require.True(t, logsGroup.IsInterfaceNil())
+
+	logsGroup, _ = groups.NewLogsGroup(&mock.FacadeStub{})
+	require.False(t, logsGroup.IsInterfaceNil())
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "/events", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -33,6 +33,7 @@ const (
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
+	integrityPath             = "/integrity"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -43,6 +44,7 @@ type nodeFacadeHandler interface {
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetIntegrityReport() (*common.IntegrityReportAPI, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetLoadedKeys() []string
@@ -151,6 +153,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
 		},
+		{
+			Path:    integrityPath,
+			Method:  http.MethodGet,
+			Handler: ng.integrityReport,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -479,6 +486,17 @@ func (ng *nodeGroup) waitingEpochsLeft(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochsLeft": epochsLeft})
 }
 
+// integrityReport returns the report of the last finished storage integrity scrubber pass
+func (ng *nodeGroup) integrityReport(c *gin.Context) {
+	report, err := ng.getFacade().GetIntegrityReport()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetIntegrityReport, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"report": report})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
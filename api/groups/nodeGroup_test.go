@@ -109,6 +109,13 @@ type waitingEpochsLeftResponse struct {
 	generalResponse
 }
 
+type integrityReportResponse struct {
+	Data struct {
+		Report *common.IntegrityReportAPI `json:"report"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -974,6 +981,75 @@ func TestNodeGroup_WaitingEpochsLeft(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_IntegrityReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetIntegrityReportCalled: func() (*common.IntegrityReportAPI, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/integrity", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReport := &common.IntegrityReportAPI{
+			Enabled:             true,
+			NumPasses:           2,
+			NumCheckedHeaders:   100,
+			NumMissingEntries:   1,
+			NumCorruptedEntries: 0,
+			Issues: []*common.IntegrityIssueAPI{
+				{
+					Type:    "missing",
+					Unit:    "TransactionUnit",
+					Key:     "aabb",
+					Details: "missing transaction",
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetIntegrityReportCalled: func() (*common.IntegrityReportAPI, error) {
+				return providedReport, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/integrity", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &integrityReportResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedReport, response.Data.Report)
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1087,6 +1163,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
 					{Name: "/waiting-epochs-left/:key", Open: true},
+					{Name: "/integrity", Open: true},
 				},
 			},
 		},
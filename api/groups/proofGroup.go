@@ -20,10 +20,16 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getTrieNodeEndpoint             = "/proof/trie-node/:hash"
+	getMultiProofEndpoint           = "/proof/root-hash/:roothash/batch"
+	verifyMultiProofEndpoint        = "/proof/verify-batch"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getTrieNodePath                 = "/trie-node/:hash"
+	getMultiProofPath               = "/root-hash/:roothash/batch"
+	verifyMultiProofPath            = "/verify-batch"
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +38,9 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error)
+	GetTrieNode(hash string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +107,39 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getTrieNodePath,
+			Method:  http.MethodGet,
+			Handler: pg.getTrieNode,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTrieNodeEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +153,25 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// MultiProofRequest represents the parameters needed to compute a Merkle multi-proof
+type MultiProofRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// VerifyMultiProofRequest represents the parameters needed to verify a Merkle multi-proof
+type VerifyMultiProofRequest struct {
+	RootHash string   `json:"roothash"`
+	Keys     []string `json:"keys"`
+	Proof    []string `json:"proof"`
+}
+
+// ProvenKeyResult holds the proven value of a key. Exists is false if the proof shows that the key is absent
+type ProvenKeyResult struct {
+	Key    string `json:"key"`
+	Exists bool   `json:"exists"`
+	Value  string `json:"value"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -238,6 +299,115 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
 }
 
+// getMultiProof will receive an accounts trie rootHash and a list of addresses from the client, and it will return a
+// single Merkle proof for all the addresses, holding the proofs of absence for the ones not found in the trie
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	var multiProofParams = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&multiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(multiProofParams.Keys) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyKeys)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(rootHash, multiProofParams.Keys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":    bytesToHex(response.Proof),
+		"results":  createProvenKeyResults(multiProofParams.Keys, response.Values),
+		"rootHash": response.RootHash,
+	})
+}
+
+// verifyMultiProof will receive a rootHash, a list of keys and a Merkle multi-proof from the client,
+// and it will verify the proof, returning the proven value of each key
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	var verifyMultiProofParams = &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&verifyMultiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(verifyMultiProofParams.Keys) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyKeys)
+		return
+	}
+
+	proof := make([][]byte, 0, len(verifyMultiProofParams.Proof))
+	for _, hexProof := range verifyMultiProofParams.Proof {
+		bytesProof, errDecode := hex.DecodeString(hexProof)
+		if errDecode != nil {
+			shared.RespondWithValidationError(c, errors.ErrValidation, errDecode)
+			return
+		}
+
+		proof = append(proof, bytesProof)
+	}
+
+	proofOk, values, err := pg.getFacade().VerifyMultiProof(verifyMultiProofParams.RootHash, verifyMultiProofParams.Keys, proof)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+	if !proofOk {
+		shared.RespondWithSuccess(c, gin.H{"ok": false})
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"ok":      true,
+		"results": createProvenKeyResults(verifyMultiProofParams.Keys, values),
+	})
+}
+
+func createProvenKeyResults(keys []string, values [][]byte) []ProvenKeyResult {
+	results := make([]ProvenKeyResult, 0, len(keys))
+	for i, key := range keys {
+		result := ProvenKeyResult{
+			Key: key,
+		}
+		if i < len(values) && values[i] != nil {
+			result.Exists = true
+			result.Value = hex.EncodeToString(values[i])
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// getTrieNode will receive a trie node hash from the client, and it will return the encoded trie node, if found
+// in the accounts trie storage
+func (pg *proofGroup) getTrieNode(c *gin.Context) {
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyTrieNodeHash)
+		return
+	}
+
+	trieNode, err := pg.getFacade().GetTrieNode(hash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTrieNode, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"node": hex.EncodeToString(trieNode)})
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -379,6 +379,307 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetTrieNode_GetTrieNodeError(t *testing.T) {
+	t.Parallel()
+
+	getTrieNodeErr := fmt.Errorf("GetTrieNode error")
+	facade := &mock.FacadeStub{
+		GetTrieNodeCalled: func(hash string) ([]byte, error) {
+			return nil, getTrieNodeErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/trie-node/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTrieNode.Error()))
+	assert.True(t, strings.Contains(response.Error, getTrieNodeErr.Error()))
+}
+
+func TestGetTrieNode(t *testing.T) {
+	t.Parallel()
+
+	hash := "aabb"
+	trieNode := []byte("encoded trie node")
+	facade := &mock.FacadeStub{
+		GetTrieNodeCalled: func(providedHash string) ([]byte, error) {
+			assert.Equal(t, hash, providedHash)
+
+			return trieNode, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/trie-node/"+hash, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, hex.EncodeToString(trieNode), responseMap["node"])
+}
+
+func TestGetMultiProof_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	t.Run("invalid body", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/batch", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("empty keys", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/batch", bytes.NewBuffer([]byte(`{"keys":[]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyKeys.Error()))
+	})
+}
+
+func TestGetMultiProof_GetMultiProofError(t *testing.T) {
+	t.Parallel()
+
+	getMultiProofErr := fmt.Errorf("GetMultiProof error")
+	facade := &mock.FacadeStub{
+		GetMultiProofCalled: func(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+			return nil, getMultiProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{Keys: []string{"key"}})
+	req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/batch", bytes.NewBuffer(multiProofBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, getMultiProofErr.Error()))
+}
+
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"present", "absent"}
+	facade := &mock.FacadeStub{
+		GetMultiProofCalled: func(rootHash string, providedKeys []string) (*common.GetMultiProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, keys, providedKeys)
+			return &common.GetMultiProofResponse{
+				Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+				Values:   [][]byte{[]byte("value"), nil},
+				RootHash: rootHash,
+			}, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{Keys: keys})
+	req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/batch", bytes.NewBuffer(multiProofBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Proof    []string                 `json:"proof"`
+			Results  []groups.ProvenKeyResult `json:"results"`
+			RootHash string                   `json:"rootHash"`
+		} `json:"data"`
+		Code shared.ReturnCode `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}, response.Data.Proof)
+	assert.Equal(t, "roothash", response.Data.RootHash)
+	assert.Equal(t, []groups.ProvenKeyResult{
+		{Key: "present", Exists: true, Value: hex.EncodeToString([]byte("value"))},
+		{Key: "absent", Exists: false, Value: ""},
+	}, response.Data.Results)
+}
+
+func TestVerifyMultiProof_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	t.Run("invalid body", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/proof/verify-batch", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("empty keys", func(t *testing.T) {
+		verifyMultiProofBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{RootHash: "rootHash"})
+		req, _ := http.NewRequest("POST", "/proof/verify-batch", bytes.NewBuffer(verifyMultiProofBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyKeys.Error()))
+	})
+	t.Run("invalid proof", func(t *testing.T) {
+		verifyMultiProofBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "rootHash",
+			Keys:     []string{"key"},
+			Proof:    []string{"invalid", "hex"},
+		})
+		req, _ := http.NewRequest("POST", "/proof/verify-batch", bytes.NewBuffer(verifyMultiProofBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+}
+
+func TestVerifyMultiProof_VerifyMultiProofErr(t *testing.T) {
+	t.Parallel()
+
+	verifyMultiProofErr := fmt.Errorf("VerifyMultiProof err")
+	facade := &mock.FacadeStub{
+		VerifyMultiProofCalled: func(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error) {
+			return false, nil, verifyMultiProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	verifyMultiProofBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+		RootHash: "rootHash",
+		Keys:     []string{"key"},
+		Proof:    []string{hex.EncodeToString([]byte("proof"))},
+	})
+	req, _ := http.NewRequest("POST", "/proof/verify-batch", bytes.NewBuffer(verifyMultiProofBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	rootHash := "rootHash"
+	keys := []string{"present", "absent"}
+	validProof := []string{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}
+	verifyMultiProofBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+		RootHash: rootHash,
+		Keys:     keys,
+		Proof:    validProof,
+	})
+
+	proofOk := true
+	facade := &mock.FacadeStub{
+		VerifyMultiProofCalled: func(rH string, providedKeys []string, proof [][]byte) (bool, [][]byte, error) {
+			assert.Equal(t, rootHash, rH)
+			assert.Equal(t, keys, providedKeys)
+			for i := range proof {
+				assert.Equal(t, validProof[i], hex.EncodeToString(proof[i]))
+			}
+			if !proofOk {
+				return false, nil, nil
+			}
+
+			return true, [][]byte{[]byte("value"), nil}, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	type verifyMultiProofResponse struct {
+		Data struct {
+			Ok      bool                     `json:"ok"`
+			Results []groups.ProvenKeyResult `json:"results"`
+		} `json:"data"`
+		Code shared.ReturnCode `json:"code"`
+	}
+
+	req, _ := http.NewRequest("POST", "/proof/verify-batch", bytes.NewBuffer(verifyMultiProofBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := verifyMultiProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	assert.True(t, response.Data.Ok)
+	assert.Equal(t, []groups.ProvenKeyResult{
+		{Key: "present", Exists: true, Value: hex.EncodeToString([]byte("value"))},
+		{Key: "absent", Exists: false, Value: ""},
+	}, response.Data.Results)
+
+	proofOk = false
+	req, _ = http.NewRequest("POST", "/proof/verify-batch", bytes.NewBuffer(verifyMultiProofBytes))
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response = verifyMultiProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	assert.False(t, response.Data.Ok)
+	assert.Empty(t, response.Data.Results)
+}
+
 func TestProofGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +777,9 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/trie-node/:hash", Open: true},
+					{Name: "/root-hash/:roothash/batch", Open: true},
+					{Name: "/verify-batch", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,198 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+)
+
+const (
+	subscriptionsWsPath            = "/ws"
+	maxSubscriptionMessageSizeInB  = 64 * 1024
+	subscriptionsActionSubscribe   = "subscribe"
+	subscriptionsActionUnsubscribe = "unsubscribe"
+)
+
+// subscriptionsFacadeHandler defines the methods to be implemented by a facade for handling live data subscriptions
+type subscriptionsFacadeHandler interface {
+	CreateSubscriber() (common.SubscriberHandler, error)
+	IsInterfaceNil() bool
+}
+
+// SubscriptionMessage defines a message sent by a websocket client in order to manage its subscriptions
+type SubscriptionMessage struct {
+	ID             uint64                     `json:"id"`
+	Action         string                     `json:"action"`
+	Request        common.SubscriptionRequest `json:"request"`
+	SubscriptionID string                     `json:"subscriptionID"`
+}
+
+// SubscriptionReply defines the reply sent to a websocket client for one of its subscription messages
+type SubscriptionReply struct {
+	ID             uint64 `json:"id"`
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+type subscriptionsGroup struct {
+	*baseGroup
+	facade    subscriptionsFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewSubscriptionsGroup returns a new instance of subscriptionsGroup
+func NewSubscriptionsGroup(facade subscriptionsFacadeHandler) (*subscriptionsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for subscriptions group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &subscriptionsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    subscriptionsWsPath,
+			Method:  http.MethodGet,
+			Handler: sg.handleWsConnection,
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// RegisterRoutes will register all the endpoints, after applying the allowed websocket origins from the config
+func (sg *subscriptionsGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	sg.upgrader.CheckOrigin = createWsOriginChecker(apiConfig.WebSocket.AllowedOrigins)
+	sg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// handleWsConnection will upgrade the connection and will forward the subscribed data to the client
+func (sg *subscriptionsGroup) handleWsConnection(c *gin.Context) {
+	subscriber, err := sg.getFacade().CreateSubscriber()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCreateSubscriber, err)
+		return
+	}
+	defer func() {
+		_ = subscriber.Close()
+	}()
+
+	conn, err := sg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("cannot upgrade subscriptions connection", "error", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	conn.SetReadLimit(maxSubscriptionMessageSizeInB)
+	writer := &wsJsonWriter{conn: conn}
+	go forwardSubscriptionEvents(subscriber, writer)
+
+	for {
+		message := &SubscriptionMessage{}
+		err = conn.ReadJSON(message)
+		if err != nil {
+			log.Trace("subscriptions websocket connection closed", "error", err)
+			return
+		}
+
+		reply := processSubscriptionMessage(subscriber, message)
+		err = writer.writeJSON(reply)
+		if err != nil {
+			log.Debug("cannot write subscription reply", "error", err)
+			return
+		}
+	}
+}
+
+func processSubscriptionMessage(subscriber common.SubscriberHandler, message *SubscriptionMessage) *SubscriptionReply {
+	reply := &SubscriptionReply{
+		ID: message.ID,
+	}
+
+	var err error
+	switch message.Action {
+	case subscriptionsActionSubscribe:
+		reply.SubscriptionID, err = subscriber.Subscribe(message.Request)
+	case subscriptionsActionUnsubscribe:
+		reply.SubscriptionID = message.SubscriptionID
+		err = subscriber.Unsubscribe(message.SubscriptionID)
+	default:
+		err = fmt.Errorf("%w: %s", errors.ErrInvalidSubscriptionAction, message.Action)
+	}
+	if err != nil {
+		reply.Error = err.Error()
+	}
+
+	return reply
+}
+
+// forwardSubscriptionEvents writes the subscriber's events until its channel gets closed. A closed channel means
+// the subscriber could not keep up with the events, so the connection is closed as well
+func forwardSubscriptionEvents(subscriber common.SubscriberHandler, writer *wsJsonWriter) {
+	for event := range subscriber.Events() {
+		err := writer.writeJSON(event)
+		if err != nil {
+			log.Debug("cannot write subscription event", "error", err)
+			_ = subscriber.Close()
+			break
+		}
+	}
+
+	_ = writer.conn.Close()
+}
+
+type wsJsonWriter struct {
+	mut  sync.Mutex
+	conn *websocket.Conn
+}
+
+func (writer *wsJsonWriter) writeJSON(value interface{}) error {
+	writer.mut.Lock()
+	defer writer.mut.Unlock()
+
+	return writer.conn.WriteJSON(value)
+}
+
+func (sg *subscriptionsGroup) getFacade() subscriptionsFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *subscriptionsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(subscriptionsFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for subscriptions group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *subscriptionsGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,218 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	apiErrors "github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/groups"
+	"github.com/kalyan3104/k-chain-go/api/mock"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type subscriptionEventTestResponse struct {
+	SubscriptionID string                 `json:"subscriptionID"`
+	Topic          string                 `json:"topic"`
+	Data           map[string]interface{} `json:"data"`
+}
+
+func TestNewSubscriptionsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSubscriptionsGroup_CreateSubscriberFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		CreateSubscriberCalled: func() (common.SubscriberHandler, error) {
+			return nil, expectedErr
+		},
+	}
+
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(subscriptionsGroup, "subscriptions", getSubscriptionsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/subscriptions/ws", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrCreateSubscriber.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSubscriptionsGroup_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan *common.SubscriptionEvent, 1)
+	closeCalled := make(chan struct{})
+	subscriber := &testscommon.SubscriberStub{
+		SubscribeCalled: func(request common.SubscriptionRequest) (string, error) {
+			if request.Topic != common.NewHeadersSubscriptionTopic {
+				return "", errors.New("unexpected topic")
+			}
+			return "1", nil
+		},
+		UnsubscribeCalled: func(subscriptionID string) error {
+			return errors.New("subscription not found")
+		},
+		EventsCalled: func() <-chan *common.SubscriptionEvent {
+			return events
+		},
+		CloseCalled: func() error {
+			close(closeCalled)
+			return nil
+		},
+	}
+	facade := &mock.FacadeStub{
+		CreateSubscriberCalled: func() (common.SubscriberHandler, error) {
+			return subscriber, nil
+		},
+	}
+
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(subscriptionsGroup, "subscriptions", getSubscriptionsRoutesConfig())
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	wsUrl := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(wsUrl, nil)
+	require.NoError(t, err)
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"id": 1, "action": "subscribe", "request": {"topic": "newHeaders"}}`))
+	require.NoError(t, err)
+	reply := groups.SubscriptionReply{}
+	err = conn.ReadJSON(&reply)
+	require.NoError(t, err)
+	assert.Equal(t, groups.SubscriptionReply{ID: 1, SubscriptionID: "1"}, reply)
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"id": 2, "action": "unsubscribe", "subscriptionID": "5"}`))
+	require.NoError(t, err)
+	reply = groups.SubscriptionReply{}
+	err = conn.ReadJSON(&reply)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), reply.ID)
+	assert.Equal(t, "subscription not found", reply.Error)
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"id": 3, "action": "unknown"}`))
+	require.NoError(t, err)
+	reply = groups.SubscriptionReply{}
+	err = conn.ReadJSON(&reply)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), reply.ID)
+	assert.True(t, strings.Contains(reply.Error, apiErrors.ErrInvalidSubscriptionAction.Error()))
+
+	events <- &common.SubscriptionEvent{
+		SubscriptionID: "1",
+		Topic:          common.NewHeadersSubscriptionTopic,
+		Data:           &common.HeaderNotificationAPI{Nonce: 37, Hash: "aa"},
+	}
+	event := subscriptionEventTestResponse{}
+	err = conn.ReadJSON(&event)
+	require.NoError(t, err)
+	assert.Equal(t, "1", event.SubscriptionID)
+	assert.Equal(t, string(common.NewHeadersSubscriptionTopic), event.Topic)
+	assert.Equal(t, float64(37), event.Data["nonce"])
+	assert.Equal(t, "aa", event.Data["hash"])
+
+	_ = conn.Close()
+	<-closeCalled
+}
+
+func TestSubscriptionsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		subscriptionsGroup, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = subscriptionsGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		subscriptionsGroup, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = subscriptionsGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		subscriptionsGroup, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		expectedErr := errors.New("new facade error")
+		newFacade := &mock.FacadeStub{
+			CreateSubscriberCalled: func() (common.SubscriberHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		err = subscriptionsGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(subscriptionsGroup, "subscriptions", getSubscriptionsRoutesConfig())
+		req, _ := http.NewRequest("GET", "/subscriptions/ws", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+}
+
+func TestSubscriptionsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	subscriptionsGroup, _ := groups.NewSubscriptionsGroup(nil)
+	require.True(t, subscriptionsGroup.IsInterfaceNil())
+
+	subscriptionsGroup, _ = groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+	require.False(t, subscriptionsGroup.IsInterfaceNil())
+}
+
+func getSubscriptionsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscriptions": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
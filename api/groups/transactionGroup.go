@@ -26,12 +26,17 @@ const (
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	traceTransactionEndpoint         = "/transaction/trace"
+	cancelTransactionsPoolEndpoint   = "/transaction/pool/cancel"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	traceTransactionPath             = "/:txhash/trace"
+	simulateTransactionTracePath     = "/simulate/trace"
 	getTransactionsPool              = "/pool"
+	cancelTransactionsPoolPath       = "/pool/cancel"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
@@ -48,11 +53,15 @@ type transactionFacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceTransaction(hash string) (*txSimData.TransactionTrace, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	ValidateCancellationTransaction(tx *transaction.Transaction) error
+	CancelTransactionsPoolForSender(tx *transaction.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -115,6 +124,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    cancelTransactionsPoolPath,
+			Method:  http.MethodPost,
+			Handler: tg.cancelTransactionsPoolForSender,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(cancelTransactionsPoolEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
@@ -137,6 +157,28 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    traceTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.traceTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    simulateTransactionTracePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionTrace,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -153,8 +195,9 @@ type TxResponse struct {
 	Timestamp   uint64 `json:"timestamp"`
 }
 
-// simulateTransaction will receive a transaction from the client and will simulate its execution and return the results
-func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
+// createTransactionForSimulation will create the transaction received from the client and will validate it for
+// simulation. On failure, the error response is written and false is returned
+func (tg *transactionGroup) createTransactionForSimulation(c *gin.Context) (*transaction.Transaction, []byte, bool) {
 	var ftx = transaction.FrontendTransaction{}
 	err := c.ShouldBindJSON(&ftx)
 	if err != nil {
@@ -166,7 +209,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
-		return
+		return nil, nil, false
 	}
 
 	checkSignature, err := getQueryParameterCheckSignature(c)
@@ -179,26 +222,10 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
-		return
+		return nil, nil, false
 	}
 
-	txArgs := &external.ArgsCreateTransaction{
-		Nonce:            ftx.Nonce,
-		Value:            ftx.Value,
-		Receiver:         ftx.Receiver,
-		ReceiverUsername: ftx.ReceiverUsername,
-		Sender:           ftx.Sender,
-		SenderUsername:   ftx.SenderUsername,
-		GasPrice:         ftx.GasPrice,
-		GasLimit:         ftx.GasLimit,
-		DataField:        ftx.Data,
-		SignatureHex:     ftx.Signature,
-		ChainID:          ftx.ChainID,
-		Version:          ftx.Version,
-		Options:          ftx.Options,
-		Guardian:         ftx.GuardianAddr,
-		GuardianSigHex:   ftx.GuardianSignature,
-	}
+	txArgs := createTransactionArgs(&ftx)
 	start := time.Now()
 	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
@@ -212,7 +239,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
-		return
+		return nil, nil, false
 	}
 
 	start = time.Now()
@@ -227,10 +254,20 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
+		return nil, nil, false
+	}
+
+	return tx, txHash, true
+}
+
+// simulateTransaction will receive a transaction from the client and will simulate its execution and return the results
+func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
+	tx, txHash, ok := tg.createTransactionForSimulation(c)
+	if !ok {
 		return
 	}
 
-	start = time.Now()
+	start := time.Now()
 	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
 	if err != nil {
@@ -256,6 +293,80 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionTrace will receive a transaction from the client and will return its execution trace on the
+// current state
+func (tg *transactionGroup) simulateTransactionTrace(c *gin.Context) {
+	tx, txHash, ok := tg.createTransactionForSimulation(c)
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().SimulateTransactionTrace(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionTrace")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	trace.Hash = hex.EncodeToString(txHash)
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"trace": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// traceTransaction will re-execute an already executed transaction and will return its execution trace
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().TraceTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: TraceTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"trace": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var ftx = transaction.FrontendTransaction{}
@@ -272,23 +383,7 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	txArgs := &external.ArgsCreateTransaction{
-		Nonce:            ftx.Nonce,
-		Value:            ftx.Value,
-		Receiver:         ftx.Receiver,
-		ReceiverUsername: ftx.ReceiverUsername,
-		Sender:           ftx.Sender,
-		SenderUsername:   ftx.SenderUsername,
-		GasPrice:         ftx.GasPrice,
-		GasLimit:         ftx.GasLimit,
-		DataField:        ftx.Data,
-		SignatureHex:     ftx.Signature,
-		ChainID:          ftx.ChainID,
-		Version:          ftx.Version,
-		Options:          ftx.Options,
-		Guardian:         ftx.GuardianAddr,
-		GuardianSigHex:   ftx.GuardianSignature,
-	}
+	txArgs := createTransactionArgs(&ftx)
 	start := time.Now()
 	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
@@ -370,23 +465,7 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	var start time.Time
 	txsHashes := make(map[int]string)
 	for idx, receivedTx := range ftxs {
-		txArgs := &external.ArgsCreateTransaction{
-			Nonce:            receivedTx.Nonce,
-			Value:            receivedTx.Value,
-			Receiver:         receivedTx.Receiver,
-			ReceiverUsername: receivedTx.ReceiverUsername,
-			Sender:           receivedTx.Sender,
-			SenderUsername:   receivedTx.SenderUsername,
-			GasPrice:         receivedTx.GasPrice,
-			GasLimit:         receivedTx.GasLimit,
-			DataField:        receivedTx.Data,
-			SignatureHex:     receivedTx.Signature,
-			ChainID:          receivedTx.ChainID,
-			Version:          receivedTx.Version,
-			Options:          receivedTx.Options,
-			Guardian:         receivedTx.GuardianAddr,
-			GuardianSigHex:   receivedTx.GuardianSignature,
-		}
+		txArgs := createTransactionArgs(&receivedTx)
 		tx, txHash, err = tg.getFacade().CreateTransaction(txArgs)
 		logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
 		if err != nil {
@@ -430,6 +509,26 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	)
 }
 
+func createTransactionArgs(ftx *transaction.FrontendTransaction) *external.ArgsCreateTransaction {
+	return &external.ArgsCreateTransaction{
+		Nonce:            ftx.Nonce,
+		Value:            ftx.Value,
+		Receiver:         ftx.Receiver,
+		ReceiverUsername: ftx.ReceiverUsername,
+		Sender:           ftx.Sender,
+		SenderUsername:   ftx.SenderUsername,
+		GasPrice:         ftx.GasPrice,
+		GasLimit:         ftx.GasLimit,
+		DataField:        ftx.Data,
+		SignatureHex:     ftx.Signature,
+		ChainID:          ftx.ChainID,
+		Version:          ftx.Version,
+		Options:          ftx.Options,
+		Guardian:         ftx.GuardianAddr,
+		GuardianSigHex:   ftx.GuardianSignature,
+	}
+}
+
 // getTransaction returns transaction details for a given txhash
 func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	txhash := c.Param("txhash")
@@ -499,23 +598,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
-	txArgs := &external.ArgsCreateTransaction{
-		Nonce:            ftx.Nonce,
-		Value:            ftx.Value,
-		Receiver:         ftx.Receiver,
-		ReceiverUsername: ftx.ReceiverUsername,
-		Sender:           ftx.Sender,
-		SenderUsername:   ftx.SenderUsername,
-		GasPrice:         ftx.GasPrice,
-		GasLimit:         ftx.GasLimit,
-		DataField:        ftx.Data,
-		SignatureHex:     ftx.Signature,
-		ChainID:          ftx.ChainID,
-		Version:          ftx.Version,
-		Options:          ftx.Options,
-		Guardian:         ftx.GuardianAddr,
-		GuardianSigHex:   ftx.GuardianSignature,
-	}
+	txArgs := createTransactionArgs(&ftx)
 	start := time.Now()
 	tx, _, err := tg.getFacade().CreateTransaction(txArgs)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
@@ -728,6 +811,95 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
+// cancelTransactionsPoolForSender will receive a signed zero value self-transfer at the stuck nonce of the sender, will
+// propagate it and will drop from this node's pool only all the sender's transactions having a higher nonce, once the
+// cancellation transaction is committed
+func (tg *transactionGroup) cancelTransactionsPoolForSender(c *gin.Context) {
+	var ftx = transaction.FrontendTransaction{}
+	err := c.ShouldBindJSON(&ftx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txArgs := createTransactionArgs(&ftx)
+	start := time.Now()
+	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	err = tg.getFacade().ValidateTransaction(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	err = tg.getFacade().ValidateCancellationTransaction(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateCancellationTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCancelTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	report, err := tg.getFacade().CancelTransactionsPoolForSender(tx, txHash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CancelTransactionsPoolForSender")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCancelTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"cancellation": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
 	if fields != "" && lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
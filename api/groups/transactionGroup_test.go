@@ -69,6 +69,16 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type traceTxResponseData struct {
+	Trace *txSimData.TransactionTrace `json:"trace"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -123,6 +133,16 @@ type txPoolNonceGapsForSenderResponseData struct {
 	NonceGaps common.TransactionsPoolNonceGapsForSenderApiResponse `json:"nonceGaps"`
 }
 
+type txPoolCancellationResponseData struct {
+	Cancellation common.TransactionsPoolCancellationApiResponse `json:"cancellation"`
+}
+
+type txPoolCancellationResponse struct {
+	Data  txPoolCancellationResponseData `json:"data"`
+	Error string                         `json:"error"`
+	Code  string                         `json:"code"`
+}
+
 type txPoolNonceGapsForSenderResponse struct {
 	Data  txPoolNonceGapsForSenderResponseData `json:"data"`
 	Error string                               `json:"error"`
@@ -692,6 +712,148 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionGroup_traceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/eeee/trace", nil))
+	t.Run("facade returns error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TraceTransactionHandler: func(hash string) (*txSimData.TransactionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/trace",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TraceTransactionHandler: func(hash string) (*txSimData.TransactionTrace, error) {
+				require.Equal(t, hexTxHash, hash)
+				return &txSimData.TransactionTrace{
+					Hash:   hash,
+					Status: dataTx.TxStatusSuccess,
+					Call: &txSimData.TraceCall{
+						Caller:   sender,
+						Callee:   receiver,
+						Function: "claim",
+					},
+				}, nil
+			},
+		}
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/trace",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, hexTxHash, response.Data.Trace.Hash)
+		assert.Equal(t, dataTx.TxStatusSuccess, response.Data.Trace.Status)
+		assert.Equal(t, "claim", response.Data.Trace.Call.Function)
+	})
+}
+
+func TestTransactionGroup_simulateTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/simulate/trace", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("ValidateTransactionForSimulation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return expectedErr
+			},
+			SimulateTransactionTraceHandler: func(tx *dataTx.Transaction) (*txSimData.TransactionTrace, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("SimulateTransactionTrace error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			SimulateTransactionTraceHandler: func(tx *dataTx.Transaction) (*txSimData.TransactionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			SimulateTransactionTraceHandler: func(tx *dataTx.Transaction) (*txSimData.TransactionTrace, error) {
+				return &txSimData.TransactionTrace{
+					Status: dataTx.TxStatusSuccess,
+				}, nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal(&dataTx.FrontendTransaction{})
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate/trace",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, hex.EncodeToString([]byte("hash")), response.Data.Trace.Hash)
+	})
+}
+
 func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -935,6 +1097,151 @@ func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing
 	}
 }
 
+func TestTransactionGroup_cancelTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid params should error", testTransactionGroupErrorScenario("/transaction/pool/cancel", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("CreateTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, expectedErr
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/cancel",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("ValidateTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return expectedErr
+			},
+			CancelTransactionsPoolForSenderCalled: func(tx *dataTx.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/cancel",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("ValidateCancellationTransaction error should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			ValidateCancellationTransactionCalled: func(tx *dataTx.Transaction) error {
+				return expectedErr
+			},
+			CancelTransactionsPoolForSenderCalled: func(tx *dataTx.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/cancel",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("CancelTransactionsPoolForSender error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			CancelTransactionsPoolForSenderCalled: func(tx *dataTx.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/cancel",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			apiErrors.ErrCancelTransactions,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedCancellation := &common.TransactionsPoolCancellationApiResponse{
+			Sender:             "alice",
+			CancellationTxHash: hexTxHash,
+			Nonce:              7,
+			TransactionsToRemove: []common.RemovedPoolTransactionApiResponse{
+				{
+					Hash:  "aabb",
+					Nonce: 8,
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				txHash, _ := hex.DecodeString(hexTxHash)
+				return &dataTx.Transaction{}, txHash, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			CancelTransactionsPoolForSenderCalled: func(tx *dataTx.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+				require.Equal(t, hexTxHash, hex.EncodeToString(txHash))
+				return expectedCancellation, nil
+			},
+		}
+
+		response := &txPoolCancellationResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool/cancel",
+			"POST",
+			bytes.NewBuffer([]byte(jsonTxStr)),
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedCancellation, response.Data.Cancellation)
+	})
+}
+
 func TestTransactionsGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1119,9 +1426,12 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/pool", Open: true},
+					{Name: "/pool/cancel", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/:txhash/trace", Open: true},
+					{Name: "/simulate/trace", Open: true},
 				},
 			},
 		},
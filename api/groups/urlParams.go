@@ -3,6 +3,7 @@ package groups
 import (
 	"encoding/hex"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/kalyan3104/k-chain-core-go/core"
@@ -58,3 +59,20 @@ func parseHexBytesUrlParam(c *gin.Context, name string) ([]byte, error) {
 
 	return decoded, nil
 }
+
+func parseStringSliceUrlParam(c *gin.Context, name string) []string {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, value := range strings.Split(param, ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
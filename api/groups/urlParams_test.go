@@ -80,3 +80,12 @@ func TestParseHexBytesUrlParam(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, []byte(nil), value)
 }
+
+func TestParseStringSliceUrlParam(t *testing.T) {
+	c := testscommon.CreateGinContextWithRawQuery("a=x,y,,z&b=x&c")
+
+	require.Equal(t, []string{"x", "y", "z"}, parseStringSliceUrlParam(c, "a"))
+	require.Equal(t, []string{"x"}, parseStringSliceUrlParam(c, "b"))
+	require.Nil(t, parseStringSliceUrlParam(c, "c"))
+	require.Nil(t, parseStringSliceUrlParam(c, "d"))
+}
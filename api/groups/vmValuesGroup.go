@@ -25,10 +25,15 @@ const (
 	queryPath  = "/query"
 )
 
+type addressPubkeyDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetBlockNonceByTimestamp(timestamp uint64) (uint64, error)
 	IsInterfaceNil() bool
 }
 
@@ -141,7 +146,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
-	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context)
+	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context, vvg.getFacade())
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
@@ -159,7 +164,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 	return vmOutputApi, vmExecErrMsg, blockInfo, nil
 }
 
-func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte, error) {
+func extractBlockCoordinates(context *gin.Context, resolver blockNonceByTimestampResolver) (core.OptionalUint64, []byte, error) {
 	blockNonce, err := parseUint64UrlParam(context, urlParamBlockNonce)
 	if err != nil {
 		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block nonce", err)
@@ -170,11 +175,26 @@ func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte,
 		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block hash", err)
 	}
 
-	return blockNonce, blockHash, nil
+	blockNonceByTimestamp, err := resolveBlockNonceByTimestamp(context, resolver)
+	if err != nil {
+		return core.OptionalUint64{}, nil, err
+	}
+	if !blockNonceByTimestamp.HasValue {
+		return blockNonce, blockHash, nil
+	}
+	if blockNonce.HasValue || len(blockHash) > 0 {
+		return core.OptionalUint64{}, nil, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, errTimestampWithOtherBlockCoordinates)
+	}
+
+	return blockNonceByTimestamp, nil, nil
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+	return createSCQuery(vvg.getFacade(), request)
+}
+
+func createSCQuery(decoder addressPubkeyDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -199,7 +219,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
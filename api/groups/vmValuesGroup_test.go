@@ -146,6 +146,8 @@ func TestQuery(t *testing.T) {
 
 	t.Run("invalid block nonce should error", testQueryShouldError("/vm-values/query?blockNonce=invalid_nonce"))
 	t.Run("invalid block hash should error", testQueryShouldError("/vm-values/query?blockHash=invalid_nonce"))
+	t.Run("invalid timestamp should error", testQueryShouldError("/vm-values/query?timestamp=invalid_timestamp"))
+	t.Run("timestamp with block nonce should error", testQueryShouldError("/vm-values/query?timestamp=1700000000&blockNonce=123"))
 	t.Run("should work - block nonce", func(t *testing.T) {
 		t.Parallel()
 
@@ -179,6 +181,26 @@ func TestQuery(t *testing.T) {
 		url := fmt.Sprintf("/vm-values/query?blockHash=%s", hex.EncodeToString(providedBlockHash))
 		testQueryShouldWork(t, url, &facade)
 	})
+	t.Run("should work - timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		providedTimestamp := uint64(1700000000)
+		facade := mock.FacadeStub{
+			GetBlockNonceByTimestampCalled: func(timestamp uint64) (uint64, error) {
+				require.Equal(t, providedTimestamp, timestamp)
+				return 123, nil
+			},
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.Equal(t, core.OptionalUint64{Value: 123, HasValue: true}, query.BlockNonce)
+				require.Empty(t, query.BlockHash)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		url := fmt.Sprintf("/vm-values/query?timestamp=%d", providedTimestamp)
+		testQueryShouldWork(t, url, &facade)
+	})
 	t.Run("should work - no block coordinates", func(t *testing.T) {
 		t.Parallel()
 
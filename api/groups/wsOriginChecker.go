@@ -0,0 +1,40 @@
+package groups
+
+import (
+	"net/http"
+	"strings"
+)
+
+const anyWsOrigin = "*"
+
+// createWsOriginChecker returns the origin check used when upgrading to websocket connections. A nil check makes the
+// upgrader accept only the browser pages served from the same host
+func createWsOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		return nil
+	}
+
+	for _, allowedOrigin := range allowedOrigins {
+		if allowedOrigin == anyWsOrigin {
+			return func(_ *http.Request) bool {
+				return true
+			}
+		}
+	}
+
+	return func(r *http.Request) bool {
+		// non-browser clients do not send the Origin header
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 {
+			return true
+		}
+
+		for _, allowedOrigin := range allowedOrigins {
+			if strings.EqualFold(origin, allowedOrigin) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
@@ -0,0 +1,47 @@
+package groups_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/api/groups"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRequestWithOrigin(origin string) *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/ws", nil)
+	if len(origin) > 0 {
+		req.Header.Set("Origin", origin)
+	}
+
+	return req
+}
+
+func TestCreateWsOriginChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no allowed origins should use the default same origin check", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, groups.CreateWsOriginChecker(nil))
+		assert.Nil(t, groups.CreateWsOriginChecker(make([]string, 0)))
+	})
+	t.Run("any origin should allow all the requests", func(t *testing.T) {
+		t.Parallel()
+
+		checkOrigin := groups.CreateWsOriginChecker([]string{"https://allowed.com", "*"})
+		assert.True(t, checkOrigin(createRequestWithOrigin("https://other.com")))
+		assert.True(t, checkOrigin(createRequestWithOrigin("")))
+	})
+	t.Run("should only allow the provided origins", func(t *testing.T) {
+		t.Parallel()
+
+		checkOrigin := groups.CreateWsOriginChecker([]string{"https://allowed.com", "http://localhost:3000"})
+		assert.True(t, checkOrigin(createRequestWithOrigin("https://allowed.com")))
+		assert.True(t, checkOrigin(createRequestWithOrigin("https://ALLOWED.com")))
+		assert.True(t, checkOrigin(createRequestWithOrigin("http://localhost:3000")))
+		assert.True(t, checkOrigin(createRequestWithOrigin("")))
+		assert.False(t, checkOrigin(createRequestWithOrigin("https://other.com")))
+		assert.False(t, checkOrigin(createRequestWithOrigin("http://localhost:3001")))
+	})
+}
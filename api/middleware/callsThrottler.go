@@ -0,0 +1,49 @@
+package middleware
+
+import "fmt"
+
+// callsThrottler applies the source and the global limits of the middlewares to each of the calls carried by a single
+// request, such as the calls of a JSON-RPC batch or the messages received over a websocket connection
+type callsThrottler struct {
+	sourceThrottler *sourceThrottler
+	globalThrottler *globalThrottler
+}
+
+// NewCallsThrottler creates a new instance of a callsThrottler
+func NewCallsThrottler(sourceThrottler *sourceThrottler, globalThrottler *globalThrottler) (*callsThrottler, error) {
+	if sourceThrottler == nil {
+		return nil, ErrNilSourceThrottler
+	}
+	if globalThrottler == nil {
+		return nil, ErrNilGlobalThrottler
+	}
+
+	return &callsThrottler{
+		sourceThrottler: sourceThrottler,
+		globalThrottler: globalThrottler,
+	}, nil
+}
+
+// StartProcessingCall counts the call against the source's quota and occupies a global processing slot. The slot
+// should be released by calling EndProcessingCall with the same name, only if no error was returned
+func (ct *callsThrottler) StartProcessingCall(source string, name string) error {
+	if !ct.sourceThrottler.addRequest(source) {
+		return fmt.Errorf("%w for address %s", ErrTooManyRequests, source)
+	}
+	if !ct.globalThrottler.tryStart(name) {
+		ct.globalThrottler.printDebugInfo()
+		return ErrTooManyRequests
+	}
+
+	return nil
+}
+
+// EndProcessingCall releases the global processing slot occupied by the call
+func (ct *callsThrottler) EndProcessingCall(name string) {
+	ct.globalThrottler.finish(name)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *callsThrottler) IsInterfaceNil() bool {
+	return ct == nil
+}
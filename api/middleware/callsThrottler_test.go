@@ -0,0 +1,77 @@
+package middleware_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/api/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCallsThrottler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil source throttler should error", func(t *testing.T) {
+		t.Parallel()
+
+		globalThrottler, _ := middleware.NewGlobalThrottler(1)
+		ct, err := middleware.NewCallsThrottler(nil, globalThrottler)
+		assert.True(t, check.IfNil(ct))
+		assert.Equal(t, middleware.ErrNilSourceThrottler, err)
+	})
+	t.Run("nil global throttler should error", func(t *testing.T) {
+		t.Parallel()
+
+		sourceThrottler, _ := middleware.NewSourceThrottler(1)
+		ct, err := middleware.NewCallsThrottler(sourceThrottler, nil)
+		assert.True(t, check.IfNil(ct))
+		assert.Equal(t, middleware.ErrNilGlobalThrottler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sourceThrottler, _ := middleware.NewSourceThrottler(1)
+		globalThrottler, _ := middleware.NewGlobalThrottler(1)
+		ct, err := middleware.NewCallsThrottler(sourceThrottler, globalThrottler)
+		assert.False(t, check.IfNil(ct))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCallsThrottler_SourceQuotaShouldBeChargedForEachCall(t *testing.T) {
+	t.Parallel()
+
+	sourceThrottler, _ := middleware.NewSourceThrottler(2)
+	globalThrottler, _ := middleware.NewGlobalThrottler(10)
+	ct, _ := middleware.NewCallsThrottler(sourceThrottler, globalThrottler)
+
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, ct.StartProcessingCall("source", "call"))
+		ct.EndProcessingCall("call")
+	}
+
+	err := ct.StartProcessingCall("source", "call")
+	assert.True(t, errors.Is(err, middleware.ErrTooManyRequests))
+	assert.Nil(t, ct.StartProcessingCall("another source", "call"))
+	ct.EndProcessingCall("call")
+
+	sourceThrottler.Reset()
+	assert.Nil(t, ct.StartProcessingCall("source", "call"))
+	ct.EndProcessingCall("call")
+}
+
+func TestCallsThrottler_GlobalSlotsShouldBeOccupiedUntilTheCallEnds(t *testing.T) {
+	t.Parallel()
+
+	sourceThrottler, _ := middleware.NewSourceThrottler(10)
+	globalThrottler, _ := middleware.NewGlobalThrottler(1)
+	ct, _ := middleware.NewCallsThrottler(sourceThrottler, globalThrottler)
+
+	assert.Nil(t, ct.StartProcessingCall("source", "first call"))
+	assert.Equal(t, middleware.ErrTooManyRequests, ct.StartProcessingCall("another source", "second call"))
+
+	ct.EndProcessingCall("first call")
+	assert.Nil(t, ct.StartProcessingCall("another source", "second call"))
+	ct.EndProcessingCall("second call")
+}
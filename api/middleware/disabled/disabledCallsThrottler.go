@@ -0,0 +1,22 @@
+package disabled
+
+type callsThrottler struct{}
+
+// NewDisabledCallsThrottler returns a disabled implementation to be used when the web server antiflood is not enabled
+func NewDisabledCallsThrottler() *callsThrottler {
+	return &callsThrottler{}
+}
+
+// StartProcessingCall does nothing and returns nil
+func (ct *callsThrottler) StartProcessingCall(_ string, _ string) error {
+	return nil
+}
+
+// EndProcessingCall does nothing
+func (ct *callsThrottler) EndProcessingCall(_ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *callsThrottler) IsInterfaceNil() bool {
+	return ct == nil
+}
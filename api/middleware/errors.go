@@ -7,3 +7,9 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrNilSourceThrottler signals that a nil source throttler was provided
+var ErrNilSourceThrottler = errors.New("nil source throttler")
+
+// ErrNilGlobalThrottler signals that a nil global throttler was provided
+var ErrNilGlobalThrottler = errors.New("nil global throttler")
@@ -37,12 +37,7 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
 
-		select {
-		case gt.queue <- struct{}{}:
-			gt.mutDebugRequests.Lock()
-			gt.debugRequests[path]++
-			gt.mutDebugRequests.Unlock()
-		default:
+		if !gt.tryStart(path) {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -63,6 +58,20 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	}
 }
 
+// tryStart occupies a processing slot for the provided path and returns false if all the slots are taken
+func (gt *globalThrottler) tryStart(path string) bool {
+	select {
+	case gt.queue <- struct{}{}:
+		gt.mutDebugRequests.Lock()
+		gt.debugRequests[path]++
+		gt.mutDebugRequests.Unlock()
+
+		return true
+	default:
+		return false
+	}
+}
+
 func (gt *globalThrottler) finish(path string) {
 	gt.mutDebugRequests.Lock()
 	gt.debugRequests[path]--
@@ -46,13 +46,7 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		st.mutRequests.Lock()
-		requests := st.sourceRequests[remoteAddr]
-		isQuotaReached := requests >= st.maxNumRequests
-		st.sourceRequests[remoteAddr]++
-		st.mutRequests.Unlock()
-
-		if isQuotaReached {
+		if !st.addRequest(remoteAddr) {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -68,6 +62,17 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	}
 }
 
+// addRequest counts a new request from the provided source and returns false if the source's quota was reached
+func (st *sourceThrottler) addRequest(source string) bool {
+	st.mutRequests.Lock()
+	defer st.mutRequests.Unlock()
+
+	requests := st.sourceRequests[source]
+	st.sourceRequests[source]++
+
+	return requests < st.maxNumRequests
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
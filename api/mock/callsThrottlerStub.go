@@ -0,0 +1,28 @@
+package mock
+
+// CallsThrottlerStub -
+type CallsThrottlerStub struct {
+	StartProcessingCallCalled func(source string, name string) error
+	EndProcessingCallCalled   func(name string)
+}
+
+// StartProcessingCall -
+func (cts *CallsThrottlerStub) StartProcessingCall(source string, name string) error {
+	if cts.StartProcessingCallCalled != nil {
+		return cts.StartProcessingCallCalled(source, name)
+	}
+
+	return nil
+}
+
+// EndProcessingCall -
+func (cts *CallsThrottlerStub) EndProcessingCall(name string) {
+	if cts.EndProcessingCallCalled != nil {
+		cts.EndProcessingCallCalled(name)
+	}
+}
+
+// IsInterfaceNil -
+func (cts *CallsThrottlerStub) IsInterfaceNil() bool {
+	return cts == nil
+}
@@ -44,12 +44,18 @@ type FacadeStub struct {
 	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled func() (string, error)
+	GetIntegrityReportCalled                    func() (*common.IntegrityReportAPI, error)
 	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetAccountsStateDiffCalled                  func(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string) (*common.AccountsStateDiffAPIResponse, error)
+	GetEventsCalled                             func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+	GetBlockNonceByTimestampCalled              func(timestamp uint64) (uint64, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionTraceHandler             func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceTransactionHandler                     func(hash string) (*txSimData.TransactionTrace, error)
 	GetDCDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*dcdt.DCDigitalToken, api.BlockInfo, error)
 	GetAllDCDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*dcdt.DCDigitalToken, api.BlockInfo, error)
 	GetDCDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -76,6 +82,9 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                         func(string, []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                      func(string, []string, [][]byte) (bool, [][]byte, error)
+	GetTrieNodeCalled                           func(hash string) ([]byte, error)
 	GetTokenSupplyCalled                        func(token string) (*api.DCDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
@@ -83,6 +92,8 @@ type FacadeStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	ValidateCancellationTransactionCalled       func(tx *transaction.Transaction) error
+	CancelTransactionsPoolForSenderCalled       func(tx *transaction.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
 	RestApiInterfaceCalled                      func() string
 	RestAPIServerDebugModeCalled                func() bool
@@ -97,6 +108,7 @@ type FacadeStub struct {
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
+	CreateSubscriberCalled                      func() (common.SubscriberHandler, error)
 }
 
 // GetTokenSupply -
@@ -144,6 +156,33 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, keys)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return false, nil, nil
+}
+
+// GetTrieNode -
+func (f *FacadeStub) GetTrieNode(hash string) ([]byte, error) {
+	if f.GetTrieNodeCalled != nil {
+		return f.GetTrieNodeCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (f *FacadeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if f.GetUsernameCalled != nil {
@@ -231,6 +270,33 @@ func (f *FacadeStub) GetKeyValuePairs(address string, options api.AccountQueryOp
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetAccountsStateDiff -
+func (f *FacadeStub) GetAccountsStateDiff(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string) (*common.AccountsStateDiffAPIResponse, error) {
+	if f.GetAccountsStateDiffCalled != nil {
+		return f.GetAccountsStateDiffCalled(fromOptions, toOptions, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (f *FacadeStub) GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	if f.GetEventsCalled != nil {
+		return f.GetEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
+// GetBlockNonceByTimestamp -
+func (f *FacadeStub) GetBlockNonceByTimestamp(timestamp uint64) (uint64, error) {
+	if f.GetBlockNonceByTimestampCalled != nil {
+		return f.GetBlockNonceByTimestampCalled(timestamp)
+	}
+
+	return 0, nil
+}
+
 // GetGuardianData -
 func (f *FacadeStub) GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error) {
 	if f.GetGuardianDataCalled != nil {
@@ -338,6 +404,24 @@ func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (
 	return nil, nil
 }
 
+// SimulateTransactionTrace is the mock implementation of a handler's SimulateTransactionTrace method
+func (f *FacadeStub) SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	if f.SimulateTransactionTraceHandler != nil {
+		return f.SimulateTransactionTraceHandler(tx)
+	}
+
+	return nil, nil
+}
+
+// TraceTransaction is the mock implementation of a handler's TraceTransaction method
+func (f *FacadeStub) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	if f.TraceTransactionHandler != nil {
+		return f.TraceTransactionHandler(hash)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if f.SendBulkTransactionsHandler != nil {
@@ -486,6 +570,15 @@ func (f *FacadeStub) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return "", nil
 }
 
+// GetIntegrityReport -
+func (f *FacadeStub) GetIntegrityReport() (*common.IntegrityReportAPI, error) {
+	if f.GetIntegrityReportCalled != nil {
+		return f.GetIntegrityReportCalled()
+	}
+
+	return nil, nil
+}
+
 // GetEpochStartDataAPI -
 func (f *FacadeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	return f.GetEpochStartDataAPICalled(epoch)
@@ -642,6 +735,24 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// ValidateCancellationTransaction -
+func (f *FacadeStub) ValidateCancellationTransaction(tx *transaction.Transaction) error {
+	if f.ValidateCancellationTransactionCalled != nil {
+		return f.ValidateCancellationTransactionCalled(tx)
+	}
+
+	return nil
+}
+
+// CancelTransactionsPoolForSender -
+func (f *FacadeStub) CancelTransactionsPoolForSender(tx *transaction.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+	if f.CancelTransactionsPoolForSenderCalled != nil {
+		return f.CancelTransactionsPoolForSenderCalled(tx, txHash)
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -740,6 +851,15 @@ func (f *FacadeStub) Close() error {
 	return nil
 }
 
+// CreateSubscriber -
+func (f *FacadeStub) CreateSubscriber() (common.SubscriberHandler, error) {
+	if f.CreateSubscriberCalled != nil {
+		return f.CreateSubscriberCalled()
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *FacadeStub) IsInterfaceNil() bool {
 	return f == nil
@@ -34,6 +34,13 @@ type MiddlewareProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// CallsThrottler defines a throttler applied to each of the calls carried by a single request
+type CallsThrottler interface {
+	StartProcessingCall(source string, name string) error
+	EndProcessingCall(name string)
+	IsInterfaceNil() bool
+}
+
 // ApiFacadeHandler interface defines methods that can be used by the web server to interact with the node
 type ApiFacadeHandler interface {
 	RestApiInterface() string
@@ -74,6 +81,9 @@ type FacadeHandler interface {
 	GetDCDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllDCDTTokens(address string, options api.AccountQueryOptions) (map[string]*dcdt.DCDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetAccountsStateDiff(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string) (*common.AccountsStateDiffAPIResponse, error)
+	GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+	GetBlockNonceByTimestamp(timestamp uint64) (uint64, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -101,16 +111,22 @@ type FacadeHandler interface {
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetIntegrityReport() (*common.IntegrityReportAPI, error)
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error)
+	GetTrieNode(hash string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceTransaction(hash string) (*txSimData.TransactionTrace, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -128,6 +144,8 @@ type FacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	ValidateCancellationTransaction(tx *transaction.Transaction) error
+	CancelTransactionsPoolForSender(tx *transaction.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
@@ -136,5 +154,6 @@ type FacadeHandler interface {
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
 	P2PPrometheusMetricsEnabled() bool
+	CreateSubscriber() (common.SubscriberHandler, error)
 	IsInterfaceNil() bool
 }
@@ -2,11 +2,15 @@
 
 generate() {
     generateForAssessmentTool
+    generateForChainSimulator
+    generateForDBTool
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
     generateForSeedNode
+    generateForStorageMigrator
     generateForTermUi
+    generateForTrieInspect
 }
 
 generateForAssessmentTool() {
@@ -23,6 +27,34 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForChainSimulator() {
+    HELP="
+# Chain Simulator CLI
+
+The **Kalyan Chain Simulator** exposes the following Command Line Interface:
+$(code)
+\$ chainsimulator --help
+
+$(./chainsimulator/chainsimulator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./chainsimulator/CLI.md
+}
+
+generateForDBTool() {
+    HELP="
+# Kalyan Database Tool CLI
+
+The **Kalyan Database Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbtool --help
+
+$(./dbtool/dbtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbtool/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -79,6 +111,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForStorageMigrator() {
+    HELP="
+# Kalyan Storage Migrator CLI
+
+The **Kalyan Storage Migrator** exposes the following Command Line Interface:
+$(code)
+\$ storagemigrator --help
+
+$(./storagemigrator/storagemigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./storagemigrator/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # Kalyan TermUI CLI
@@ -93,6 +139,20 @@ $(code)
     echo "$HELP" > ./termui/CLI.md
 }
 
+generateForTrieInspect() {
+    HELP="
+# Kalyan Trie Inspector CLI
+
+The **Kalyan Trie Inspector** exposes the following Command Line Interface:
+$(code)
+\$ trieinspect --help
+
+$(./trieinspect/trieinspect --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./trieinspect/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
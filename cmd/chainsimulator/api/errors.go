@@ -0,0 +1,15 @@
+package api
+
+import "errors"
+
+var (
+	errNilSimulatorHandler    = errors.New("nil simulator handler")
+	errNilMetachainNode       = errors.New("nil metachain node handler")
+	errMissingShardInterface  = errors.New("missing rest api interface for shard")
+	errInvalidShardID         = errors.New("invalid shard ID")
+	errInvalidNumberOfBlocks  = errors.New("invalid number of blocks")
+	errInvalidEpoch           = errors.New("invalid epoch")
+	errInvalidAddress         = errors.New("invalid address")
+	errTransactionNotFound    = errors.New("transaction not found")
+	errEmptyTransactionsSlice = errors.New("empty slice of transactions")
+)
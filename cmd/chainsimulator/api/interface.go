@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+)
+
+// SimulatorHandler defines what a chain simulator should be able to do in order to be exposed through the REST API
+type SimulatorHandler interface {
+	GenerateBlocks(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReached(targetEpoch int32) error
+	SetStateMultiple(stateSlice []*dtos.AddressState) error
+	GetInitialWalletKeys() *dtos.InitialWalletKeys
+	GetRestAPIInterfaces() map[uint32]string
+	GetNodeHandler(shardID uint32) process.NodeHandler
+	StartBlocksProduction(config dtos.BlocksProductionConfig) error
+	PauseBlocksProduction() error
+	ResumeBlocksProduction() error
+	StopBlocksProduction() error
+	GetBlocksProductionStatus() dtos.BlocksProductionStatus
+	IsInterfaceNil() bool
+}
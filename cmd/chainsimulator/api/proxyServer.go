@@ -0,0 +1,507 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	apiErrors "github.com/kalyan3104/k-chain-go/api/errors"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+	"github.com/kalyan3104/k-chain-go/node/external"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("chainsimulator/api")
+
+const (
+	queryParamShardID     = "shard-id"
+	queryParamBySender    = "by-sender"
+	queryParamWithResults = "withResults"
+	metachainShardName    = "metachain"
+)
+
+// ArgsProxyServer holds the arguments needed to create a new proxy server
+type ArgsProxyServer struct {
+	RestApiInterface string
+	Simulator        SimulatorHandler
+}
+
+type proxyServer struct {
+	simulator    SimulatorHandler
+	metaNode     process.NodeHandler
+	shardProxies map[uint32]*httputil.ReverseProxy
+	httpServer   *http.Server
+}
+
+// NewProxyServer creates a HTTP server that exposes the simulator controls and routes all the node API calls
+// towards the node that handles the requested shard
+func NewProxyServer(args ArgsProxyServer) (*proxyServer, error) {
+	if check.IfNil(args.Simulator) {
+		return nil, errNilSimulatorHandler
+	}
+
+	metaNode := args.Simulator.GetNodeHandler(core.MetachainShardId)
+	if check.IfNil(metaNode) {
+		return nil, errNilMetachainNode
+	}
+
+	ps := &proxyServer{
+		simulator:    args.Simulator,
+		metaNode:     metaNode,
+		shardProxies: make(map[uint32]*httputil.ReverseProxy),
+	}
+
+	for shardID, restApiInterface := range args.Simulator.GetRestAPIInterfaces() {
+		targetURL, err := url.Parse("http://" + restApiInterface)
+		if err != nil {
+			return nil, fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		ps.shardProxies[shardID] = httputil.NewSingleHostReverseProxy(targetURL)
+	}
+
+	ws := gin.Default()
+	ws.Use(cors.Default())
+	ps.registerRoutes(ws)
+
+	ps.httpServer = &http.Server{
+		Addr:    args.RestApiInterface,
+		Handler: ws,
+	}
+
+	return ps, nil
+}
+
+func (ps *proxyServer) registerRoutes(ws *gin.Engine) {
+	simulatorGroup := ws.Group("/simulator")
+	simulatorGroup.POST("/generate-blocks/:num", ps.generateBlocks)
+	simulatorGroup.POST("/generate-blocks-until-epoch-reached/:epoch", ps.generateBlocksUntilEpochIsReached)
+	simulatorGroup.POST("/set-state", ps.setState)
+	simulatorGroup.GET("/initial-wallets", ps.getInitialWallets)
+
+	blocksProductionGroup := simulatorGroup.Group("/blocks-production")
+	blocksProductionGroup.POST("/start", ps.startBlocksProduction)
+	blocksProductionGroup.POST("/pause", ps.pauseBlocksProduction)
+	blocksProductionGroup.POST("/resume", ps.resumeBlocksProduction)
+	blocksProductionGroup.POST("/stop", ps.stopBlocksProduction)
+	blocksProductionGroup.GET("/status", ps.getBlocksProductionStatus)
+
+	ws.NoRoute(ps.routeRequest)
+}
+
+// Start will start the HTTP server in a separate go routine
+func (ps *proxyServer) Start() {
+	go func() {
+		log.Info("starting chain simulator proxy server", "interface", ps.httpServer.Addr)
+
+		err := ps.httpServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("chain simulator proxy server stopped", "error", err)
+		}
+	}()
+}
+
+func (ps *proxyServer) generateBlocks(c *gin.Context) {
+	numOfBlocks, err := strconv.Atoi(c.Param("num"))
+	if err != nil || numOfBlocks <= 0 {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, errInvalidNumberOfBlocks)
+		return
+	}
+
+	err = ps.simulator.GenerateBlocks(numOfBlocks)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.New("cannot generate blocks"), err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
+func (ps *proxyServer) generateBlocksUntilEpochIsReached(c *gin.Context) {
+	targetEpoch, err := strconv.ParseInt(c.Param("epoch"), 10, 32)
+	if err != nil || targetEpoch < 0 {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, errInvalidEpoch)
+		return
+	}
+
+	err = ps.simulator.GenerateBlocksUntilEpochIsReached(int32(targetEpoch))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.New("cannot generate blocks until epoch is reached"), err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
+func (ps *proxyServer) setState(c *gin.Context) {
+	var stateSlice []*dtos.AddressState
+	err := c.ShouldBindJSON(&stateSlice)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+
+	err = ps.simulator.SetStateMultiple(stateSlice)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.New("cannot set state"), err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
+func (ps *proxyServer) getInitialWallets(c *gin.Context) {
+	shared.RespondWithSuccess(c, ps.simulator.GetInitialWalletKeys())
+}
+
+func (ps *proxyServer) startBlocksProduction(c *gin.Context) {
+	var config dtos.BlocksProductionConfig
+	err := c.ShouldBindJSON(&config)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+
+	ps.respondAfterBlocksProductionAction(c, ps.simulator.StartBlocksProduction(config))
+}
+
+func (ps *proxyServer) pauseBlocksProduction(c *gin.Context) {
+	ps.respondAfterBlocksProductionAction(c, ps.simulator.PauseBlocksProduction())
+}
+
+func (ps *proxyServer) resumeBlocksProduction(c *gin.Context) {
+	ps.respondAfterBlocksProductionAction(c, ps.simulator.ResumeBlocksProduction())
+}
+
+func (ps *proxyServer) stopBlocksProduction(c *gin.Context) {
+	ps.respondAfterBlocksProductionAction(c, ps.simulator.StopBlocksProduction())
+}
+
+func (ps *proxyServer) respondAfterBlocksProductionAction(c *gin.Context, err error) {
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": ps.simulator.GetBlocksProductionStatus()})
+}
+
+func (ps *proxyServer) getBlocksProductionStatus(c *gin.Context) {
+	shared.RespondWithSuccess(c, gin.H{"status": ps.simulator.GetBlocksProductionStatus()})
+}
+
+func (ps *proxyServer) routeRequest(c *gin.Context) {
+	segments := splitPath(c.Request.URL.Path)
+	if isGetTransactionRequest(c.Request.Method, segments) {
+		ps.getTransaction(c, segments[1], len(segments) == 3)
+		return
+	}
+	if isSendMultipleTransactionsRequest(c.Request.Method, segments) {
+		ps.sendMultipleTransactions(c)
+		return
+	}
+
+	shardID, path, err := ps.computeDestination(c.Request, segments)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+
+	reverseProxy, found := ps.shardProxies[shardID]
+	if !found {
+		shared.RespondWithInternalError(c, errMissingShardInterface, fmt.Errorf("shard %d", shardID))
+		return
+	}
+
+	c.Request.URL.Path = path
+	reverseProxy.ServeHTTP(c.Writer, c.Request)
+}
+
+func splitPath(path string) []string {
+	trimmed := strings.Trim(path, "/")
+	if len(trimmed) == 0 {
+		return make([]string, 0)
+	}
+
+	return strings.Split(trimmed, "/")
+}
+
+func isGetTransactionRequest(method string, segments []string) bool {
+	if method != http.MethodGet || len(segments) < 2 || segments[0] != "transaction" {
+		return false
+	}
+	if segments[1] == "pool" {
+		return false
+	}
+
+	return len(segments) == 2 || (len(segments) == 3 && segments[2] == "status")
+}
+
+func isSendMultipleTransactionsRequest(method string, segments []string) bool {
+	return method == http.MethodPost && len(segments) == 2 && segments[0] == "transaction" && segments[1] == "send-multiple"
+}
+
+// computeDestination returns the shard that should handle the request and the path that should be called on that shard
+func (ps *proxyServer) computeDestination(request *http.Request, segments []string) (uint32, string, error) {
+	path := request.URL.Path
+	if len(segments) < 2 {
+		shardID, err := ps.shardFromQuery(request)
+		return shardID, path, err
+	}
+
+	switch segments[0] {
+	case "address":
+		if segments[1] == "bulk" {
+			shardID, err := ps.shardFromQuery(request)
+			return shardID, path, err
+		}
+
+		shardID, err := ps.shardFromAddress(segments[1])
+		return shardID, path, err
+	case "transaction":
+		return ps.transactionDestination(request, segments)
+	case "vm-values":
+		shardID, err := ps.shardFromBodyField(request, "scAddress")
+		return shardID, path, err
+	case "proof":
+		for idx := 1; idx < len(segments)-1; idx++ {
+			if segments[idx] == "address" {
+				shardID, err := ps.shardFromAddress(segments[idx+1])
+				return shardID, path, err
+			}
+		}
+	case "block", "internal":
+		shardID, err := parseShardID(segments[1])
+		if err == nil {
+			withoutShard := append([]string{segments[0]}, segments[2:]...)
+			return shardID, "/" + strings.Join(withoutShard, "/"), nil
+		}
+	case "network":
+		if segments[1] == "status" && len(segments) == 3 {
+			shardID, err := parseShardID(segments[2])
+			return shardID, "/network/status", err
+		}
+	}
+
+	shardID, err := ps.shardFromQuery(request)
+	return shardID, path, err
+}
+
+func (ps *proxyServer) transactionDestination(request *http.Request, segments []string) (uint32, string, error) {
+	path := request.URL.Path
+	switch segments[1] {
+	case "send", "simulate", "cost":
+		shardID, err := ps.shardFromBodyField(request, "sender")
+		return shardID, path, err
+	case "pool":
+		sender := request.URL.Query().Get(queryParamBySender)
+		if len(sender) > 0 {
+			shardID, err := ps.shardFromAddress(sender)
+			return shardID, path, err
+		}
+	}
+
+	shardID, err := ps.shardFromQuery(request)
+	return shardID, path, err
+}
+
+func (ps *proxyServer) shardFromQuery(request *http.Request) (uint32, error) {
+	shardIDStr := request.URL.Query().Get(queryParamShardID)
+	if len(shardIDStr) == 0 {
+		return core.MetachainShardId, nil
+	}
+
+	return parseShardID(shardIDStr)
+}
+
+func (ps *proxyServer) shardFromAddress(address string) (uint32, error) {
+	addressBytes, err := ps.metaNode.GetCoreComponents().AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errInvalidAddress, err.Error())
+	}
+
+	return ps.metaNode.GetShardCoordinator().ComputeId(addressBytes), nil
+}
+
+// shardFromBodyField reads the request body in order to find the address that will decide the destination shard.
+// The body is restored so it can be forwarded afterward
+func (ps *proxyServer) shardFromBodyField(request *http.Request, fieldName string) (uint32, error) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return 0, err
+	}
+	_ = request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	fields := make(map[string]interface{})
+	err = json.Unmarshal(body, &fields)
+	if err != nil {
+		return 0, err
+	}
+
+	address, ok := fields[fieldName].(string)
+	if !ok {
+		return 0, fmt.Errorf("%w: missing field %s", errInvalidAddress, fieldName)
+	}
+
+	return ps.shardFromAddress(address)
+}
+
+func parseShardID(shardIDStr string) (uint32, error) {
+	if shardIDStr == metachainShardName {
+		return core.MetachainShardId, nil
+	}
+
+	shardID, err := strconv.ParseUint(shardIDStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errInvalidShardID, shardIDStr)
+	}
+
+	return uint32(shardID), nil
+}
+
+func (ps *proxyServer) sortedShardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, len(ps.shardProxies))
+	for shardID := range ps.shardProxies {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+// getTransaction will search the transaction on all shards, preferring the result of the receiver's shard as it
+// holds the final status of a cross-shard transaction
+func (ps *proxyServer) getTransaction(c *gin.Context, txHash string, onlyStatus bool) {
+	withResults := c.Request.URL.Query().Get(queryParamWithResults) == "true"
+
+	var tx *transaction.ApiTransactionResult
+	for _, shardID := range ps.sortedShardIDs() {
+		result, err := ps.simulator.GetNodeHandler(shardID).GetFacadeHandler().GetTransaction(txHash, withResults)
+		if err != nil || result == nil {
+			continue
+		}
+
+		tx = result
+		receiverShardID, err := ps.shardFromAddress(result.Receiver)
+		if err != nil || receiverShardID == shardID {
+			break
+		}
+
+		resultOnReceiver, err := ps.simulator.GetNodeHandler(receiverShardID).GetFacadeHandler().GetTransaction(txHash, withResults)
+		if err == nil && resultOnReceiver != nil {
+			tx = resultOnReceiver
+		}
+		break
+	}
+
+	if tx == nil {
+		shared.RespondWith(c, http.StatusNotFound, nil, fmt.Sprintf("%s: %s", apiErrors.ErrGetTransaction.Error(), errTransactionNotFound.Error()), shared.ReturnCodeRequestError)
+		return
+	}
+
+	if onlyStatus {
+		shared.RespondWithSuccess(c, gin.H{"status": tx.Status})
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"transaction": tx})
+}
+
+// sendMultipleTransactions will dispatch every transaction to the facade of the sender's shard
+func (ps *proxyServer) sendMultipleTransactions(c *gin.Context) {
+	var ftxs []transaction.FrontendTransaction
+	err := c.ShouldBindJSON(&ftxs)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+	if len(ftxs) == 0 {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, errEmptyTransactionsSlice)
+		return
+	}
+
+	txsHashes := make(map[int]string)
+	txsPerShard := make(map[uint32][]*transaction.Transaction)
+	for idx, receivedTx := range ftxs {
+		shardID, errShard := ps.shardFromAddress(receivedTx.Sender)
+		if errShard != nil {
+			continue
+		}
+
+		facadeHandler := ps.simulator.GetNodeHandler(shardID).GetFacadeHandler()
+		tx, txHash, errCreate := facadeHandler.CreateTransaction(&external.ArgsCreateTransaction{
+			Nonce:            receivedTx.Nonce,
+			Value:            receivedTx.Value,
+			Receiver:         receivedTx.Receiver,
+			ReceiverUsername: receivedTx.ReceiverUsername,
+			Sender:           receivedTx.Sender,
+			SenderUsername:   receivedTx.SenderUsername,
+			GasPrice:         receivedTx.GasPrice,
+			GasLimit:         receivedTx.GasLimit,
+			DataField:        receivedTx.Data,
+			SignatureHex:     receivedTx.Signature,
+			ChainID:          receivedTx.ChainID,
+			Version:          receivedTx.Version,
+			Options:          receivedTx.Options,
+			Guardian:         receivedTx.GuardianAddr,
+			GuardianSigHex:   receivedTx.GuardianSignature,
+		})
+		if errCreate != nil {
+			continue
+		}
+
+		errCreate = facadeHandler.ValidateTransaction(tx)
+		if errCreate != nil {
+			continue
+		}
+
+		txsPerShard[shardID] = append(txsPerShard[shardID], tx)
+		txsHashes[idx] = hex.EncodeToString(txHash)
+	}
+
+	numOfSentTxs := uint64(0)
+	for shardID, txs := range txsPerShard {
+		numSent, errSend := ps.simulator.GetNodeHandler(shardID).GetFacadeHandler().SendBulkTransactions(txs)
+		if errSend != nil {
+			shared.RespondWithInternalError(c, fmt.Errorf("cannot send transactions on shard %d", shardID), errSend)
+			return
+		}
+
+		numOfSentTxs += numSent
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"txsSent":   numOfSentTxs,
+		"txsHashes": txsHashes,
+	})
+}
+
+// Close will stop the HTTP server
+func (ps *proxyServer) Close() error {
+	return ps.httpServer.Shutdown(context.Background())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ps *proxyServer) IsInterfaceNil() bool {
+	return ps == nil
+}
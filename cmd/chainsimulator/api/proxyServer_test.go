@@ -0,0 +1,613 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	apiMock "github.com/kalyan3104/k-chain-go/api/mock"
+	"github.com/kalyan3104/k-chain-go/api/shared"
+	"github.com/kalyan3104/k-chain-go/factory"
+	"github.com/kalyan3104/k-chain-go/integrationTests/mock"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+	"github.com/kalyan3104/k-chain-go/node/external"
+	"github.com/kalyan3104/k-chain-go/sharding"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/chainSimulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// createNodeHandler returns a node handler which decodes the addresses as plain text and places an address in the
+// shard given by its last character
+func createNodeHandler(facade shared.FacadeHandler) *chainSimulator.NodeHandlerMock {
+	return &chainSimulator.NodeHandlerMock{
+		GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+			return &mock.CoreComponentsStub{
+				AddressPubKeyConverterField: &testscommon.PubkeyConverterStub{
+					DecodeCalled: func(humanReadable string) ([]byte, error) {
+						if !strings.HasPrefix(humanReadable, "addr") {
+							return nil, expectedErr
+						}
+
+						return []byte(humanReadable), nil
+					},
+				},
+			}
+		},
+		GetShardCoordinatorCalled: func() sharding.Coordinator {
+			return &testscommon.ShardsCoordinatorMock{
+				ComputeIdCalled: func(address []byte) uint32 {
+					lastChar := address[len(address)-1]
+					if lastChar == 'm' {
+						return core.MetachainShardId
+					}
+
+					return uint32(lastChar - '0')
+				},
+			}
+		},
+		GetFacadeHandlerCalled: func() shared.FacadeHandler {
+			return facade
+		},
+	}
+}
+
+func createSimulatorHandler(facades map[uint32]shared.FacadeHandler, restApiInterfaces map[uint32]string) *chainSimulator.SimulatorHandlerMock {
+	return &chainSimulator.SimulatorHandlerMock{
+		GetNodeHandlerCalled: func(shardID uint32) process.NodeHandler {
+			facade, found := facades[shardID]
+			if !found {
+				facade = &apiMock.FacadeStub{}
+			}
+
+			return createNodeHandler(facade)
+		},
+		GetRestAPIInterfacesCalled: func() map[uint32]string {
+			return restApiInterfaces
+		},
+	}
+}
+
+func createProxyServerHandler(t *testing.T, simulator SimulatorHandler) http.Handler {
+	ps, err := NewProxyServer(ArgsProxyServer{
+		RestApiInterface: "localhost:0",
+		Simulator:        simulator,
+	})
+	require.Nil(t, err)
+
+	return ps.httpServer.Handler
+}
+
+func doRequest(handler http.Handler, method string, path string, body string) (int, *shared.GenericAPIResponse) {
+	var bodyReader io.Reader
+	if len(body) > 0 {
+		bodyReader = bytes.NewBufferString(body)
+	}
+
+	request, _ := http.NewRequest(method, path, bodyReader)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	response := &shared.GenericAPIResponse{}
+	_ = json.Unmarshal(recorder.Body.Bytes(), response)
+
+	return recorder.Code, response
+}
+
+func TestNewProxyServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil simulator should error", func(t *testing.T) {
+		t.Parallel()
+
+		ps, err := NewProxyServer(ArgsProxyServer{})
+		require.Equal(t, errNilSimulatorHandler, err)
+		require.Nil(t, ps)
+	})
+	t.Run("nil metachain node should error", func(t *testing.T) {
+		t.Parallel()
+
+		ps, err := NewProxyServer(ArgsProxyServer{
+			Simulator: &chainSimulator.SimulatorHandlerMock{},
+		})
+		require.Equal(t, errNilMetachainNode, err)
+		require.Nil(t, ps)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ps, err := NewProxyServer(ArgsProxyServer{
+			Simulator: createSimulatorHandler(nil, map[uint32]string{0: "localhost:8081", core.MetachainShardId: "localhost:8082"}),
+		})
+		require.Nil(t, err)
+		require.False(t, ps.IsInterfaceNil())
+		require.Len(t, ps.shardProxies, 2)
+	})
+}
+
+func TestProxyServer_GenerateBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createProxyServerHandler(t, createSimulatorHandler(nil, nil))
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/generate-blocks/0", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errInvalidNumberOfBlocks.Error())
+
+		code, _ = doRequest(handler, http.MethodPost, "/simulator/generate-blocks/abc", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.GenerateBlocksCalled = func(numOfBlocks int) error {
+			return expectedErr
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/generate-blocks/3", "")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		generatedBlocks := 0
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.GenerateBlocksCalled = func(numOfBlocks int) error {
+			generatedBlocks = numOfBlocks
+			return nil
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/generate-blocks/3", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, 3, generatedBlocks)
+	})
+}
+
+func TestProxyServer_GenerateBlocksUntilEpochIsReached(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createProxyServerHandler(t, createSimulatorHandler(nil, nil))
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/generate-blocks-until-epoch-reached/-1", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errInvalidEpoch.Error())
+	})
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.GenerateBlocksUntilEpochIsReachedCalled = func(targetEpoch int32) error {
+			return expectedErr
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/generate-blocks-until-epoch-reached/2", "")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reachedEpoch := int32(0)
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.GenerateBlocksUntilEpochIsReachedCalled = func(targetEpoch int32) error {
+			reachedEpoch = targetEpoch
+			return nil
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, _ := doRequest(handler, http.MethodPost, "/simulator/generate-blocks-until-epoch-reached/2", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(2), reachedEpoch)
+	})
+}
+
+func TestProxyServer_SetState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createProxyServerHandler(t, createSimulatorHandler(nil, nil))
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/set-state", "{not json")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.SetStateMultipleCalled = func(stateSlice []*dtos.AddressState) error {
+			return expectedErr
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/set-state", `[{"address":"addr0"}]`)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedState []*dtos.AddressState
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.SetStateMultipleCalled = func(stateSlice []*dtos.AddressState) error {
+			receivedState = stateSlice
+			return nil
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, _ := doRequest(handler, http.MethodPost, "/simulator/set-state", `[{"address":"addr0","balance":"10"}]`)
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, receivedState, 1)
+		assert.Equal(t, "addr0", receivedState[0].Address)
+		assert.Equal(t, "10", receivedState[0].Balance)
+	})
+}
+
+func TestProxyServer_GetInitialWallets(t *testing.T) {
+	t.Parallel()
+
+	simulator := createSimulatorHandler(nil, nil)
+	simulator.GetInitialWalletKeysCalled = func() *dtos.InitialWalletKeys {
+		return &dtos.InitialWalletKeys{
+			BalanceWallets: map[uint32]*dtos.WalletKey{
+				0: {Address: dtos.WalletAddress{Bech32: "addr0"}},
+			},
+		}
+	}
+	handler := createProxyServerHandler(t, simulator)
+
+	code, response := doRequest(handler, http.MethodGet, "/simulator/initial-wallets", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, toJSON(response.Data), "addr0")
+}
+
+func TestProxyServer_BlocksProduction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid start config should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createProxyServerHandler(t, createSimulatorHandler(nil, nil))
+
+		code, _ := doRequest(handler, http.MethodPost, "/simulator/blocks-production/start", "{not json")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.StartBlocksProductionCalled = func(config dtos.BlocksProductionConfig) error {
+			return expectedErr
+		}
+		simulator.PauseBlocksProductionCalled = func() error {
+			return expectedErr
+		}
+		simulator.ResumeBlocksProductionCalled = func() error {
+			return expectedErr
+		}
+		simulator.StopBlocksProductionCalled = func() error {
+			return expectedErr
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/blocks-production/start", `{"mode":"interval"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+
+		for _, action := range []string{"pause", "resume", "stop"} {
+			code, response = doRequest(handler, http.MethodPost, "/simulator/blocks-production/"+action, "")
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Contains(t, response.Error, expectedErr.Error())
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedConfig dtos.BlocksProductionConfig
+		simulator := createSimulatorHandler(nil, nil)
+		simulator.StartBlocksProductionCalled = func(config dtos.BlocksProductionConfig) error {
+			receivedConfig = config
+			return nil
+		}
+		simulator.GetBlocksProductionStatusCalled = func() dtos.BlocksProductionStatus {
+			return dtos.BlocksProductionStatus{
+				Running: true,
+				Config:  &receivedConfig,
+			}
+		}
+		handler := createProxyServerHandler(t, simulator)
+
+		code, response := doRequest(handler, http.MethodPost, "/simulator/blocks-production/start", `{"mode":"interval","intervalInMillis":500}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, uint64(500), receivedConfig.IntervalInMillis)
+		assert.Contains(t, toJSON(response.Data), `"running":true`)
+
+		code, response = doRequest(handler, http.MethodGet, "/simulator/blocks-production/status", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, toJSON(response.Data), `"intervalInMillis":500`)
+	})
+}
+
+func TestProxyServer_RouteRequest(t *testing.T) {
+	t.Parallel()
+
+	mutForwarded := sync.Mutex{}
+	forwardedPaths := make(map[uint32][]string)
+	createShardServer := func(shardID uint32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutForwarded.Lock()
+			forwardedPaths[shardID] = append(forwardedPaths[shardID], r.URL.Path)
+			mutForwarded.Unlock()
+
+			w.WriteHeader(http.StatusOK)
+		}))
+	}
+	shard1Server := createShardServer(1)
+	defer shard1Server.Close()
+	metaServer := createShardServer(core.MetachainShardId)
+	defer metaServer.Close()
+
+	restApiInterfaces := map[uint32]string{
+		1:                     strings.TrimPrefix(shard1Server.URL, "http://"),
+		core.MetachainShardId: strings.TrimPrefix(metaServer.URL, "http://"),
+	}
+	handler := createProxyServerHandler(t, createSimulatorHandler(nil, restApiInterfaces))
+
+	code, _ := doRequest(handler, http.MethodGet, "/address/addr1", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = doRequest(handler, http.MethodGet, "/block/1/by-nonce/3", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = doRequest(handler, http.MethodPost, "/transaction/send", `{"sender":"addr1"}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = doRequest(handler, http.MethodGet, "/network/config", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	mutForwarded.Lock()
+	assert.Equal(t, []string{"/address/addr1", "/block/by-nonce/3", "/transaction/send"}, forwardedPaths[1])
+	assert.Equal(t, []string{"/network/config"}, forwardedPaths[core.MetachainShardId])
+	mutForwarded.Unlock()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		code, response := doRequest(handler, http.MethodGet, "/address/invalid", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errInvalidAddress.Error())
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		code, response := doRequest(handler, http.MethodGet, "/node/status?shard-id=abc", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errInvalidShardID.Error())
+	})
+	t.Run("missing body field should error", func(t *testing.T) {
+		code, response := doRequest(handler, http.MethodPost, "/transaction/send", `{"receiver":"addr1"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errInvalidAddress.Error())
+	})
+	t.Run("shard without interface should error", func(t *testing.T) {
+		code, response := doRequest(handler, http.MethodGet, "/address/addr0", "")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Contains(t, response.Error, errMissingShardInterface.Error())
+	})
+}
+
+func TestProxyServer_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("transaction not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMock.FacadeStub{
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		facades := map[uint32]shared.FacadeHandler{0: facade, core.MetachainShardId: facade}
+		handler := createProxyServerHandler(t, createSimulatorHandler(facades, map[uint32]string{0: "", core.MetachainShardId: ""}))
+
+		code, response := doRequest(handler, http.MethodGet, "/transaction/aabb", "")
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Contains(t, response.Error, errTransactionNotFound.Error())
+	})
+	t.Run("cross shard transaction should return the receiver's result", func(t *testing.T) {
+		t.Parallel()
+
+		facades := map[uint32]shared.FacadeHandler{
+			0: &apiMock.FacadeStub{
+				GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+					return &transaction.ApiTransactionResult{Hash: hash, Receiver: "addr1", Status: transaction.TxStatusPending}, nil
+				},
+			},
+			1: &apiMock.FacadeStub{
+				GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+					return &transaction.ApiTransactionResult{Hash: hash, Receiver: "addr1", Status: transaction.TxStatusSuccess}, nil
+				},
+			},
+		}
+		handler := createProxyServerHandler(t, createSimulatorHandler(facades, map[uint32]string{0: "", 1: "", core.MetachainShardId: ""}))
+
+		code, response := doRequest(handler, http.MethodGet, "/transaction/aabb", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, toJSON(response.Data), string(transaction.TxStatusSuccess))
+
+		code, response = doRequest(handler, http.MethodGet, "/transaction/aabb/status", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, `{"status":"success"}`, toJSON(response.Data))
+	})
+}
+
+func TestProxyServer_SendMultipleTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createProxyServerHandler(t, createSimulatorHandler(nil, nil))
+
+		code, _ := doRequest(handler, http.MethodPost, "/transaction/send-multiple", "{not json")
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, response := doRequest(handler, http.MethodPost, "/transaction/send-multiple", "[]")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, response.Error, errEmptyTransactionsSlice.Error())
+	})
+	t.Run("send error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				return &transaction.Transaction{}, []byte("hash"), nil
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		handler := createProxyServerHandler(t, createSimulatorHandler(map[uint32]shared.FacadeHandler{0: facade}, nil))
+
+		code, response := doRequest(handler, http.MethodPost, "/transaction/send-multiple", `[{"sender":"addr0"}]`)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should dispatch the transactions to the senders' shards", func(t *testing.T) {
+		t.Parallel()
+
+		mutSent := sync.Mutex{}
+		sentPerShard := make(map[uint32]int)
+		createFacade := func(shardID uint32) *apiMock.FacadeStub {
+			return &apiMock.FacadeStub{
+				CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+					return &transaction.Transaction{}, []byte(txArgs.Sender), nil
+				},
+				SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+					mutSent.Lock()
+					sentPerShard[shardID] += len(txs)
+					mutSent.Unlock()
+
+					return uint64(len(txs)), nil
+				},
+			}
+		}
+		facades := map[uint32]shared.FacadeHandler{0: createFacade(0), 1: createFacade(1)}
+		handler := createProxyServerHandler(t, createSimulatorHandler(facades, nil))
+
+		code, response := doRequest(handler, http.MethodPost, "/transaction/send-multiple", `[{"sender":"addr0"},{"sender":"addr1"},{"sender":"addr1"},{"sender":"invalid"}]`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, map[uint32]int{0: 1, 1: 2}, sentPerShard)
+		assert.Contains(t, toJSON(response.Data), `"txsSent":3`)
+		assert.NotContains(t, toJSON(response.Data), `"3":`)
+	})
+}
+
+func toJSON(value interface{}) string {
+	buff, _ := json.Marshal(value)
+	return string(buff)
+}
+
+func TestSplitPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{}, splitPath("/"))
+	assert.Equal(t, []string{}, splitPath(""))
+	assert.Equal(t, []string{"address", "moa1"}, splitPath("/address/moa1"))
+	assert.Equal(t, []string{"block", "1", "by-nonce", "2"}, splitPath("/block/1/by-nonce/2/"))
+}
+
+func TestIsGetTransactionRequest(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isGetTransactionRequest(http.MethodGet, []string{"transaction", "aabb"}))
+	assert.True(t, isGetTransactionRequest(http.MethodGet, []string{"transaction", "aabb", "status"}))
+	assert.False(t, isGetTransactionRequest(http.MethodPost, []string{"transaction", "aabb"}))
+	assert.False(t, isGetTransactionRequest(http.MethodGet, []string{"transaction", "pool"}))
+	assert.False(t, isGetTransactionRequest(http.MethodGet, []string{"transaction"}))
+	assert.False(t, isGetTransactionRequest(http.MethodGet, []string{"address", "aabb"}))
+	assert.False(t, isGetTransactionRequest(http.MethodGet, []string{"transaction", "aabb", "other"}))
+}
+
+func TestIsSendMultipleTransactionsRequest(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isSendMultipleTransactionsRequest(http.MethodPost, []string{"transaction", "send-multiple"}))
+	assert.False(t, isSendMultipleTransactionsRequest(http.MethodGet, []string{"transaction", "send-multiple"}))
+	assert.False(t, isSendMultipleTransactionsRequest(http.MethodPost, []string{"transaction", "send"}))
+}
+
+func TestParseShardID(t *testing.T) {
+	t.Parallel()
+
+	shardID, err := parseShardID("metachain")
+	assert.Nil(t, err)
+	assert.Equal(t, core.MetachainShardId, shardID)
+
+	shardID, err = parseShardID("2")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), shardID)
+
+	_, err = parseShardID("by-nonce")
+	assert.ErrorIs(t, err, errInvalidShardID)
+}
+
+func TestProxyServer_ComputeDestinationForBlockShouldStripShard(t *testing.T) {
+	t.Parallel()
+
+	ps := &proxyServer{
+		metaNode: &chainSimulator.NodeHandlerMock{},
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "/block/1/by-nonce/37?withTxs=true", nil)
+	shardID, path, err := ps.computeDestination(request, splitPath(request.URL.Path))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), shardID)
+	assert.Equal(t, "/block/by-nonce/37", path)
+
+	request, _ = http.NewRequest(http.MethodGet, "/network/status/metachain", nil)
+	shardID, path, err = ps.computeDestination(request, splitPath(request.URL.Path))
+	require.Nil(t, err)
+	assert.Equal(t, core.MetachainShardId, shardID)
+	assert.Equal(t, "/network/status", path)
+
+	request, _ = http.NewRequest(http.MethodGet, "/network/config", nil)
+	shardID, path, err = ps.computeDestination(request, splitPath(request.URL.Path))
+	require.Nil(t, err)
+	assert.Equal(t, core.MetachainShardId, shardID)
+	assert.Equal(t, "/network/config", path)
+
+	request, _ = http.NewRequest(http.MethodGet, "/node/status?shard-id=2", nil)
+	shardID, path, err = ps.computeDestination(request, splitPath(request.URL.Path))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), shardID)
+	assert.Equal(t, "/node/status", path)
+}
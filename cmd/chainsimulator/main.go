@@ -0,0 +1,338 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/cmd/chainsimulator/api"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator"
+	simulatorAPI "github.com/kalyan3104/k-chain-go/node/chainSimulator/components/api"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+	tempDirPattern      = "chain-simulator-"
+)
+
+var (
+	chainSimulatorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodeConfigsPath defines a flag for the path to the directory holding the node's configuration files
+	nodeConfigsPath = cli.StringFlag{
+		Name:  "node-configs",
+		Usage: "The `" + filePathPlaceholder + "` for the directory containing the node's configuration files (config.toml, genesis.json and so on)",
+		Value: "../node/config/",
+	}
+	// restApiInterfaceFlag defines a flag for the interface on which the simulator's API will try to bind with
+	restApiInterfaceFlag = cli.StringFlag{
+		Name: "rest-api-interface",
+		Usage: "The interface `address and port` to which the chain simulator REST API will attempt to bind. " +
+			"To bind to all available interfaces, set this flag to :8085",
+		Value: "localhost:8085",
+	}
+	// numOfShards defines a flag for the number of shards, metachain excluded
+	numOfShards = cli.UintFlag{
+		Name:  "num-of-shards",
+		Usage: "The number of shards the simulator will start, metachain excluded",
+		Value: 3,
+	}
+	// minNodesPerShard defines a flag for the minimum number of nodes in each shard
+	minNodesPerShard = cli.UintFlag{
+		Name:  "min-nodes-per-shard",
+		Usage: "The minimum number of validators in each shard",
+		Value: 1,
+	}
+	// metaChainMinNodes defines a flag for the minimum number of nodes in metachain
+	metaChainMinNodes = cli.UintFlag{
+		Name:  "meta-min-nodes",
+		Usage: "The minimum number of validators in the metachain",
+		Value: 1,
+	}
+	// roundsPerEpoch defines a flag for the number of rounds in each epoch
+	roundsPerEpoch = cli.Uint64Flag{
+		Name:  "rounds-per-epoch",
+		Usage: "The number of rounds in each epoch. If set to 0, the value from the node's config.toml file will be used",
+		Value: 20,
+	}
+	// roundDurationInMillis defines a flag for the round duration
+	roundDurationInMillis = cli.Uint64Flag{
+		Name:  "round-duration",
+		Usage: "The round duration in milliseconds, used to compute the blocks timestamps",
+		Value: 6000,
+	}
+	// bypassTxSignatureCheck defines a flag for disabling the transactions' signature check
+	bypassTxSignatureCheck = cli.BoolTFlag{
+		Name:  "bypass-tx-signature-check",
+		Usage: "Boolean option for bypassing the transactions' signature check. Enabled by default",
+	}
+	// blocksProductionMode defines a flag for the automatic blocks production mode
+	blocksProductionMode = cli.StringFlag{
+		Name: "blocks-production-mode",
+		Usage: "The automatic blocks production `mode`. Can be " + dtos.BlocksProductionOnInterval + " or " +
+			dtos.BlocksProductionOnTransaction + ". If not set, the blocks are produced only on demand",
+		Value: "",
+	}
+	// blocksProductionInterval defines a flag for the interval used by the automatic blocks production
+	blocksProductionInterval = cli.Uint64Flag{
+		Name:  "blocks-production-interval",
+		Usage: "The interval in milliseconds between two automatically produced blocks, used in the " + dtos.BlocksProductionOnInterval + " mode",
+		Value: 6000,
+	}
+	// wallClockTimestamps defines a flag for using the wall clock for the blocks timestamps
+	wallClockTimestamps = cli.BoolFlag{
+		Name:  "wall-clock-timestamps",
+		Usage: "Boolean option for using the wall clock time as blocks timestamps while producing blocks automatically",
+	}
+	// timestampOffset defines a flag for the offset added to the blocks timestamps
+	timestampOffset = cli.Int64Flag{
+		Name:  "timestamp-offset",
+		Usage: "The offset in seconds added to the blocks timestamps while producing blocks automatically",
+		Value: 0,
+	}
+	// forkDBPaths defines a flag for the databases the simulator will fork the state from
+	forkDBPaths = cli.StringFlag{
+		Name: "fork-db-paths",
+		Usage: "Comma separated list of shard=`path` pairs pointing to the database directories of stopped nodes, chain ID " +
+			"included (for example metachain=/node-meta/db/1,0=/node-0/db/1). If set, the simulator will start from the " +
+			"state of the provided nodes instead of the genesis. All shards, including the metachain, are required",
+		Value: "",
+	}
+	// forkObserverURLs defines a flag for the observers used to fetch the trie nodes missing from the fork databases
+	forkObserverURLs = cli.StringFlag{
+		Name: "fork-observer-urls",
+		Usage: "Comma separated list of shard=`url` pairs pointing to the REST API of observers used to fetch the trie " +
+			"nodes missing from the fork databases (for example 0=http://localhost:8080)",
+		Value: "",
+	}
+	// forkEpoch defines a flag for the epoch the state is forked at
+	forkEpoch = cli.UintFlag{
+		Name:  "fork-epoch",
+		Usage: "The epoch the state is forked at. Used only if the fork-db-paths flag is set",
+		Value: 0,
+	}
+	// forkNonce defines a flag for the metachain block nonce the state is forked at
+	forkNonce = cli.Uint64Flag{
+		Name:  "fork-nonce",
+		Usage: "The metachain block nonce the state is forked at. If set to 0, the epoch start block of the fork epoch will be used",
+		Value: 0,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = chainSimulatorHelpTemplate
+	app.Name = "Chain Simulator CLI App"
+	app.Usage = "This is the entry point for starting a new chain simulator - the app will start a local network with all shards in a single process"
+	app.Flags = []cli.Flag{
+		nodeConfigsPath,
+		restApiInterfaceFlag,
+		numOfShards,
+		minNodesPerShard,
+		metaChainMinNodes,
+		roundsPerEpoch,
+		roundDurationInMillis,
+		bypassTxSignatureCheck,
+		blocksProductionMode,
+		blocksProductionInterval,
+		wallClockTimestamps,
+		timestampOffset,
+		forkDBPaths,
+		forkObserverURLs,
+		forkEpoch,
+		forkNonce,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Kalyan Team",
+			Email: "contact@kalyan.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startChainSimulator(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startChainSimulator(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	tempDir, err := os.MkdirTemp("", tempDirPattern)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errRemove := os.RemoveAll(tempDir)
+		log.LogIfError(errRemove)
+	}()
+
+	rounds := core.OptionalUint64{}
+	if ctx.GlobalUint64(roundsPerEpoch.Name) > 0 {
+		rounds = core.OptionalUint64{
+			HasValue: true,
+			Value:    ctx.GlobalUint64(roundsPerEpoch.Name),
+		}
+	}
+
+	fork, err := createArgsFork(ctx)
+	if err != nil {
+		return err
+	}
+
+	simulator, err := chainSimulator.NewChainSimulator(chainSimulator.ArgsChainSimulator{
+		BypassTxSignatureCheck: ctx.GlobalBoolT(bypassTxSignatureCheck.Name),
+		TempDir:                tempDir,
+		PathToInitialConfig:    ctx.GlobalString(nodeConfigsPath.Name),
+		NumOfShards:            uint32(ctx.GlobalUint(numOfShards.Name)),
+		MinNodesPerShard:       uint32(ctx.GlobalUint(minNodesPerShard.Name)),
+		MetaChainMinNodes:      uint32(ctx.GlobalUint(metaChainMinNodes.Name)),
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  ctx.GlobalUint64(roundDurationInMillis.Name),
+		RoundsPerEpoch:         rounds,
+		ApiInterface:           simulatorAPI.NewFreePortAPIConfigurator("localhost"),
+		Fork:                   fork,
+	})
+	if err != nil {
+		return fmt.Errorf("%w while creating the chain simulator", err)
+	}
+	defer simulator.Close()
+
+	// at least one block should be produced over the genesis block so the facades can serve account requests
+	err = simulator.GenerateBlocks(1)
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.GlobalString(blocksProductionMode.Name)) > 0 {
+		err = simulator.StartBlocksProduction(dtos.BlocksProductionConfig{
+			Mode:                     ctx.GlobalString(blocksProductionMode.Name),
+			IntervalInMillis:         ctx.GlobalUint64(blocksProductionInterval.Name),
+			UseWallClockTimeStamps:   ctx.GlobalBool(wallClockTimestamps.Name),
+			TimeStampOffsetInSeconds: ctx.GlobalInt64(timestampOffset.Name),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	proxyServer, err := api.NewProxyServer(api.ArgsProxyServer{
+		RestApiInterface: ctx.GlobalString(restApiInterfaceFlag.Name),
+		Simulator:        simulator,
+	})
+	if err != nil {
+		return err
+	}
+	proxyServer.Start()
+
+	log.Info("chain simulator is now running", "nodes api interfaces", simulator.GetRestAPIInterfaces())
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	log.Info("terminating at user's signal...")
+
+	return proxyServer.Close()
+}
+
+func createArgsFork(ctx *cli.Context) (*chainSimulator.ArgsFork, error) {
+	if len(ctx.GlobalString(forkDBPaths.Name)) == 0 {
+		return nil, nil
+	}
+
+	dbPaths, err := parseShardsMap(ctx.GlobalString(forkDBPaths.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%w for flag %s", err, forkDBPaths.Name)
+	}
+
+	observerURLs, err := parseShardsMap(ctx.GlobalString(forkObserverURLs.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%w for flag %s", err, forkObserverURLs.Name)
+	}
+
+	nonce := core.OptionalUint64{}
+	if ctx.GlobalUint64(forkNonce.Name) > 0 {
+		nonce = core.OptionalUint64{
+			HasValue: true,
+			Value:    ctx.GlobalUint64(forkNonce.Name),
+		}
+	}
+
+	return &chainSimulator.ArgsFork{
+		DBPaths:      dbPaths,
+		ObserverURLs: observerURLs,
+		Epoch:        uint32(ctx.GlobalUint(forkEpoch.Name)),
+		Nonce:        nonce,
+	}, nil
+}
+
+// parseShardsMap parses values formatted as shard=value,shard=value, where shard is either a number or "metachain"
+func parseShardsMap(value string) (map[uint32]string, error) {
+	shardsMap := make(map[uint32]string)
+	if len(value) == 0 {
+		return shardsMap, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		splitPair := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(splitPair) != 2 || len(splitPair[1]) == 0 {
+			return nil, fmt.Errorf("invalid shard=value pair %s", pair)
+		}
+
+		shardID := core.MetachainShardId
+		if splitPair[0] != core.GetShardIDString(core.MetachainShardId) {
+			parsedShardID, err := strconv.ParseUint(splitPair[0], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w while parsing the shard of pair %s", err, pair)
+			}
+			shardID = uint32(parsedShardID)
+		}
+
+		shardsMap[shardID] = splitPair[1]
+	}
+
+	return shardsMap, nil
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/typeConverters/uint64ByteSlice"
+	hasherFactory "github.com/kalyan3104/k-chain-core-go/hashing/factory"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	marshalizerFactory "github.com/kalyan3104/k-chain-core-go/marshal/factory"
+	"github.com/kalyan3104/k-chain-go/cmd/dbtool/maintenance"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/directoryhandler"
+	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/latestData"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type storageMaintainerHandler interface {
+	GetLatestData() (storage.LatestDataFromStorage, error)
+	ListStorers() ([]*maintenance.StorerInfo, error)
+	CompactStorers(identifiers []string) ([]*maintenance.CompactionResult, error)
+	VerifyConsistency(shardID uint32, fromNonce uint64, toNonce uint64) (*maintenance.VerificationReport, error)
+	RemoveEpochsOutsideRetention(numEpochsToKeep uint32, dryRun bool) ([]uint32, error)
+	IsInterfaceNil() bool
+}
+
+type cliConfig struct {
+	databasePath    string
+	configFile      string
+	logLevel        string
+	storers         string
+	shard           string
+	fromNonce       uint64
+	toNonce         uint64
+	numEpochsToKeep uint
+	dryRun          bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// databasePath defines a flag for the node's database directory, the one holding the epoch directories
+	databasePath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The `path` to the node's database directory, the one holding the Epoch_* and Static directories (for example ./db/1)",
+		Destination: &argsConfig.databasePath,
+	}
+	// configurationFile defines a flag for the path to the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file, used for the storers identifiers, the marshaller and the hasher",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,dbtool:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the dbtool package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	// storers defines a flag for the storers to be compacted
+	storers = cli.StringFlag{
+		Name:        "storers",
+		Usage:       "Comma separated storer `identifiers` to be compacted, as found in the shard directories (for example Transactions,MiniBlocks)",
+		Destination: &argsConfig.storers,
+	}
+	// shard defines a flag for the shard whose blocks are verified
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The `shard` whose blocks are verified (a shard ID or metachain). If not set, the shard holding the latest bootstrap data is used",
+		Destination: &argsConfig.shard,
+	}
+	// fromNonce defines a flag for the first verified nonce
+	fromNonce = cli.Uint64Flag{
+		Name:        "from-nonce",
+		Usage:       "The first block nonce to be verified",
+		Destination: &argsConfig.fromNonce,
+	}
+	// toNonce defines a flag for the last verified nonce
+	toNonce = cli.Uint64Flag{
+		Name:        "to-nonce",
+		Usage:       "The last block nonce to be verified",
+		Destination: &argsConfig.toNonce,
+	}
+	// numEpochsToKeep defines a flag for the retention window
+	numEpochsToKeep = cli.UintFlag{
+		Name:        "epochs-to-keep",
+		Usage:       "The number of most recent epochs to keep, the older epoch directories being deleted",
+		Value:       4,
+		Destination: &argsConfig.numEpochsToKeep,
+	}
+	// dryRun defines a flag for only displaying the epochs that would be deleted
+	dryRun = cli.BoolFlag{
+		Name:        "dry-run",
+		Usage:       "Only displays the epochs that would be deleted",
+		Destination: &argsConfig.dryRun,
+	}
+	argsConfig = &cliConfig{}
+
+	log    = logger.GetOrCreate("dbtool")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Kalyan Database Tool"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Offline tool used to inspect and maintain the node's databases. The node must be stopped"
+	cliApp.Flags = []cli.Flag{
+		databasePath,
+		configurationFile,
+		logLevel,
+	}
+	cliApp.Commands = []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the epochs, the shards and the size of each storer",
+			Action: listStorers,
+		},
+		{
+			Name:   "compact",
+			Usage:  "compacts the selected storers, from all the epochs",
+			Flags:  []cli.Flag{storers},
+			Action: compactStorers,
+		},
+		{
+			Name:   "verify",
+			Usage:  "verifies that the headers, miniblocks and transactions are consistent for a nonce range",
+			Flags:  []cli.Flag{shard, fromNonce, toNonce},
+			Action: verifyConsistency,
+		},
+		{
+			Name:   "prune",
+			Usage:  "deletes the epochs outside the retention window",
+			Flags:  []cli.Flag{numEpochsToKeep, dryRun},
+			Action: removeOldEpochs,
+		},
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Kalyan Team",
+			Email: "contact@kalyan.com",
+		},
+	}
+}
+
+func listStorers(_ *cli.Context) error {
+	storageMaintainer, err := createStorageMaintainer()
+	if err != nil {
+		return err
+	}
+
+	latest, err := storageMaintainer.GetLatestData()
+	if err != nil {
+		log.Warn("cannot fetch the latest usable data", "error", err)
+	} else {
+		log.Info("latest usable data", "epoch", latest.Epoch, "shard", core.GetShardIDString(latest.ShardID),
+			"round", latest.LastRound, "epoch start round", latest.EpochStartRound)
+	}
+
+	storerInfos, err := storageMaintainer.ListStorers()
+	if err != nil {
+		return err
+	}
+
+	totalSize := int64(0)
+	for _, info := range storerInfos {
+		epoch := fmt.Sprintf("%d", info.Epoch)
+		if info.IsStatic {
+			epoch = storage.DefaultStaticDbString
+		}
+
+		log.Info("storer", "epoch", epoch, "shard", info.ShardID, "identifier", info.Identifier,
+			"size", core.ConvertBytes(uint64(info.SizeInBytes)))
+		totalSize += info.SizeInBytes
+	}
+
+	log.Info("storers found", "num storers", len(storerInfos), "total size", core.ConvertBytes(uint64(totalSize)))
+
+	return nil
+}
+
+func compactStorers(_ *cli.Context) error {
+	storageMaintainer, err := createStorageMaintainer()
+	if err != nil {
+		return err
+	}
+
+	identifiers := make([]string, 0)
+	for _, identifier := range strings.Split(argsConfig.storers, ",") {
+		identifier = strings.TrimSpace(identifier)
+		if len(identifier) > 0 {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+
+	results, err := storageMaintainer.CompactStorers(identifiers)
+	if err != nil {
+		return err
+	}
+
+	sizeBefore, sizeAfter := int64(0), int64(0)
+	for _, result := range results {
+		sizeBefore += result.SizeBeforeInBytes
+		sizeAfter += result.SizeAfterInBytes
+	}
+
+	log.Info("compaction finished", "num storers", len(results),
+		"size before", core.ConvertBytes(uint64(sizeBefore)), "size after", core.ConvertBytes(uint64(sizeAfter)))
+
+	return nil
+}
+
+func verifyConsistency(_ *cli.Context) error {
+	storageMaintainer, err := createStorageMaintainer()
+	if err != nil {
+		return err
+	}
+
+	shardID, err := getShardToVerify(storageMaintainer)
+	if err != nil {
+		return err
+	}
+
+	report, err := storageMaintainer.VerifyConsistency(shardID, argsConfig.fromNonce, argsConfig.toNonce)
+	if err != nil {
+		return err
+	}
+
+	for _, inconsistency := range report.Inconsistencies {
+		log.Warn("inconsistency", "details", inconsistency)
+	}
+
+	log.Info("verification finished", "shard", core.GetShardIDString(shardID),
+		"from nonce", argsConfig.fromNonce, "to nonce", argsConfig.toNonce,
+		"num headers", report.NumHeaders, "num miniblocks", report.NumMiniBlocks,
+		"num transactions", report.NumTransactions, "num inconsistencies", len(report.Inconsistencies))
+
+	if len(report.Inconsistencies) > 0 {
+		return fmt.Errorf("%d inconsistencies found", len(report.Inconsistencies))
+	}
+
+	return nil
+}
+
+func getShardToVerify(storageMaintainer storageMaintainerHandler) (uint32, error) {
+	if len(argsConfig.shard) > 0 {
+		return core.ConvertShardIDToUint32(argsConfig.shard)
+	}
+
+	latest, err := storageMaintainer.GetLatestData()
+	if err != nil {
+		return 0, fmt.Errorf("%w while fetching the shard to verify, consider setting the --%s flag", err, shard.Name)
+	}
+
+	return latest.ShardID, nil
+}
+
+func removeOldEpochs(_ *cli.Context) error {
+	storageMaintainer, err := createStorageMaintainer()
+	if err != nil {
+		return err
+	}
+
+	removedEpochs, err := storageMaintainer.RemoveEpochsOutsideRetention(uint32(argsConfig.numEpochsToKeep), argsConfig.dryRun)
+	if err != nil {
+		return err
+	}
+
+	log.Info("epochs outside the retention window", "epochs", removedEpochs, "dry run", argsConfig.dryRun)
+
+	return nil
+}
+
+func createStorageMaintainer() (storageMaintainerHandler, error) {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	latestStorageDataProvider, err := createLatestStorageDataProvider(*generalConfig, marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	args := maintenance.ArgsStorageMaintainer{
+		DatabasePath:              argsConfig.databasePath,
+		GeneralConfig:             *generalConfig,
+		LatestStorageDataProvider: latestStorageDataProvider,
+		Marshaller:                marshaller,
+		Hasher:                    hasher,
+		Uint64Converter:           uint64ByteSlice.NewBigEndianConverter(),
+	}
+
+	return maintenance.NewStorageMaintainer(args)
+}
+
+func createLatestStorageDataProvider(generalConfig config.Config, marshaller marshal.Marshalizer) (storage.LatestStorageDataProviderHandler, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	args := latestData.ArgsLatestDataProvider{
+		GeneralConfig:         generalConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryhandler.NewDirectoryReader(),
+		ParentDir:             argsConfig.databasePath,
+		DefaultEpochString:    storage.DefaultEpochString,
+		DefaultShardString:    storage.DefaultShardString,
+	}
+
+	return latestData.NewLatestDataProvider(args)
+}
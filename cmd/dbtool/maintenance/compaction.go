@@ -0,0 +1,181 @@
+package maintenance
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+)
+
+const (
+	compactedDirSuffix = ".compacted"
+	replacedDirSuffix  = ".replaced"
+)
+
+// CompactionResult holds the outcome of compacting one storer
+type CompactionResult struct {
+	Path              string
+	NumKeys           int
+	SizeBeforeInBytes int64
+	SizeAfterInBytes  int64
+}
+
+// CompactStorers compacts all the storers, static or from any epoch, having one of the provided identifiers.
+// The compaction rewrites all the live keys in a new persister of the same type and layout, which then replaces
+// the original one, so the space held by the deleted or overwritten keys is released
+func (sm *storageMaintainer) CompactStorers(identifiers []string) ([]*CompactionResult, error) {
+	if len(identifiers) == 0 {
+		return nil, ErrNoStorerSelected
+	}
+
+	selected := make(map[string]struct{}, len(identifiers))
+	for _, identifier := range identifiers {
+		selected[identifier] = struct{}{}
+	}
+
+	storers, err := sm.ListStorers()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*CompactionResult, 0)
+	for _, storerInfo := range storers {
+		_, isSelected := selected[storerInfo.Identifier]
+		if !isSelected {
+			continue
+		}
+
+		result, errCompact := compactPersister(storerInfo)
+		if errCompact != nil {
+			return results, fmt.Errorf("%w while compacting %s", errCompact, storerInfo.Path)
+		}
+
+		log.Info("compacted storer", "path", result.Path, "num keys", result.NumKeys,
+			"size before", result.SizeBeforeInBytes, "size after", result.SizeAfterInBytes)
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w for identifiers %v", ErrNoStorerFound, identifiers)
+	}
+
+	return results, nil
+}
+
+func compactPersister(storerInfo *StorerInfo) (*CompactionResult, error) {
+	path := storerInfo.Path
+	compactedPath := path + compactedDirSuffix
+	replacedPath := path + replacedDirSuffix
+
+	// leftovers of an interrupted compaction are never used by the node
+	err := os.RemoveAll(compactedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	numKeys, err := copyToCompactedPersister(path, compactedPath)
+	if err != nil {
+		_ = os.RemoveAll(compactedPath)
+		return nil, err
+	}
+
+	err = os.Rename(path, replacedPath)
+	if err != nil {
+		return nil, err
+	}
+	err = os.Rename(compactedPath, path)
+	if err != nil {
+		return nil, err
+	}
+	err = os.RemoveAll(replacedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sizeAfter, err := computeDirSize(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompactionResult{
+		Path:              path,
+		NumKeys:           numKeys,
+		SizeBeforeInBytes: storerInfo.SizeInBytes,
+		SizeAfterInBytes:  sizeAfter,
+	}, nil
+}
+
+func copyToCompactedPersister(path string, compactedPath string) (int, error) {
+	dbConfigHandler := factory.NewDBConfigHandler(createDefaultDBConfig())
+	dbConfig, err := dbConfigHandler.GetDBConfig(path)
+	if err != nil {
+		return 0, err
+	}
+
+	source, err := createPersister(dbConfigHandler, path)
+	if err != nil {
+		return 0, err
+	}
+	defer closePersister(source, path)
+
+	// the compacted persister keeps the type and the sharding of the original one
+	compacted, err := createPersister(factory.NewDBConfigHandler(*dbConfig), compactedPath)
+	if err != nil {
+		return 0, err
+	}
+
+	numKeys, err := copyKeys(source, compacted)
+	closePersister(compacted, compactedPath)
+	if err != nil {
+		return 0, err
+	}
+
+	// the compacted persister is reopened as the batched writes are only visible after being flushed
+	numCompactedKeys, err := countPersistedKeys(compactedPath, *dbConfig)
+	if err != nil {
+		return 0, err
+	}
+	if numCompactedKeys != numKeys {
+		return 0, fmt.Errorf("%w: copied %d keys, found %d", ErrKeysCountMismatch, numKeys, numCompactedKeys)
+	}
+
+	return numKeys, nil
+}
+
+func copyKeys(source storage.Persister, destination storage.Persister) (int, error) {
+	numKeys := 0
+	var errPut error
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destination.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numKeys++
+		return true
+	})
+
+	return numKeys, errPut
+}
+
+func countPersistedKeys(path string, dbConfig config.DBConfig) (int, error) {
+	persister, err := createPersister(factory.NewDBConfigHandler(dbConfig), path)
+	if err != nil {
+		return 0, err
+	}
+	defer closePersister(persister, path)
+
+	return countKeys(persister), nil
+}
+
+func countKeys(persister storage.Persister) int {
+	numKeys := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys
+}
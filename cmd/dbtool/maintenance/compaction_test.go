@@ -0,0 +1,92 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageMaintainer_CompactStorers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no storer selected should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := NewStorageMaintainer(createMockArgsStorageMaintainer(t))
+		results, err := sm.CompactStorers(nil)
+		assert.Nil(t, results)
+		assert.Equal(t, ErrNoStorerSelected, err)
+	})
+	t.Run("no storer found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		putInStorer(t, args.DatabasePath, epochDir(0), "0", "Transactions", map[string][]byte{"key": []byte("value")})
+
+		sm, _ := NewStorageMaintainer(args)
+		results, err := sm.CompactStorers([]string{"MiniBlocks"})
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, ErrNoStorerFound))
+	})
+	t.Run("should compact the selected storers from all epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		numKeys := 1000
+		keysValues := make(map[string][]byte, numKeys)
+		for i := 0; i < numKeys; i++ {
+			keysValues[fmt.Sprintf("key%d", i)] = []byte(fmt.Sprintf("value%d", i))
+		}
+		putInStorer(t, args.DatabasePath, epochDir(0), "0", "Transactions", keysValues)
+		putInStorer(t, args.DatabasePath, epochDir(1), "0", "Transactions", keysValues)
+		putInStorer(t, args.DatabasePath, epochDir(1), "0", "MiniBlocks", keysValues)
+
+		// remove most of the keys from one of the storers
+		path := filepath.Join(args.DatabasePath, epochDir(1), "Shard_0", "Transactions")
+		persister, err := createPersister(factory.NewDBConfigHandler(createDefaultDBConfig()), path)
+		require.Nil(t, err)
+		for i := 10; i < numKeys; i++ {
+			_ = persister.Remove([]byte(fmt.Sprintf("key%d", i)))
+		}
+		_ = persister.Close()
+
+		sm, _ := NewStorageMaintainer(args)
+		results, err := sm.CompactStorers([]string{"Transactions"})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(results))
+		assert.Equal(t, numKeys, results[0].NumKeys)
+		assert.Equal(t, 10, results[1].NumKeys)
+		assert.Equal(t, path, results[1].Path)
+		assert.True(t, results[1].SizeAfterInBytes < results[1].SizeBeforeInBytes)
+		assert.False(t, dirExists(path+compactedDirSuffix))
+		assert.False(t, dirExists(path+replacedDirSuffix))
+
+		dbConfig := &config.DBConfig{}
+		err = core.LoadTomlFile(dbConfig, filepath.Join(path, "config.toml"))
+		require.Nil(t, err)
+		assert.Equal(t, string(storageunit.LvlDBSerial), dbConfig.Type)
+
+		persister, err = createPersister(factory.NewDBConfigHandler(createDefaultDBConfig()), path)
+		require.Nil(t, err)
+		defer closePersister(persister, path)
+
+		assert.Equal(t, 10, countKeys(persister))
+		for i := 0; i < numKeys; i++ {
+			value, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+			if i < 10 {
+				assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+				continue
+			}
+			assert.Equal(t, storage.ErrKeyNotFound, errGet)
+		}
+	})
+}
@@ -0,0 +1,279 @@
+package maintenance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+)
+
+// VerificationReport holds the outcome of a consistency verification
+type VerificationReport struct {
+	NumHeaders      int
+	NumMiniBlocks   int
+	NumTransactions int
+	Inconsistencies []string
+}
+
+type consistencyVerifier struct {
+	*storageMaintainer
+	shardID          uint32
+	shardIDStr       string
+	epochsNewerFirst []uint32
+	persisters       map[string]storage.Persister
+	report           *VerificationReport
+}
+
+// VerifyConsistency checks, for each nonce of the provided range, that the block header can be found by its nonce,
+// that all its miniblocks are stored and that all the transactions processed in those miniblocks are stored.
+// The hashes of the headers and miniblocks are checked against their stored contents
+func (sm *storageMaintainer) VerifyConsistency(shardID uint32, fromNonce uint64, toNonce uint64) (*VerificationReport, error) {
+	if fromNonce > toNonce {
+		return nil, fmt.Errorf("%w: from %d to %d", ErrInvalidNonceRange, fromNonce, toNonce)
+	}
+
+	epochs, err := sm.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	epochsNewerFirst := make([]uint32, 0, len(epochs))
+	for i := len(epochs) - 1; i >= 0; i-- {
+		epochsNewerFirst = append(epochsNewerFirst, epochs[i])
+	}
+
+	cv := &consistencyVerifier{
+		storageMaintainer: sm,
+		shardID:           shardID,
+		shardIDStr:        core.GetShardIDString(shardID),
+		epochsNewerFirst:  epochsNewerFirst,
+		persisters:        make(map[string]storage.Persister),
+		report: &VerificationReport{
+			Inconsistencies: make([]string, 0),
+		},
+	}
+	defer cv.closePersisters()
+
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		err = cv.verifyNonce(nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		if nonce == toNonce {
+			// avoid the overflow when toNonce is the maximum uint64 value
+			break
+		}
+	}
+
+	return cv.report, nil
+}
+
+func (cv *consistencyVerifier) verifyNonce(nonce uint64) error {
+	nonceHashPath := cv.pathManager.PathForStatic(cv.shardIDStr, cv.getNonceHashIdentifier())
+	headerHash, err := cv.get(nonceHashPath, cv.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		return cv.handleMissingData(err, "no header hash for nonce %d", nonce)
+	}
+
+	headerIdentifier := cv.getHeaderIdentifier()
+	headerBuff, err := cv.getFromEpochs(headerIdentifier, headerHash, cv.epochsNewerFirst)
+	if err != nil {
+		return cv.handleMissingData(err, "missing header %s for nonce %d", hex.EncodeToString(headerHash), nonce)
+	}
+
+	if !bytes.Equal(cv.hasher.Compute(string(headerBuff)), headerHash) {
+		cv.addInconsistency("header %s for nonce %d does not match its hash", hex.EncodeToString(headerHash), nonce)
+		return nil
+	}
+
+	header, err := process.UnmarshalHeader(cv.shardID, cv.marshaller, headerBuff)
+	if err != nil {
+		cv.addInconsistency("header %s for nonce %d cannot be unmarshalled: %s", hex.EncodeToString(headerHash), nonce, err.Error())
+		return nil
+	}
+	if header.GetNonce() != nonce {
+		cv.addInconsistency("header %s is indexed for nonce %d but has nonce %d", hex.EncodeToString(headerHash), nonce, header.GetNonce())
+		return nil
+	}
+
+	cv.report.NumHeaders++
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		err = cv.verifyMiniBlock(header, miniBlockHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cv *consistencyVerifier) verifyMiniBlock(header data.HeaderHandler, miniBlockHeader data.MiniBlockHeaderHandler) error {
+	miniBlockHash := miniBlockHeader.GetHash()
+	epochs := cv.getEpochsStartingWith(header.GetEpoch())
+	miniBlockBuff, err := cv.getFromEpochs(cv.generalConfig.MiniBlocksStorage.DB.FilePath, miniBlockHash, epochs)
+	if err != nil {
+		return cv.handleMissingData(err, "missing miniblock %s from header with nonce %d",
+			hex.EncodeToString(miniBlockHash), header.GetNonce())
+	}
+
+	if !bytes.Equal(cv.hasher.Compute(string(miniBlockBuff)), miniBlockHash) {
+		cv.addInconsistency("miniblock %s from header with nonce %d does not match its hash",
+			hex.EncodeToString(miniBlockHash), header.GetNonce())
+		return nil
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = cv.marshaller.Unmarshal(miniBlock, miniBlockBuff)
+	if err != nil {
+		cv.addInconsistency("miniblock %s from header with nonce %d cannot be unmarshalled: %s",
+			hex.EncodeToString(miniBlockHash), header.GetNonce(), err.Error())
+		return nil
+	}
+	if uint32(len(miniBlock.TxHashes)) != miniBlockHeader.GetTxCount() {
+		cv.addInconsistency("miniblock %s from header with nonce %d holds %d transactions, the header declares %d",
+			hex.EncodeToString(miniBlockHash), header.GetNonce(), len(miniBlock.TxHashes), miniBlockHeader.GetTxCount())
+		return nil
+	}
+
+	cv.report.NumMiniBlocks++
+	txIdentifier, shouldCheckTxs := cv.getTransactionsIdentifier(miniBlock.Type)
+	if !shouldCheckTxs {
+		return nil
+	}
+
+	// only the processed transactions are saved, as a miniblock can be partially executed in a block
+	firstIndex := int(miniBlockHeader.GetIndexOfFirstTxProcessed())
+	lastIndex := int(miniBlockHeader.GetIndexOfLastTxProcessed())
+	for index := firstIndex; index <= lastIndex && index < len(miniBlock.TxHashes); index++ {
+		txHash := miniBlock.TxHashes[index]
+		_, err = cv.getFromEpochs(txIdentifier, txHash, epochs)
+		if err != nil {
+			err = cv.handleMissingData(err, "missing transaction %s from miniblock %s, header with nonce %d",
+				hex.EncodeToString(txHash), hex.EncodeToString(miniBlockHash), header.GetNonce())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		cv.report.NumTransactions++
+	}
+
+	return nil
+}
+
+func (cv *consistencyVerifier) getNonceHashIdentifier() string {
+	if cv.shardID == core.MetachainShardId {
+		return cv.generalConfig.MetaHdrNonceHashStorage.DB.FilePath
+	}
+
+	return cv.generalConfig.ShardHdrNonceHashStorage.DB.FilePath + cv.shardIDStr
+}
+
+func (cv *consistencyVerifier) getHeaderIdentifier() string {
+	if cv.shardID == core.MetachainShardId {
+		return cv.generalConfig.MetaBlockStorage.DB.FilePath
+	}
+
+	return cv.generalConfig.BlockHeaderStorage.DB.FilePath
+}
+
+func (cv *consistencyVerifier) getTransactionsIdentifier(miniBlockType block.Type) (string, bool) {
+	switch miniBlockType {
+	case block.TxBlock:
+		return cv.generalConfig.TxStorage.DB.FilePath, true
+	case block.SmartContractResultBlock:
+		return cv.generalConfig.UnsignedTransactionStorage.DB.FilePath, true
+	case block.RewardsBlock:
+		return cv.generalConfig.RewardTxStorage.DB.FilePath, true
+	default:
+		return "", false
+	}
+}
+
+// getEpochsStartingWith returns the known epochs, the provided one first, as the block data is usually saved
+// in the epoch of the block header
+func (cv *consistencyVerifier) getEpochsStartingWith(epoch uint32) []uint32 {
+	epochs := make([]uint32, 0, len(cv.epochsNewerFirst))
+	epochs = append(epochs, epoch)
+	for _, existingEpoch := range cv.epochsNewerFirst {
+		if existingEpoch != epoch {
+			epochs = append(epochs, existingEpoch)
+		}
+	}
+
+	return epochs
+}
+
+func (cv *consistencyVerifier) getFromEpochs(identifier string, key []byte, epochs []uint32) ([]byte, error) {
+	for _, epoch := range epochs {
+		path := cv.pathManager.PathForEpoch(cv.shardIDStr, epoch, identifier)
+		value, err := cv.get(path, key)
+		if err == nil {
+			return value, nil
+		}
+		if err != storage.ErrKeyNotFound {
+			return nil, err
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+func (cv *consistencyVerifier) get(path string, key []byte) ([]byte, error) {
+	persister, err := cv.getPersister(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return persister.Get(key)
+}
+
+func (cv *consistencyVerifier) getPersister(path string) (storage.Persister, error) {
+	persister, found := cv.persisters[path]
+	if found {
+		return persister, nil
+	}
+
+	// the persisters are opened only if they exist, so the verification does not create any directory
+	if !dirExists(path) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	persister, err := createPersister(factory.NewDBConfigHandler(createDefaultDBConfig()), path)
+	if err != nil {
+		return nil, err
+	}
+	cv.persisters[path] = persister
+
+	return persister, nil
+}
+
+// handleMissingData records the inconsistency for a missing key, any other error stopping the verification
+func (cv *consistencyVerifier) handleMissingData(err error, format string, args ...interface{}) error {
+	if err != storage.ErrKeyNotFound {
+		return err
+	}
+
+	cv.addInconsistency(format, args...)
+	return nil
+}
+
+func (cv *consistencyVerifier) addInconsistency(format string, args ...interface{}) {
+	inconsistency := fmt.Sprintf(format, args...)
+	log.Debug("inconsistency found", "details", inconsistency)
+	cv.report.Inconsistencies = append(cv.report.Inconsistencies, inconsistency)
+}
+
+func (cv *consistencyVerifier) closePersisters() {
+	for path, persister := range cv.persisters {
+		closePersister(persister, path)
+	}
+}
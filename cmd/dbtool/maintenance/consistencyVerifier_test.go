@@ -0,0 +1,185 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlocksData struct {
+	nonceHashes  map[string][]byte
+	headers      map[string][]byte
+	miniBlocks   map[string][]byte
+	transactions map[string][]byte
+}
+
+// createTestBlocks creates numBlocks shard blocks starting with nonce 1, each holding one transactions miniblock
+// with two transactions
+func createTestBlocks(tb testing.TB, args ArgsStorageMaintainer, epoch uint32, numBlocks int) *testBlocksData {
+	blocksData := &testBlocksData{
+		nonceHashes:  make(map[string][]byte),
+		headers:      make(map[string][]byte),
+		miniBlocks:   make(map[string][]byte),
+		transactions: make(map[string][]byte),
+	}
+
+	for i := 1; i <= numBlocks; i++ {
+		txHashes := [][]byte{
+			[]byte(fmt.Sprintf("tx%d-0", i)),
+			[]byte(fmt.Sprintf("tx%d-1", i)),
+		}
+		for _, txHash := range txHashes {
+			blocksData.transactions[string(txHash)] = []byte("tx")
+		}
+
+		miniBlock := &block.MiniBlock{
+			TxHashes: txHashes,
+			Type:     block.TxBlock,
+		}
+		miniBlockBuff, err := args.Marshaller.Marshal(miniBlock)
+		require.Nil(tb, err)
+		miniBlockHash := args.Hasher.Compute(string(miniBlockBuff))
+		blocksData.miniBlocks[string(miniBlockHash)] = miniBlockBuff
+
+		miniBlockHeader := block.MiniBlockHeader{
+			Hash:    miniBlockHash,
+			TxCount: uint32(len(txHashes)),
+			Type:    block.TxBlock,
+		}
+		err = miniBlockHeader.SetIndexOfLastTxProcessed(int32(len(txHashes) - 1))
+		require.Nil(tb, err)
+
+		header := &block.Header{
+			Nonce:            uint64(i),
+			Epoch:            epoch,
+			MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader},
+		}
+		headerBuff, err := args.Marshaller.Marshal(header)
+		require.Nil(tb, err)
+		headerHash := args.Hasher.Compute(string(headerBuff))
+		blocksData.headers[string(headerHash)] = headerBuff
+		blocksData.nonceHashes[string(args.Uint64Converter.ToByteSlice(uint64(i)))] = headerHash
+	}
+
+	return blocksData
+}
+
+func (blocksData *testBlocksData) save(tb testing.TB, dbPath string, epoch uint32) {
+	putInStorer(tb, dbPath, storage.DefaultStaticDbString, "0", "ShardHdrHashNonce0", blocksData.nonceHashes)
+	putInStorer(tb, dbPath, epochDir(epoch), "0", "BlockHeaders", blocksData.headers)
+	putInStorer(tb, dbPath, epochDir(epoch), "0", "MiniBlocks", blocksData.miniBlocks)
+	putInStorer(tb, dbPath, epochDir(epoch), "0", "Transactions", blocksData.transactions)
+}
+
+func containsInconsistency(inconsistencies []string, substring string) bool {
+	for _, inconsistency := range inconsistencies {
+		if strings.Contains(inconsistency, substring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestStorageMaintainer_VerifyConsistency(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := NewStorageMaintainer(createMockArgsStorageMaintainer(t))
+		report, err := sm.VerifyConsistency(0, 10, 9)
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, ErrInvalidNonceRange))
+	})
+	t.Run("consistent blocks should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		createTestBlocks(t, args, 3, 5).save(t, args.DatabasePath, 3)
+
+		sm, _ := NewStorageMaintainer(args)
+		report, err := sm.VerifyConsistency(0, 1, 5)
+		require.Nil(t, err)
+		assert.Equal(t, &VerificationReport{
+			NumHeaders:      5,
+			NumMiniBlocks:   5,
+			NumTransactions: 10,
+			Inconsistencies: make([]string, 0),
+		}, report)
+	})
+	t.Run("blocks data spread in multiple epochs should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		blocksData := createTestBlocks(t, args, 3, 5)
+		putInStorer(t, args.DatabasePath, storage.DefaultStaticDbString, "0", "ShardHdrHashNonce0", blocksData.nonceHashes)
+		putInStorer(t, args.DatabasePath, epochDir(3), "0", "BlockHeaders", blocksData.headers)
+		putInStorer(t, args.DatabasePath, epochDir(3), "0", "MiniBlocks", blocksData.miniBlocks)
+		putInStorer(t, args.DatabasePath, epochDir(4), "0", "Transactions", blocksData.transactions)
+
+		sm, _ := NewStorageMaintainer(args)
+		report, err := sm.VerifyConsistency(0, 1, 5)
+		require.Nil(t, err)
+		assert.Empty(t, report.Inconsistencies)
+		assert.Equal(t, 10, report.NumTransactions)
+	})
+	t.Run("missing data should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		blocksData := createTestBlocks(t, args, 3, 5)
+		delete(blocksData.transactions, "tx2-1")
+		for hash, miniBlockBuff := range blocksData.miniBlocks {
+			miniBlock := &block.MiniBlock{}
+			_ = args.Marshaller.Unmarshal(miniBlock, miniBlockBuff)
+			if string(miniBlock.TxHashes[0]) == "tx3-0" {
+				delete(blocksData.miniBlocks, hash)
+			}
+		}
+		for hash, headerBuff := range blocksData.headers {
+			header := &block.Header{}
+			_ = args.Marshaller.Unmarshal(header, headerBuff)
+			if header.Nonce == 4 {
+				blocksData.headers[hash] = append(headerBuff, 0)
+			}
+		}
+		blocksData.save(t, args.DatabasePath, 3)
+
+		sm, _ := NewStorageMaintainer(args)
+		report, err := sm.VerifyConsistency(0, 1, 6)
+		require.Nil(t, err)
+		assert.Equal(t, 4, len(report.Inconsistencies))
+		assert.True(t, containsInconsistency(report.Inconsistencies, "missing transaction 7478322d31"))
+		assert.True(t, containsInconsistency(report.Inconsistencies, "header with nonce 3"))
+		assert.True(t, containsInconsistency(report.Inconsistencies, "for nonce 4 does not match its hash"))
+		assert.True(t, containsInconsistency(report.Inconsistencies, "no header hash for nonce 6"))
+		assert.Equal(t, 4, report.NumHeaders)
+		assert.Equal(t, 3, report.NumMiniBlocks)
+		assert.Equal(t, 5, report.NumTransactions)
+	})
+	t.Run("metachain should use the meta storers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		header := &block.MetaBlock{Nonce: 1}
+		headerBuff, _ := args.Marshaller.Marshal(header)
+		headerHash := args.Hasher.Compute(string(headerBuff))
+		nonceKey := string(args.Uint64Converter.ToByteSlice(1))
+		putInStorer(t, args.DatabasePath, storage.DefaultStaticDbString, "metachain", "MetaHdrHashNonce", map[string][]byte{nonceKey: headerHash})
+		putInStorer(t, args.DatabasePath, epochDir(0), "metachain", "MetaBlock", map[string][]byte{string(headerHash): headerBuff})
+
+		sm, _ := NewStorageMaintainer(args)
+		report, err := sm.VerifyConsistency(core.MetachainShardId, 1, 1)
+		require.Nil(t, err)
+		assert.Empty(t, report.Inconsistencies)
+		assert.Equal(t, 1, report.NumHeaders)
+	})
+}
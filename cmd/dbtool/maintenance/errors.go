@@ -0,0 +1,33 @@
+package maintenance
+
+import "errors"
+
+// ErrEmptyDatabasePath signals that an empty database path has been provided
+var ErrEmptyDatabasePath = errors.New("empty database path")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilLatestStorageDataProvider signals that a nil latest storage data provider has been provided
+var ErrNilLatestStorageDataProvider = errors.New("nil latest storage data provider")
+
+// ErrNoStorerSelected signals that no storer identifier has been provided for compaction
+var ErrNoStorerSelected = errors.New("no storer selected")
+
+// ErrNoStorerFound signals that none of the selected storers has been found on disk
+var ErrNoStorerFound = errors.New("no storer found")
+
+// ErrKeysCountMismatch signals that the compacted persister does not hold all the original keys
+var ErrKeysCountMismatch = errors.New("keys count mismatch between original and compacted persister")
+
+// ErrInvalidNonceRange signals that an invalid nonce range has been provided
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrInvalidRetentionWindow signals that the retention window would remove epochs still needed by the node
+var ErrInvalidRetentionWindow = errors.New("invalid retention window")
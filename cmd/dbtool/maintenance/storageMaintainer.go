@@ -0,0 +1,309 @@
+package maintenance
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data/typeConverters"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dbtool")
+
+// ArgsStorageMaintainer holds the arguments needed to create a storage maintainer
+type ArgsStorageMaintainer struct {
+	DatabasePath              string
+	GeneralConfig             config.Config
+	LatestStorageDataProvider storage.LatestStorageDataProviderHandler
+	Marshaller                marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	Uint64Converter           typeConverters.Uint64ByteSliceConverter
+}
+
+// StorerInfo holds the details of a storer directory found in the node's database directory
+type StorerInfo struct {
+	IsStatic    bool
+	Epoch       uint32
+	ShardID     string
+	Identifier  string
+	Path        string
+	SizeInBytes int64
+}
+
+type storageMaintainer struct {
+	databasePath              string
+	generalConfig             config.Config
+	latestStorageDataProvider storage.LatestStorageDataProviderHandler
+	marshaller                marshal.Marshalizer
+	hasher                    hashing.Hasher
+	uint64Converter           typeConverters.Uint64ByteSliceConverter
+	pathManager               storage.PathManagerHandler
+}
+
+// NewStorageMaintainer creates a component able to inspect and maintain the databases of a stopped node
+func NewStorageMaintainer(args ArgsStorageMaintainer) (*storageMaintainer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(args.DatabasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageMaintainer{
+		databasePath:              args.DatabasePath,
+		generalConfig:             args.GeneralConfig,
+		latestStorageDataProvider: args.LatestStorageDataProvider,
+		marshaller:                args.Marshaller,
+		hasher:                    args.Hasher,
+		uint64Converter:           args.Uint64Converter,
+		pathManager:               pathManager,
+	}, nil
+}
+
+func checkArgs(args ArgsStorageMaintainer) error {
+	if len(args.DatabasePath) == 0 {
+		return ErrEmptyDatabasePath
+	}
+	if check.IfNil(args.LatestStorageDataProvider) {
+		return ErrNilLatestStorageDataProvider
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return ErrNilUint64Converter
+	}
+
+	return nil
+}
+
+// GetLatestData returns the latest usable epoch, shard and round found in the bootstrap storers
+func (sm *storageMaintainer) GetLatestData() (storage.LatestDataFromStorage, error) {
+	return sm.latestStorageDataProvider.Get()
+}
+
+// ListStorers returns all the storers found on disk, the static ones first, followed by the per epoch ones
+func (sm *storageMaintainer) ListStorers() ([]*StorerInfo, error) {
+	storers := make([]*StorerInfo, 0)
+	staticStorers, err := sm.listStorersInDir(filepath.Join(sm.databasePath, storage.DefaultStaticDbString), true, 0)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	storers = append(storers, staticStorers...)
+
+	epochs, err := sm.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+	for _, epoch := range epochs {
+		epochStorers, errList := sm.listStorersInDir(sm.getEpochPath(epoch), false, epoch)
+		if errList != nil {
+			return nil, errList
+		}
+
+		storers = append(storers, epochStorers...)
+	}
+
+	return storers, nil
+}
+
+func (sm *storageMaintainer) listStorersInDir(path string, isStatic bool, epoch uint32) ([]*StorerInfo, error) {
+	shardDirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	storers := make([]*StorerInfo, 0)
+	shardPrefix := storage.DefaultShardString + "_"
+	for _, shardDir := range shardDirs {
+		if !shardDir.IsDir() || !strings.HasPrefix(shardDir.Name(), shardPrefix) {
+			continue
+		}
+
+		shardPath := filepath.Join(path, shardDir.Name())
+		storerDirs, errRead := os.ReadDir(shardPath)
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		for _, storerDir := range storerDirs {
+			if !storerDir.IsDir() {
+				continue
+			}
+
+			storerPath := filepath.Join(shardPath, storerDir.Name())
+			size, errSize := computeDirSize(storerPath)
+			if errSize != nil {
+				return nil, errSize
+			}
+
+			storers = append(storers, &StorerInfo{
+				IsStatic:    isStatic,
+				Epoch:       epoch,
+				ShardID:     strings.TrimPrefix(shardDir.Name(), shardPrefix),
+				Identifier:  storerDir.Name(),
+				Path:        storerPath,
+				SizeInBytes: size,
+			})
+		}
+	}
+
+	return storers, nil
+}
+
+// getEpochs returns the epochs that have a directory on disk, sorted ascending
+func (sm *storageMaintainer) getEpochs() ([]uint32, error) {
+	entries, err := os.ReadDir(sm.databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochPrefix := storage.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+func (sm *storageMaintainer) getEpochPath(epoch uint32) string {
+	return filepath.Join(sm.databasePath, fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch))
+}
+
+// RemoveEpochsOutsideRetention deletes the epoch directories older than the last numEpochsToKeep epochs, the last
+// epoch being the one holding the latest usable bootstrap data. The removed epochs are returned, but nothing is
+// deleted if dryRun is set
+func (sm *storageMaintainer) RemoveEpochsOutsideRetention(numEpochsToKeep uint32, dryRun bool) ([]uint32, error) {
+	minEpochsToKeep := sm.generalConfig.StoragePruning.NumActivePersisters
+	if minEpochsToKeep == 0 {
+		minEpochsToKeep = 1
+	}
+	if uint64(numEpochsToKeep) < minEpochsToKeep {
+		return nil, fmt.Errorf("%w: %d epochs to keep, the node needs at least %d active epochs",
+			ErrInvalidRetentionWindow, numEpochsToKeep, minEpochsToKeep)
+	}
+
+	latestData, err := sm.latestStorageDataProvider.Get()
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the latest usable epoch, make sure the node is stopped", err)
+	}
+
+	removedEpochs := make([]uint32, 0)
+	if latestData.Epoch < numEpochsToKeep {
+		return removedEpochs, nil
+	}
+
+	oldestEpochToKeep := latestData.Epoch - numEpochsToKeep + 1
+	epochs, err := sm.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epoch := range epochs {
+		if epoch >= oldestEpochToKeep {
+			break
+		}
+
+		log.Debug("removing epoch directory", "epoch", epoch, "dry run", dryRun)
+		if !dryRun {
+			err = os.RemoveAll(sm.getEpochPath(epoch))
+			if err != nil {
+				return removedEpochs, err
+			}
+		}
+
+		removedEpochs = append(removedEpochs, epoch)
+	}
+
+	return removedEpochs, nil
+}
+
+func createDefaultDBConfig() config.DBConfig {
+	return config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createPersister(dbConfigHandler storage.DBConfigHandler, path string) (storage.Persister, error) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+func closePersister(persister storage.Persister, path string) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "path", path, "error", err)
+	}
+}
+
+func computeDirSize(path string) (int64, error) {
+	size := int64(0)
+	err := filepath.WalkDir(path, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+
+		return nil
+	})
+
+	return size, err
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.IsDir()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *storageMaintainer) IsInterfaceNil() bool {
+	return sm == nil
+}
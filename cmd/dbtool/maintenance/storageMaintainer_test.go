@@ -0,0 +1,239 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/kalyan3104/k-chain-core-go/hashing/blake2b"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGeneralConfig() config.Config {
+	cfg := config.Config{}
+	cfg.StoragePruning.NumActivePersisters = 2
+	cfg.ShardHdrNonceHashStorage.DB.FilePath = "ShardHdrHashNonce"
+	cfg.MetaHdrNonceHashStorage.DB.FilePath = "MetaHdrHashNonce"
+	cfg.BlockHeaderStorage.DB.FilePath = "BlockHeaders"
+	cfg.MetaBlockStorage.DB.FilePath = "MetaBlock"
+	cfg.MiniBlocksStorage.DB.FilePath = "MiniBlocks"
+	cfg.TxStorage.DB.FilePath = "Transactions"
+	cfg.UnsignedTransactionStorage.DB.FilePath = "UnsignedTransactions"
+	cfg.RewardTxStorage.DB.FilePath = "RewardTransactions"
+
+	return cfg
+}
+
+func createMockArgsStorageMaintainer(tb testing.TB) ArgsStorageMaintainer {
+	return ArgsStorageMaintainer{
+		DatabasePath:  tb.TempDir(),
+		GeneralConfig: createGeneralConfig(),
+		LatestStorageDataProvider: &mock.LatestStorageDataProviderStub{
+			GetCalled: func() (storage.LatestDataFromStorage, error) {
+				return storage.LatestDataFromStorage{Epoch: 5}, nil
+			},
+		},
+		Marshaller:      &marshal.GogoProtoMarshalizer{},
+		Hasher:          blake2b.NewBlake2b(),
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	}
+}
+
+// putInStorer writes the provided data in the storer found at <dbPath>/<epochDir>/Shard_<shard>/<identifier>
+func putInStorer(tb testing.TB, dbPath string, epochDir string, shard string, identifier string, keysValues map[string][]byte) {
+	path := filepath.Join(dbPath, epochDir, storage.DefaultShardString+"_"+shard, identifier)
+	persister, err := createPersister(factory.NewDBConfigHandler(createDefaultDBConfig()), path)
+	require.Nil(tb, err)
+
+	for key, value := range keysValues {
+		err = persister.Put([]byte(key), value)
+		require.Nil(tb, err)
+	}
+
+	err = persister.Close()
+	require.Nil(tb, err)
+}
+
+func epochDir(epoch uint32) string {
+	return fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch)
+}
+
+func TestNewStorageMaintainer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty database path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		args.DatabasePath = ""
+		sm, err := NewStorageMaintainer(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrEmptyDatabasePath, err)
+	})
+	t.Run("nil latest storage data provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		args.LatestStorageDataProvider = nil
+		sm, err := NewStorageMaintainer(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrNilLatestStorageDataProvider, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		args.Marshaller = nil
+		sm, err := NewStorageMaintainer(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		args.Hasher = nil
+		sm, err := NewStorageMaintainer(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		args.Uint64Converter = nil
+		sm, err := NewStorageMaintainer(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrNilUint64Converter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := NewStorageMaintainer(createMockArgsStorageMaintainer(t))
+		assert.Nil(t, err)
+		assert.False(t, sm.IsInterfaceNil())
+	})
+}
+
+func TestStorageMaintainer_ListStorers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStorageMaintainer(t)
+	keysValues := map[string][]byte{"key": []byte("value")}
+	putInStorer(t, args.DatabasePath, storage.DefaultStaticDbString, "0", "ShardHdrHashNonce0", keysValues)
+	putInStorer(t, args.DatabasePath, epochDir(10), "0", "Transactions", keysValues)
+	putInStorer(t, args.DatabasePath, epochDir(2), "0", "Transactions", keysValues)
+	putInStorer(t, args.DatabasePath, epochDir(2), "metachain", "MetaBlock", keysValues)
+	err := os.MkdirAll(filepath.Join(args.DatabasePath, "not an epoch"), os.ModePerm)
+	require.Nil(t, err)
+
+	sm, _ := NewStorageMaintainer(args)
+	storers, err := sm.ListStorers()
+	require.Nil(t, err)
+	require.Equal(t, 4, len(storers))
+
+	type storerKey struct {
+		isStatic   bool
+		epoch      uint32
+		shardID    string
+		identifier string
+	}
+	expectedOrder := []storerKey{
+		{true, 0, "0", "ShardHdrHashNonce0"},
+		{false, 2, "0", "Transactions"},
+		{false, 2, "metachain", "MetaBlock"},
+		{false, 10, "0", "Transactions"},
+	}
+	for i, storerInfo := range storers {
+		assert.Equal(t, expectedOrder[i], storerKey{storerInfo.IsStatic, storerInfo.Epoch, storerInfo.ShardID, storerInfo.Identifier})
+		assert.True(t, storerInfo.SizeInBytes > 0)
+		assert.True(t, dirExists(storerInfo.Path))
+	}
+}
+
+func TestStorageMaintainer_RemoveEpochsOutsideRetention(t *testing.T) {
+	t.Parallel()
+
+	createEpochs := func(tb testing.TB, dbPath string) {
+		for epoch := uint32(0); epoch <= 5; epoch++ {
+			putInStorer(tb, dbPath, epochDir(epoch), "0", "Transactions", map[string][]byte{"key": []byte("value")})
+		}
+	}
+
+	t.Run("retention window smaller than the active persisters should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := NewStorageMaintainer(createMockArgsStorageMaintainer(t))
+		removed, err := sm.RemoveEpochsOutsideRetention(1, false)
+		assert.Nil(t, removed)
+		assert.True(t, errors.Is(err, ErrInvalidRetentionWindow))
+	})
+	t.Run("latest data error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsStorageMaintainer(t)
+		args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+			GetCalled: func() (storage.LatestDataFromStorage, error) {
+				return storage.LatestDataFromStorage{}, expectedErr
+			},
+		}
+		createEpochs(t, args.DatabasePath)
+
+		sm, _ := NewStorageMaintainer(args)
+		removed, err := sm.RemoveEpochsOutsideRetention(2, false)
+		assert.Nil(t, removed)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.True(t, dirExists(filepath.Join(args.DatabasePath, epochDir(0))))
+	})
+	t.Run("dry run should not remove", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		createEpochs(t, args.DatabasePath)
+
+		sm, _ := NewStorageMaintainer(args)
+		removed, err := sm.RemoveEpochsOutsideRetention(3, true)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{0, 1, 2}, removed)
+		for epoch := uint32(0); epoch <= 5; epoch++ {
+			assert.True(t, dirExists(filepath.Join(args.DatabasePath, epochDir(epoch))))
+		}
+	})
+	t.Run("window larger than the existing epochs should not remove", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		createEpochs(t, args.DatabasePath)
+
+		sm, _ := NewStorageMaintainer(args)
+		removed, err := sm.RemoveEpochsOutsideRetention(10, false)
+		assert.Nil(t, err)
+		assert.Empty(t, removed)
+	})
+	t.Run("should remove the old epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMaintainer(t)
+		createEpochs(t, args.DatabasePath)
+		putInStorer(t, args.DatabasePath, storage.DefaultStaticDbString, "0", "ShardHdrHashNonce0", map[string][]byte{"key": []byte("value")})
+
+		sm, _ := NewStorageMaintainer(args)
+		removed, err := sm.RemoveEpochsOutsideRetention(2, false)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{0, 1, 2, 3}, removed)
+		for epoch := uint32(0); epoch <= 5; epoch++ {
+			assert.Equal(t, epoch >= 4, dirExists(filepath.Join(args.DatabasePath, epochDir(epoch))))
+		}
+		assert.True(t, dirExists(filepath.Join(args.DatabasePath, storage.DefaultStaticDbString)))
+	})
+}
@@ -408,6 +408,14 @@ var (
 		Name:  "p2p-prometheus-metrics",
 		Usage: "Boolean option for enabling the /debug/metrics/prometheus route for p2p prometheus metrics",
 	}
+	// importStateSnapshot defines a flag for the portable state snapshot file used when bootstrapping from the network
+	importStateSnapshot = cli.StringFlag{
+		Name: "import-state-snapshot",
+		Usage: "This flag specifies the `file` holding a portable state snapshot. If set, the node will import the " +
+			"accounts state from this file when bootstrapping from the network, instead of syncing all the trie nodes " +
+			"from its peers. The snapshot must hold the state of the epoch the node bootstraps in",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -470,6 +478,7 @@ func getFlags() []cli.Flag {
 		operationMode,
 		repopulateTokensSupplies,
 		p2pPrometheusMetrics,
+		importStateSnapshot,
 	}
 }
 
@@ -499,6 +508,7 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
 	flagsConfig.P2PPrometheusMetricsEnabled = ctx.GlobalBool(p2pPrometheusMetrics.Name)
+	flagsConfig.ImportStateSnapshotFile = ctx.GlobalString(importStateSnapshot.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
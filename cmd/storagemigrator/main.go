@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/kalyan3104/k-chain-go/cmd/storagemigrator/migrator"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type config struct {
+	sourcePath      string
+	destinationPath string
+	destinationType string
+	maxOpenFiles    int
+	maxBatchSize    int
+	logLevel        string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// source defines a flag for setting the directory holding the persisters to be migrated
+	source = cli.StringFlag{
+		Name: "source",
+		Usage: "The `path` to the persister(s) to be migrated. It can point either to a single persister directory or " +
+			"to a whole node database directory (for example ./db/1), case in which all the persisters found are migrated",
+		Destination: &argsConfig.sourcePath,
+	}
+	// destination defines a flag for setting the directory where the migrated persisters will be written
+	destination = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The `path` where the migrated persisters will be written, keeping the source directory layout",
+		Destination: &argsConfig.destinationPath,
+	}
+	// destinationType defines a flag for setting the database type of the migrated persisters
+	destinationType = cli.StringFlag{
+		Name:        "type",
+		Usage:       "The database `type` of the migrated persisters. Can be one of PebbleDB, LvlDB or LvlDBSerial",
+		Value:       string(storageunit.PebbleDB),
+		Destination: &argsConfig.destinationType,
+	}
+	// maxOpenFiles defines a flag for setting the maximum number of files opened by each persister
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files each of the opened persisters can keep open",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// maxBatchSize defines a flag for setting the batch size of the migrated persisters
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The number of writes after which the destination persisters are synced on disk",
+		Value:       45000,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,storagemigrator:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the storagemigrator package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	argsConfig = &config{}
+
+	log    = logger.GetOrCreate("storagemigrator")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(c *cli.Context) error {
+		return startMigration()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startMigration() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	args := migrator.ArgsStorageMigrator{
+		SourcePath:      argsConfig.sourcePath,
+		DestinationPath: argsConfig.destinationPath,
+		DestinationType: argsConfig.destinationType,
+		MaxOpenFiles:    argsConfig.maxOpenFiles,
+		MaxBatchSize:    argsConfig.maxBatchSize,
+	}
+	storageMigrator, err := migrator.NewStorageMigrator(args)
+	if err != nil {
+		return err
+	}
+
+	log.Info("starting storage migration", "source", argsConfig.sourcePath, "destination", argsConfig.destinationPath,
+		"type", argsConfig.destinationType)
+
+	return storageMigrator.Migrate()
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Kalyan Storage Migrator"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Offline tool used to migrate the node persisters to another database type. The node must be stopped"
+	cliApp.Flags = []cli.Flag{
+		source,
+		destination,
+		destinationType,
+		maxOpenFiles,
+		maxBatchSize,
+		logLevel,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Kalyan Team",
+			Email: "contact@kalyan.com",
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package migrator
+
+import "errors"
+
+// ErrEmptySourcePath signals that an empty source path has been provided
+var ErrEmptySourcePath = errors.New("empty source path")
+
+// ErrEmptyDestinationPath signals that an empty destination path has been provided
+var ErrEmptyDestinationPath = errors.New("empty destination path")
+
+// ErrSameSourceAndDestination signals that the source and the destination paths are the same
+var ErrSameSourceAndDestination = errors.New("the source and the destination paths should be different")
+
+// ErrNotSupportedDestinationType signals that the destination database type is not a persistent one
+var ErrNotSupportedDestinationType = errors.New("not supported destination database type")
+
+// ErrDestinationNotEmpty signals that the destination persister directory already holds data
+var ErrDestinationNotEmpty = errors.New("destination directory is not empty")
+
+// ErrNoPersisterFound signals that no persister directory has been found in the source path
+var ErrNoPersisterFound = errors.New("no persister directory found")
+
+// ErrKeysCountMismatch signals that the destination persister does not hold all the source keys
+var ErrKeysCountMismatch = errors.New("keys count mismatch between source and destination")
@@ -0,0 +1,258 @@
+package migrator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+const (
+	dbConfigFileName     = "config.toml"
+	levelDBCurrentFile   = "CURRENT"
+	defaultBatchDelay    = 2
+	defaultSourceMaxSize = 100
+)
+
+var log = logger.GetOrCreate("storagemigrator")
+
+// ArgsStorageMigrator holds the arguments needed to create a storage migrator
+type ArgsStorageMigrator struct {
+	SourcePath      string
+	DestinationPath string
+	DestinationType string
+	MaxOpenFiles    int
+	MaxBatchSize    int
+}
+
+type storageMigrator struct {
+	sourcePath      string
+	destinationPath string
+	destinationType string
+	maxOpenFiles    int
+	maxBatchSize    int
+}
+
+// NewStorageMigrator creates a new storage migrator instance
+func NewStorageMigrator(args ArgsStorageMigrator) (*storageMigrator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageMigrator{
+		sourcePath:      filepath.Clean(args.SourcePath),
+		destinationPath: filepath.Clean(args.DestinationPath),
+		destinationType: args.DestinationType,
+		maxOpenFiles:    args.MaxOpenFiles,
+		maxBatchSize:    args.MaxBatchSize,
+	}, nil
+}
+
+func checkArgs(args ArgsStorageMigrator) error {
+	if len(args.SourcePath) == 0 {
+		return ErrEmptySourcePath
+	}
+	if len(args.DestinationPath) == 0 {
+		return ErrEmptyDestinationPath
+	}
+	if filepath.Clean(args.SourcePath) == filepath.Clean(args.DestinationPath) {
+		return ErrSameSourceAndDestination
+	}
+
+	switch storageunit.DBType(args.DestinationType) {
+	case storageunit.PebbleDB, storageunit.LvlDB, storageunit.LvlDBSerial:
+	default:
+		return fmt.Errorf("%w: %s", ErrNotSupportedDestinationType, args.DestinationType)
+	}
+
+	if args.MaxOpenFiles < 1 {
+		return fmt.Errorf("%w: %d", storage.ErrInvalidNumOpenFiles, args.MaxOpenFiles)
+	}
+	if args.MaxBatchSize < 1 {
+		return fmt.Errorf("%w for max batch size: %d", storage.ErrInvalidConfig, args.MaxBatchSize)
+	}
+
+	return nil
+}
+
+// Migrate copies all the persisters found in the source path into the destination path, keeping the same
+// directory layout. The source path can either point to a single persister or to a whole node database directory
+func (sm *storageMigrator) Migrate() error {
+	numPersisters := 0
+	numKeys := 0
+	err := filepath.WalkDir(sm.sourcePath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() || !isPersisterDir(path) {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sm.sourcePath, path)
+		if err != nil {
+			return err
+		}
+
+		numCopiedKeys, err := sm.migratePersister(path, filepath.Join(sm.destinationPath, relativePath))
+		if err != nil {
+			return fmt.Errorf("%w while migrating %s", err, path)
+		}
+		numPersisters++
+		numKeys += numCopiedKeys
+
+		// the persister directories hold no other persisters, besides their own shards
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return err
+	}
+	if numPersisters == 0 {
+		return fmt.Errorf("%w in %s", ErrNoPersisterFound, sm.sourcePath)
+	}
+
+	log.Info("storage migration finished", "num persisters", numPersisters, "num keys", numKeys,
+		"destination", sm.destinationPath, "type", sm.destinationType)
+
+	return nil
+}
+
+func (sm *storageMigrator) migratePersister(sourcePath string, destinationPath string) (int, error) {
+	if !isDirMissingOrEmpty(destinationPath) {
+		return 0, fmt.Errorf("%w: %s", ErrDestinationNotEmpty, destinationPath)
+	}
+
+	sourceConfigHandler := factory.NewDBConfigHandler(config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: defaultBatchDelay,
+		MaxBatchSize:      defaultSourceMaxSize,
+		MaxOpenFiles:      sm.maxOpenFiles,
+	})
+	sourceConfig, err := sourceConfigHandler.GetDBConfig(sourcePath)
+	if err != nil {
+		return 0, err
+	}
+
+	destinationConfig := *sourceConfig
+	destinationConfig.Type = sm.destinationType
+	destinationConfig.MaxBatchSize = sm.maxBatchSize
+	destinationConfig.MaxOpenFiles = sm.maxOpenFiles
+
+	source, err := createPersister(sourceConfigHandler, sourcePath)
+	if err != nil {
+		return 0, err
+	}
+	defer closePersister(source, sourcePath)
+
+	destinationConfigHandler := factory.NewDBConfigHandler(destinationConfig)
+	destination, err := createPersister(destinationConfigHandler, destinationPath)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Debug("migrating persister", "source", sourcePath, "source type", sourceConfig.Type, "destination", destinationPath)
+
+	numKeys, err := copyKeys(source, destination)
+	closePersister(destination, destinationPath)
+	if err != nil {
+		return 0, err
+	}
+
+	// the destination is reopened as the batched writes are only visible after being flushed
+	numDestinationKeys, err := countPersistedKeys(destinationConfigHandler, destinationPath)
+	if err != nil {
+		return 0, err
+	}
+	if numDestinationKeys != numKeys {
+		return 0, fmt.Errorf("%w: copied %d keys, found %d", ErrKeysCountMismatch, numKeys, numDestinationKeys)
+	}
+
+	log.Info("migrated persister", "source", sourcePath, "num keys", numKeys)
+
+	return numKeys, nil
+}
+
+func createPersister(dbConfigHandler storage.DBConfigHandler, path string) (storage.Persister, error) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+func copyKeys(source storage.Persister, destination storage.Persister) (int, error) {
+	numKeys := 0
+	var errPut error
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destination.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numKeys++
+		return true
+	})
+
+	return numKeys, errPut
+}
+
+func countPersistedKeys(dbConfigHandler storage.DBConfigHandler, path string) (int, error) {
+	persister, err := createPersister(dbConfigHandler, path)
+	if err != nil {
+		return 0, err
+	}
+	defer closePersister(persister, path)
+
+	return countKeys(persister), nil
+}
+
+func countKeys(persister storage.Persister) int {
+	numKeys := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys
+}
+
+func closePersister(persister storage.Persister, path string) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "path", path, "error", err)
+	}
+}
+
+// isPersisterDir returns true if the directory holds the config file written by the persister factory
+// or, for the older directories, the files written by LevelDB
+func isPersisterDir(path string) bool {
+	for _, fileName := range []string{dbConfigFileName, levelDBCurrentFile} {
+		info, err := os.Stat(filepath.Join(path, fileName))
+		if err == nil && !info.IsDir() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDirMissingOrEmpty(path string) bool {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return os.IsNotExist(err)
+	}
+
+	return len(entries) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *storageMigrator) IsInterfaceNil() bool {
+	return sm == nil
+}
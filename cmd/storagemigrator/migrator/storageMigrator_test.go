@@ -0,0 +1,229 @@
+package migrator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStorageMigrator(tb testing.TB) ArgsStorageMigrator {
+	return ArgsStorageMigrator{
+		SourcePath:      tb.TempDir(),
+		DestinationPath: tb.TempDir(),
+		DestinationType: string(storageunit.PebbleDB),
+		MaxOpenFiles:    10,
+		MaxBatchSize:    100,
+	}
+}
+
+func createLevelDBPersister(tb testing.TB, path string, numShards int32, numKeys int) {
+	dbConfig := config.DBConfig{
+		Type:                string(storageunit.LvlDBSerial),
+		BatchDelaySeconds:   2,
+		MaxBatchSize:        100,
+		MaxOpenFiles:        10,
+		ShardIDProviderType: "BinarySplit",
+		NumShards:           numShards,
+	}
+
+	persister, err := createPersister(factory.NewDBConfigHandler(dbConfig), path)
+	require.Nil(tb, err)
+
+	for i := 0; i < numKeys; i++ {
+		err = persister.Put(createKey(i), createValue(i))
+		require.Nil(tb, err)
+	}
+
+	err = persister.Close()
+	require.Nil(tb, err)
+}
+
+func createKey(idx int) []byte {
+	return []byte(fmt.Sprintf("key%d", idx))
+}
+
+func createValue(idx int) []byte {
+	return []byte(fmt.Sprintf("value%d", idx))
+}
+
+func checkMigratedPersister(tb testing.TB, path string, expectedNumShards int32, numKeys int) {
+	dbConfig := &config.DBConfig{}
+	err := core.LoadTomlFile(dbConfig, filepath.Join(path, dbConfigFileName))
+	require.Nil(tb, err)
+	assert.Equal(tb, string(storageunit.PebbleDB), dbConfig.Type)
+	assert.Equal(tb, expectedNumShards, dbConfig.NumShards)
+
+	persister, err := createPersister(factory.NewDBConfigHandler(config.DBConfig{}), path)
+	require.Nil(tb, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	for i := 0; i < numKeys; i++ {
+		value, errGet := persister.Get(createKey(i))
+		require.Nil(tb, errGet)
+		assert.Equal(tb, createValue(i), value)
+	}
+	assert.Equal(tb, numKeys, countKeys(persister))
+}
+
+func TestNewStorageMigrator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		args.SourcePath = ""
+		sm, err := NewStorageMigrator(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrEmptySourcePath, err)
+	})
+	t.Run("empty destination path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		args.DestinationPath = ""
+		sm, err := NewStorageMigrator(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrEmptyDestinationPath, err)
+	})
+	t.Run("same source and destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		args.DestinationPath = args.SourcePath + string(filepath.Separator)
+		sm, err := NewStorageMigrator(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, ErrSameSourceAndDestination, err)
+	})
+	t.Run("not supported destination type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		args.DestinationType = string(storageunit.MemoryDB)
+		sm, err := NewStorageMigrator(args)
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, ErrNotSupportedDestinationType))
+	})
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		args.MaxOpenFiles = 0
+		sm, err := NewStorageMigrator(args)
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, storage.ErrInvalidNumOpenFiles))
+	})
+	t.Run("invalid max batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		args.MaxBatchSize = 0
+		sm, err := NewStorageMigrator(args)
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, storage.ErrInvalidConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := NewStorageMigrator(createMockArgsStorageMigrator(t))
+		assert.Nil(t, err)
+		assert.False(t, sm.IsInterfaceNil())
+	})
+}
+
+func TestStorageMigrator_Migrate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no persister found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		err := os.MkdirAll(filepath.Join(args.SourcePath, "Epoch_0", "Shard_0"), os.ModePerm)
+		require.Nil(t, err)
+
+		sm, _ := NewStorageMigrator(args)
+		err = sm.Migrate()
+		assert.True(t, errors.Is(err, ErrNoPersisterFound))
+	})
+	t.Run("destination not empty should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		createLevelDBPersister(t, args.SourcePath, 0, 10)
+		f, err := os.Create(filepath.Join(args.DestinationPath, "file"))
+		require.Nil(t, err)
+		_ = f.Close()
+
+		sm, _ := NewStorageMigrator(args)
+		err = sm.Migrate()
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+	})
+	t.Run("single persister should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		createLevelDBPersister(t, args.SourcePath, 0, 100)
+
+		sm, _ := NewStorageMigrator(args)
+		err := sm.Migrate()
+		require.Nil(t, err)
+
+		checkMigratedPersister(t, args.DestinationPath, 0, 100)
+	})
+	t.Run("node database directory should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageMigrator(t)
+		relativePaths := []string{
+			filepath.Join("Static", "Shard_0", "MetaHdrHashNonce"),
+			filepath.Join("Epoch_0", "Shard_0", "BlockHeaders"),
+			filepath.Join("Epoch_0", "Shard_0", "Transactions"),
+			filepath.Join("Epoch_1", "Shard_0", "Transactions"),
+		}
+		for i, relativePath := range relativePaths {
+			createLevelDBPersister(t, filepath.Join(args.SourcePath, relativePath), int32(i%2)*4, 10*(i+1))
+		}
+
+		sm, _ := NewStorageMigrator(args)
+		err := sm.Migrate()
+		require.Nil(t, err)
+
+		for i, relativePath := range relativePaths {
+			checkMigratedPersister(t, filepath.Join(args.DestinationPath, relativePath), int32(i%2)*4, 10*(i+1))
+		}
+	})
+}
+
+func TestStorageMigrator_MigrateToLevelDB(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStorageMigrator(t)
+	args.DestinationType = string(storageunit.LvlDBSerial)
+	createLevelDBPersister(t, args.SourcePath, 0, 150)
+
+	sm, _ := NewStorageMigrator(args)
+	err := sm.Migrate()
+	require.Nil(t, err)
+
+	dbConfig := &config.DBConfig{}
+	err = core.LoadTomlFile(dbConfig, filepath.Join(args.DestinationPath, dbConfigFileName))
+	require.Nil(t, err)
+	assert.Equal(t, string(storageunit.LvlDBSerial), dbConfig.Type)
+
+	persister, err := createPersister(factory.NewDBConfigHandler(config.DBConfig{}), args.DestinationPath)
+	require.Nil(t, err)
+	assert.Equal(t, 150, countKeys(persister))
+	_ = persister.Close()
+}
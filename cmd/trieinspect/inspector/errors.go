@@ -0,0 +1,30 @@
+package inspector
+
+import "errors"
+
+// ErrNoPersister signals that no persister has been provided
+var ErrNoPersister = errors.New("no persister provided")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler has been provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNoRootHash signals that no root hash has been provided for inspection
+var ErrNoRootHash = errors.New("no root hash provided")
+
+// ErrReadOnlyStorer signals that a write operation was attempted on the read only storer
+var ErrReadOnlyStorer = errors.New("read only storer")
+
+// ErrUnknownOutputFormat signals that an unknown report output format has been provided
+var ErrUnknownOutputFormat = errors.New("unknown output format")
@@ -0,0 +1,73 @@
+package inspector
+
+import (
+	"sync"
+
+	"github.com/kalyan3104/k-chain-go/storage"
+)
+
+// readOnlyStorer searches the keys in all the persisters, in the provided order, as the nodes of a trie might be
+// spread in the directories of several epochs. When tracking is enabled, all the keys found are remembered, so the
+// persisted trie nodes never read can be reported as orphans
+type readOnlyStorer struct {
+	persisters  []storage.Persister
+	trackKeys   bool
+	mutReadKeys sync.RWMutex
+	readKeys    map[string]struct{}
+}
+
+func newReadOnlyStorer(persisters []storage.Persister, trackKeys bool) *readOnlyStorer {
+	return &readOnlyStorer{
+		persisters: persisters,
+		trackKeys:  trackKeys,
+		readKeys:   make(map[string]struct{}),
+	}
+}
+
+// Get returns the value of the key from the first persister holding it
+func (ros *readOnlyStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range ros.persisters {
+		value, err := persister.Get(key)
+		if err != nil || len(value) == 0 {
+			continue
+		}
+
+		if ros.trackKeys {
+			ros.mutReadKeys.Lock()
+			ros.readKeys[string(key)] = struct{}{}
+			ros.mutReadKeys.Unlock()
+		}
+
+		return value, nil
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+func (ros *readOnlyStorer) wasRead(key []byte) bool {
+	ros.mutReadKeys.RLock()
+	defer ros.mutReadKeys.RUnlock()
+
+	_, found := ros.readKeys[string(key)]
+	return found
+}
+
+// Put returns ErrReadOnlyStorer
+func (ros *readOnlyStorer) Put(_, _ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Remove returns ErrReadOnlyStorer
+func (ros *readOnlyStorer) Remove(_ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Close does nothing, the persisters being closed by their owner
+func (ros *readOnlyStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ros *readOnlyStorer) IsInterfaceNil() bool {
+	return ros == nil
+}
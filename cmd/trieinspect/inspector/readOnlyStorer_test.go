@@ -0,0 +1,66 @@
+package inspector
+
+import (
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get should search all the persisters in order", func(t *testing.T) {
+		t.Parallel()
+
+		firstPersister := testscommon.NewMemDbMock()
+		_ = firstPersister.Put([]byte("key1"), []byte("value1"))
+		secondPersister := testscommon.NewMemDbMock()
+		_ = secondPersister.Put([]byte("key1"), []byte("old value1"))
+		_ = secondPersister.Put([]byte("key2"), []byte("value2"))
+
+		storer := newReadOnlyStorer([]storage.Persister{firstPersister, secondPersister}, false)
+		value, err := storer.Get([]byte("key1"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value1"), value)
+
+		value, err = storer.Get([]byte("key2"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value2"), value)
+
+		value, err = storer.Get([]byte("key3"))
+		assert.Nil(t, value)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.False(t, storer.wasRead([]byte("key1")))
+	})
+	t.Run("should track the read keys", func(t *testing.T) {
+		t.Parallel()
+
+		persister := testscommon.NewMemDbMock()
+		_ = persister.Put([]byte("key1"), []byte("value1"))
+		_ = persister.Put([]byte("key2"), []byte("value2"))
+
+		storer := newReadOnlyStorer([]storage.Persister{persister}, true)
+		_, _ = storer.Get([]byte("key1"))
+		_, _ = storer.Get([]byte("key3"))
+		assert.True(t, storer.wasRead([]byte("key1")))
+		assert.False(t, storer.wasRead([]byte("key2")))
+		assert.False(t, storer.wasRead([]byte("key3")))
+	})
+	t.Run("should not write", func(t *testing.T) {
+		t.Parallel()
+
+		persister := testscommon.NewMemDbMock()
+		_ = persister.Put([]byte("key"), []byte("value"))
+
+		storer := newReadOnlyStorer([]storage.Persister{persister}, false)
+		assert.Equal(t, ErrReadOnlyStorer, storer.Put([]byte("key"), []byte("new value")))
+		assert.Equal(t, ErrReadOnlyStorer, storer.Remove([]byte("key")))
+		assert.Nil(t, storer.Close())
+		assert.False(t, storer.IsInterfaceNil())
+
+		value, _ := persister.Get([]byte("key"))
+		assert.Equal(t, []byte("value"), value)
+	})
+}
@@ -0,0 +1,185 @@
+package inspector
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/kalyan3104/k-chain-go/common"
+)
+
+const (
+	// JSONFormat is the JSON output format of the inspection report
+	JSONFormat = "json"
+
+	// CSVFormat is the CSV output format of the inspection report
+	CSVFormat = "csv"
+)
+
+// TrieReport holds the statistics of a trie or the aggregated statistics of several tries
+type TrieReport struct {
+	Address             string            `json:"address,omitempty"`
+	RootHash            string            `json:"rootHash,omitempty"`
+	NumBranchNodes      uint64            `json:"numBranchNodes"`
+	NumExtensionNodes   uint64            `json:"numExtensionNodes"`
+	NumLeafNodes        uint64            `json:"numLeafNodes"`
+	BranchNodesSize     uint64            `json:"branchNodesSize"`
+	ExtensionNodesSize  uint64            `json:"extensionNodesSize"`
+	LeafNodesSize       uint64            `json:"leafNodesSize"`
+	TotalSize           uint64            `json:"totalSize"`
+	MaxDepth            uint32            `json:"maxDepth"`
+	NumNodesPerDepth    []uint64          `json:"numNodesPerDepth"`
+	NumLeavesPerVersion map[string]uint64 `json:"numLeavesPerVersion"`
+}
+
+// DataTriesMigrationReport holds the number of data tries by their leaves version. A data trie is migrated when
+// all its leaves hold data trie values with the auto balance version
+type DataTriesMigrationReport struct {
+	NumMigrated          uint64 `json:"numMigrated"`
+	NumNotMigrated       uint64 `json:"numNotMigrated"`
+	NumPartiallyMigrated uint64 `json:"numPartiallyMigrated"`
+}
+
+// OrphanNodesReport holds the persisted trie nodes not reachable from any of the inspected root hashes
+type OrphanNodesReport struct {
+	NumPersistedNodes uint64   `json:"numPersistedNodes"`
+	NumOrphanNodes    uint64   `json:"numOrphanNodes"`
+	OrphanNodesSize   uint64   `json:"orphanNodesSize"`
+	OrphanNodesHashes []string `json:"orphanNodesHashes"`
+}
+
+// InspectionReport holds the outcome of inspecting one or more root hashes
+type InspectionReport struct {
+	Tries              []*TrieReport             `json:"tries"`
+	NumDataTries       uint64                    `json:"numDataTries"`
+	DataTries          *TrieReport               `json:"dataTries,omitempty"`
+	DataTriesMigration *DataTriesMigrationReport `json:"dataTriesMigration,omitempty"`
+	LargestDataTries   []*TrieReport             `json:"largestDataTries,omitempty"`
+	OrphanNodes        *OrphanNodesReport        `json:"orphanNodes,omitempty"`
+}
+
+func newTrieReport(address string, rootHash []byte, stats common.TrieStatisticsHandler) *TrieReport {
+	numNodesPerDepth := make([]uint64, 0)
+	for depth, numNodes := range stats.GetNumNodesPerDepth() {
+		for uint32(len(numNodesPerDepth)) <= depth {
+			numNodesPerDepth = append(numNodesPerDepth, 0)
+		}
+		numNodesPerDepth[depth] = numNodes
+	}
+
+	numLeavesPerVersion := make(map[string]uint64)
+	for version, numLeaves := range stats.GetLeavesMigrationStats() {
+		numLeavesPerVersion[version.String()] = numLeaves
+	}
+
+	return &TrieReport{
+		Address:             address,
+		RootHash:            hex.EncodeToString(rootHash),
+		NumBranchNodes:      stats.GetNumBranchNodes(),
+		NumExtensionNodes:   stats.GetNumExtensionNodes(),
+		NumLeafNodes:        stats.GetNumLeafNodes(),
+		BranchNodesSize:     stats.GetBranchNodesSize(),
+		ExtensionNodesSize:  stats.GetExtensionNodesSize(),
+		LeafNodesSize:       stats.GetLeafNodesSize(),
+		TotalSize:           stats.GetTotalNodesSize(),
+		MaxDepth:            stats.GetMaxTrieDepth(),
+		NumNodesPerDepth:    numNodesPerDepth,
+		NumLeavesPerVersion: numLeavesPerVersion,
+	}
+}
+
+// WriteReport writes the inspection report in the given format
+func WriteReport(writer io.Writer, report *InspectionReport, format string) error {
+	switch format {
+	case JSONFormat:
+		return writeJSON(writer, report)
+	case CSVFormat:
+		return writeCSV(writer, report)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownOutputFormat, format)
+	}
+}
+
+func writeJSON(writer io.Writer, report *InspectionReport) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// writeCSV writes the report as section,address,root_hash,metric,value records, so all the sections share the
+// same columns
+func writeCSV(writer io.Writer, report *InspectionReport) error {
+	csvWriter := csv.NewWriter(writer)
+	records := [][]string{{"section", "address", "root_hash", "metric", "value"}}
+
+	for _, trieReport := range report.Tries {
+		records = append(records, trieReportRecords("trie", trieReport)...)
+	}
+	if report.DataTries != nil {
+		records = append(records, []string{"data_tries", "", "", "num_data_tries", strconv.FormatUint(report.NumDataTries, 10)})
+		records = append(records, trieReportRecords("data_tries", report.DataTries)...)
+	}
+	if report.DataTriesMigration != nil {
+		records = append(records,
+			[]string{"data_tries_migration", "", "", "num_migrated", strconv.FormatUint(report.DataTriesMigration.NumMigrated, 10)},
+			[]string{"data_tries_migration", "", "", "num_not_migrated", strconv.FormatUint(report.DataTriesMigration.NumNotMigrated, 10)},
+			[]string{"data_tries_migration", "", "", "num_partially_migrated", strconv.FormatUint(report.DataTriesMigration.NumPartiallyMigrated, 10)},
+		)
+	}
+	for _, trieReport := range report.LargestDataTries {
+		records = append(records, trieReportRecords("largest_data_trie", trieReport)...)
+	}
+	if report.OrphanNodes != nil {
+		records = append(records,
+			[]string{"orphan_nodes", "", "", "num_persisted_nodes", strconv.FormatUint(report.OrphanNodes.NumPersistedNodes, 10)},
+			[]string{"orphan_nodes", "", "", "num_orphan_nodes", strconv.FormatUint(report.OrphanNodes.NumOrphanNodes, 10)},
+			[]string{"orphan_nodes", "", "", "orphan_nodes_size", strconv.FormatUint(report.OrphanNodes.OrphanNodesSize, 10)},
+		)
+		for _, hash := range report.OrphanNodes.OrphanNodesHashes {
+			records = append(records, []string{"orphan_nodes", "", hash, "orphan_node", ""})
+		}
+	}
+
+	err := csvWriter.WriteAll(records)
+	if err != nil {
+		return err
+	}
+
+	return csvWriter.Error()
+}
+
+func trieReportRecords(section string, trieReport *TrieReport) [][]string {
+	newRecord := func(metric string, value uint64) []string {
+		return []string{section, trieReport.Address, trieReport.RootHash, metric, strconv.FormatUint(value, 10)}
+	}
+
+	records := [][]string{
+		newRecord("num_branch_nodes", trieReport.NumBranchNodes),
+		newRecord("num_extension_nodes", trieReport.NumExtensionNodes),
+		newRecord("num_leaf_nodes", trieReport.NumLeafNodes),
+		newRecord("branch_nodes_size", trieReport.BranchNodesSize),
+		newRecord("extension_nodes_size", trieReport.ExtensionNodesSize),
+		newRecord("leaf_nodes_size", trieReport.LeafNodesSize),
+		newRecord("total_size", trieReport.TotalSize),
+		newRecord("max_depth", uint64(trieReport.MaxDepth)),
+	}
+	for depth, numNodes := range trieReport.NumNodesPerDepth {
+		records = append(records, newRecord(fmt.Sprintf("num_nodes_depth_%d", depth), numNodes))
+	}
+
+	versions := make([]string, 0, len(trieReport.NumLeavesPerVersion))
+	for version := range trieReport.NumLeavesPerVersion {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	for _, version := range versions {
+		records = append(records, newRecord("num_leaves_"+version, trieReport.NumLeavesPerVersion[version]))
+	}
+
+	return records
+}
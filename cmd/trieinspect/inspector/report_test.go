@@ -0,0 +1,102 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/trie/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestInspectionReport() *InspectionReport {
+	trieStats := statistics.NewTrieStatistics()
+	trieStats.AddBranchNode(0, 100)
+	trieStats.AddExtensionNode(1, 20)
+	trieStats.AddLeafNode(2, 40, core.AutoBalanceEnabled)
+	trieStats.AddLeafNode(2, 50, core.NotSpecified)
+
+	return &InspectionReport{
+		Tries:        []*TrieReport{newTrieReport("", []byte("root hash"), trieStats)},
+		NumDataTries: 1,
+		DataTries:    newTrieReport("", nil, trieStats),
+		DataTriesMigration: &DataTriesMigrationReport{
+			NumPartiallyMigrated: 1,
+		},
+		LargestDataTries: []*TrieReport{newTrieReport("address", []byte("data root hash"), trieStats)},
+		OrphanNodes: &OrphanNodesReport{
+			NumPersistedNodes: 6,
+			NumOrphanNodes:    2,
+			OrphanNodesSize:   70,
+			OrphanNodesHashes: []string{"aa", "bb"},
+		},
+	}
+}
+
+func TestNewTrieReport(t *testing.T) {
+	t.Parallel()
+
+	report := createTestInspectionReport()
+	assert.Equal(t, &TrieReport{
+		Address:            "address",
+		RootHash:           "6461746120726f6f742068617368",
+		NumBranchNodes:     1,
+		NumExtensionNodes:  1,
+		NumLeafNodes:       2,
+		BranchNodesSize:    100,
+		ExtensionNodesSize: 20,
+		LeafNodesSize:      90,
+		TotalSize:          210,
+		MaxDepth:           2,
+		NumNodesPerDepth:   []uint64{1, 1, 2},
+		NumLeavesPerVersion: map[string]uint64{
+			core.AutoBalanceEnabled.String(): 1,
+			core.NotSpecified.String():       1,
+		},
+	}, report.LargestDataTries[0])
+}
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown format should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := WriteReport(&bytes.Buffer{}, createTestInspectionReport(), "xml")
+		assert.True(t, errors.Is(err, ErrUnknownOutputFormat))
+	})
+	t.Run("json format should work", func(t *testing.T) {
+		t.Parallel()
+
+		report := createTestInspectionReport()
+		buff := &bytes.Buffer{}
+		err := WriteReport(buff, report, JSONFormat)
+		require.Nil(t, err)
+
+		recovered := &InspectionReport{}
+		err = json.Unmarshal(buff.Bytes(), recovered)
+		require.Nil(t, err)
+		assert.Equal(t, report, recovered)
+	})
+	t.Run("csv format should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		err := WriteReport(buff, createTestInspectionReport(), CSVFormat)
+		require.Nil(t, err)
+
+		records, err := csv.NewReader(buff).ReadAll()
+		require.Nil(t, err)
+		assert.Equal(t, []string{"section", "address", "root_hash", "metric", "value"}, records[0])
+		assert.Contains(t, records, []string{"trie", "", "726f6f742068617368", "num_nodes_depth_2", "2"})
+		assert.Contains(t, records, []string{"data_tries", "", "", "num_data_tries", "1"})
+		assert.Contains(t, records, []string{"data_tries_migration", "", "", "num_partially_migrated", "1"})
+		assert.Contains(t, records, []string{"largest_data_trie", "address", "6461746120726f6f742068617368", "total_size", "210"})
+		assert.Contains(t, records, []string{"orphan_nodes", "", "", "num_orphan_nodes", "2"})
+		assert.Contains(t, records, []string{"orphan_nodes", "", "bb", "orphan_node", ""})
+	})
+}
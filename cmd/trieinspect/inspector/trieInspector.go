@@ -0,0 +1,331 @@
+package inspector
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sort"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	disabledCommon "github.com/kalyan3104/k-chain-go/common/disabled"
+	"github.com/kalyan3104/k-chain-go/common/errChan"
+	disabledStatistics "github.com/kalyan3104/k-chain-go/common/statistics/disabled"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/state/accounts"
+	"github.com/kalyan3104/k-chain-go/state/parsers"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/trie"
+	"github.com/kalyan3104/k-chain-go/trie/keyBuilder"
+	"github.com/kalyan3104/k-chain-go/trie/statistics"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("trieinspect")
+
+const (
+	// the inspected tries are only read, so only the root node is kept in memory
+	maxTrieLevelInMemory = 1
+
+	// DefaultMaxOrphanNodesHashes is the default number of orphan nodes hashes listed in the report
+	DefaultMaxOrphanNodesHashes = 100
+)
+
+// TrieInspectorHandler defines the trie inspector behavior
+type TrieInspectorHandler interface {
+	Inspect(rootHashes [][]byte, options InspectOptions) (*InspectionReport, error)
+	IsInterfaceNil() bool
+}
+
+// ArgsTrieInspector holds the arguments needed to create a trie inspector
+type ArgsTrieInspector struct {
+	Persisters           []storage.Persister
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	AddressConverter     core.PubkeyConverter
+	EnableEpochsHandler  common.EnableEpochsHandler
+	NumLargestDataTries  int
+	MaxOrphanNodesHashes int
+}
+
+// InspectOptions holds the options of one inspection
+type InspectOptions struct {
+	// IsDataTrie should be set when the root hashes belong to data tries, so their leaves are not parsed as accounts
+	IsDataTrie bool
+
+	// FindOrphanNodes enables the scan of all the persisted trie nodes, reporting the ones not reachable from the
+	// inspected root hashes. All the reached nodes hashes are kept in memory during the inspection
+	FindOrphanNodes bool
+}
+
+type inspectedTrie interface {
+	common.Trie
+	common.TrieStats
+}
+
+type trieInspector struct {
+	persisters           []storage.Persister
+	marshaller           marshal.Marshalizer
+	hasher               hashing.Hasher
+	addressConverter     core.PubkeyConverter
+	enableEpochsHandler  common.EnableEpochsHandler
+	numLargestDataTries  int
+	maxOrphanNodesHashes int
+}
+
+// NewTrieInspector creates a component able to walk the tries found in the persisters of a stopped node and
+// report their node level statistics
+func NewTrieInspector(args ArgsTrieInspector) (*trieInspector, error) {
+	if len(args.Persisters) == 0 {
+		return nil, ErrNoPersister
+	}
+	for _, persister := range args.Persisters {
+		if check.IfNil(persister) {
+			return nil, ErrNilPersister
+		}
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	return &trieInspector{
+		persisters:           args.Persisters,
+		marshaller:           args.Marshaller,
+		hasher:               args.Hasher,
+		addressConverter:     args.AddressConverter,
+		enableEpochsHandler:  args.EnableEpochsHandler,
+		numLargestDataTries:  args.NumLargestDataTries,
+		maxOrphanNodesHashes: args.MaxOrphanNodesHashes,
+	}, nil
+}
+
+// Inspect walks the tries of the given root hashes and, for main tries, the data tries of all the accounts.
+// The data tries shared by several accounts or by several root hashes are inspected only once
+func (ti *trieInspector) Inspect(rootHashes [][]byte, options InspectOptions) (*InspectionReport, error) {
+	if len(rootHashes) == 0 {
+		return nil, ErrNoRootHash
+	}
+
+	storer := newReadOnlyStorer(ti.persisters, options.FindOrphanNodes)
+	trieStorageManager, err := trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:  storer,
+		Marshalizer: ti.marshaller,
+		Hasher:      ti.hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsGoroutineNum: 1,
+		},
+		IdleProvider:   disabledCommon.NewProcessStatusHandler(),
+		Identifier:     dataRetriever.UserAccountsUnit.String(),
+		StatsCollector: disabledStatistics.NewStateStatistics(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = trieStorageManager.Close()
+	}()
+
+	tr, err := trie.NewTrie(trieStorageManager, ti.marshaller, ti.hasher, ti.enableEpochsHandler, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &InspectionReport{
+		Tries: make([]*TrieReport, 0, len(rootHashes)),
+	}
+	dataTries := newDataTriesInspection(ti.numLargestDataTries)
+	for _, rootHash := range rootHashes {
+		trieStats, errStats := tr.GetTrieStats("", rootHash)
+		if errStats != nil {
+			return nil, errStats
+		}
+
+		report.Tries = append(report.Tries, newTrieReport("", rootHash, trieStats))
+		log.Info("inspected trie", "root hash", rootHash, "num nodes", trieStats.GetTotalNumNodes(),
+			"size", core.ConvertBytes(trieStats.GetTotalNodesSize()))
+
+		if options.IsDataTrie {
+			continue
+		}
+
+		err = ti.inspectDataTries(tr, rootHash, dataTries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !options.IsDataTrie {
+		dataTries.addToReport(report)
+	}
+
+	if options.FindOrphanNodes {
+		report.OrphanNodes = ti.findOrphanNodes(storer)
+	}
+
+	return report, nil
+}
+
+func (ti *trieInspector) inspectDataTries(tr inspectedTrie, rootHash []byte, dataTries *dataTriesInspection) error {
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := tr.GetAllLeavesOnChannel(
+		iteratorChannels,
+		context.Background(),
+		rootHash,
+		keyBuilder.NewKeyBuilder(),
+		parsers.NewMainTrieLeafParser(),
+	)
+	if err != nil {
+		return err
+	}
+
+	var errStats error
+	for leaf := range iteratorChannels.LeavesChan {
+		// the leaves channel is drained even after an error, so the iterating go routine can finish
+		if errStats != nil {
+			continue
+		}
+
+		account := &accounts.UserAccountData{}
+		errUnmarshal := ti.marshaller.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			// leaves holding code are not accounts
+			continue
+		}
+		if common.IsEmptyTrie(account.RootHash) || dataTries.isInspected(account.RootHash) {
+			continue
+		}
+
+		address := ti.addressConverter.SilentEncode(account.Address, log)
+		trieStats, errGet := tr.GetTrieStats(address, account.RootHash)
+		if errGet != nil {
+			errStats = errGet
+			continue
+		}
+
+		dataTries.add(address, account.RootHash, trieStats)
+	}
+	if errStats != nil {
+		return errStats
+	}
+
+	return iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+}
+
+// findOrphanNodes scans all the persisted entries. An entry is a trie node if its key is the hash of its value,
+// the other entries, like the markers written by the trie storage manager, being ignored
+func (ti *trieInspector) findOrphanNodes(storer *readOnlyStorer) *OrphanNodesReport {
+	orphanNodes := &OrphanNodesReport{
+		OrphanNodesHashes: make([]string, 0),
+	}
+
+	for _, persister := range ti.persisters {
+		persister.RangeKeys(func(key []byte, value []byte) bool {
+			if !bytes.Equal(ti.hasher.Compute(string(value)), key) {
+				return true
+			}
+
+			orphanNodes.NumPersistedNodes++
+			if storer.wasRead(key) {
+				return true
+			}
+
+			orphanNodes.NumOrphanNodes++
+			orphanNodes.OrphanNodesSize += uint64(len(value))
+			if len(orphanNodes.OrphanNodesHashes) < ti.maxOrphanNodesHashes {
+				orphanNodes.OrphanNodesHashes = append(orphanNodes.OrphanNodesHashes, hex.EncodeToString(key))
+			}
+
+			return true
+		})
+	}
+
+	return orphanNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ti *trieInspector) IsInterfaceNil() bool {
+	return ti == nil
+}
+
+type dataTriesInspection struct {
+	inspectedRootHashes map[string]struct{}
+	totalStats          common.TrieStatisticsHandler
+	migration           *DataTriesMigrationReport
+	largestTries        []*TrieReport
+	numLargestTries     int
+}
+
+func newDataTriesInspection(numLargestTries int) *dataTriesInspection {
+	return &dataTriesInspection{
+		inspectedRootHashes: make(map[string]struct{}),
+		totalStats:          statistics.NewTrieStatistics(),
+		migration:           &DataTriesMigrationReport{},
+		largestTries:        make([]*TrieReport, 0, numLargestTries+1),
+		numLargestTries:     numLargestTries,
+	}
+}
+
+func (dti *dataTriesInspection) isInspected(rootHash []byte) bool {
+	_, found := dti.inspectedRootHashes[string(rootHash)]
+	return found
+}
+
+func (dti *dataTriesInspection) add(address string, rootHash []byte, trieStats common.TrieStatisticsHandler) {
+	dti.inspectedRootHashes[string(rootHash)] = struct{}{}
+	dti.totalStats.MergeTriesStatistics(trieStats)
+
+	numMigratedLeaves := trieStats.GetLeavesMigrationStats()[core.AutoBalanceEnabled]
+	switch numMigratedLeaves {
+	case 0:
+		dti.migration.NumNotMigrated++
+	case trieStats.GetNumLeafNodes():
+		dti.migration.NumMigrated++
+	default:
+		dti.migration.NumPartiallyMigrated++
+	}
+
+	dti.addToLargestTries(address, rootHash, trieStats)
+}
+
+func (dti *dataTriesInspection) addToLargestTries(address string, rootHash []byte, trieStats common.TrieStatisticsHandler) {
+	if dti.numLargestTries <= 0 {
+		return
+	}
+
+	numTries := len(dti.largestTries)
+	isLargeEnough := numTries < dti.numLargestTries || trieStats.GetTotalNodesSize() > dti.largestTries[numTries-1].TotalSize
+	if !isLargeEnough {
+		return
+	}
+
+	dti.largestTries = append(dti.largestTries, newTrieReport(address, rootHash, trieStats))
+	sort.SliceStable(dti.largestTries, func(i, j int) bool {
+		return dti.largestTries[i].TotalSize > dti.largestTries[j].TotalSize
+	})
+	if len(dti.largestTries) > dti.numLargestTries {
+		dti.largestTries = dti.largestTries[:dti.numLargestTries]
+	}
+}
+
+func (dti *dataTriesInspection) addToReport(report *InspectionReport) {
+	report.NumDataTries = uint64(len(dti.inspectedRootHashes))
+	report.DataTries = newTrieReport("", nil, dti.totalStats)
+	report.DataTriesMigration = dti.migration
+	report.LargestDataTries = dti.largestTries
+}
@@ -0,0 +1,310 @@
+package inspector
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/hashing/blake2b"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/state/accounts"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/enableEpochsHandlerMock"
+	testStorage "github.com/kalyan3104/k-chain-go/testscommon/storage"
+	"github.com/kalyan3104/k-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddressLen = 32
+
+func createMockArgsTrieInspector() ArgsTrieInspector {
+	return ArgsTrieInspector{
+		Persisters:           []storage.Persister{testscommon.NewMemDbMock()},
+		Marshaller:           &marshal.GogoProtoMarshalizer{},
+		Hasher:               blake2b.NewBlake2b(),
+		AddressConverter:     testscommon.NewPubkeyConverterMock(testAddressLen),
+		EnableEpochsHandler:  &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		NumLargestDataTries:  2,
+		MaxOrphanNodesHashes: DefaultMaxOrphanNodesHashes,
+	}
+}
+
+type testTriesCreator struct {
+	tb                 testing.TB
+	args               ArgsTrieInspector
+	trieStorageManager common.StorageManager
+}
+
+// newTestTriesCreator creates the tries directly in the first persister of the arguments
+func newTestTriesCreator(tb testing.TB, args ArgsTrieInspector) *testTriesCreator {
+	storageManagerArgs := testStorage.GetStorageManagerArgs()
+	storageManagerArgs.MainStorer = args.Persisters[0]
+	storageManagerArgs.Marshalizer = args.Marshaller
+	storageManagerArgs.Hasher = args.Hasher
+	trieStorageManager, err := trie.NewTrieStorageManager(storageManagerArgs)
+	require.Nil(tb, err)
+
+	return &testTriesCreator{
+		tb:                 tb,
+		args:               args,
+		trieStorageManager: trieStorageManager,
+	}
+}
+
+func (creator *testTriesCreator) createTrie(leaves map[string][]byte, versions map[string]core.TrieNodeVersion) []byte {
+	tr, err := trie.NewTrie(creator.trieStorageManager, creator.args.Marshaller, creator.args.Hasher, creator.args.EnableEpochsHandler, 5)
+	require.Nil(creator.tb, err)
+
+	for key, value := range leaves {
+		err = tr.UpdateWithVersion([]byte(key), value, versions[key])
+		require.Nil(creator.tb, err)
+	}
+	err = tr.Commit()
+	require.Nil(creator.tb, err)
+
+	rootHash, err := tr.RootHash()
+	require.Nil(creator.tb, err)
+
+	return rootHash
+}
+
+func (creator *testTriesCreator) createDataTrie(numLeaves int, numMigratedLeaves int) []byte {
+	leaves := make(map[string][]byte, numLeaves)
+	versions := make(map[string]core.TrieNodeVersion, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		key := fmt.Sprintf("key%d", i)
+		leaves[key] = []byte(fmt.Sprintf("value%d", i))
+		versions[key] = core.NotSpecified
+		if i < numMigratedLeaves {
+			versions[key] = core.AutoBalanceEnabled
+		}
+	}
+
+	return creator.createTrie(leaves, versions)
+}
+
+func (creator *testTriesCreator) createMainTrie(dataTriesRootHashes [][]byte) []byte {
+	leaves := make(map[string][]byte, len(dataTriesRootHashes))
+	for i, rootHash := range dataTriesRootHashes {
+		address := bytes.Repeat([]byte{byte(i + 1)}, testAddressLen)
+		account := &accounts.UserAccountData{
+			Address:  address,
+			Nonce:    uint64(i),
+			RootHash: rootHash,
+		}
+		accountBytes, err := creator.args.Marshaller.Marshal(account)
+		require.Nil(creator.tb, err)
+
+		leaves[string(address)] = accountBytes
+	}
+
+	return creator.createTrie(leaves, nil)
+}
+
+func TestNewTrieInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.Persisters = nil
+		ti, err := NewTrieInspector(args)
+		assert.Nil(t, ti)
+		assert.Equal(t, ErrNoPersister, err)
+	})
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.Persisters = append(args.Persisters, nil)
+		ti, err := NewTrieInspector(args)
+		assert.Nil(t, ti)
+		assert.Equal(t, ErrNilPersister, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.Marshaller = nil
+		ti, err := NewTrieInspector(args)
+		assert.Nil(t, ti)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.Hasher = nil
+		ti, err := NewTrieInspector(args)
+		assert.Nil(t, ti)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.AddressConverter = nil
+		ti, err := NewTrieInspector(args)
+		assert.Nil(t, ti)
+		assert.Equal(t, ErrNilAddressConverter, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.EnableEpochsHandler = nil
+		ti, err := NewTrieInspector(args)
+		assert.Nil(t, ti)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ti, err := NewTrieInspector(createMockArgsTrieInspector())
+		assert.Nil(t, err)
+		assert.False(t, ti.IsInterfaceNil())
+	})
+}
+
+func TestTrieInspector_Inspect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		ti, _ := NewTrieInspector(createMockArgsTrieInspector())
+		report, err := ti.Inspect(nil, InspectOptions{})
+		assert.Nil(t, report)
+		assert.Equal(t, ErrNoRootHash, err)
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		ti, _ := NewTrieInspector(createMockArgsTrieInspector())
+		report, err := ti.Inspect([][]byte{[]byte("missing root hash")}, InspectOptions{})
+		assert.Nil(t, report)
+		assert.NotNil(t, err)
+	})
+	t.Run("main trie should report the data tries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		creator := newTestTriesCreator(t, args)
+		migratedDataTrie := creator.createDataTrie(30, 30)
+		notMigratedDataTrie := creator.createDataTrie(20, 0)
+		partiallyMigratedDataTrie := creator.createDataTrie(10, 5)
+		// the data trie shared by two accounts and the empty data trie are not counted
+		rootHash := creator.createMainTrie([][]byte{
+			migratedDataTrie,
+			notMigratedDataTrie,
+			partiallyMigratedDataTrie,
+			migratedDataTrie,
+			nil,
+		})
+
+		ti, _ := NewTrieInspector(args)
+		report, err := ti.Inspect([][]byte{rootHash}, InspectOptions{})
+		require.Nil(t, err)
+
+		require.Equal(t, 1, len(report.Tries))
+		assert.Equal(t, uint64(5), report.Tries[0].NumLeafNodes)
+		assert.Equal(t, fmt.Sprintf("%x", rootHash), report.Tries[0].RootHash)
+		assert.Equal(t, uint64(3), report.NumDataTries)
+		assert.Equal(t, uint64(60), report.DataTries.NumLeafNodes)
+		assert.Equal(t, map[string]uint64{
+			core.AutoBalanceEnabled.String(): 35,
+			core.NotSpecified.String():       25,
+		}, report.DataTries.NumLeavesPerVersion)
+		assert.Equal(t, &DataTriesMigrationReport{
+			NumMigrated:          1,
+			NumNotMigrated:       1,
+			NumPartiallyMigrated: 1,
+		}, report.DataTriesMigration)
+
+		require.Equal(t, 2, len(report.LargestDataTries))
+		assert.Equal(t, fmt.Sprintf("%x", migratedDataTrie), report.LargestDataTries[0].RootHash)
+		assert.Equal(t, fmt.Sprintf("%x", bytes.Repeat([]byte{1}, testAddressLen)), report.LargestDataTries[0].Address)
+		assert.Equal(t, fmt.Sprintf("%x", notMigratedDataTrie), report.LargestDataTries[1].RootHash)
+		assert.Nil(t, report.OrphanNodes)
+
+		totalNodes := uint64(0)
+		for _, numNodes := range report.DataTries.NumNodesPerDepth {
+			totalNodes += numNodes
+		}
+		dataTries := report.DataTries
+		assert.Equal(t, dataTries.NumBranchNodes+dataTries.NumExtensionNodes+dataTries.NumLeafNodes, totalNodes)
+	})
+	t.Run("data trie should not parse the leaves", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		creator := newTestTriesCreator(t, args)
+		rootHash := creator.createDataTrie(10, 10)
+
+		ti, _ := NewTrieInspector(args)
+		report, err := ti.Inspect([][]byte{rootHash}, InspectOptions{IsDataTrie: true})
+		require.Nil(t, err)
+
+		require.Equal(t, 1, len(report.Tries))
+		assert.Equal(t, uint64(10), report.Tries[0].NumLeafNodes)
+		assert.Equal(t, uint64(0), report.NumDataTries)
+		assert.Nil(t, report.DataTries)
+		assert.Nil(t, report.DataTriesMigration)
+	})
+	t.Run("trie nodes spread in several persisters should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		rootHash := newTestTriesCreator(t, args).createDataTrie(20, 0)
+
+		// move half of the nodes in a second persister, as after an epoch change
+		newPersister := testscommon.NewMemDbMock()
+		oldPersister := args.Persisters[0]
+		moveNode := false
+		keysToMove := make([][]byte, 0)
+		oldPersister.RangeKeys(func(key []byte, value []byte) bool {
+			moveNode = !moveNode
+			if moveNode {
+				_ = newPersister.Put(key, value)
+				keysToMove = append(keysToMove, key)
+			}
+			return true
+		})
+		for _, key := range keysToMove {
+			_ = oldPersister.Remove(key)
+		}
+		args.Persisters = []storage.Persister{newPersister, oldPersister}
+
+		ti, _ := NewTrieInspector(args)
+		report, err := ti.Inspect([][]byte{rootHash}, InspectOptions{IsDataTrie: true})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(20), report.Tries[0].NumLeafNodes)
+	})
+	t.Run("should find the orphan nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieInspector()
+		args.MaxOrphanNodesHashes = 1
+		creator := newTestTriesCreator(t, args)
+		_ = creator.createDataTrie(3, 0)
+		rootHash := creator.createDataTrie(10, 0)
+		// entries which are not trie nodes are ignored
+		_ = args.Persisters[0].Put([]byte("key"), []byte("value"))
+
+		ti, _ := NewTrieInspector(args)
+		report, err := ti.Inspect([][]byte{rootHash}, InspectOptions{IsDataTrie: true, FindOrphanNodes: true})
+		require.Nil(t, err)
+
+		numNodes := report.Tries[0].NumBranchNodes + report.Tries[0].NumExtensionNodes + report.Tries[0].NumLeafNodes
+		require.NotNil(t, report.OrphanNodes)
+		assert.True(t, report.OrphanNodes.NumOrphanNodes > 0)
+		assert.Equal(t, numNodes+report.OrphanNodes.NumOrphanNodes, report.OrphanNodes.NumPersistedNodes)
+		assert.True(t, report.OrphanNodes.OrphanNodesSize > 0)
+		assert.Equal(t, 1, len(report.OrphanNodes.OrphanNodesHashes))
+	})
+}
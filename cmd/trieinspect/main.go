@@ -0,0 +1,310 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	hasherFactory "github.com/kalyan3104/k-chain-core-go/hashing/factory"
+	marshalizerFactory "github.com/kalyan3104/k-chain-core-go/marshal/factory"
+	"github.com/kalyan3104/k-chain-go/cmd/trieinspect/inspector"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/enablers"
+	commonFactory "github.com/kalyan3104/k-chain-go/common/factory"
+	"github.com/kalyan3104/k-chain-go/common/forking"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cliConfig struct {
+	dbPaths             string
+	configFile          string
+	logLevel            string
+	rootHashes          string
+	isDataTrie          bool
+	findOrphanNodes     bool
+	numLargestDataTries uint
+	outputFormat        string
+	outputFile          string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPaths defines a flag for the trie storer directories
+	dbPaths = cli.StringFlag{
+		Name: "db-path",
+		Usage: "Comma separated `paths` of the trie storer directories (for example ./db/1/Epoch_5/Shard_1/AccountsTrie)." +
+			" The trie nodes are searched in the provided order, so the directories of several epochs can be used together",
+		Destination: &argsConfig.dbPaths,
+	}
+	// configurationFile defines a flag for the path to the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file, used for the marshaller, the hasher, the address converter and the trie storer configuration",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,trieinspect:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the trieinspect package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	// rootHashes defines a flag for the inspected root hashes
+	rootHashes = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "Comma separated hex encoded root `hashes` to be inspected",
+		Destination: &argsConfig.rootHashes,
+	}
+	// isDataTrie defines a flag for inspecting data tries instead of main tries
+	isDataTrie = cli.BoolFlag{
+		Name:        "data-trie",
+		Usage:       "If set, the root hashes are inspected as data tries, without parsing their leaves as accounts",
+		Destination: &argsConfig.isDataTrie,
+	}
+	// findOrphanNodes defines a flag for reporting the trie nodes no root hash reaches
+	findOrphanNodes = cli.BoolFlag{
+		Name: "find-orphans",
+		Usage: "If set, all the persisted trie nodes are scanned and the ones not reachable from any of the root hashes are reported." +
+			" The hashes of all the reached nodes are kept in memory during the inspection",
+		Destination: &argsConfig.findOrphanNodes,
+	}
+	// numLargestDataTries defines a flag for the number of largest data tries in the report
+	numLargestDataTries = cli.UintFlag{
+		Name:        "largest-data-tries",
+		Usage:       "The number of largest data tries, by size, to be reported together with their accounts",
+		Value:       20,
+		Destination: &argsConfig.numLargestDataTries,
+	}
+	// outputFormat defines a flag for the report format
+	outputFormat = cli.StringFlag{
+		Name:        "format",
+		Usage:       "The report `format`: json or csv",
+		Value:       inspector.JSONFormat,
+		Destination: &argsConfig.outputFormat,
+	}
+	// outputFile defines a flag for the report file
+	outputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The `filepath` where the report is written. If not set, the report is written to the standard output",
+		Destination: &argsConfig.outputFile,
+	}
+	argsConfig = &cliConfig{}
+
+	log = logger.GetOrCreate("trieinspect")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Kalyan Trie Inspector"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "Offline tool used to report node level statistics of the state tries. The node must be stopped"
+	app.Flags = []cli.Flag{
+		dbPaths,
+		configurationFile,
+		logLevel,
+		rootHashes,
+		isDataTrie,
+		findOrphanNodes,
+		numLargestDataTries,
+		outputFormat,
+		outputFile,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Kalyan Team",
+			Email: "contact@kalyan.com",
+		},
+	}
+	app.Action = inspectTries
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func inspectTries(_ *cli.Context) error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	hashes, err := parseRootHashes(argsConfig.rootHashes)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	persisters, err := openPersisters(splitList(argsConfig.dbPaths), generalConfig.AccountsTrieStorage.DB)
+	defer closePersisters(persisters)
+	if err != nil {
+		return err
+	}
+
+	trieInspector, err := createTrieInspector(*generalConfig, persisters)
+	if err != nil {
+		return err
+	}
+
+	report, err := trieInspector.Inspect(hashes, inspector.InspectOptions{
+		IsDataTrie:      argsConfig.isDataTrie,
+		FindOrphanNodes: argsConfig.findOrphanNodes,
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeReport(report)
+}
+
+func parseRootHashes(rootHashesString string) ([][]byte, error) {
+	hashes := make([][]byte, 0)
+	for _, rootHashString := range splitList(rootHashesString) {
+		rootHash, err := hex.DecodeString(rootHashString)
+		if err != nil {
+			return nil, fmt.Errorf("%w for root hash %s", err, rootHashString)
+		}
+
+		hashes = append(hashes, rootHash)
+	}
+
+	return hashes, nil
+}
+
+func splitList(list string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func openPersisters(paths []string, dbConfig config.DBConfig) ([]storage.Persister, error) {
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	persisters := make([]storage.Persister, 0, len(paths))
+	for _, path := range paths {
+		// the persister factory would create an empty database for a wrong path
+		_, err = os.Stat(path)
+		if err != nil {
+			return persisters, err
+		}
+
+		persister, errCreate := persisterFactory.Create(path)
+		if errCreate != nil {
+			return persisters, fmt.Errorf("%w while opening %s", errCreate, path)
+		}
+
+		persisters = append(persisters, persister)
+	}
+
+	return persisters, nil
+}
+
+func closePersisters(persisters []storage.Persister) {
+	for _, persister := range persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("cannot close persister", "error", err)
+		}
+	}
+}
+
+func createTrieInspector(generalConfig config.Config, persisters []storage.Persister) (inspector.TrieInspectorHandler, error) {
+	marshaller, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tries are only read, so the activation epochs are not relevant
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(config.EnableEpochs{}, forking.NewGenericEpochNotifier())
+	if err != nil {
+		return nil, err
+	}
+
+	args := inspector.ArgsTrieInspector{
+		Persisters:           persisters,
+		Marshaller:           marshaller,
+		Hasher:               hasher,
+		AddressConverter:     addressConverter,
+		EnableEpochsHandler:  enableEpochsHandler,
+		NumLargestDataTries:  int(argsConfig.numLargestDataTries),
+		MaxOrphanNodesHashes: inspector.DefaultMaxOrphanNodesHashes,
+	}
+
+	return inspector.NewTrieInspector(args)
+}
+
+func writeReport(report *inspector.InspectionReport) error {
+	var writer io.Writer = os.Stdout
+	if len(argsConfig.outputFile) > 0 {
+		file, err := os.Create(argsConfig.outputFile)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+
+		writer = file
+	}
+
+	err := inspector.WriteReport(writer, report, argsConfig.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	if len(argsConfig.outputFile) > 0 {
+		log.Info("report written", "file", argsConfig.outputFile, "format", argsConfig.outputFormat)
+	}
+
+	return nil
+}
@@ -130,6 +130,14 @@ const MetricNumShardsWithoutMetachain = "moa_num_shards_without_meta"
 // MetricTxPoolLoad is the metric for monitoring number of transactions from pool of a node
 const MetricTxPoolLoad = "moa_tx_pool_load"
 
+// MetricNumTxPoolReplacements is the metric for monitoring the number of transactions evicted from the pool by a
+// transaction having the same sender and nonce, but a higher gas price
+const MetricNumTxPoolReplacements = "moa_num_tx_pool_replacements"
+
+// MetricNumTxPoolUnderpricedReplacements is the metric for monitoring the number of transactions received with the same
+// sender and nonce as a pooled one, but without a gas price high enough to replace it
+const MetricNumTxPoolUnderpricedReplacements = "moa_num_tx_pool_underpriced_replacements"
+
 // MetricCountLeader is the metric for monitoring number of rounds when a node was leader
 const MetricCountLeader = "moa_count_leader"
 
@@ -895,9 +903,21 @@ const MetricTrieSyncNumReceivedBytes = "moa_trie_sync_num_bytes_received"
 // MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed for accounts during trie sync
 const MetricTrieSyncNumProcessedNodes = "moa_trie_sync_num_nodes_processed"
 
+// MetricIntegrityNumMissingEntries is the metric that outputs the number of missing storage entries found by the last integrity scrubber pass
+const MetricIntegrityNumMissingEntries = "moa_integrity_num_missing_entries"
+
+// MetricIntegrityNumCorruptedEntries is the metric that outputs the number of corrupted storage entries found by the last integrity scrubber pass
+const MetricIntegrityNumCorruptedEntries = "moa_integrity_num_corrupted_entries"
+
+// MetricIntegrityLastPassTimestamp is the metric that outputs the unix timestamp of the last finished integrity scrubber pass
+const MetricIntegrityLastPassTimestamp = "moa_integrity_last_pass_timestamp"
+
 // FullArchiveMetricSuffix is the suffix added to metrics specific for full archive network
 const FullArchiveMetricSuffix = "_full_archive"
 
+// MetaDCDT is the token type of the meta DCDT tokens, as stored by the DCDT system smart contract
+const MetaDCDT = "MetaDCDT"
+
 // Enable epoch flags definitions
 const (
 	SCDeployFlag                                       core.EnableEpochFlag = "SCDeployFlag"
@@ -2,6 +2,7 @@ package common
 
 import (
 	"github.com/kalyan3104/k-chain-core-go/data/alteredAccount"
+	"github.com/kalyan3104/k-chain-core-go/data/api"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -11,6 +12,14 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// GetMultiProofResponse is a struct that stores the response of a GetMultiProof API request. The values are in the
+// order of the requested keys, a nil value meaning that the proof shows the key as absent
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []Transaction `json:"regularTransactions"`
@@ -42,6 +51,21 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// RemovedPoolTransactionApiResponse is a struct that holds a transaction to be dropped from the transactions pool
+type RemovedPoolTransactionApiResponse struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+}
+
+// TransactionsPoolCancellationApiResponse is a struct that holds the data to be returned when cancelling the pending transactions of a sender from an API call.
+// The transactions are only removed from the pool of the node serving the call, once the cancellation transaction is committed
+type TransactionsPoolCancellationApiResponse struct {
+	Sender               string                              `json:"sender"`
+	CancellationTxHash   string                              `json:"cancellationTxHash"`
+	Nonce                uint64                              `json:"nonce"`
+	TransactionsToRemove []RemovedPoolTransactionApiResponse `json:"transactionsToRemove"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -76,6 +100,97 @@ type AlteredAccountsForBlockAPIResponse struct {
 	Accounts []*alteredAccount.AlteredAccount `json:"accounts"`
 }
 
+// AccountsStateDiffAPIResponse holds the differences between the accounts states of two blocks
+type AccountsStateDiffAPIResponse struct {
+	FromBlock api.BlockInfo          `json:"fromBlock"`
+	ToBlock   api.BlockInfo          `json:"toBlock"`
+	Accounts  []*AccountStateDiffAPI `json:"accounts"`
+}
+
+// AccountStateDiffAPI holds the differences of a single account between two states
+type AccountStateDiffAPI struct {
+	Address  string         `json:"address"`
+	Status   string         `json:"status"`
+	Balance  *ValueDiffAPI  `json:"balance,omitempty"`
+	Nonce    *ValueDiffAPI  `json:"nonce,omitempty"`
+	CodeHash *ValueDiffAPI  `json:"codeHash,omitempty"`
+	DCDTs    []*DCDTDiffAPI `json:"dcdts,omitempty"`
+	Keys     []*KeyDiffAPI  `json:"keys,omitempty"`
+}
+
+// ValueDiffAPI holds the old and the new value of an account field
+type ValueDiffAPI struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// DCDTDiffAPI holds the old and the new balance of a DCDT token held by an account
+type DCDTDiffAPI struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	OldBalance      string `json:"oldBalance"`
+	NewBalance      string `json:"newBalance"`
+}
+
+// KeyDiffAPI holds the old and the new value of a data trie key, hex encoded
+type KeyDiffAPI struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// EventsQueryOptions holds the filters used when querying the events emitted by an address
+type EventsQueryOptions struct {
+	Address    string
+	Identifier string
+	Topic      string
+	FromBlock  uint64
+	ToBlock    uint64
+	Cursor     string
+	Limit      uint32
+}
+
+// IndexedEventAPI holds an indexed event, as returned by the events API
+type IndexedEventAPI struct {
+	Address        string   `json:"address"`
+	Identifier     string   `json:"identifier"`
+	Topics         [][]byte `json:"topics"`
+	Data           []byte   `json:"data"`
+	AdditionalData [][]byte `json:"additionalData,omitempty"`
+	TxHash         string   `json:"txHash"`
+	BlockNonce     uint64   `json:"blockNonce"`
+	BlockHash      string   `json:"blockHash"`
+}
+
+// EventsQueryAPIResponse holds a page of events matching an events query
+type EventsQueryAPIResponse struct {
+	Events     []*IndexedEventAPI `json:"events"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
+
+// IntegrityReportAPI holds the outcome of the last finished storage integrity scrubber pass
+type IntegrityReportAPI struct {
+	Enabled                bool                 `json:"enabled"`
+	PassInProgress         bool                 `json:"passInProgress"`
+	NumPasses              uint64               `json:"numPasses"`
+	LastPassTimestamp      int64                `json:"lastPassTimestamp"`
+	LastPassDurationInSec  float64              `json:"lastPassDurationInSec"`
+	NumCheckedHeaders      uint64               `json:"numCheckedHeaders"`
+	NumCheckedMiniBlocks   uint64               `json:"numCheckedMiniBlocks"`
+	NumCheckedTransactions uint64               `json:"numCheckedTransactions"`
+	NumCheckedTrieLeaves   uint64               `json:"numCheckedTrieLeaves"`
+	NumMissingEntries      uint64               `json:"numMissingEntries"`
+	NumCorruptedEntries    uint64               `json:"numCorruptedEntries"`
+	Issues                 []*IntegrityIssueAPI `json:"issues"`
+}
+
+// IntegrityIssueAPI holds a missing or a corrupted storage entry found by the integrity scrubber
+type IntegrityIssueAPI struct {
+	Type    string `json:"type"`
+	Unit    string `json:"unit"`
+	Key     string `json:"key"`
+	Details string `json:"details"`
+}
+
 // AuctionNode holds data needed for a node in auction to respond to API calls
 type AuctionNode struct {
 	BlsKey    string `json:"blsKey"`
@@ -91,3 +206,73 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// SubscriptionTopic defines the kind of live data a subscriber is interested in
+type SubscriptionTopic string
+
+const (
+	// NewHeadersSubscriptionTopic is the topic used for notifications about newly committed headers
+	NewHeadersSubscriptionTopic SubscriptionTopic = "newHeaders"
+	// FinalizedBlocksSubscriptionTopic is the topic used for notifications about finalized blocks
+	FinalizedBlocksSubscriptionTopic SubscriptionTopic = "finalizedBlocks"
+	// TxStatusSubscriptionTopic is the topic used for notifications about the status of the given transactions
+	TxStatusSubscriptionTopic SubscriptionTopic = "txStatus"
+	// ContractEventsSubscriptionTopic is the topic used for notifications about the events generated by contracts
+	ContractEventsSubscriptionTopic SubscriptionTopic = "events"
+)
+
+// SubscriptionRequest holds the topic and the filters of a live data subscription
+type SubscriptionRequest struct {
+	Topic       SubscriptionTopic `json:"topic"`
+	ShardID     *uint32           `json:"shardID,omitempty"`
+	TxHashes    []string          `json:"txHashes,omitempty"`
+	Addresses   []string          `json:"addresses,omitempty"`
+	Identifiers []string          `json:"identifiers,omitempty"`
+}
+
+// SubscriptionEvent holds a notification delivered to a subscriber for one of its subscriptions
+type SubscriptionEvent struct {
+	SubscriptionID string            `json:"subscriptionID"`
+	Topic          SubscriptionTopic `json:"topic"`
+	Data           interface{}       `json:"data"`
+}
+
+// HeaderNotificationAPI holds the details of a newly committed header
+type HeaderNotificationAPI struct {
+	ShardID   uint32 `json:"shardID"`
+	Epoch     uint32 `json:"epoch"`
+	Round     uint64 `json:"round"`
+	Nonce     uint64 `json:"nonce"`
+	Hash      string `json:"hash"`
+	PrevHash  string `json:"prevHash"`
+	TimeStamp uint64 `json:"timestamp"`
+	NumTxs    uint32 `json:"numTxs"`
+}
+
+// FinalizedBlockNotificationAPI holds the details of a finalized block
+type FinalizedBlockNotificationAPI struct {
+	ShardID uint32 `json:"shardID"`
+	Nonce   uint64 `json:"nonce,omitempty"`
+	Hash    string `json:"hash"`
+}
+
+// TxStatusNotificationAPI holds the status of a transaction as seen in a committed block
+type TxStatusNotificationAPI struct {
+	Hash       string `json:"hash"`
+	Status     string `json:"status"`
+	ShardID    uint32 `json:"shardID"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+}
+
+// ContractEventNotificationAPI holds an event generated by a contract in a committed block
+type ContractEventNotificationAPI struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	ShardID    uint32   `json:"shardID"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+}
@@ -54,6 +54,8 @@ type Trie interface {
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error)
 	GetStorageManager() StorageManager
 	IsMigratedToLatestVersion() (bool, error)
 	Close() error
@@ -163,6 +165,7 @@ type Locker interface {
 // MerkleProofVerifier is used to verify merkle proofs
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error)
 }
 
 // SizeSyncStatisticsHandler extends the SyncStatisticsHandler interface by allowing setting up the trie node size
@@ -205,6 +208,7 @@ type TrieStatisticsHandler interface {
 	GetLeafNodesSize() uint64
 	GetNumLeafNodes() uint64
 	GetLeavesMigrationStats() map[core.TrieNodeVersion]uint64
+	GetNumNodesPerDepth() map[uint32]uint64
 
 	MergeTriesStatistics(statsToBeMerged TrieStatisticsHandler)
 	ToString() []string
@@ -373,3 +377,19 @@ type ExecutionOrderGetter interface {
 	Len() int
 	IsInterfaceNil() bool
 }
+
+// SubscriptionsHandler defines the operations of a component that registers live data subscribers
+type SubscriptionsHandler interface {
+	CreateSubscriber() (SubscriberHandler, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// SubscriberHandler defines the operations of a live data subscriber
+type SubscriberHandler interface {
+	Subscribe(request SubscriptionRequest) (string, error)
+	Unsubscribe(subscriptionID string) error
+	Events() <-chan *SubscriptionEvent
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -13,6 +13,25 @@ type CacheConfig struct {
 	Shards               uint32
 }
 
+// TxPoolReplacementConfig will map the replace-by-fee configuration of the transactions pool
+type TxPoolReplacementConfig struct {
+	Enabled                   bool
+	MinGasPriceBumpPercentage uint32
+}
+
+// TxPoolPersistenceConfig will map the configuration of the transactions pool persistence across restarts
+type TxPoolPersistenceConfig struct {
+	Enabled                  bool
+	PersistIntervalInSeconds uint32
+	DB                       DBConfig
+}
+
+// TxSelectionPolicyConfig will map the configuration of the policy used to select the transactions for a proposed block
+type TxSelectionPolicyConfig struct {
+	Type            string
+	MaxTxsPerSender uint32
+}
+
 // HeadersPoolConfig will map the headers cache configuration
 type HeadersPoolConfig struct {
 	MaxHeadersPerShard            int
@@ -43,6 +62,14 @@ type TrieSyncStorageConfig struct {
 	SizeInBytes uint64
 	EnableDB    bool
 	DB          DBConfig
+	Checkpoints TrieSyncCheckpointsConfig
+}
+
+// TrieSyncCheckpointsConfig will map the configuration of the trie sync progress checkpoints
+type TrieSyncCheckpointsConfig struct {
+	Enabled           bool
+	IntervalInSeconds uint32
+	FilePath          string
 }
 
 // PubkeyConfig will map the public key configuration
@@ -166,11 +193,15 @@ type Config struct {
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
+	PortableStateSnapshot    PortableStateSnapshotConfig
 	BadBlocksCache           CacheConfig
 
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolReplacement           TxPoolReplacementConfig
+	TxPoolPersistence           TxPoolPersistenceConfig
+	TxSelectionPolicy           TxSelectionPolicyConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
@@ -206,6 +237,8 @@ type Config struct {
 	LogsAndEvents        LogsAndEventsConfig
 	HardwareRequirements HardwareRequirementsConfig
 
+	WebSocketSubscriptions WebSocketSubscriptionsConfig
+
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
@@ -216,6 +249,8 @@ type Config struct {
 	Debug    DebugConfig
 	Health   HealthServiceConfig
 
+	IntegrityScrubber IntegrityScrubberConfig
+
 	SoftwareVersionConfig SoftwareVersionConfig
 	GatewayMetricsConfig  GatewayMetricsConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
@@ -312,6 +347,18 @@ type StateTriesConfig struct {
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 	StateStatisticsEnabled      bool
+	NumStateTrieCommitWorkers   uint
+
+	FlatStateEnabled                      bool
+	FlatStateAccountsCacheCapacity        uint32
+	FlatStateDataTriesValuesCacheCapacity uint32
+}
+
+// PortableStateSnapshotConfig will hold the configuration for exporting portable state snapshots
+type PortableStateSnapshotConfig struct {
+	ExportEnabled       bool
+	ExportDirectory     string
+	MaxChunkSizeInBytes uint32
 }
 
 // TrieStorageManagerConfig will hold config information about trie storage manager
@@ -339,6 +386,15 @@ type WebServerAntifloodConfig struct {
 	EndpointsThrottlers                []EndpointsThrottlersConfig
 }
 
+// WebSocketSubscriptionsConfig will hold the parameters for the live data subscriptions served over websocket
+type WebSocketSubscriptionsConfig struct {
+	Enabled                       bool
+	MaxSubscribers                uint32
+	MaxSubscriptionsPerSubscriber uint32
+	MaxTxHashesPerSubscription    uint32
+	EventsBufferSize              uint32
+}
+
 // BlackListConfig will hold the p2p peer black list threshold values
 type BlackListConfig struct {
 	ThresholdNumMessagesPerInterval uint32
@@ -477,6 +533,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	DCDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	EventsByAddressStorageConfig       StorageConfig
+	TimestampToNonceStorageConfig      StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -498,6 +556,18 @@ type HealthServiceConfig struct {
 	FolderPath                                string
 }
 
+// IntegrityScrubberConfig will hold the background storage integrity scrubber configuration
+type IntegrityScrubberConfig struct {
+	Enabled                          bool
+	IntervalInMinutes                int
+	NumRecentBlocks                  uint64
+	PauseBetweenChecksInMilliseconds int
+	CheckStateTries                  bool
+	CheckDataTries                   bool
+	RequestMissingTrieNodes          bool
+	MaxReportedIssues                int
+}
+
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
 type InterceptorResolverDebugConfig struct {
 	Enabled                    bool
@@ -541,6 +611,7 @@ type ProcessDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	WebSocket   ApiWebSocketConfig
 	APIPackages map[string]APIPackageConfig
 }
 
@@ -550,6 +621,11 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// ApiWebSocketConfig holds the configuration related to the API websocket routes
+type ApiWebSocketConfig struct {
+	AllowedOrigins []string
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
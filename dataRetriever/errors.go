@@ -119,6 +119,9 @@ var ErrCacheConfigInvalidShards = errors.New("cache parameter [shards] is not va
 // ErrCacheConfigInvalidEconomics signals that an economics parameter required by the cache is invalid
 var ErrCacheConfigInvalidEconomics = errors.New("cache-economics parameter is not valid")
 
+// ErrInvalidMinGasPriceBumpPercentage signals that an invalid minimum gas price bump for the transactions replacement was provided
+var ErrInvalidMinGasPriceBumpPercentage = errors.New("invalid minimum gas price bump percentage for transactions replacement")
+
 // ErrCacheConfigInvalidSharding signals that a sharding parameter required by the cache is invalid
 var ErrCacheConfigInvalidSharding = errors.New("cache-sharding parameter is not valid")
 
@@ -265,3 +268,6 @@ var ErrNilValidatorInfoStorage = errors.New("nil validator info storage")
 
 // ErrValidatorInfoNotFound signals that no validator info was found
 var ErrValidatorInfoNotFound = errors.New("validator info not found")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
@@ -39,6 +39,7 @@ type ArgsDataPool struct {
 	ShardCoordinator sharding.Coordinator
 	Marshalizer      marshal.Marshalizer
 	PathManager      storage.PathManagerHandler
+	AppStatusHandler core.AppStatusHandler
 }
 
 // NewDataPoolFromConfig will return a new instance of a PoolsHolder
@@ -57,14 +58,19 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	if check.IfNil(args.PathManager) {
 		return nil, dataRetriever.ErrNilPathManager
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, dataRetriever.ErrNilAppStatusHandler
+	}
 
 	mainConfig := args.Config
 
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
-		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
-		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
-		SelfShardID:    args.ShardCoordinator.SelfId(),
-		TxGasHandler:   args.EconomicsData,
+		Config:            factory.GetCacherFromConfig(mainConfig.TxDataPool),
+		ReplacementConfig: mainConfig.TxPoolReplacement,
+		NumberOfShards:    args.ShardCoordinator.NumberOfShards(),
+		SelfShardID:       args.ShardCoordinator.SelfId(),
+		TxGasHandler:      args.EconomicsData,
+		AppStatusHandler:  args.AppStatusHandler,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
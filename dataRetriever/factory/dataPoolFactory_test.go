@@ -12,6 +12,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/storage"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/kalyan3104/k-chain-go/testscommon/economicsmocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,6 +47,12 @@ func TestNewDataPoolFromConfig_MissingDependencyShouldErr(t *testing.T) {
 	holder, err = NewDataPoolFromConfig(args)
 	require.Nil(t, holder)
 	require.Equal(t, dataRetriever.ErrNilPathManager, err)
+
+	args = getGoodArgs()
+	args.AppStatusHandler = nil
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	require.Equal(t, dataRetriever.ErrNilAppStatusHandler, err)
 }
 
 func TestNewDataPoolFromConfig_BadConfigShouldErr(t *testing.T) {
@@ -159,5 +166,6 @@ func getGoodArgs() ArgsDataPool {
 		ShardCoordinator: mock.NewMultipleShardsCoordinatorMock(),
 		Marshalizer:      &mock.MarshalizerMock{},
 		PathManager:      &testscommon.PathManagerStub{},
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
 	}
 }
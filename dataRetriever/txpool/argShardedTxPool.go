@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
 	"github.com/kalyan3104/k-chain-go/storage/txcache"
@@ -12,10 +14,12 @@ import (
 
 // ArgShardedTxPool is the argument for ShardedTxPool's constructor
 type ArgShardedTxPool struct {
-	Config         storageunit.CacheConfig
-	TxGasHandler   txcache.TxGasHandler
-	NumberOfShards uint32
-	SelfShardID    uint32
+	Config            storageunit.CacheConfig
+	ReplacementConfig config.TxPoolReplacementConfig
+	TxGasHandler      txcache.TxGasHandler
+	AppStatusHandler  core.AppStatusHandler `json:"-"`
+	NumberOfShards    uint32
+	SelfShardID       uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -43,6 +47,12 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.TxGasHandler.MinGasPrice() == 0 {
 		return fmt.Errorf("%w: MinGasPrice is not valid", dataRetriever.ErrCacheConfigInvalidEconomics)
 	}
+	if args.ReplacementConfig.Enabled && args.ReplacementConfig.MinGasPriceBumpPercentage == 0 {
+		return fmt.Errorf("%w: ReplacementConfig.MinGasPriceBumpPercentage is not valid", dataRetriever.ErrInvalidMinGasPriceBumpPercentage)
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return dataRetriever.ErrNilAppStatusHandler
+	}
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/dataRetriever/txpool"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
 	"github.com/kalyan3104/k-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -116,8 +117,9 @@ func newPool() dataRetriever.ShardedDataCacherNotifier {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
-		NumberOfShards: 2,
-		SelfShardID:    0,
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+		NumberOfShards:   2,
+		SelfShardID:      0,
 	}
 	pool, err := txpool.NewShardedTxPool(args)
 	if err != nil {
@@ -1,15 +1,19 @@
 package txpool
 
 import (
+	"math/big"
 	"strconv"
 	"sync"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/core/counting"
 	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/cache"
 	"github.com/kalyan3104/k-chain-go/storage/txcache"
 	logger "github.com/kalyan3104/k-chain-logger-go"
 )
@@ -18,6 +22,15 @@ var _ dataRetriever.ShardedDataCacherNotifier = (*shardedTxPool)(nil)
 
 var log = logger.GetOrCreate("txpool")
 
+// maxNumTrackedReplacements is the number of the most recent replacements that can be queried through
+// GetReplacedTxHashes. It also bounds the number of replaced transactions kept available for the validators
+const maxNumTrackedReplacements = 10000
+
+// maxNumPendingCancellations bounds the number of cancellations waiting for their transaction to be committed
+const maxNumPendingCancellations = 1000
+
+const percentageDenominator = 100
+
 // shardedTxPool holds transaction caches organised by source & destination shard
 type shardedTxPool struct {
 	mutexBackingMap              sync.RWMutex
@@ -28,11 +41,24 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	replacementConfig            config.TxPoolReplacementConfig
+	replacements                 storage.Cacher
+	replacedTxs                  storage.Cacher
+	cancellations                storage.Cacher
+	appStatusHandler             core.AppStatusHandler
 }
 
 type txPoolShard struct {
 	CacheID string
 	Cache   txCache
+	// serializes the replace-by-fee additions, so that concurrent replacements of the same sender and nonce are not
+	// both checked against the same pooled transactions
+	mutReplacements sync.Mutex
+}
+
+type pendingCancellation struct {
+	sender []byte
+	nonce  uint64
 }
 
 // NewShardedTxPool creates a new sharded tx pool
@@ -69,6 +95,19 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumItemsToPreemptivelyEvict: storage.TxPoolNumTxsToPreemptivelyEvict,
 	}
 
+	replacements, err := cache.NewLRUCache(maxNumTrackedReplacements)
+	if err != nil {
+		return nil, err
+	}
+	replacedTxs, err := cache.NewLRUCache(maxNumTrackedReplacements)
+	if err != nil {
+		return nil, err
+	}
+	cancellations, err := cache.NewLRUCache(maxNumPendingCancellations)
+	if err != nil {
+		return nil, err
+	}
+
 	shardedTxPoolObject := &shardedTxPool{
 		mutexBackingMap:              sync.RWMutex{},
 		backingMap:                   make(map[string]*txPoolShard),
@@ -78,6 +117,11 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		replacementConfig:            args.ReplacementConfig,
+		replacements:                 replacements,
+		replacedTxs:                  replacedTxs,
+		cancellations:                cancellations,
+		appStatusHandler:             args.AppStatusHandler,
 	}
 
 	return shardedTxPoolObject, nil
@@ -187,11 +231,132 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes
 // addTx adds the transaction to the cache
 func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
-	cache := shard.Cache
-	_, added := cache.AddTx(tx)
-	if added {
-		txPool.onAdded(tx.TxHash, tx)
+	added := txPool.addTxToShard(tx, shard)
+	if !added {
+		return
+	}
+
+	txPool.onAdded(tx.TxHash, tx)
+}
+
+// addTxToShard adds the transaction to the shard's cache. When replace-by-fee applies, the search of the outbid
+// transactions, the addition and the removal of the outbid transactions are done under the shard's replacements mutex
+func (txPool *shardedTxPool) addTxToShard(tx *txcache.WrappedTransaction, shard *txPoolShard) bool {
+	if !txPool.isReplacementEnabledForShard(shard) {
+		_, added := shard.Cache.AddTx(tx)
+		return added
+	}
+
+	shard.mutReplacements.Lock()
+	defer shard.mutReplacements.Unlock()
+
+	txsToReplace := txPool.getTxsToReplace(tx, shard)
+	_, added := shard.Cache.AddTx(tx)
+	if !added {
+		return false
+	}
+
+	txPool.removeReplacedTxs(tx, txsToReplace, shard.Cache)
+
+	return true
+}
+
+func (txPool *shardedTxPool) isReplacementEnabledForShard(shard *txPoolShard) bool {
+	return txPool.replacementConfig.Enabled && process.IsShardCacherIdentifierForSourceMe(shard.CacheID, txPool.selfShardID)
+}
+
+// getTxsToReplace returns the pooled transactions having the same sender and nonce as the provided one, that are outbid
+// by it. Transactions of the same sender and nonce that are not outbid are kept, so both will coexist in the pool:
+// a proposer might have already selected the existing one, case in which the validators must still be able to fetch it.
+func (txPool *shardedTxPool) getTxsToReplace(tx *txcache.WrappedTransaction, shard *txPoolShard) []*txcache.WrappedTransaction {
+	txsToReplace := make([]*txcache.WrappedTransaction, 0)
+	pooledTxs := shard.Cache.GetTransactionsPoolForSender(string(tx.Tx.GetSndAddr()))
+	for _, pooledTx := range pooledTxs {
+		if pooledTx.Tx.GetNonce() != tx.Tx.GetNonce() || string(pooledTx.TxHash) == string(tx.TxHash) {
+			continue
+		}
+
+		if !txPool.isGasPriceBumpEnough(pooledTx.Tx.GetGasPrice(), tx.Tx.GetGasPrice()) {
+			log.Trace("shardedTxPool.getTxsToReplace: gas price bump is too low",
+				"pooled tx", pooledTx.TxHash,
+				"pooled gas price", pooledTx.Tx.GetGasPrice(),
+				"tx", tx.TxHash,
+				"gas price", tx.Tx.GetGasPrice())
+			txPool.appStatusHandler.Increment(common.MetricNumTxPoolUnderpricedReplacements)
+			continue
+		}
+
+		txsToReplace = append(txsToReplace, pooledTx)
+	}
+
+	return txsToReplace
+}
+
+func (txPool *shardedTxPool) isGasPriceBumpEnough(oldGasPrice uint64, newGasPrice uint64) bool {
+	minGasPrice := big.NewInt(0).SetUint64(oldGasPrice)
+	minGasPrice.Mul(minGasPrice, big.NewInt(int64(percentageDenominator+txPool.replacementConfig.MinGasPriceBumpPercentage)))
+
+	gasPrice := big.NewInt(0).SetUint64(newGasPrice)
+	gasPrice.Mul(gasPrice, big.NewInt(percentageDenominator))
+
+	return gasPrice.Cmp(minGasPrice) >= 0
+}
+
+// removeReplacedTxs removes the outbid transactions from the cache, so they can no longer be selected. They are kept
+// aside, reachable through SearchFirstData, because a proposer might have already included them in a block: the
+// validators missing them request them from their peers, whose resolvers search the pool this way. They are dropped
+// once removed from the pool, after being executed, or when too many replacements follow
+func (txPool *shardedTxPool) removeReplacedTxs(tx *txcache.WrappedTransaction, txsToReplace []*txcache.WrappedTransaction, txCache txCache) {
+	if len(txsToReplace) == 0 {
+		return
+	}
+
+	replacedTxHashes := txPool.getReplacedTxHashes(tx.TxHash)
+	numReplacedTxHashes := len(replacedTxHashes)
+	for _, replacedTx := range txsToReplace {
+		if !txCache.RemoveTxByHash(replacedTx.TxHash) {
+			continue
+		}
+
+		log.Debug("shardedTxPool: transaction replaced",
+			"nonce", tx.Tx.GetNonce(),
+			"replaced tx", replacedTx.TxHash,
+			"replaced gas price", replacedTx.Tx.GetGasPrice(),
+			"tx", tx.TxHash,
+			"gas price", tx.Tx.GetGasPrice())
+		txPool.replacedTxs.Put(replacedTx.TxHash, replacedTx, int(replacedTx.Size))
+		replacedTxHashes = append(replacedTxHashes, replacedTx.TxHash)
+		txPool.appStatusHandler.Increment(common.MetricNumTxPoolReplacements)
+	}
+
+	if len(replacedTxHashes) > numReplacedTxHashes {
+		txPool.replacements.Put(tx.TxHash, replacedTxHashes, len(replacedTxHashes))
+	}
+}
+
+// GetReplacedTxHashes returns the hashes of the transactions replaced in the pool by the provided one, if any
+func (txPool *shardedTxPool) GetReplacedTxHashes(txHash []byte) ([][]byte, bool) {
+	replacedTxHashes := txPool.getReplacedTxHashes(txHash)
+	if len(replacedTxHashes) == 0 {
+		return nil, false
+	}
+
+	return replacedTxHashes, true
+}
+
+// getReplacedTxHashes returns a copy of the tracked replaced hashes, so that it can be safely extended
+func (txPool *shardedTxPool) getReplacedTxHashes(txHash []byte) [][]byte {
+	value, ok := txPool.replacements.Get(txHash)
+	if !ok {
+		return make([][]byte, 0)
+	}
+
+	replacedTxHashes, ok := value.([][]byte)
+	if !ok {
+		return make([][]byte, 0)
 	}
+
+	return append(make([][]byte, 0, len(replacedTxHashes)), replacedTxHashes...)
 }
 
 func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
@@ -209,7 +374,8 @@ func (txPool *shardedTxPool) SearchFirstData(key []byte) (interface{}, bool) {
 	return tx, ok
 }
 
-// searchFirstTx searches the transaction against all shard data store, retrieving the first found
+// searchFirstTx searches the transaction against all shard data store, retrieving the first found. The replaced
+// transactions are searched as well, so they can still be served to the peers requesting them
 func (txPool *shardedTxPool) searchFirstTx(txHash []byte) (tx data.TransactionHandler, ok bool) {
 	txPool.mutexBackingMap.RLock()
 	defer txPool.mutexBackingMap.RUnlock()
@@ -223,7 +389,21 @@ func (txPool *shardedTxPool) searchFirstTx(txHash []byte) (tx data.TransactionHa
 		}
 	}
 
-	return nil, false
+	return txPool.getReplacedTx(txHash)
+}
+
+func (txPool *shardedTxPool) getReplacedTx(txHash []byte) (data.TransactionHandler, bool) {
+	value, ok := txPool.replacedTxs.Peek(txHash)
+	if !ok {
+		return nil, false
+	}
+
+	replacedTx, ok := value.(*txcache.WrappedTransaction)
+	if !ok {
+		return nil, false
+	}
+
+	return replacedTx.Tx, true
 }
 
 // RemoveData removes the transaction from the pool
@@ -233,6 +413,8 @@ func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 
 // removeTx removes the transaction from the pool
 func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string) bool {
+	txPool.replacedTxs.Remove(txHash)
+
 	shard := txPool.getOrCreateShard(cacheID)
 	return shard.Cache.RemoveTxByHash(txHash)
 }
@@ -252,6 +434,60 @@ func (txPool *shardedTxPool) removeTxBulk(txHashes [][]byte, cacheID string) {
 	}
 
 	log.Trace("shardedTxPool.removeTxBulk()", "name", cacheID, "numToRemove", len(txHashes), "numRemoved", numRemoved)
+
+	txPool.applyCancellations(txHashes)
+}
+
+// ScheduleCancellation registers the cancellation transaction of a sender. Once the block including it is committed
+// and its transactions are removed from the pool, all the sender's transactions having a higher nonce are dropped too
+func (txPool *shardedTxPool) ScheduleCancellation(txHash []byte, sender []byte, nonce uint64) {
+	cancellation := &pendingCancellation{
+		sender: sender,
+		nonce:  nonce,
+	}
+	txPool.cancellations.Put(txHash, cancellation, len(sender))
+}
+
+// applyCancellations drops the transactions cancelled by the provided ones, if any. It is called for the transactions
+// of the committed blocks only, so a cancellation that is not executed leaves the pool untouched
+func (txPool *shardedTxPool) applyCancellations(txHashes [][]byte) {
+	if txPool.cancellations.Len() == 0 {
+		return
+	}
+
+	for _, txHash := range txHashes {
+		value, ok := txPool.cancellations.Peek(txHash)
+		if !ok {
+			continue
+		}
+
+		txPool.cancellations.Remove(txHash)
+		cancellation, ok := value.(*pendingCancellation)
+		if !ok {
+			continue
+		}
+
+		txPool.removeTxsForSenderAboveNonce(cancellation.sender, cancellation.nonce)
+	}
+}
+
+func (txPool *shardedTxPool) removeTxsForSenderAboveNonce(sender []byte, nonce uint64) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	numRemoved := 0
+	for _, shard := range txPool.backingMap {
+		for _, tx := range shard.Cache.GetTransactionsPoolForSender(string(sender)) {
+			if tx.Tx.GetNonce() <= nonce {
+				continue
+			}
+			if shard.Cache.RemoveTxByHash(tx.TxHash) {
+				numRemoved++
+			}
+		}
+	}
+
+	log.Debug("shardedTxPool: transactions cancelled", "sender", sender, "nonce", nonce, "numRemoved", numRemoved)
 }
 
 // RemoveDataFromAllShards removes the transaction from the pool (it searches in all shards)
@@ -261,6 +497,8 @@ func (txPool *shardedTxPool) RemoveDataFromAllShards(key []byte) {
 
 // removeTxFromAllShards removes the transaction from the pool (it searches in all shards)
 func (txPool *shardedTxPool) removeTxFromAllShards(txHash []byte) {
+	txPool.replacedTxs.Remove(txHash)
+
 	txPool.mutexBackingMap.RLock()
 	defer txPool.mutexBackingMap.RUnlock()
 
@@ -292,6 +530,8 @@ func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
 	txPool.backingMap = make(map[string]*txPoolShard)
 	txPool.mutexBackingMap.Unlock()
+
+	txPool.replacedTxs.Clear()
 }
 
 // ClearShardStore clears a specific cache
@@ -1,7 +1,9 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -10,8 +12,11 @@ import (
 	"github.com/kalyan3104/k-chain-core-go/core/check"
 	"github.com/kalyan3104/k-chain-core-go/data"
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
 	"github.com/kalyan3104/k-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -38,7 +43,8 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 100,
 		},
-		NumberOfShards: 1,
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+		NumberOfShards:   1,
 	}
 
 	args := goodArgs
@@ -100,6 +106,21 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.Nil(t, pool)
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidSharding.Error())
+
+	args = goodArgs
+	args.ReplacementConfig = config.TxPoolReplacementConfig{
+		Enabled:                   true,
+		MinGasPriceBumpPercentage: 0,
+	}
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidMinGasPriceBumpPercentage))
+
+	args = goodArgs
+	args.AppStatusHandler = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.Equal(t, dataRetriever.ErrNilAppStatusHandler, err)
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
@@ -111,7 +132,8 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 1,
 		},
-		NumberOfShards: 2,
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+		NumberOfShards:   2,
 	}
 
 	pool, err := NewShardedTxPool(args)
@@ -189,6 +211,161 @@ func Test_AddData(t *testing.T) {
 	require.True(t, ok)
 }
 
+func Test_AddData_ReplacesByFee(t *testing.T) {
+	t.Run("higher gas price with enough bump should replace", func(t *testing.T) {
+		counters := make(map[string]int)
+		pool := newTxPoolWithReplacementToTest(t, counters)
+		cache := pool.getTxCache("0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 1100), 0, "0")
+		require.Equal(t, 1, cache.Len())
+
+		_, ok := cache.GetByTxHash([]byte("hash-a"))
+		require.False(t, ok)
+		_, ok = cache.GetByTxHash([]byte("hash-b"))
+		require.True(t, ok)
+
+		replacedTxHashes, ok := pool.GetReplacedTxHashes([]byte("hash-b"))
+		require.True(t, ok)
+		require.Equal(t, [][]byte{[]byte("hash-a")}, replacedTxHashes)
+		require.Equal(t, 1, counters[common.MetricNumTxPoolReplacements])
+		require.Equal(t, 0, counters[common.MetricNumTxPoolUnderpricedReplacements])
+	})
+	t.Run("replaced transaction should still be found until removed", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(t, make(map[string]int))
+
+		replacedTx := createTxWithGasPrice("alice", 7, 1000)
+		pool.AddData([]byte("hash-a"), replacedTx, 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 1100), 0, "0")
+
+		_, ok := pool.ShardDataStore("0").Peek([]byte("hash-a"))
+		require.False(t, ok)
+		tx, ok := pool.SearchFirstData([]byte("hash-a"))
+		require.True(t, ok)
+		require.Equal(t, replacedTx, tx)
+
+		pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-a"), []byte("hash-b")}, "0")
+		_, ok = pool.SearchFirstData([]byte("hash-a"))
+		require.False(t, ok)
+	})
+	t.Run("replaced transaction should be dropped on clear", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(t, make(map[string]int))
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 1100), 0, "0")
+		pool.Clear()
+
+		_, ok := pool.SearchFirstData([]byte("hash-a"))
+		require.False(t, ok)
+	})
+	t.Run("should track all the transactions replaced at once", func(t *testing.T) {
+		counters := make(map[string]int)
+		pool := newTxPoolWithReplacementToTest(t, counters)
+		cache := pool.getTxCache("0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 1050), 0, "0")
+		require.Equal(t, 2, cache.Len())
+
+		pool.AddData([]byte("hash-c"), createTxWithGasPrice("alice", 7, 2000), 0, "0")
+		require.Equal(t, 1, cache.Len())
+
+		replacedTxHashes, ok := pool.GetReplacedTxHashes([]byte("hash-c"))
+		require.True(t, ok)
+		require.ElementsMatch(t, [][]byte{[]byte("hash-a"), []byte("hash-b")}, replacedTxHashes)
+		require.Equal(t, 2, counters[common.MetricNumTxPoolReplacements])
+	})
+	t.Run("not enough bump should keep both transactions", func(t *testing.T) {
+		counters := make(map[string]int)
+		pool := newTxPoolWithReplacementToTest(t, counters)
+		cache := pool.getTxCache("0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 1099), 0, "0")
+		require.Equal(t, 2, cache.Len())
+
+		_, ok := pool.GetReplacedTxHashes([]byte("hash-b"))
+		require.False(t, ok)
+		require.Equal(t, 0, counters[common.MetricNumTxPoolReplacements])
+		require.Equal(t, 1, counters[common.MetricNumTxPoolUnderpricedReplacements])
+	})
+	t.Run("should only replace the same sender and nonce", func(t *testing.T) {
+		counters := make(map[string]int)
+		pool := newTxPoolWithReplacementToTest(t, counters)
+		cache := pool.getTxCache("0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 8, 2000), 0, "0")
+		pool.AddData([]byte("hash-c"), createTxWithGasPrice("bob", 7, 2000), 0, "0")
+		require.Equal(t, 3, cache.Len())
+		require.Equal(t, 0, counters[common.MetricNumTxPoolReplacements])
+	})
+	t.Run("cross shard transactions should not be replaced", func(t *testing.T) {
+		counters := make(map[string]int)
+		pool := newTxPoolWithReplacementToTest(t, counters)
+		cache := pool.getTxCache("1_0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "1_0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 2000), 0, "1_0")
+		require.Equal(t, 2, cache.Len())
+		require.Equal(t, 0, counters[common.MetricNumTxPoolReplacements])
+	})
+	t.Run("concurrent replacements should be checked one after the other", func(t *testing.T) {
+		counters := make(map[string]int)
+		pool := newTxPoolWithReplacementToTest(t, counters)
+		cache := pool.getTxCache("0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+
+		numReplacements := 9
+		start := make(chan struct{})
+		wg := sync.WaitGroup{}
+		wg.Add(numReplacements)
+		for i := 0; i < numReplacements; i++ {
+			go func(idx int) {
+				defer wg.Done()
+				<-start
+
+				txHash := []byte(fmt.Sprintf("hash-replacement-%d", idx))
+				pool.AddData(txHash, createTxWithGasPrice("alice", 7, 2000), 0, "0")
+			}(i)
+		}
+		close(start)
+		wg.Wait()
+
+		// only the first replacement outbids the original transaction, the following ones having the same gas price
+		_, ok := cache.GetByTxHash([]byte("hash-a"))
+		require.False(t, ok)
+		require.Equal(t, numReplacements, cache.Len())
+		require.Equal(t, 1, counters[common.MetricNumTxPoolReplacements])
+	})
+	t.Run("disabled replacement should keep both transactions", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+		cache := pool.getTxCache("0")
+
+		pool.AddData([]byte("hash-a"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+		pool.AddData([]byte("hash-b"), createTxWithGasPrice("alice", 7, 2000), 0, "0")
+		require.Equal(t, 2, cache.Len())
+	})
+}
+
+func Test_isGasPriceBumpEnough(t *testing.T) {
+	pool := &shardedTxPool{
+		replacementConfig: config.TxPoolReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+	}
+
+	require.True(t, pool.isGasPriceBumpEnough(1000, 1100))
+	require.True(t, pool.isGasPriceBumpEnough(1000, 5000))
+	require.False(t, pool.isGasPriceBumpEnough(1000, 1099))
+	require.False(t, pool.isGasPriceBumpEnough(1000, 1000))
+	require.True(t, pool.isGasPriceBumpEnough(math.MaxUint64/2, math.MaxUint64))
+}
+
 func Test_AddData_NoPanic_IfNotATransaction(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 
@@ -266,6 +443,45 @@ func Test_RemoveSetOfDataFromPool(t *testing.T) {
 	require.Zero(t, cache.Len())
 }
 
+func Test_ScheduleCancellation(t *testing.T) {
+	t.Run("committed cancellation should drop the higher nonces of the sender", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+
+		pool.AddData([]byte("hash-a"), createTx("alice", 42), 0, "0")
+		pool.AddData([]byte("hash-b"), createTx("alice", 43), 0, "0")
+		pool.AddData([]byte("hash-c"), createTx("alice", 44), 0, "0_1")
+		pool.AddData([]byte("hash-d"), createTx("bob", 43), 0, "0")
+		pool.ScheduleCancellation([]byte("hash-a"), []byte("alice"), 42)
+
+		pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-a")}, "0")
+
+		_, ok := pool.searchFirstTx([]byte("hash-b"))
+		require.False(t, ok)
+		_, ok = pool.searchFirstTx([]byte("hash-c"))
+		require.False(t, ok)
+		_, ok = pool.searchFirstTx([]byte("hash-d"))
+		require.True(t, ok)
+		require.Zero(t, pool.cancellations.Len())
+	})
+	t.Run("pending cancellation should keep the transactions of the sender", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+
+		pool.AddData([]byte("hash-a"), createTx("alice", 42), 0, "0")
+		pool.AddData([]byte("hash-b"), createTx("alice", 43), 0, "0")
+		pool.AddData([]byte("hash-x"), createTx("carol", 7), 0, "0")
+		pool.ScheduleCancellation([]byte("hash-a"), []byte("alice"), 42)
+
+		pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-x")}, "0")
+		pool.RemoveData([]byte("hash-a"), "0")
+
+		_, ok := pool.searchFirstTx([]byte("hash-b"))
+		require.True(t, ok)
+		require.Equal(t, 1, pool.cancellations.Len())
+	})
+}
+
 func Test_RemoveDataFromAllShards(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -398,8 +614,9 @@ func Test_routeToCacheUnions(t *testing.T) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
-		NumberOfShards: 4,
-		SelfShardID:    42,
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+		NumberOfShards:   4,
+		SelfShardID:      42,
 	}
 	pool, _ := NewShardedTxPool(args)
 
@@ -419,6 +636,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -441,8 +666,42 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+		NumberOfShards:   4,
+		SelfShardID:      0,
+	}
+	return NewShardedTxPool(args)
+}
+
+func newTxPoolWithReplacementToTest(t *testing.T, counters map[string]int) *shardedTxPool {
+	cacheConfig := storageunit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{
+		Config: cacheConfig,
+		ReplacementConfig: config.TxPoolReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				counters[key]++
+			},
+		},
 		NumberOfShards: 4,
 		SelfShardID:    0,
 	}
-	return NewShardedTxPool(args)
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	return pool
 }
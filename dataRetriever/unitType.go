@@ -49,6 +49,10 @@ const (
 	PeerAccountsUnit UnitType = 21
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 22
+	// EventsByAddressUnit is the events by emitter address and identifier storage unit identifier
+	EventsByAddressUnit UnitType = 23
+	// TimestampToNonceUnit is the block timestamp to block nonce storage unit identifier
+	TimestampToNonceUnit UnitType = 24
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -110,6 +114,10 @@ func (ut UnitType) String() string {
 		return "PeerAccountsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case EventsByAddressUnit:
+		return "EventsByAddressUnit"
+	case TimestampToNonceUnit:
+		return "TimestampToNonceUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -55,6 +55,10 @@ func TestUnitType_String(t *testing.T) {
 	require.Equal(t, "PeerAccountsUnit", ut.String())
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
+	ut = EventsByAddressUnit
+	require.Equal(t, "EventsByAddressUnit", ut.String())
+	ut = TimestampToNonceUnit
+	require.Equal(t, "TimestampToNonceUnit", ut.String())
 
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
@@ -53,6 +53,16 @@ func (nhr *nilHistoryRepository) GetDCDTSupply(_ string) (*dcdtSupply.SupplyDCDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetEvents -
+func (nhr *nilHistoryRepository) GetEvents(_ dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetBlockNonceByTimestamp -
+func (nhr *nilHistoryRepository) GetBlockNonceByTimestamp(_ uint64) (uint64, error) {
+	return 0, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
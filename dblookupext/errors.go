@@ -9,6 +9,9 @@ import (
 // ErrNotFoundInStorage signals that an item was not found in storage
 var ErrNotFoundInStorage = errors.New("not found in storage")
 
+// ErrBlockNotFoundForTimestamp signals that no block produced at or before the provided timestamp has been found
+var ErrBlockNotFoundForTimestamp = errors.New("no block found at or before the provided timestamp")
+
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilDCDTSuppliesHandler = errors.New("nil dcdt supplies handler")
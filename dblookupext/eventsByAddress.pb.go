@@ -0,0 +1,1188 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eventsByAddress.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedEvent is used to store an event emitted by an address, with a given identifier
+type IndexedEvent struct {
+	TxHash         []byte   `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Topics         [][]byte `protobuf:"bytes,2,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Data           []byte   `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	AdditionalData [][]byte `protobuf:"bytes,4,rep,name=AdditionalData,proto3" json:"AdditionalData,omitempty"`
+}
+
+func (m *IndexedEvent) Reset()      { *m = IndexedEvent{} }
+func (*IndexedEvent) ProtoMessage() {}
+func (*IndexedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c834e47ad41632f, []int{0}
+}
+func (m *IndexedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IndexedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEvent.Merge(m, src)
+}
+func (m *IndexedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEvent proto.InternalMessageInfo
+
+func (m *IndexedEvent) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetAdditionalData() [][]byte {
+	if m != nil {
+		return m.AdditionalData
+	}
+	return nil
+}
+
+// IndexedEventsInBlock is used to store all the events with the same emitter address and identifier from a block
+type IndexedEventsInBlock struct {
+	BlockNonce uint64          `protobuf:"varint,1,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash  []byte          `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Events     []*IndexedEvent `protobuf:"bytes,3,rep,name=Events,proto3" json:"Events,omitempty"`
+}
+
+func (m *IndexedEventsInBlock) Reset()      { *m = IndexedEventsInBlock{} }
+func (*IndexedEventsInBlock) ProtoMessage() {}
+func (*IndexedEventsInBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c834e47ad41632f, []int{1}
+}
+func (m *IndexedEventsInBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedEventsInBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IndexedEventsInBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEventsInBlock.Merge(m, src)
+}
+func (m *IndexedEventsInBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedEventsInBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEventsInBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEventsInBlock proto.InternalMessageInfo
+
+func (m *IndexedEventsInBlock) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *IndexedEventsInBlock) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *IndexedEventsInBlock) GetEvents() []*IndexedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// IndexedEventsNonces is used to store the nonces of the blocks containing events with the same emitter address and identifier
+type IndexedEventsNonces struct {
+	Nonces []uint64 `protobuf:"varint,1,rep,packed,name=Nonces,proto3" json:"Nonces,omitempty"`
+}
+
+func (m *IndexedEventsNonces) Reset()      { *m = IndexedEventsNonces{} }
+func (*IndexedEventsNonces) ProtoMessage() {}
+func (*IndexedEventsNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c834e47ad41632f, []int{2}
+}
+func (m *IndexedEventsNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedEventsNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IndexedEventsNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEventsNonces.Merge(m, src)
+}
+func (m *IndexedEventsNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedEventsNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEventsNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEventsNonces proto.InternalMessageInfo
+
+func (m *IndexedEventsNonces) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IndexedEvent)(nil), "proto.IndexedEvent")
+	proto.RegisterType((*IndexedEventsInBlock)(nil), "proto.IndexedEventsInBlock")
+	proto.RegisterType((*IndexedEventsNonces)(nil), "proto.IndexedEventsNonces")
+}
+
+func init() { proto.RegisterFile("eventsByAddress.proto", fileDescriptor_2c834e47ad41632f) }
+
+var fileDescriptor_2c834e47ad41632f = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xb1, 0x4e, 0x32, 0x41,
+	0x14, 0x85, 0xe7, 0xb2, 0xfb, 0x93, 0xfc, 0x03, 0xb1, 0x18, 0xd4, 0x6c, 0x8c, 0xb9, 0xd9, 0x50,
+	0x18, 0x12, 0x03, 0x24, 0xfa, 0x04, 0x10, 0x49, 0xa4, 0xb1, 0xd8, 0x58, 0xd9, 0xed, 0xee, 0x8c,
+	0xb0, 0x01, 0x77, 0x08, 0x33, 0x18, 0xb4, 0xd2, 0x37, 0xf0, 0x31, 0x7c, 0x14, 0x4b, 0x4a, 0x4a,
+	0x19, 0x1a, 0x4b, 0x1e, 0xc1, 0x70, 0x97, 0x44, 0xb4, 0xda, 0x73, 0xbe, 0x9d, 0x7b, 0xcf, 0xb9,
+	0xfc, 0x48, 0x3d, 0xaa, 0xdc, 0x9a, 0xee, 0x53, 0x47, 0xca, 0xa9, 0x32, 0xa6, 0x35, 0x99, 0x6a,
+	0xab, 0xc5, 0x3f, 0xfa, 0x9c, 0x34, 0x07, 0x99, 0x1d, 0xce, 0x92, 0x56, 0xaa, 0x1f, 0xda, 0x03,
+	0x3d, 0xd0, 0x6d, 0xc2, 0xc9, 0xec, 0x9e, 0x1c, 0x19, 0x52, 0xc5, 0x54, 0xfd, 0x99, 0x57, 0xfb,
+	0xb9, 0x54, 0x73, 0x25, 0x7b, 0xdb, 0xad, 0xe2, 0x98, 0x97, 0x6f, 0xe7, 0xd7, 0xb1, 0x19, 0x06,
+	0x10, 0x42, 0xa3, 0x1a, 0xed, 0x1c, 0x71, 0x3d, 0xc9, 0x52, 0x13, 0x94, 0x42, 0x8f, 0x38, 0x39,
+	0x21, 0xb8, 0x7f, 0x15, 0xdb, 0x38, 0xf0, 0xe8, 0x35, 0x69, 0x71, 0xc6, 0x0f, 0x3a, 0x52, 0x66,
+	0x36, 0xd3, 0x79, 0x3c, 0xa6, 0xbf, 0x3e, 0xcd, 0xfc, 0xa1, 0xf5, 0x57, 0xe0, 0x87, 0xfb, 0xe1,
+	0xa6, 0x9f, 0x77, 0xc7, 0x3a, 0x1d, 0x09, 0xe4, 0x9c, 0xc4, 0x8d, 0xce, 0x53, 0x45, 0x45, 0xfc,
+	0x68, 0x8f, 0x88, 0x53, 0xfe, 0x9f, 0x1c, 0xf5, 0x2c, 0x51, 0xf2, 0x0f, 0x10, 0xe7, 0xbc, 0x5c,
+	0xac, 0x0b, 0xbc, 0xd0, 0x6b, 0x54, 0x2e, 0x6a, 0xc5, 0xa9, 0xad, 0xfd, 0xa8, 0x68, 0xf7, 0xa4,
+	0xde, 0xe4, 0xb5, 0x5f, 0x15, 0x28, 0xc0, 0x6c, 0xcf, 0x2d, 0x54, 0x00, 0xa1, 0xd7, 0xf0, 0xa3,
+	0x9d, 0xeb, 0xf6, 0x16, 0x2b, 0x64, 0xcb, 0x15, 0xb2, 0xcd, 0x0a, 0xe1, 0xc5, 0x21, 0xbc, 0x3b,
+	0x84, 0x0f, 0x87, 0xb0, 0x70, 0x08, 0x4b, 0x87, 0xf0, 0xe9, 0x10, 0xbe, 0x1c, 0xb2, 0x8d, 0x43,
+	0x78, 0x5b, 0x23, 0x5b, 0xac, 0x91, 0x2d, 0xd7, 0xc8, 0xee, 0x2a, 0x32, 0x19, 0x6b, 0x3d, 0x9a,
+	0x4d, 0xd4, 0xdc, 0x26, 0x65, 0x6a, 0x74, 0xf9, 0x3d, 0x00, 0x9e, 0xff, 0x49, 0x8d, 0xcb, 0x01,
+	0x00, 0x00,
+}
+
+func (this *IndexedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedEvent)
+	if !ok {
+		that2, ok := that.(IndexedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if !bytes.Equal(this.Topics[i], that1.Topics[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if len(this.AdditionalData) != len(that1.AdditionalData) {
+		return false
+	}
+	for i := range this.AdditionalData {
+		if !bytes.Equal(this.AdditionalData[i], that1.AdditionalData[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *IndexedEventsInBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedEventsInBlock)
+	if !ok {
+		that2, ok := that.(IndexedEventsInBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(that1.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *IndexedEventsNonces) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedEventsNonces)
+	if !ok {
+		that2, ok := that.(IndexedEventsNonces)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nonces) != len(that1.Nonces) {
+		return false
+	}
+	for i := range this.Nonces {
+		if this.Nonces[i] != that1.Nonces[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *IndexedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&dblookupext.IndexedEvent{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "AdditionalData: "+fmt.Sprintf("%#v", this.AdditionalData)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IndexedEventsInBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.IndexedEventsInBlock{")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IndexedEventsNonces) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.IndexedEventsNonces{")
+	s = append(s, "Nonces: "+fmt.Sprintf("%#v", this.Nonces)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEventsByAddress(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *IndexedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalData) > 0 {
+		for iNdEx := len(m.AdditionalData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalData[iNdEx])
+			copy(dAtA[i:], m.AdditionalData[iNdEx])
+			i = encodeVarintEventsByAddress(dAtA, i, uint64(len(m.AdditionalData[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEventsByAddress(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintEventsByAddress(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEventsByAddress(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedEventsInBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEventsInBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEventsInBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEventsByAddress(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEventsByAddress(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintEventsByAddress(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedEventsNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEventsNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEventsNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		dAtA2 := make([]byte, len(m.Nonces)*10)
+		var j1 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEventsByAddress(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventsByAddress(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventsByAddress(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEventsByAddress(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovEventsByAddress(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEventsByAddress(uint64(l))
+	}
+	if len(m.AdditionalData) > 0 {
+		for _, b := range m.AdditionalData {
+			l = len(b)
+			n += 1 + l + sovEventsByAddress(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexedEventsInBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		n += 1 + sovEventsByAddress(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovEventsByAddress(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEventsByAddress(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexedEventsNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovEventsByAddress(uint64(e))
+		}
+		n += 1 + sovEventsByAddress(uint64(l)) + l
+	}
+	return n
+}
+
+func sovEventsByAddress(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventsByAddress(x uint64) (n int) {
+	return sovEventsByAddress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *IndexedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexedEvent{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`AdditionalData:` + fmt.Sprintf("%v", this.AdditionalData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IndexedEventsInBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]*IndexedEvent{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(f.String(), "IndexedEvent", "IndexedEvent", 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&IndexedEventsInBlock{`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IndexedEventsNonces) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexedEventsNonces{`,
+		`Nonces:` + fmt.Sprintf("%v", this.Nonces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEventsByAddress(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *IndexedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalData = append(m.AdditionalData, make([]byte, postIndex-iNdEx))
+			copy(m.AdditionalData[len(m.AdditionalData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedEventsInBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedEventsInBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedEventsInBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &IndexedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedEventsNonces) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedEventsNonces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedEventsNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEventsByAddress
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEventsByAddress
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEventsByAddress
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEventsByAddress
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEventsByAddress
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventsByAddress(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventsByAddress
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventsByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventsByAddress
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventsByAddress
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventsByAddress
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventsByAddress        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventsByAddress          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventsByAddress = fmt.Errorf("proto: unexpected end of group")
+)
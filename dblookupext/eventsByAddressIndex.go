@@ -0,0 +1,332 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/kalyan3104/protobuf/protobuf  --gogoslick_out=. eventsByAddress.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common/logging"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/cache"
+)
+
+const (
+	// the nonces of the blocks containing events are grouped in buckets so that a block range query
+	// will only need one storage read for each bucket
+	eventsNoncesBucketSize = 100
+	noncesKeyPrefix        = byte('n')
+	eventsInBlockKeyPrefix = byte('e')
+
+	// the topic is filtered after reading the events records, so the number of records read for a query is capped,
+	// the remaining blocks being fetched with the returned cursor
+	maxEventsRecordsReadPerQuery = 1000
+)
+
+// EventsQuery holds the filters used when fetching the indexed events
+type EventsQuery struct {
+	Address    []byte
+	Identifier []byte
+	Topic      []byte
+	FromNonce  uint64
+	ToNonce    uint64
+	// FromIndex is the position of the first event to be returned from the FromNonce block, used for pagination
+	FromIndex uint32
+	Limit     int
+}
+
+// EventsQueryResult holds the indexed events matching an events query, grouped by block
+type EventsQueryResult struct {
+	Blocks    []*IndexedEventsInBlock
+	HasMore   bool
+	NextNonce uint64
+	NextIndex uint32
+}
+
+// eventsByAddressIndex stores the events keyed by the emitter address and the event identifier, so both of them are
+// required when querying. The records are saved in the epoch of the block that generated them, so they are pruned along with that epoch.
+type eventsByAddressIndex struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	storer      storage.Storer
+
+	// holds, for the recently indexed blocks, the keys of the saved records so that they can be removed on revert
+	recentlyIndexedKeys    storage.Cacher
+	maxRecordsReadPerQuery int
+}
+
+func newEventsByAddressIndex(storer storage.Storer, marshalizer marshal.Marshalizer, hasher hashing.Hasher) *eventsByAddressIndex {
+	recentlyIndexedKeys, _ := cache.NewLRUCache(sizeOfDeduplicationCache)
+
+	return &eventsByAddressIndex{
+		marshalizer:            marshalizer,
+		hasher:                 hasher,
+		storer:                 storer,
+		recentlyIndexedKeys:    recentlyIndexedKeys,
+		maxRecordsReadPerQuery: maxEventsRecordsReadPerQuery,
+	}
+}
+
+func (index *eventsByAddressIndex) saveEvents(blockHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	eventsByKey := index.groupEventsByAddressAndIdentifier(logs)
+	if len(eventsByKey) == 0 {
+		return nil
+	}
+
+	epoch := blockHeader.GetEpoch()
+	nonce := blockHeader.GetNonce()
+	indexedKeys := make([][]byte, 0, len(eventsByKey))
+	for baseKey, eventsInBlock := range eventsByKey {
+		eventsInBlock.BlockNonce = nonce
+		eventsInBlock.BlockHash = blockHash
+
+		key := buildEventsKey(eventsInBlockKeyPrefix, []byte(baseKey), nonce)
+		err := index.putRecord(key, eventsInBlock, epoch)
+		if err != nil {
+			logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "eventsByAddressIndex.saveEvents(): cannot save events",
+				"nonce", nonce, "err", err)
+			continue
+		}
+		indexedKeys = append(indexedKeys, key)
+
+		err = index.saveNonce([]byte(baseKey), nonce, epoch)
+		if err != nil {
+			logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "eventsByAddressIndex.saveEvents(): cannot save nonce",
+				"nonce", nonce, "err", err)
+		}
+	}
+
+	_ = index.recentlyIndexedKeys.Put(blockHash, indexedKeys, 0)
+
+	return nil
+}
+
+func (index *eventsByAddressIndex) groupEventsByAddressAndIdentifier(logs []*data.LogData) map[string]*IndexedEventsInBlock {
+	eventsByKey := make(map[string]*IndexedEventsInBlock)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, event := range logData.LogHandler.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			baseKey := string(index.computeBaseKey(event.GetAddress(), event.GetIdentifier()))
+			eventsInBlock, exists := eventsByKey[baseKey]
+			if !exists {
+				eventsInBlock = &IndexedEventsInBlock{}
+				eventsByKey[baseKey] = eventsInBlock
+			}
+
+			indexedEvent := &IndexedEvent{
+				TxHash: []byte(logData.TxHash),
+				Topics: event.GetTopics(),
+				Data:   event.GetData(),
+			}
+			eventWithData, ok := event.(eventWithAdditionalData)
+			if ok {
+				indexedEvent.AdditionalData = eventWithData.GetAdditionalData()
+			}
+			eventsInBlock.Events = append(eventsInBlock.Events, indexedEvent)
+		}
+	}
+
+	return eventsByKey
+}
+
+func (index *eventsByAddressIndex) saveNonce(baseKey []byte, nonce uint64, epoch uint32) error {
+	key := buildEventsKey(noncesKeyPrefix, baseKey, nonce/eventsNoncesBucketSize)
+	record := index.getNonces(key)
+
+	position := sort.Search(len(record.Nonces), func(i int) bool {
+		return record.Nonces[i] >= nonce
+	})
+	if position < len(record.Nonces) && record.Nonces[position] == nonce {
+		return nil
+	}
+
+	record.Nonces = append(record.Nonces, 0)
+	copy(record.Nonces[position+1:], record.Nonces[position:])
+	record.Nonces[position] = nonce
+
+	return index.putRecord(key, record, epoch)
+}
+
+func (index *eventsByAddressIndex) putRecord(key []byte, record interface{}, epoch uint32) error {
+	recordBytes, err := index.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return index.storer.PutInEpoch(key, recordBytes, epoch)
+}
+
+// getNonces returns the nonces stored in the provided bucket. The records are searched in all the active epochs,
+// the most recent one being returned
+func (index *eventsByAddressIndex) getNonces(key []byte) *IndexedEventsNonces {
+	record := &IndexedEventsNonces{}
+	recordBytes, err := index.storer.Get(key)
+	if err != nil {
+		return record
+	}
+
+	err = index.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		log.Debug("eventsByAddressIndex.getNonces(): cannot unmarshal record", "error", err)
+		return &IndexedEventsNonces{}
+	}
+
+	return record
+}
+
+func (index *eventsByAddressIndex) getEventsInBlock(key []byte) (*IndexedEventsInBlock, error) {
+	recordBytes, err := index.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &IndexedEventsInBlock{}
+	err = index.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// revertEvents removes the events saved for the reverted block. The nonce remains recorded in its bucket,
+// the missing events records being skipped when queried
+func (index *eventsByAddressIndex) revertEvents(blockHeader data.HeaderHandler) {
+	blockHash, err := core.CalculateHash(index.marshalizer, index.hasher, blockHeader)
+	if err != nil {
+		log.Debug("eventsByAddressIndex.revertEvents(): cannot compute block hash", "error", err)
+		return
+	}
+
+	value, found := index.recentlyIndexedKeys.Get(blockHash)
+	if !found {
+		return
+	}
+	index.recentlyIndexedKeys.Remove(blockHash)
+
+	indexedKeys, ok := value.([][]byte)
+	if !ok {
+		return
+	}
+
+	for _, key := range indexedKeys {
+		eventsInBlock, errGet := index.getEventsInBlock(key)
+		if errGet != nil || !bytes.Equal(eventsInBlock.BlockHash, blockHash) {
+			// already overwritten by another block with the same nonce
+			continue
+		}
+
+		errRemove := index.storer.Remove(key)
+		if errRemove != nil {
+			log.Debug("eventsByAddressIndex.revertEvents(): cannot remove events", "error", errRemove)
+		}
+	}
+}
+
+func (index *eventsByAddressIndex) getEvents(query EventsQuery) *EventsQueryResult {
+	baseKey := index.computeBaseKey(query.Address, query.Identifier)
+	result := &EventsQueryResult{
+		Blocks: make([]*IndexedEventsInBlock, 0),
+	}
+
+	numEvents := 0
+	numRecordsRead := 0
+	lastBucket := query.ToNonce / eventsNoncesBucketSize
+	for bucket := query.FromNonce / eventsNoncesBucketSize; bucket <= lastBucket; bucket++ {
+		record := index.getNonces(buildEventsKey(noncesKeyPrefix, baseKey, bucket))
+		for _, nonce := range record.Nonces {
+			if nonce < query.FromNonce || nonce > query.ToNonce {
+				continue
+			}
+			if numRecordsRead == index.maxRecordsReadPerQuery {
+				result.HasMore = true
+				result.NextNonce = nonce
+				result.NextIndex = 0
+				return result
+			}
+			numRecordsRead++
+
+			eventsInBlock, err := index.getEventsInBlock(buildEventsKey(eventsInBlockKeyPrefix, baseKey, nonce))
+			if err != nil {
+				// pruned or reverted
+				continue
+			}
+
+			startIndex := uint32(0)
+			if nonce == query.FromNonce {
+				startIndex = query.FromIndex
+			}
+
+			matchingEvents := make([]*IndexedEvent, 0)
+			for idx := startIndex; idx < uint32(len(eventsInBlock.Events)); idx++ {
+				event := eventsInBlock.Events[idx]
+				if !eventHasTopic(event, query.Topic) {
+					continue
+				}
+				if numEvents == query.Limit {
+					result.HasMore = true
+					result.NextNonce = nonce
+					result.NextIndex = idx
+					break
+				}
+
+				matchingEvents = append(matchingEvents, event)
+				numEvents++
+			}
+
+			if len(matchingEvents) > 0 {
+				eventsInBlock.Events = matchingEvents
+				result.Blocks = append(result.Blocks, eventsInBlock)
+			}
+			if result.HasMore {
+				return result
+			}
+		}
+	}
+
+	return result
+}
+
+func (index *eventsByAddressIndex) computeBaseKey(address []byte, identifier []byte) []byte {
+	// the address has a fixed length so the concatenation is not ambiguous
+	buff := make([]byte, 0, len(address)+len(identifier))
+	buff = append(buff, address...)
+	buff = append(buff, identifier...)
+
+	return index.hasher.Compute(string(buff))
+}
+
+func buildEventsKey(prefix byte, baseKey []byte, value uint64) []byte {
+	key := make([]byte, 0, 1+len(baseKey)+8)
+	key = append(key, prefix)
+	key = append(key, baseKey...)
+
+	return binary.BigEndian.AppendUint64(key, value)
+}
+
+func eventHasTopic(event *IndexedEvent, topic []byte) bool {
+	if len(topic) == 0 {
+		return true
+	}
+
+	for _, eventTopic := range event.Topics {
+		if bytes.Equal(eventTopic, topic) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,210 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/common/mock"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEmitter     = []byte("emitter-address-of-32-bytes-long")
+	testOtherSender = []byte("another-address-of-32-bytes-long")
+	testIdentifier  = []byte("swap")
+)
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash: txHash,
+		LogHandler: &transaction.Log{
+			Events: events,
+		},
+	}
+}
+
+func createEvent(address []byte, identifier []byte, topics ...string) *transaction.Event {
+	event := &transaction.Event{
+		Address:    address,
+		Identifier: identifier,
+		Data:       []byte("data"),
+	}
+	for _, topic := range topics {
+		event.Topics = append(event.Topics, []byte(topic))
+	}
+
+	return event
+}
+
+func saveTestBlock(t *testing.T, index *eventsByAddressIndex, nonce uint64, logs ...*data.LogData) []byte {
+	header := &block.Header{Nonce: nonce, Epoch: 1}
+	headerHash, err := core.CalculateHash(index.marshalizer, index.hasher, header)
+	require.Nil(t, err)
+
+	err = index.saveEvents(headerHash, header, logs)
+	require.Nil(t, err)
+
+	return headerHash
+}
+
+func createEventsQuery(fromNonce uint64, toNonce uint64, limit int) EventsQuery {
+	return EventsQuery{
+		Address:    testEmitter,
+		Identifier: testIdentifier,
+		FromNonce:  fromNonce,
+		ToNonce:    toNonce,
+		Limit:      limit,
+	}
+}
+
+func collectTxHashes(result *EventsQueryResult) []string {
+	txHashes := make([]string, 0)
+	for _, eventsInBlock := range result.Blocks {
+		for _, event := range eventsInBlock.Events {
+			txHashes = append(txHashes, string(event.TxHash))
+		}
+	}
+
+	return txHashes
+}
+
+func TestEventsByAddressIndex_SaveAndGetEvents(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsByAddressIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+
+	saveTestBlock(t, index, 5,
+		createLogData("tx1",
+			createEvent(testEmitter, testIdentifier, "a"),
+			createEvent(testEmitter, []byte("transfer"), "a"),
+			createEvent(testOtherSender, testIdentifier, "a"),
+		),
+		createLogData("tx2", createEvent(testEmitter, testIdentifier, "b")),
+	)
+	blockHash := saveTestBlock(t, index, 250, createLogData("tx3", createEvent(testEmitter, testIdentifier, "a")))
+	saveTestBlock(t, index, 251, createLogData("tx4", createEvent(testOtherSender, testIdentifier, "a")))
+
+	t.Run("all events in range", func(t *testing.T) {
+		t.Parallel()
+
+		result := index.getEvents(createEventsQuery(0, 1000, 10))
+		require.Equal(t, []string{"tx1", "tx2", "tx3"}, collectTxHashes(result))
+		require.False(t, result.HasMore)
+		require.Equal(t, uint64(250), result.Blocks[1].BlockNonce)
+		require.Equal(t, blockHash, result.Blocks[1].BlockHash)
+		require.Equal(t, [][]byte{[]byte("a")}, result.Blocks[1].Events[0].Topics)
+		require.Equal(t, []byte("data"), result.Blocks[1].Events[0].Data)
+	})
+	t.Run("block range filter", func(t *testing.T) {
+		t.Parallel()
+
+		result := index.getEvents(createEventsQuery(6, 250, 10))
+		require.Equal(t, []string{"tx3"}, collectTxHashes(result))
+
+		result = index.getEvents(createEventsQuery(0, 249, 10))
+		require.Equal(t, []string{"tx1", "tx2"}, collectTxHashes(result))
+	})
+	t.Run("topic filter", func(t *testing.T) {
+		t.Parallel()
+
+		query := createEventsQuery(0, 1000, 10)
+		query.Topic = []byte("a")
+		result := index.getEvents(query)
+		require.Equal(t, []string{"tx1", "tx3"}, collectTxHashes(result))
+	})
+	t.Run("other identifier", func(t *testing.T) {
+		t.Parallel()
+
+		query := createEventsQuery(0, 1000, 10)
+		query.Identifier = []byte("transfer")
+		result := index.getEvents(query)
+		require.Equal(t, []string{"tx1"}, collectTxHashes(result))
+	})
+	t.Run("pagination", func(t *testing.T) {
+		t.Parallel()
+
+		result := index.getEvents(createEventsQuery(0, 1000, 1))
+		require.Equal(t, []string{"tx1"}, collectTxHashes(result))
+		require.True(t, result.HasMore)
+		require.Equal(t, uint64(5), result.NextNonce)
+		require.Equal(t, uint32(1), result.NextIndex)
+
+		query := createEventsQuery(result.NextNonce, 1000, 1)
+		query.FromIndex = result.NextIndex
+		result = index.getEvents(query)
+		require.Equal(t, []string{"tx2"}, collectTxHashes(result))
+		require.True(t, result.HasMore)
+		require.Equal(t, uint64(250), result.NextNonce)
+		require.Equal(t, uint32(0), result.NextIndex)
+
+		query = createEventsQuery(result.NextNonce, 1000, 1)
+		query.FromIndex = result.NextIndex
+		result = index.getEvents(query)
+		require.Equal(t, []string{"tx3"}, collectTxHashes(result))
+		require.False(t, result.HasMore)
+	})
+}
+
+func TestEventsByAddressIndex_GetEventsShouldCapTheReadRecords(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsByAddressIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+	index.maxRecordsReadPerQuery = 2
+
+	saveTestBlock(t, index, 5, createLogData("tx1", createEvent(testEmitter, testIdentifier, "a")))
+	saveTestBlock(t, index, 6, createLogData("tx2", createEvent(testEmitter, testIdentifier, "b")))
+	saveTestBlock(t, index, 7, createLogData("tx3", createEvent(testEmitter, testIdentifier, "a")))
+
+	query := createEventsQuery(0, 1000, 10)
+	query.Topic = []byte("a")
+	result := index.getEvents(query)
+	require.Equal(t, []string{"tx1"}, collectTxHashes(result))
+	require.True(t, result.HasMore)
+	require.Equal(t, uint64(7), result.NextNonce)
+	require.Equal(t, uint32(0), result.NextIndex)
+
+	query.FromNonce = result.NextNonce
+	result = index.getEvents(query)
+	require.Equal(t, []string{"tx3"}, collectTxHashes(result))
+	require.False(t, result.HasMore)
+}
+
+func TestEventsByAddressIndex_SaveSameNonceTwice(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsByAddressIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+
+	saveTestBlock(t, index, 7, createLogData("tx1", createEvent(testEmitter, testIdentifier)))
+	saveTestBlock(t, index, 3, createLogData("tx0", createEvent(testEmitter, testIdentifier)))
+	saveTestBlock(t, index, 7, createLogData("tx2", createEvent(testEmitter, testIdentifier)))
+
+	baseKey := index.computeBaseKey(testEmitter, testIdentifier)
+	nonces := index.getNonces(buildEventsKey(noncesKeyPrefix, baseKey, 0))
+	require.Equal(t, []uint64{3, 7}, nonces.Nonces)
+
+	result := index.getEvents(createEventsQuery(0, 10, 10))
+	require.Equal(t, []string{"tx0", "tx2"}, collectTxHashes(result))
+}
+
+func TestEventsByAddressIndex_RevertEvents(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsByAddressIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+
+	saveTestBlock(t, index, 1, createLogData("tx1", createEvent(testEmitter, testIdentifier)))
+	saveTestBlock(t, index, 2, createLogData("tx2", createEvent(testEmitter, testIdentifier)))
+
+	index.revertEvents(&block.Header{Nonce: 2, Epoch: 1})
+	result := index.getEvents(createEventsQuery(0, 10, 10))
+	require.Equal(t, []string{"tx1"}, collectTxHashes(result))
+
+	// reverting an unknown block should not affect the stored events
+	index.revertEvents(&block.Header{Nonce: 1, Epoch: 5})
+	result = index.getEvents(createEventsQuery(0, 10, 10))
+	require.Equal(t, []string{"tx1"}, collectTxHashes(result))
+}
@@ -109,6 +109,16 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	eventsByAddressStorer, err := hpf.store.GetStorer(dataRetriever.EventsByAddressUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	timestampToNonceStorer, err := hpf.store.GetStorer(dataRetriever.TimestampToNonceUnit)
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -119,6 +129,8 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           epochByHashStorer,
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
+		EventsByAddressStorer:       eventsByAddressStorer,
+		TimestampToNonceStorer:      timestampToNonceStorer,
 		DCDTSuppliesHandler:         dcdtSuppliesHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
@@ -35,6 +35,8 @@ type HistoryRepositoryArguments struct {
 	Uint64ByteSliceConverter    typeConverters.Uint64ByteSliceConverter
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	EventsByAddressStorer       storage.Storer
+	TimestampToNonceStorer      storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	DCDTSuppliesHandler         SuppliesHandler
@@ -48,6 +50,8 @@ type historyRepository struct {
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	eventsByAddressIndex       *eventsByAddressIndex
+	timestampToNonceIndex      *timestampToNonceIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	dcdtSuppliesHandler        SuppliesHandler
@@ -91,6 +95,12 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.EventsByAddressStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.TimestampToNonceStorer) {
+		return nil, core.ErrNilStore
+	}
 	if check.IfNil(arguments.DCDTSuppliesHandler) {
 		return nil, errNilDCDTSuppliesHandler
 	}
@@ -102,6 +112,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	deduplicationCacheForInsertMiniblockMetadata, _ := cache.NewLRUCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
+	eventsByAddressIndex := newEventsByAddressIndex(arguments.EventsByAddressStorer, arguments.Marshalizer, arguments.Hasher)
 
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
@@ -116,6 +127,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		eventsByAddressIndex:                         eventsByAddressIndex,
+		timestampToNonceIndex:                        newTimestampToNonceIndex(arguments.TimestampToNonceStorer),
 		dcdtSuppliesHandler:                          arguments.DCDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
@@ -178,11 +191,21 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.eventsByAddressIndex.saveEvents(blockHeaderHash, blockHeader, logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
 	}
 
+	err = hr.timestampToNonceIndex.saveBlock(blockHeader)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -480,9 +503,75 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	hr.revertEvents(blockHeader)
+	hr.revertTimestampToNonce(blockHeader)
+
 	return hr.dcdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
 }
 
+// UnmarkRevertedMiniblocksMetadata makes sure that the metadata of the reverted miniblocks will be recorded again
+// if the same miniblocks are committed, in the same epoch, on another block. It is not part of RevertBlock, being
+// only needed by the tools that rewind the chain, such as the chain simulator
+func (hr *historyRepository) UnmarkRevertedMiniblocksMetadata(blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	if check.IfNil(blockHeader) {
+		return
+	}
+	body, ok := blockBody.(*block.Body)
+	if !ok {
+		return
+	}
+
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock {
+			continue
+		}
+
+		miniblockHash, err := hr.computeMiniblockHash(miniblock)
+		if err != nil {
+			log.Debug("UnmarkRevertedMiniblocksMetadata(): cannot compute miniblock hash", "error", err)
+			continue
+		}
+
+		key := hr.buildKeyOfDeduplicationCacheForInsertMiniblockMetadata(miniblockHash, blockHeader.GetEpoch())
+		hr.deduplicationCacheForInsertMiniblockMetadata.Remove(key)
+	}
+}
+
+func (hr *historyRepository) revertEvents(blockHeader data.HeaderHandler) {
+	if check.IfNil(blockHeader) {
+		return
+	}
+
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	hr.eventsByAddressIndex.revertEvents(blockHeader)
+}
+
+func (hr *historyRepository) revertTimestampToNonce(blockHeader data.HeaderHandler) {
+	if check.IfNil(blockHeader) {
+		return
+	}
+
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	hr.timestampToNonceIndex.revertBlock(blockHeader)
+}
+
+// GetEvents will return the indexed events emitted by an address with the given identifier, filtered by the provided query
+func (hr *historyRepository) GetEvents(query EventsQuery) (*EventsQueryResult, error) {
+	return hr.eventsByAddressIndex.getEvents(query), nil
+}
+
+// GetBlockNonceByTimestamp will return the nonce of the last block produced at or before the given timestamp
+func (hr *historyRepository) GetBlockNonceByTimestamp(timestamp uint64) (uint64, error) {
+	return hr.timestampToNonceIndex.getBlockNonce(timestamp)
+}
+
 // GetDCDTSupply will return the supply from the storage for the given token
 func (hr *historyRepository) GetDCDTSupply(token string) (*dcdtSupply.SupplyDCDT, error) {
 	return hr.dcdtSuppliesHandler.GetDCDTSupply(token)
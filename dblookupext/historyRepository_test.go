@@ -33,6 +33,8 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		MiniblockHashByTxHashStorer: genericMocks.NewStorerMockWithEpoch(epoch),
 		EpochByHashStorer:           genericMocks.NewStorerMockWithEpoch(epoch),
 		EventsHashesByTxHashStorer:  genericMocks.NewStorerMockWithEpoch(epoch),
+		EventsByAddressStorer:       genericMocks.NewStorerMockWithEpoch(epoch),
+		TimestampToNonceStorer:      genericMocks.NewStorerMockWithEpoch(epoch),
 		BlockHashByRound:            genericMocks.NewStorerMockWithEpoch(epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
@@ -70,6 +72,18 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilStore, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.EventsByAddressStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.TimestampToNonceStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	args.Hasher = nil
 	repo, err = NewHistoryRepository(args)
@@ -236,6 +250,29 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestHistoryRepository_GetBlockNonceByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(42)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	_ = repo.RecordBlock([]byte("fooblock"), &block.Header{Epoch: 42, Nonce: 7, TimeStamp: 6000}, &block.Body{}, nil, nil, nil, nil)
+	_ = repo.RecordBlock([]byte("barblock"), &block.Header{Epoch: 42, Nonce: 8, TimeStamp: 6006}, &block.Body{}, nil, nil, nil, nil)
+
+	nonce, err := repo.GetBlockNonceByTimestamp(6005)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), nonce)
+
+	_ = repo.RevertBlock(&block.Header{Epoch: 42, Nonce: 8, TimeStamp: 6006}, &block.Body{})
+	nonce, err = repo.GetBlockNonceByTimestamp(6010)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), nonce)
+
+	_, err = repo.GetBlockNonceByTimestamp(5999)
+	require.True(t, errors.Is(err, ErrBlockNotFoundForTimestamp))
+}
+
 func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 	t.Parallel()
 
@@ -639,6 +676,47 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 	require.Nil(t, orphanedMetadata.NotarizedAtDestinationInMetaHash)
 }
 
+func TestHistoryRepository_UnmarkRevertedMiniblocksMetadataThenCommitSameMiniblockOnAnotherBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(42)
+	notFoundStorer := &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, storage.ErrKeyNotFound
+		},
+	}
+	args.DCDTSuppliesHandler, _ = dcdtSupply.NewSuppliesProcessor(&mock.MarshalizerMock{}, notFoundStorer, notFoundStorer)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	miniblock := &block.MiniBlock{
+		SenderShardID:   0,
+		ReceiverShardID: 0,
+		TxHashes:        [][]byte{[]byte("txA")},
+	}
+	miniblockHash, _ := repo.computeMiniblockHash(miniblock)
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			miniblock,
+		},
+	}
+
+	revertedHeader := &block.Header{Epoch: 42, Round: 4321, Nonce: 10}
+	_ = repo.RecordBlock([]byte("fooReverted"), revertedHeader, body, nil, nil, nil, nil)
+
+	err = repo.RevertBlock(revertedHeader, body)
+	require.Nil(t, err)
+	repo.UnmarkRevertedMiniblocksMetadata(revertedHeader, body)
+
+	// the same miniblock is committed, in the same epoch, on the block that replaces the reverted one
+	_ = repo.RecordBlock([]byte("fooOnChain"), &block.Header{Epoch: 42, Round: 4322, Nonce: 10}, body, nil, nil, nil, nil)
+
+	metadata, err := repo.getMiniblockMetadataByMiniblockHash(miniblockHash)
+	require.Nil(t, err)
+	require.Equal(t, []byte("fooOnChain"), metadata.HeaderHash)
+	require.Equal(t, 4322, int(metadata.Round))
+}
+
 func TestHistoryRepository_getMiniblockMetadataByMiniblockHashGetFromEpochErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
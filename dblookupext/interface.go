@@ -27,6 +27,8 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetDCDTSupply(token string) (*dcdtSupply.SupplyDCDT, error)
+	GetEvents(query EventsQuery) (*EventsQueryResult, error)
+	GetBlockNonceByTimestamp(timestamp uint64) (uint64, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +49,7 @@ type SuppliesHandler interface {
 	GetDCDTSupply(token string) (*dcdtSupply.SupplyDCDT, error)
 	IsInterfaceNil() bool
 }
+
+type eventWithAdditionalData interface {
+	GetAdditionalData() [][]byte
+}
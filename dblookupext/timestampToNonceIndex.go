@@ -0,0 +1,137 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-go/storage"
+)
+
+const (
+	// the blocks are grouped in buckets by their timestamp so that a lookup will only need a few storage reads
+	timestampsBucketSizeInSeconds = 600
+	// the number of preceding buckets searched when the bucket of the requested timestamp holds no older block
+	maxTimestampLookbackBuckets = 144
+	timestampEntrySize          = 16
+)
+
+type timestampEntry struct {
+	timestamp uint64
+	nonce     uint64
+}
+
+// timestampToNonceIndex stores the timestamp and the nonce of each recorded block so that the last block produced
+// at or before a given timestamp can be found. Each bucket record holds its entries sorted by timestamp
+type timestampToNonceIndex struct {
+	storer storage.Storer
+}
+
+func newTimestampToNonceIndex(storer storage.Storer) *timestampToNonceIndex {
+	return &timestampToNonceIndex{
+		storer: storer,
+	}
+}
+
+func (index *timestampToNonceIndex) saveBlock(blockHeader data.HeaderHandler) error {
+	timestamp := blockHeader.GetTimeStamp()
+	key := buildTimestampsBucketKey(timestamp / timestampsBucketSizeInSeconds)
+
+	// a block with the same nonce could have been recorded before, on another fork
+	entries := removeTimestampEntriesWithNonce(index.getEntries(key), blockHeader.GetNonce())
+	position := sort.Search(len(entries), func(i int) bool {
+		return entries[i].timestamp > timestamp
+	})
+
+	entries = append(entries, timestampEntry{})
+	copy(entries[position+1:], entries[position:])
+	entries[position] = timestampEntry{
+		timestamp: timestamp,
+		nonce:     blockHeader.GetNonce(),
+	}
+
+	return index.storer.Put(key, encodeTimestampEntries(entries))
+}
+
+func (index *timestampToNonceIndex) revertBlock(blockHeader data.HeaderHandler) {
+	key := buildTimestampsBucketKey(blockHeader.GetTimeStamp() / timestampsBucketSizeInSeconds)
+	entries := index.getEntries(key)
+	remainingEntries := removeTimestampEntriesWithNonce(entries, blockHeader.GetNonce())
+	if len(remainingEntries) == len(entries) {
+		return
+	}
+
+	err := index.storer.Put(key, encodeTimestampEntries(remainingEntries))
+	if err != nil {
+		log.Debug("timestampToNonceIndex.revertBlock(): cannot update bucket", "nonce", blockHeader.GetNonce(), "error", err)
+	}
+}
+
+// getBlockNonce returns the nonce of the last recorded block having the timestamp lower or equal to the provided one
+func (index *timestampToNonceIndex) getBlockNonce(timestamp uint64) (uint64, error) {
+	bucket := timestamp / timestampsBucketSizeInSeconds
+	for numSearchedBuckets := 0; numSearchedBuckets <= maxTimestampLookbackBuckets; numSearchedBuckets++ {
+		entries := index.getEntries(buildTimestampsBucketKey(bucket))
+		position := sort.Search(len(entries), func(i int) bool {
+			return entries[i].timestamp > timestamp
+		})
+		if position > 0 {
+			return entries[position-1].nonce, nil
+		}
+
+		if bucket == 0 {
+			break
+		}
+		bucket--
+	}
+
+	return 0, fmt.Errorf("%w: %d", ErrBlockNotFoundForTimestamp, timestamp)
+}
+
+func (index *timestampToNonceIndex) getEntries(key []byte) []timestampEntry {
+	recordBytes, err := index.storer.Get(key)
+	if err != nil {
+		return make([]timestampEntry, 0)
+	}
+
+	if len(recordBytes)%timestampEntrySize != 0 {
+		log.Debug("timestampToNonceIndex.getEntries(): invalid record length", "length", len(recordBytes))
+		return make([]timestampEntry, 0)
+	}
+
+	entries := make([]timestampEntry, 0, len(recordBytes)/timestampEntrySize)
+	for offset := 0; offset < len(recordBytes); offset += timestampEntrySize {
+		entries = append(entries, timestampEntry{
+			timestamp: binary.BigEndian.Uint64(recordBytes[offset:]),
+			nonce:     binary.BigEndian.Uint64(recordBytes[offset+8:]),
+		})
+	}
+
+	return entries
+}
+
+func removeTimestampEntriesWithNonce(entries []timestampEntry, nonce uint64) []timestampEntry {
+	remainingEntries := make([]timestampEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.nonce != nonce {
+			remainingEntries = append(remainingEntries, entry)
+		}
+	}
+
+	return remainingEntries
+}
+
+func encodeTimestampEntries(entries []timestampEntry) []byte {
+	recordBytes := make([]byte, 0, len(entries)*timestampEntrySize)
+	for _, entry := range entries {
+		recordBytes = binary.BigEndian.AppendUint64(recordBytes, entry.timestamp)
+		recordBytes = binary.BigEndian.AppendUint64(recordBytes, entry.nonce)
+	}
+
+	return recordBytes
+}
+
+func buildTimestampsBucketKey(bucket uint64) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, 8), bucket)
+}
@@ -0,0 +1,97 @@
+package dblookupext
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func saveTestBlockWithTimestamp(t *testing.T, index *timestampToNonceIndex, nonce uint64, timestamp uint64) {
+	err := index.saveBlock(&block.Header{Nonce: nonce, TimeStamp: timestamp})
+	require.Nil(t, err)
+}
+
+func requireBlockNonceForTimestamp(t *testing.T, index *timestampToNonceIndex, timestamp uint64, expectedNonce uint64) {
+	nonce, err := index.getBlockNonce(timestamp)
+	require.Nil(t, err)
+	require.Equal(t, expectedNonce, nonce)
+}
+
+func TestTimestampToNonceIndex_GetBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the last block at or before the timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		index := newTimestampToNonceIndex(genericMocks.NewStorerMock())
+		saveTestBlockWithTimestamp(t, index, 10, 6000)
+		saveTestBlockWithTimestamp(t, index, 12, 6012)
+		saveTestBlockWithTimestamp(t, index, 11, 6006)
+
+		requireBlockNonceForTimestamp(t, index, 6000, 10)
+		requireBlockNonceForTimestamp(t, index, 6005, 10)
+		requireBlockNonceForTimestamp(t, index, 6006, 11)
+		requireBlockNonceForTimestamp(t, index, 6011, 11)
+		requireBlockNonceForTimestamp(t, index, 6100, 12)
+	})
+	t.Run("should search the previous buckets", func(t *testing.T) {
+		t.Parallel()
+
+		index := newTimestampToNonceIndex(genericMocks.NewStorerMock())
+		saveTestBlockWithTimestamp(t, index, 10, 6000)
+		saveTestBlockWithTimestamp(t, index, 11, 6000+3*timestampsBucketSizeInSeconds)
+
+		requireBlockNonceForTimestamp(t, index, 6000+3*timestampsBucketSizeInSeconds-1, 10)
+	})
+	t.Run("timestamp before the first block should error", func(t *testing.T) {
+		t.Parallel()
+
+		index := newTimestampToNonceIndex(genericMocks.NewStorerMock())
+		saveTestBlockWithTimestamp(t, index, 10, 6000)
+
+		_, err := index.getBlockNonce(5999)
+		require.True(t, errors.Is(err, ErrBlockNotFoundForTimestamp))
+	})
+	t.Run("timestamp after the lookback window should error", func(t *testing.T) {
+		t.Parallel()
+
+		index := newTimestampToNonceIndex(genericMocks.NewStorerMock())
+		saveTestBlockWithTimestamp(t, index, 10, 6000)
+
+		_, err := index.getBlockNonce(6000 + (maxTimestampLookbackBuckets+2)*timestampsBucketSizeInSeconds)
+		require.True(t, errors.Is(err, ErrBlockNotFoundForTimestamp))
+	})
+}
+
+func TestTimestampToNonceIndex_SaveBlockShouldReplaceTheSameNonce(t *testing.T) {
+	t.Parallel()
+
+	index := newTimestampToNonceIndex(genericMocks.NewStorerMock())
+	saveTestBlockWithTimestamp(t, index, 10, 6000)
+	saveTestBlockWithTimestamp(t, index, 11, 6006)
+	// block with nonce 11 from another fork, proposed in a later round
+	saveTestBlockWithTimestamp(t, index, 11, 6012)
+
+	requireBlockNonceForTimestamp(t, index, 6006, 10)
+	requireBlockNonceForTimestamp(t, index, 6012, 11)
+	require.Len(t, index.getEntries(buildTimestampsBucketKey(10)), 2)
+}
+
+func TestTimestampToNonceIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := newTimestampToNonceIndex(genericMocks.NewStorerMock())
+	saveTestBlockWithTimestamp(t, index, 10, 6000)
+	saveTestBlockWithTimestamp(t, index, 11, 6006)
+
+	index.revertBlock(&block.Header{Nonce: 11, TimeStamp: 6006})
+	requireBlockNonceForTimestamp(t, index, 6006, 10)
+
+	index.revertBlock(&block.Header{Nonce: 10, TimeStamp: 6000})
+	_, err := index.getBlockNonce(6006)
+	require.True(t, errors.Is(err, ErrBlockNotFoundForTimestamp))
+	require.Len(t, index.getEntries(buildTimestampsBucketKey(10)), 0)
+}
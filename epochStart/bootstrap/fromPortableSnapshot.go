@@ -0,0 +1,88 @@
+package bootstrap
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/epochStart"
+	"github.com/kalyan3104/k-chain-go/state/portableSnapshot"
+)
+
+// loadUserAccountsState brings the user accounts state of the provided root hash on disk, either by importing
+// it from the portable state snapshot, if one was provided, or by syncing it from the network
+func (e *epochStartBootstrap) loadUserAccountsState(rootHash []byte) error {
+	if len(e.flagsConfig.ImportStateSnapshotFile) == 0 {
+		return e.syncUserAccountsState(rootHash)
+	}
+
+	return e.importUserAccountsState(rootHash)
+}
+
+func (e *epochStartBootstrap) importUserAccountsState(rootHash []byte) error {
+	filePath := e.flagsConfig.ImportStateSnapshotFile
+	log.Info("start in epoch bootstrap: importing the accounts state from the portable snapshot", "file", filePath)
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader, err := portableSnapshot.NewSnapshotReader(bufio.NewReader(file), e.coreComponentsHolder.Hasher())
+	if err != nil {
+		return err
+	}
+
+	err = e.checkPortableSnapshotMetadata(reader.Metadata())
+	if err != nil {
+		return err
+	}
+
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
+	e.mutTrieStorageManagers.RUnlock()
+
+	numNodes, err := reader.ImportTrieNodes(rootHash, trieStorageManager)
+	if err != nil {
+		return err
+	}
+
+	log.Info("start in epoch bootstrap: imported the portable snapshot", "root hash", rootHash, "num trie nodes", numNodes)
+
+	// the syncer checks the imported state completeness, requesting from the network only the missing nodes, if any
+	e.checkNodesOnDisk = true
+	return e.syncUserAccountsState(rootHash)
+}
+
+// checkPortableSnapshotMetadata verifies that the snapshot was exported by the same shard, from the same epoch
+// start meta block as the one the node bootstraps from
+func (e *epochStartBootstrap) checkPortableSnapshotMetadata(metadata portableSnapshot.Metadata) error {
+	if metadata.ShardID != e.shardCoordinator.SelfId() {
+		return fmt.Errorf("%w: snapshot for shard %s, node in shard %s", epochStart.ErrPortableSnapshotMismatch,
+			core.GetShardIDString(metadata.ShardID), core.GetShardIDString(e.shardCoordinator.SelfId()))
+	}
+	if metadata.Epoch != e.epochStartMeta.GetEpoch() {
+		return fmt.Errorf("%w: snapshot for epoch %d, node bootstraps in epoch %d", epochStart.ErrPortableSnapshotMismatch,
+			metadata.Epoch, e.epochStartMeta.GetEpoch())
+	}
+
+	hasher := e.coreComponentsHolder.Hasher()
+	epochStartMetaHash, err := core.CalculateHash(e.coreComponentsHolder.InternalMarshalizer(), hasher, e.epochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	snapshotMetaHash := hasher.Compute(string(metadata.EpochStartMetaBlock))
+	if !bytes.Equal(epochStartMetaHash, snapshotMetaHash) {
+		return fmt.Errorf("%w: epoch start meta block hash %x, snapshot has %x", epochStart.ErrPortableSnapshotMismatch,
+			epochStartMetaHash, snapshotMetaHash)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package bootstrap
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/epochStart"
+	"github.com/kalyan3104/k-chain-go/state/portableSnapshot"
+	"github.com/kalyan3104/k-chain-go/testscommon/storageManager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEpochStartBootstrapWithPortableSnapshot(t *testing.T, metadata *portableSnapshot.Metadata) *epochStartBootstrap {
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+
+	buff := &bytes.Buffer{}
+	writer, err := portableSnapshot.NewSnapshotWriter(buff, coreComp.Hasher(), metadata, portableSnapshot.DefaultMaxChunkSizeInBytes)
+	require.Nil(t, err)
+	_ = writer.AddTrieNode([]byte("trie node"))
+	err = writer.Close()
+	require.Nil(t, err)
+
+	args.FlagsConfig.ImportStateSnapshotFile = filepath.Join(t.TempDir(), portableSnapshot.FileName(metadata.ShardID, metadata.Epoch))
+	err = os.WriteFile(args.FlagsConfig.ImportStateSnapshotFile, buff.Bytes(), os.ModePerm)
+	require.Nil(t, err)
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	require.Nil(t, err)
+
+	return epochStartProvider
+}
+
+func createPortableSnapshotMetadata(t *testing.T, metaBlock *block.MetaBlock) *portableSnapshot.Metadata {
+	coreComp, _ := createComponentsForEpochStart()
+	metaBlockBuff, err := coreComp.InternalMarshalizer().Marshal(metaBlock)
+	require.Nil(t, err)
+
+	return &portableSnapshot.Metadata{
+		ShardID:             0,
+		Epoch:               metaBlock.Epoch,
+		RootHash:            []byte("root hash"),
+		EpochStartMetaBlock: metaBlockBuff,
+	}
+}
+
+func TestEpochStartBootstrap_CheckPortableSnapshotMetadata(t *testing.T) {
+	t.Parallel()
+
+	metaBlock := &block.MetaBlock{Epoch: 5, Nonce: 100}
+
+	t.Run("different shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		metadata := createPortableSnapshotMetadata(t, metaBlock)
+		metadata.ShardID = 1
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, metadata)
+		epochStartProvider.epochStartMeta = metaBlock
+
+		err := epochStartProvider.checkPortableSnapshotMetadata(*metadata)
+		assert.True(t, errors.Is(err, epochStart.ErrPortableSnapshotMismatch))
+	})
+	t.Run("different epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		metadata := createPortableSnapshotMetadata(t, metaBlock)
+		metadata.Epoch = 4
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, metadata)
+		epochStartProvider.epochStartMeta = metaBlock
+
+		err := epochStartProvider.checkPortableSnapshotMetadata(*metadata)
+		assert.True(t, errors.Is(err, epochStart.ErrPortableSnapshotMismatch))
+	})
+	t.Run("different epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		metadata := createPortableSnapshotMetadata(t, &block.MetaBlock{Epoch: 5, Nonce: 101})
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, metadata)
+		epochStartProvider.epochStartMeta = metaBlock
+
+		err := epochStartProvider.checkPortableSnapshotMetadata(*metadata)
+		assert.True(t, errors.Is(err, epochStart.ErrPortableSnapshotMismatch))
+	})
+	t.Run("matching metadata should work", func(t *testing.T) {
+		t.Parallel()
+
+		metadata := createPortableSnapshotMetadata(t, metaBlock)
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, metadata)
+		epochStartProvider.epochStartMeta = metaBlock
+
+		err := epochStartProvider.checkPortableSnapshotMetadata(*metadata)
+		assert.Nil(t, err)
+	})
+}
+
+func TestEpochStartBootstrap_ImportUserAccountsState(t *testing.T) {
+	t.Parallel()
+
+	metaBlock := &block.MetaBlock{Epoch: 5, Nonce: 100}
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, createPortableSnapshotMetadata(t, metaBlock))
+		epochStartProvider.epochStartMeta = metaBlock
+		epochStartProvider.flagsConfig.ImportStateSnapshotFile = filepath.Join(t.TempDir(), "missing")
+
+		err := epochStartProvider.loadUserAccountsState([]byte("root hash"))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("metadata mismatch should not import the trie nodes", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, createPortableSnapshotMetadata(t, metaBlock))
+		epochStartProvider.epochStartMeta = &block.MetaBlock{Epoch: 5, Nonce: 101}
+		epochStartProvider.trieStorageManagers = map[string]common.StorageManager{
+			dataRetriever.UserAccountsUnit.String(): &storageManager.StorageManagerStub{
+				PutCalled: func(_ []byte, _ []byte) error {
+					assert.Fail(t, "should not have imported the trie nodes")
+					return nil
+				},
+			},
+		}
+
+		err := epochStartProvider.loadUserAccountsState([]byte("root hash"))
+		assert.True(t, errors.Is(err, epochStart.ErrPortableSnapshotMismatch))
+	})
+	t.Run("different root hash should not import the trie nodes", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createEpochStartBootstrapWithPortableSnapshot(t, createPortableSnapshotMetadata(t, metaBlock))
+		epochStartProvider.epochStartMeta = metaBlock
+		epochStartProvider.trieStorageManagers = map[string]common.StorageManager{
+			dataRetriever.UserAccountsUnit.String(): &storageManager.StorageManagerStub{
+				PutCalled: func(_ []byte, _ []byte) error {
+					assert.Fail(t, "should not have imported the trie nodes")
+					return nil
+				},
+			},
+		}
+
+		err := epochStartProvider.loadUserAccountsState([]byte("another root hash"))
+		assert.True(t, errors.Is(err, portableSnapshot.ErrRootHashMismatch))
+	})
+}
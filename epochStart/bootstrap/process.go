@@ -45,6 +45,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/state/syncer"
 	"github.com/kalyan3104/k-chain-go/storage"
 	"github.com/kalyan3104/k-chain-go/storage/cache"
+	storageDisabled "github.com/kalyan3104/k-chain-go/storage/disabled"
 	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
 	"github.com/kalyan3104/k-chain-go/trie/factory"
@@ -359,6 +360,7 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 			ShardCoordinator: e.shardCoordinator,
 			Marshalizer:      e.coreComponentsHolder.InternalMarshalizer(),
 			PathManager:      e.coreComponentsHolder.PathHandler(),
+			AppStatusHandler: e.statusHandler,
 		},
 	)
 	if err != nil {
@@ -833,7 +835,7 @@ func (e *epochStartBootstrap) requestAndProcessForMeta(peerMiniBlocks []*block.M
 	}
 	log.Debug("start in epoch bootstrap: syncUserAccountsState")
 
-	err = e.syncUserAccountsState(e.epochStartMeta.GetRootHash())
+	err = e.loadUserAccountsState(e.epochStartMeta.GetRootHash())
 	if err != nil {
 		return err
 	}
@@ -999,7 +1001,7 @@ func (e *epochStartBootstrap) requestAndProcessForShard(peerMiniBlocks []*block.
 	e.trieStorageManagers = trieStorageManagers
 
 	log.Debug("start in epoch bootstrap: started syncUserAccountsState", "rootHash", dts.rootHashToSync)
-	err = e.syncUserAccountsState(dts.rootHashToSync)
+	err = e.loadUserAccountsState(dts.rootHashToSync)
 	if err != nil {
 		return err
 	}
@@ -1109,6 +1111,12 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	checkpointsStorer, err := e.createTrieSyncCheckpointsStorer(dataRetriever.UserAccountsUnit)
+	if err != nil {
+		return err
+	}
+	defer closeTrieSyncCheckpointsStorer(checkpointsStorer)
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1124,6 +1132,8 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			UserAccountsSyncStatisticsHandler: e.trieSyncStatisticsProvider,
 			AppStatusHandler:                  e.statusHandler,
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
+			CheckpointsStorer:                 checkpointsStorer,
+			TimeBetweenCheckpoints:            e.timeBetweenTrieSyncCheckpoints(),
 		},
 		ShardId:                e.shardCoordinator.SelfId(),
 		Throttler:              thr,
@@ -1142,6 +1152,50 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+// createTrieSyncCheckpointsStorer creates the storer holding the progress of the trie sync for the provided unit. The
+// checkpoints need to survive a restart, so the storer never uses a temporary directory
+func (e *epochStartBootstrap) createTrieSyncCheckpointsStorer(unit dataRetriever.UnitType) (storage.Persister, error) {
+	checkpointsConfig := e.generalConfig.TrieSyncStorage.Checkpoints
+	if !checkpointsConfig.Enabled {
+		return storageDisabled.NewPersister(), nil
+	}
+
+	dbConfig := e.generalConfig.TrieSyncStorage.DB
+	dbConfig.FilePath = fmt.Sprintf("%s_%s", checkpointsConfig.FilePath, unit.String())
+	dbConfig.UseTmpAsFilePath = false
+
+	shardId := core.GetShardIDString(e.shardCoordinator.SelfId())
+	path := e.coreComponentsHolder.PathHandler().PathForStatic(shardId, dbConfig.FilePath)
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := storageunit.NewDB(persisterFactory, path)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the db for the trie sync checkpoints", err)
+	}
+
+	return db, nil
+}
+
+func (e *epochStartBootstrap) timeBetweenTrieSyncCheckpoints() time.Duration {
+	checkpointsConfig := e.generalConfig.TrieSyncStorage.Checkpoints
+	if !checkpointsConfig.Enabled {
+		return 0
+	}
+
+	return time.Duration(checkpointsConfig.IntervalInSeconds) * time.Second
+}
+
+func closeTrieSyncCheckpointsStorer(checkpointsStorer storage.Persister) {
+	err := checkpointsStorer.Close()
+	if err != nil {
+		log.Warn("cannot close the trie sync checkpoints storer", "error", err)
+	}
+}
+
 func (e *epochStartBootstrap) createStorageServiceForImportDB(
 	shardCoordinator sharding.Coordinator,
 	pathManager storage.PathManagerHandler,
@@ -1183,6 +1237,12 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[dataRetriever.PeerAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	checkpointsStorer, err := e.createTrieSyncCheckpointsStorer(dataRetriever.PeerAccountsUnit)
+	if err != nil {
+		return err
+	}
+	defer closeTrieSyncCheckpointsStorer(checkpointsStorer)
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1198,6 +1258,8 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabledCommon.NewAppStatusHandler(),
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
+			CheckpointsStorer:                 checkpointsStorer,
+			TimeBetweenCheckpoints:            e.timeBetweenTrieSyncCheckpoints(),
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
@@ -352,3 +352,6 @@ var ErrUint32SubtractionOverflow = errors.New("uint32 subtraction overflowed")
 
 // ErrReceivedAuctionValidatorsBeforeStakingV4 signals that an auction node has been provided before enabling staking v4
 var ErrReceivedAuctionValidatorsBeforeStakingV4 = errors.New("auction node has been provided before enabling staking v4")
+
+// ErrPortableSnapshotMismatch signals that the portable state snapshot does not match the bootstrapped epoch start data
+var ErrPortableSnapshotMismatch = errors.New("portable state snapshot mismatch")
@@ -764,6 +764,7 @@ func createAccountsDB(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, _ := state.NewAccountsDB(args)
 	return adb
@@ -556,6 +556,9 @@ var ErrNilHistoryRepository = errors.New("history repository is nil")
 // ErrNilMissingTrieNodesNotifier signals that a nil missing trie nodes notifier was provided
 var ErrNilMissingTrieNodesNotifier = errors.New("nil missing trie nodes notifier")
 
+// ErrNilFlatState signals that a nil flat state was provided
+var ErrNilFlatState = errors.New("nil flat state")
+
 // ErrInvalidTrieNodeVersion signals that an invalid trie node version has been provided
 var ErrInvalidTrieNodeVersion = errors.New("invalid trie node version")
 
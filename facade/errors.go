@@ -46,3 +46,21 @@ var ErrTooManyAddressesInBulk = errors.New("too many addresses in the bulk reque
 
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrInvalidCancellationTransaction signals that a nil cancellation transaction has been provided
+var ErrInvalidCancellationTransaction = errors.New("invalid cancellation transaction, expected a zero value self-transfer without data")
+
+// ErrCancellationNotSelfTransfer signals that the cancellation transaction has a receiver different from its sender
+var ErrCancellationNotSelfTransfer = errors.New("invalid cancellation transaction, expected a self-transfer")
+
+// ErrCancellationWithValue signals that the cancellation transaction has a non-zero value
+var ErrCancellationWithValue = errors.New("invalid cancellation transaction, expected a zero value")
+
+// ErrCancellationWithData signals that the cancellation transaction has a data field
+var ErrCancellationWithData = errors.New("invalid cancellation transaction, expected no data")
+
+// ErrInvalidCancellationNonce signals that the cancellation transaction does not have the current nonce of the sender
+var ErrInvalidCancellationNonce = errors.New("invalid cancellation transaction nonce")
+
+// ErrCancellationTransactionNotSent signals that the cancellation transaction was not propagated
+var ErrCancellationTransactionNotSent = errors.New("cancellation transaction was not sent")
@@ -81,6 +81,21 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ string, _ []string, _ [][]byte) (bool, [][]byte, error) {
+	return false, nil, errNodeStarting
+}
+
+// GetTrieNode -
+func (inf *initialNodeFacade) GetTrieNode(_ string) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -171,6 +186,16 @@ func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transa
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionTrace returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionTrace(_ *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	return nil, errNodeStarting
+}
+
+// TraceTransaction returns nil and error
+func (inf *initialNodeFacade) TraceTransaction(_ string) (*txSimData.TransactionTrace, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -261,6 +286,11 @@ func (inf *initialNodeFacade) GetConnectedPeersRatingsOnMainNetwork() (string, e
 	return "", errNodeStarting
 }
 
+// GetIntegrityReport returns nil and error
+func (inf *initialNodeFacade) GetIntegrityReport() (*common.IntegrityReportAPI, error) {
+	return nil, errNodeStarting
+}
+
 // GetEpochStartDataAPI returns nil and error
 func (inf *initialNodeFacade) GetEpochStartDataAPI(_ uint32) (*common.EpochStartDataAPI, error) {
 	return nil, errNodeStarting
@@ -346,6 +376,21 @@ func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ api.AccountQueryOptio
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// GetAccountsStateDiff returns nil and error
+func (inf *initialNodeFacade) GetAccountsStateDiff(_ api.AccountQueryOptions, _ api.AccountQueryOptions, _ []string) (*common.AccountsStateDiffAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetEvents returns nil and error
+func (inf *initialNodeFacade) GetEvents(_ common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetBlockNonceByTimestamp returns 0 and error
+func (inf *initialNodeFacade) GetBlockNonceByTimestamp(_ uint64) (uint64, error) {
+	return 0, errNodeStarting
+}
+
 // GetGuardianData returns error
 func (inf *initialNodeFacade) GetGuardianData(_ string, _ api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error) {
 	return api.GuardianData{}, api.BlockInfo{}, errNodeStarting
@@ -406,6 +451,16 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// ValidateCancellationTransaction returns error
+func (inf *initialNodeFacade) ValidateCancellationTransaction(_ *transaction.Transaction) error {
+	return errNodeStarting
+}
+
+// CancelTransactionsPoolForSender returns a nil structure and error
+func (inf *initialNodeFacade) CancelTransactionsPoolForSender(_ *transaction.Transaction, _ []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -456,6 +511,11 @@ func (inf *initialNodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return inf.p2pPrometheusMetricsEnabled
 }
 
+// CreateSubscriber returns nil and error
+func (inf *initialNodeFacade) CreateSubscriber() (common.SubscriberHandler, error) {
+	return nil, errNodeStarting
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (inf *initialNodeFacade) IsInterfaceNil() bool {
 	return inf == nil
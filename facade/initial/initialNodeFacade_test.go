@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/facade"
 	"github.com/kalyan3104/k-chain-go/node/external"
 	"github.com/kalyan3104/k-chain-go/testscommon"
@@ -107,6 +108,18 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	trace, err := inf.SimulateTransactionTrace(nil)
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
+	trace, err = inf.TraceTransaction("")
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
+	subscriber, err := inf.CreateSubscriber()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
@@ -189,6 +202,15 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.False(t, b)
 	assert.Equal(t, errNodeStarting, err)
 
+	multiProof, err := inf.GetMultiProof("", nil)
+	assert.Nil(t, multiProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, provenValues, err := inf.VerifyMultiProof("", nil, nil)
+	assert.False(t, b)
+	assert.Nil(t, provenValues)
+	assert.Equal(t, errNodeStarting, err)
+
 	sa, _, err := inf.GetNFTTokenIDsRegisteredByAddress("", api.AccountQueryOptions{})
 	assert.Nil(t, sa)
 	assert.Equal(t, errNodeStarting, err)
@@ -208,6 +230,18 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, mss)
 	assert.Equal(t, errNodeStarting, err)
 
+	stateDiff, err := inf.GetAccountsStateDiff(api.AccountQueryOptions{}, api.AccountQueryOptions{}, nil)
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, errNodeStarting, err)
+
+	events, err := inf.GetEvents(common.EventsQueryOptions{})
+	assert.Nil(t, events)
+	assert.Equal(t, errNodeStarting, err)
+
+	blockNonce, err := inf.GetBlockNonceByTimestamp(0)
+	assert.Zero(t, blockNonce)
+	assert.Equal(t, errNodeStarting, err)
+
 	ds, err := inf.GetDelegatorsList()
 	assert.Nil(t, ds)
 	assert.Equal(t, errNodeStarting, err)
@@ -276,6 +310,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, "", ratings)
 	assert.Equal(t, errNodeStarting, err)
 
+	integrityReport, err := inf.GetIntegrityReport()
+	assert.Nil(t, integrityReport)
+	assert.Equal(t, errNodeStarting, err)
+
 	epochStartData, err := inf.GetEpochStartDataAPI(0)
 	assert.Nil(t, epochStartData)
 	assert.Equal(t, errNodeStarting, err)
@@ -333,6 +371,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, txPoolGaps)
 	assert.Equal(t, errNodeStarting, err)
 
+	cancellation, err := inf.CancelTransactionsPoolForSender(nil, nil)
+	assert.Nil(t, cancellation)
+	assert.Equal(t, errNodeStarting, err)
+
 	count := inf.GetManagedKeysCount()
 	assert.Zero(t, count)
 
@@ -9,6 +9,7 @@ import (
 	"github.com/kalyan3104/k-chain-core-go/data/alteredAccount"
 	"github.com/kalyan3104/k-chain-core-go/data/api"
 	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
+	"github.com/kalyan3104/k-chain-core-go/data/smartContractResult"
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
 	"github.com/kalyan3104/k-chain-core-go/data/validator"
 	"github.com/kalyan3104/k-chain-go/common"
@@ -41,6 +42,15 @@ type NodeHandler interface {
 	// GetKeyValuePairs returns the key-value pairs under a given address
 	GetKeyValuePairs(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
 
+	// GetAccountsStateDiff returns the differences between the accounts states of two blocks
+	GetAccountsStateDiff(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string, ctx context.Context) (*common.AccountsStateDiffAPIResponse, error)
+
+	// GetEvents returns the indexed events emitted by an address, matching the provided filters
+	GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+
+	// GetBlockNonceByTimestamp returns the nonce of the last block produced at or before the given timestamp
+	GetBlockNonceByTimestamp(timestamp uint64) (uint64, error)
+
 	// GetAllIssuedDCDTs returns all the issued dcdt tokens from dcdt system smart contract
 	GetAllIssuedDCDTs(tokenType string, ctx context.Context) ([]string, error)
 
@@ -72,6 +82,10 @@ type NodeHandler interface {
 	// SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
 
+	// ScheduleTransactionsPoolCancellationForSender schedules the removal from the node's own pool of the sender's
+	// transactions with higher nonces, once the cancellation transaction is committed
+	ScheduleTransactionsPoolCancellationForSender(cancellationTxHash []byte, sender []byte, nonce uint64) ([]common.RemovedPoolTransactionApiResponse, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
 	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
@@ -102,18 +116,23 @@ type NodeHandler interface {
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetIntegrityReport() (*common.IntegrityReportAPI, error)
 
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error)
+	GetTrieNode(hash string) ([]byte, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction, currentHeader coreData.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
+	ProcessSCR(scr *smartContractResult.SmartContractResult, currentHeader coreData.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
 	IsInterfaceNil() bool
 }
 
@@ -122,6 +141,8 @@ type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceTransaction(hash string) (*txSimData.TransactionTrace, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
@@ -154,6 +175,7 @@ type ApiResolver interface {
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
 	Close() error
+	CreateSubscriber() (common.SubscriberHandler, error)
 	IsInterfaceNil() bool
 }
 
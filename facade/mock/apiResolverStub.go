@@ -20,6 +20,8 @@ type ApiResolverStub struct {
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionTraceHandler             func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceTransactionHandler                     func(hash string) (*txSimData.TransactionTrace, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -50,6 +52,7 @@ type ApiResolverStub struct {
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	CreateSubscriberCalled                      func() (common.SubscriberHandler, error)
 }
 
 // GetTransaction -
@@ -132,6 +135,22 @@ func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transac
 	return nil, nil
 }
 
+// SimulateTransactionTrace -
+func (ars *ApiResolverStub) SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	if ars.SimulateTransactionTraceHandler != nil {
+		return ars.SimulateTransactionTraceHandler(tx)
+	}
+	return nil, nil
+}
+
+// TraceTransaction -
+func (ars *ApiResolverStub) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	if ars.TraceTransactionHandler != nil {
+		return ars.TraceTransactionHandler(hash)
+	}
+	return nil, nil
+}
+
 // GetTotalStakedValue -
 func (ars *ApiResolverStub) GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error) {
 	if ars.GetTotalStakedValueHandler != nil {
@@ -347,6 +366,15 @@ func (ars *ApiResolverStub) Close() error {
 	return nil
 }
 
+// CreateSubscriber -
+func (ars *ApiResolverStub) CreateSubscriber() (common.SubscriberHandler, error) {
+	if ars.CreateSubscriberCalled != nil {
+		return ars.CreateSubscriberCalled()
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
@@ -18,44 +18,52 @@ import (
 
 // NodeStub -
 type NodeStub struct {
-	ConnectToAddressesHandler                      func([]string) error
-	GetBalanceCalled                               func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
-	GenerateTransactionHandler                     func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
-	CreateTransactionHandler                       func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
-	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountCalled                               func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
-	GetAccountWithKeysCalled                       func(address string, options api.AccountQueryOptions, ctx context.Context) (api.AccountResponse, api.BlockInfo, error)
-	GetCodeCalled                                  func(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
-	GetCurrentPublicKeyHandler                     func() string
-	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
-	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
-	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
-	ValidatorStatisticsApiCalled                   func() (map[string]*validator.ValidatorStatistics, error)
-	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
-	IsSelfTriggerCalled                            func() bool
-	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
-	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetConnectedPeersRatingsOnMainNetworkCalled    func() (string, error)
-	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetCodeHashCalled                              func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
-	GetDCDTDataCalled                              func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*dcdt.DCDigitalToken, api.BlockInfo, error)
-	GetAllDCDTTokensCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*dcdt.DCDigitalToken, api.BlockInfo, error)
-	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
-	GetDCDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
-	GetDCDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
-	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
-	GetAllIssuedDCDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
-	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
-	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
-	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
-	GetTokenSupplyCalled                           func(token string) (*api.DCDTSupply, error)
-	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
-	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	ConnectToAddressesHandler                           func([]string) error
+	GetBalanceCalled                                    func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
+	GenerateTransactionHandler                          func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
+	CreateTransactionHandler                            func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransactionHandler                          func(tx *transaction.Transaction) error
+	ValidateTransactionForSimulationCalled              func(tx *transaction.Transaction, bypassSignature bool) error
+	SendBulkTransactionsHandler                         func(txs []*transaction.Transaction) (uint64, error)
+	ScheduleTransactionsPoolCancellationForSenderCalled func(cancellationTxHash []byte, sender []byte, nonce uint64) ([]common.RemovedPoolTransactionApiResponse, error)
+	GetAccountCalled                                    func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountWithKeysCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (api.AccountResponse, api.BlockInfo, error)
+	GetCodeCalled                                       func(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
+	GetCurrentPublicKeyHandler                          func() string
+	GenerateAndSendBulkTransactionsHandler              func(destination string, value *big.Int, nrTransactions uint64) error
+	GenerateAndSendBulkTransactionsOneByOneHandler      func(destination string, value *big.Int, nrTransactions uint64) error
+	GetHeartbeatsHandler                                func() []data.PubKeyHeartbeat
+	ValidatorStatisticsApiCalled                        func() (map[string]*validator.ValidatorStatistics, error)
+	DirectTriggerCalled                                 func(epoch uint32, withEarlyEndOfEpoch bool) error
+	IsSelfTriggerCalled                                 func() bool
+	GetQueryHandlerCalled                               func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                                func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetGuardianDataCalled                               func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
+	GetPeerInfoCalled                                   func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConnectedPeersRatingsOnMainNetworkCalled         func() (string, error)
+	GetIntegrityReportCalled                            func() (*common.IntegrityReportAPI, error)
+	GetEpochStartDataAPICalled                          func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetUsernameCalled                                   func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetCodeHashCalled                                   func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
+	GetDCDTDataCalled                                   func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*dcdt.DCDigitalToken, api.BlockInfo, error)
+	GetAllDCDTTokensCalled                              func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*dcdt.DCDigitalToken, api.BlockInfo, error)
+	GetNFTTokenIDsRegisteredByAddressCalled             func(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
+	GetDCDTsWithRoleCalled                              func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
+	GetDCDTsRolesCalled                                 func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
+	GetKeyValuePairsCalled                              func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
+	GetAccountsStateDiffCalled                          func(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string, ctx context.Context) (*common.AccountsStateDiffAPIResponse, error)
+	GetEventsCalled                                     func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+	GetBlockNonceByTimestampCalled                      func(timestamp uint64) (uint64, error)
+	GetAllIssuedDCDTsCalled                             func(tokenType string, ctx context.Context) ([]string, error)
+	GetProofCalled                                      func(rootHash string, key string) (*common.GetProofResponse, error)
+	GetProofDataTrieCalled                              func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetTrieNodeCalled                                   func(hash string) ([]byte, error)
+	VerifyProofCalled                                   func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                                 func(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                              func(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error)
+	GetTokenSupplyCalled                                func(token string) (*api.DCDTSupply, error)
+	IsDataTrieMigratedCalled                            func(address string, options api.AccountQueryOptions) (bool, error)
+	AuctionListApiCalled                                func() ([]*common.AuctionListValidatorAPIResponse, error)
 }
 
 // GetProof -
@@ -85,6 +93,33 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, keys)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return false, nil, nil
+}
+
+// GetTrieNode -
+func (ns *NodeStub) GetTrieNode(hash string) ([]byte, error) {
+	if ns.GetTrieNodeCalled != nil {
+		return ns.GetTrieNodeCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (ns *NodeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetUsernameCalled != nil {
@@ -112,6 +147,33 @@ func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOpt
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetAccountsStateDiff -
+func (ns *NodeStub) GetAccountsStateDiff(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string, ctx context.Context) (*common.AccountsStateDiffAPIResponse, error) {
+	if ns.GetAccountsStateDiffCalled != nil {
+		return ns.GetAccountsStateDiffCalled(fromOptions, toOptions, addresses, ctx)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (ns *NodeStub) GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	if ns.GetEventsCalled != nil {
+		return ns.GetEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
+// GetBlockNonceByTimestamp -
+func (ns *NodeStub) GetBlockNonceByTimestamp(timestamp uint64) (uint64, error) {
+	if ns.GetBlockNonceByTimestampCalled != nil {
+		return ns.GetBlockNonceByTimestampCalled(timestamp)
+	}
+
+	return 0, nil
+}
+
 // GetValueForKey -
 func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
@@ -181,6 +243,15 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 	return 0, nil
 }
 
+// ScheduleTransactionsPoolCancellationForSender -
+func (ns *NodeStub) ScheduleTransactionsPoolCancellationForSender(cancellationTxHash []byte, sender []byte, nonce uint64) ([]common.RemovedPoolTransactionApiResponse, error) {
+	if ns.ScheduleTransactionsPoolCancellationForSenderCalled != nil {
+		return ns.ScheduleTransactionsPoolCancellationForSenderCalled(cancellationTxHash, sender, nonce)
+	}
+
+	return make([]common.RemovedPoolTransactionApiResponse, 0), nil
+}
+
 // GetAccount -
 func (ns *NodeStub) GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	if ns.GetAccountCalled != nil {
@@ -280,6 +351,15 @@ func (ns *NodeStub) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return "", nil
 }
 
+// GetIntegrityReport -
+func (ns *NodeStub) GetIntegrityReport() (*common.IntegrityReportAPI, error) {
+	if ns.GetIntegrityReportCalled != nil {
+		return ns.GetIntegrityReportCalled()
+	}
+
+	return &common.IntegrityReportAPI{}, nil
+}
+
 // GetEpochStartDataAPI -
 func (ns *NodeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if ns.GetEpochStartDataAPICalled != nil {
@@ -229,6 +229,24 @@ func (nf *nodeFacade) GetKeyValuePairs(address string, options apiData.AccountQu
 	return nf.node.GetKeyValuePairs(address, options, ctx)
 }
 
+// GetAccountsStateDiff returns the differences between the accounts states of two blocks
+func (nf *nodeFacade) GetAccountsStateDiff(fromOptions apiData.AccountQueryOptions, toOptions apiData.AccountQueryOptions, addresses []string) (*common.AccountsStateDiffAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetAccountsStateDiff(fromOptions, toOptions, addresses, ctx)
+}
+
+// GetEvents returns the indexed events emitted by an address, matching the provided filters
+func (nf *nodeFacade) GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	return nf.node.GetEvents(options)
+}
+
+// GetBlockNonceByTimestamp returns the nonce of the last block produced at or before the given timestamp
+func (nf *nodeFacade) GetBlockNonceByTimestamp(timestamp uint64) (uint64, error) {
+	return nf.node.GetBlockNonceByTimestamp(timestamp)
+}
+
 // GetGuardianData returns the guardian data for the provided address
 func (nf *nodeFacade) GetGuardianData(address string, options apiData.AccountQueryOptions) (apiData.GuardianData, apiData.BlockInfo, error) {
 	return nf.node.GetGuardianData(address, options)
@@ -299,6 +317,16 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.apiResolver.SimulateTransactionExecution(tx)
 }
 
+// SimulateTransactionTrace will execute the transaction on the current state and will return its execution trace
+func (nf *nodeFacade) SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	return nf.apiResolver.SimulateTransactionTrace(tx)
+}
+
+// TraceTransaction will re-execute an already executed transaction and will return its execution trace
+func (nf *nodeFacade) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	return nf.apiResolver.TraceTransaction(hash)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.apiResolver.GetTransaction(hash, withResults)
@@ -329,6 +357,78 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender, accountResponse.Nonce)
 }
 
+// ValidateCancellationTransaction checks that the provided transaction is a zero value self-transfer, without data,
+// having the current nonce of the sender
+func (nf *nodeFacade) ValidateCancellationTransaction(tx *transaction.Transaction) error {
+	err := checkCancellationTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	sender, err := nf.node.EncodeAddressPubkey(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	accountResponse, _, err := nf.node.GetAccount(sender, apiData.AccountQueryOptions{})
+	if err != nil {
+		return err
+	}
+	if tx.Nonce != accountResponse.Nonce {
+		return fmt.Errorf("%w, expected %d, got %d", ErrInvalidCancellationNonce, accountResponse.Nonce, tx.Nonce)
+	}
+
+	return nil
+}
+
+func checkCancellationTransaction(tx *transaction.Transaction) error {
+	if check.IfNil(tx) {
+		return ErrInvalidCancellationTransaction
+	}
+	if !bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return ErrCancellationNotSelfTransfer
+	}
+	if tx.Value != nil && tx.Value.Sign() != 0 {
+		return ErrCancellationWithValue
+	}
+	if len(tx.Data) > 0 {
+		return ErrCancellationWithData
+	}
+
+	return nil
+}
+
+// CancelTransactionsPoolForSender will propagate the provided cancellation transaction, a zero value self-transfer at the
+// stuck nonce of the sender, already checked by ValidateCancellationTransaction. All the sender's transactions having
+// a higher nonce are dropped from this node's pool once the cancellation transaction is included in a committed block,
+// so that they are not dropped for nothing if it never gets executed. The other nodes keep them
+func (nf *nodeFacade) CancelTransactionsPoolForSender(tx *transaction.Transaction, txHash []byte) (*common.TransactionsPoolCancellationApiResponse, error) {
+	sender, err := nf.node.EncodeAddressPubkey(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	numSentTxs, err := nf.node.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, err
+	}
+	if numSentTxs == 0 {
+		return nil, ErrCancellationTransactionNotSent
+	}
+
+	txsToRemove, err := nf.node.ScheduleTransactionsPoolCancellationForSender(txHash, tx.SndAddr, tx.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.TransactionsPoolCancellationApiResponse{
+		Sender:               sender,
+		CancellationTxHash:   hex.EncodeToString(txHash),
+		Nonce:                tx.Nonce,
+		TransactionsToRemove: txsToRemove,
+	}, nil
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -489,6 +589,11 @@ func (nf *nodeFacade) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return nf.node.GetConnectedPeersRatingsOnMainNetwork()
 }
 
+// GetIntegrityReport returns the report of the last finished storage integrity scrubber pass
+func (nf *nodeFacade) GetIntegrityReport() (*common.IntegrityReportAPI, error) {
+	return nf.node.GetIntegrityReport()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	if !nf.wsAntifloodConfig.WebServerAntifloodEnabled {
@@ -603,6 +708,21 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given keys and root hash
+func (nf *nodeFacade) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProof(rootHash, keys)
+}
+
+// VerifyMultiProof verifies the given Merkle multi-proof
+func (nf *nodeFacade) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error) {
+	return nf.node.VerifyMultiProof(rootHash, keys, proof)
+}
+
+// GetTrieNode returns the encoded accounts trie node for the given hash
+func (nf *nodeFacade) GetTrieNode(hash string) ([]byte, error) {
+	return nf.node.GetTrieNode(hash)
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (nf *nodeFacade) IsDataTrieMigrated(address string, options apiData.AccountQueryOptions) (bool, error) {
 	return nf.node.IsDataTrieMigrated(address, options)
@@ -772,6 +892,11 @@ func (nf *nodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return nf.config.P2PPrometheusMetricsEnabled
 }
 
+// CreateSubscriber registers a new live data subscriber
+func (nf *nodeFacade) CreateSubscriber() (common.SubscriberHandler, error) {
+	return nf.apiResolver.CreateSubscriber()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
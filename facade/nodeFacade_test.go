@@ -844,6 +844,70 @@ func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	require.Equal(t, expectedPairs, res)
 }
 
+func TestNodeFacade_GetAccountsStateDiff(t *testing.T) {
+	t.Parallel()
+
+	providedFromOptions := api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 1, HasValue: true}}
+	providedToOptions := api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 2, HasValue: true}}
+	providedAddresses := []string{"addr"}
+	expectedStateDiff := &common.AccountsStateDiffAPIResponse{}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetAccountsStateDiffCalled: func(fromOptions api.AccountQueryOptions, toOptions api.AccountQueryOptions, addresses []string, ctx context.Context) (*common.AccountsStateDiffAPIResponse, error) {
+			require.Equal(t, providedFromOptions, fromOptions)
+			require.Equal(t, providedToOptions, toOptions)
+			require.Equal(t, providedAddresses, addresses)
+			require.NotNil(t, ctx)
+			return expectedStateDiff, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetAccountsStateDiff(providedFromOptions, providedToOptions, providedAddresses)
+	require.NoError(t, err)
+	require.True(t, expectedStateDiff == res) // pointer testing
+}
+
+func TestNodeFacade_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	providedOptions := common.EventsQueryOptions{Address: "addr", Identifier: "swap"}
+	expectedResponse := &common.EventsQueryAPIResponse{}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+			require.Equal(t, providedOptions, options)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetEvents(providedOptions)
+	require.NoError(t, err)
+	require.True(t, expectedResponse == res) // pointer testing
+}
+
+func TestNodeFacade_GetBlockNonceByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	providedTimestamp := uint64(1700000000)
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetBlockNonceByTimestampCalled: func(timestamp uint64) (uint64, error) {
+			require.Equal(t, providedTimestamp, timestamp)
+			return 37, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	nonce, err := nf.GetBlockNonceByTimestamp(providedTimestamp)
+	require.NoError(t, err)
+	require.Equal(t, uint64(37), nonce)
+}
+
 func TestNodeFacade_GetGuardianData(t *testing.T) {
 	t.Parallel()
 	arg := createMockArguments()
@@ -1190,6 +1254,45 @@ func TestNodeFacade_VerifyProof(t *testing.T) {
 	require.True(t, response)
 }
 
+func TestNodeFacade_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.GetMultiProofResponse{
+		Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+		Values:   [][]byte{[]byte("value"), nil},
+		RootHash: "rootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofCalled: func(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetMultiProof("hash", []string{"key0", "key1"})
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	expectedValues := [][]byte{[]byte("value"), nil}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyMultiProofCalled: func(_ string, _ []string, _ [][]byte) (bool, [][]byte, error) {
+			return true, expectedValues, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	ok, values, err := nf.VerifyMultiProof("hash", []string{"key0", "key1"}, [][]byte{[]byte("proof")})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, expectedValues, values)
+}
+
 func TestNodeFacade_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
@@ -2000,6 +2103,191 @@ func TestNodeFacade_GetLastPoolNonceForSender(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_ValidateCancellationTransaction(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender")
+	createCancellationTx := func() *transaction.Transaction {
+		return &transaction.Transaction{
+			Nonce:   7,
+			Value:   big.NewInt(0),
+			SndAddr: sender,
+			RcvAddr: sender,
+		}
+	}
+	createNodeStub := func() *mock.NodeStub {
+		return &mock.NodeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{Nonce: 7}, api.BlockInfo{}, nil
+			},
+		}
+	}
+
+	t.Run("not a cancellation transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.Node = createNodeStub()
+		nf, _ := NewNodeFacade(arg)
+
+		tx := createCancellationTx()
+		tx.RcvAddr = []byte("receiver")
+		err := nf.ValidateCancellationTransaction(tx)
+		require.Equal(t, ErrCancellationNotSelfTransfer, err)
+
+		tx = createCancellationTx()
+		tx.Value = big.NewInt(1)
+		err = nf.ValidateCancellationTransaction(tx)
+		require.Equal(t, ErrCancellationWithValue, err)
+
+		tx = createCancellationTx()
+		tx.Data = []byte("data")
+		err = nf.ValidateCancellationTransaction(tx)
+		require.Equal(t, ErrCancellationWithData, err)
+
+		err = nf.ValidateCancellationTransaction(nil)
+		require.Equal(t, ErrInvalidCancellationTransaction, err)
+	})
+	t.Run("GetAccount error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		nodeStub := createNodeStub()
+		nodeStub.GetAccountCalled = func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+		}
+		arg.Node = nodeStub
+		nf, _ := NewNodeFacade(arg)
+
+		err := nf.ValidateCancellationTransaction(createCancellationTx())
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("nonce different from the account nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.Node = createNodeStub()
+		nf, _ := NewNodeFacade(arg)
+
+		tx := createCancellationTx()
+		tx.Nonce = 6
+		err := nf.ValidateCancellationTransaction(tx)
+		require.True(t, errors.Is(err, ErrInvalidCancellationNonce))
+
+		tx.Nonce = 8
+		err = nf.ValidateCancellationTransaction(tx)
+		require.True(t, errors.Is(err, ErrInvalidCancellationNonce))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.Node = createNodeStub()
+		nf, _ := NewNodeFacade(arg)
+
+		err := nf.ValidateCancellationTransaction(createCancellationTx())
+		require.NoError(t, err)
+	})
+}
+
+func TestNodeFacade_CancelTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender")
+	cancellationTx := &transaction.Transaction{
+		Nonce:   7,
+		Value:   big.NewInt(0),
+		SndAddr: sender,
+		RcvAddr: sender,
+	}
+	createNodeStub := func() *mock.NodeStub {
+		return &mock.NodeStub{
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				return uint64(len(txs)), nil
+			},
+			ScheduleTransactionsPoolCancellationForSenderCalled: func(cancellationTxHash []byte, sender []byte, nonce uint64) ([]common.RemovedPoolTransactionApiResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+	}
+
+	t.Run("SendBulkTransactions error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		nodeStub := createNodeStub()
+		nodeStub.SendBulkTransactionsHandler = func(txs []*transaction.Transaction) (uint64, error) {
+			return 0, expectedErr
+		}
+		arg.Node = nodeStub
+		nf, _ := NewNodeFacade(arg)
+
+		res, err := nf.CancelTransactionsPoolForSender(cancellationTx, []byte("hash"))
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("transaction not sent should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		nodeStub := createNodeStub()
+		nodeStub.SendBulkTransactionsHandler = func(txs []*transaction.Transaction) (uint64, error) {
+			return 0, nil
+		}
+		arg.Node = nodeStub
+		nf, _ := NewNodeFacade(arg)
+
+		res, err := nf.CancelTransactionsPoolForSender(cancellationTx, []byte("hash"))
+		require.Nil(t, res)
+		require.Equal(t, ErrCancellationTransactionNotSent, err)
+	})
+	t.Run("ScheduleTransactionsPoolCancellationForSender error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		nodeStub := createNodeStub()
+		nodeStub.ScheduleTransactionsPoolCancellationForSenderCalled = func(cancellationTxHash []byte, sender []byte, nonce uint64) ([]common.RemovedPoolTransactionApiResponse, error) {
+			return nil, expectedErr
+		}
+		arg.Node = nodeStub
+		nf, _ := NewNodeFacade(arg)
+
+		res, err := nf.CancelTransactionsPoolForSender(cancellationTx, []byte("hash"))
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txsToRemove := []common.RemovedPoolTransactionApiResponse{
+			{
+				Hash:  "aabb",
+				Nonce: 8,
+			},
+		}
+		arg := createMockArguments()
+		nodeStub := createNodeStub()
+		nodeStub.ScheduleTransactionsPoolCancellationForSenderCalled = func(cancellationTxHash []byte, providedSender []byte, nonce uint64) ([]common.RemovedPoolTransactionApiResponse, error) {
+			require.Equal(t, []byte("hash"), cancellationTxHash)
+			require.Equal(t, sender, providedSender)
+			require.Equal(t, uint64(7), nonce)
+			return txsToRemove, nil
+		}
+		arg.Node = nodeStub
+		nf, _ := NewNodeFacade(arg)
+
+		res, err := nf.CancelTransactionsPoolForSender(cancellationTx, []byte("hash"))
+		require.NoError(t, err)
+		require.Equal(t, &common.TransactionsPoolCancellationApiResponse{
+			Sender:               hex.EncodeToString(sender),
+			CancellationTxHash:   hex.EncodeToString([]byte("hash")),
+			Nonce:                7,
+			TransactionsToRemove: txsToRemove,
+		}, res)
+	})
+}
+
 func TestNodeFacade_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	t.Parallel()
 
@@ -2209,6 +2497,67 @@ func TestNodeFacade_SimulateTransactionExecution(t *testing.T) {
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_SimulateTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	providedTrace := &txSimData.TransactionTrace{
+		Status: transaction.TxStatusSuccess,
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		SimulateTransactionTraceHandler: func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+			return providedTrace, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	trace, err := nf.SimulateTransactionTrace(&transaction.Transaction{})
+	require.NoError(t, err)
+	require.Equal(t, providedTrace, trace)
+}
+
+func TestNodeFacade_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	providedHash := "aabb"
+	providedTrace := &txSimData.TransactionTrace{
+		Hash:   providedHash,
+		Status: transaction.TxStatusSuccess,
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		TraceTransactionHandler: func(hash string) (*txSimData.TransactionTrace, error) {
+			require.Equal(t, providedHash, hash)
+			return providedTrace, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	trace, err := nf.TraceTransaction(providedHash)
+	require.NoError(t, err)
+	require.Equal(t, providedTrace, trace)
+}
+
+func TestNodeFacade_CreateSubscriber(t *testing.T) {
+	t.Parallel()
+
+	providedSubscriber := &testscommon.SubscriberStub{}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		CreateSubscriberCalled: func() (common.SubscriberHandler, error) {
+			return providedSubscriber, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	subscriber, err := nf.CreateSubscriber()
+	require.NoError(t, err)
+	require.True(t, subscriber == providedSubscriber) // pointer testing
+}
+
 func TestNodeFacade_ComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -2268,6 +2617,26 @@ func TestNodeFacade_GetConnectedPeersRatingsOnMainNetwork(t *testing.T) {
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_GetIntegrityReport(t *testing.T) {
+	t.Parallel()
+
+	providedReport := &common.IntegrityReportAPI{
+		Enabled:           true,
+		NumMissingEntries: 2,
+	}
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetIntegrityReportCalled: func() (*common.IntegrityReportAPI, error) {
+			return providedReport, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	report, err := nf.GetIntegrityReport()
+	require.NoError(t, err)
+	require.Equal(t, providedReport, report)
+}
+
 func TestNodeFacade_GetBlockByHash(t *testing.T) {
 	t.Parallel()
 
@@ -24,6 +24,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/node/external/transactionAPI"
 	"github.com/kalyan3104/k-chain-go/node/trieIterators"
 	trieIteratorsFactory "github.com/kalyan3104/k-chain-go/node/trieIterators/factory"
+	outportDriverFactory "github.com/kalyan3104/k-chain-go/outport/factory"
 	"github.com/kalyan3104/k-chain-go/outport/process/alteredaccounts"
 	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/process/coordinator"
@@ -39,6 +40,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/state/blockInfoProviders"
 	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	factoryState "github.com/kalyan3104/k-chain-go/state/factory"
+	"github.com/kalyan3104/k-chain-go/state/flatState"
 	"github.com/kalyan3104/k-chain-go/state/storagePruningManager"
 	"github.com/kalyan3104/k-chain-go/state/storagePruningManager/evictionWaitingList"
 	"github.com/kalyan3104/k-chain-go/state/syncer"
@@ -260,6 +262,16 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	subscriptionsHandler, err := outportDriverFactory.CreateSubscriptionsHandler(outportDriverFactory.ArgsSubscriptionsHandlerFactory{
+		Config:                 args.Configs.GeneralConfig.WebSocketSubscriptions,
+		Marshaller:             args.CoreComponents.InternalMarshalizer(),
+		AddressPubkeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		OutportHandler:         args.StatusComponents.OutportHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
@@ -278,6 +290,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		PublicKey:                args.CryptoComponents.PublicKeyString(),
 		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
 		StorageManagers:          storageManagers,
+		SubscriptionsHandler:     subscriptionsHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -590,6 +603,12 @@ func createNewAccountsAdapterApi(args scQueryElementArgs, chainHandler data.Chai
 		return nil, nil, err
 	}
 
+	// the SC query services read the flat state maintained by the processing accounts adapter
+	readOnlyFlatState, err := flatState.NewReadOnlyFlatState(args.stateComponents.FlatState())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	argsAPIAccountsDB := state.ArgsAccountsDB{
 		Trie:                  merkleTrie,
 		Hasher:                args.coreComponents.Hasher(),
@@ -598,6 +617,7 @@ func createNewAccountsAdapterApi(args scQueryElementArgs, chainHandler data.Chai
 		StoragePruningManager: storagePruning,
 		AddressConverter:      args.coreComponents.AddressPubKeyConverter(),
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		FlatState:             readOnlyFlatState,
 	}
 
 	provider, err := blockInfoProviders.NewCurrentBlockInfo(chainHandler)
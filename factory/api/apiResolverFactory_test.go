@@ -19,6 +19,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/process/sync/disabled"
 	"github.com/kalyan3104/k-chain-go/state"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	componentsMock "github.com/kalyan3104/k-chain-go/testscommon/components"
 	"github.com/kalyan3104/k-chain-go/testscommon/dataRetriever"
@@ -343,6 +344,9 @@ func createMockSCQueryElementArgs() api.SCQueryElementArgs {
 			PeerAccountsCalled: func() state.AccountsAdapter {
 				return &stateMocks.AccountsStub{}
 			},
+			FlatStateCalled: func() state.FlatStateHandler {
+				return disabledState.NewDisabledFlatState()
+			},
 		},
 		StatusCoreComponents: &factory.StatusCoreComponentsStub{
 			AppStatusHandlerCalled: func() core.AppStatusHandler {
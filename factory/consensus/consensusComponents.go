@@ -26,6 +26,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/process/sync/storageBootstrap"
 	"github.com/kalyan3104/k-chain-go/sharding"
 	"github.com/kalyan3104/k-chain-go/state/syncer"
+	storageDisabled "github.com/kalyan3104/k-chain-go/storage/disabled"
 	"github.com/kalyan3104/k-chain-go/trie/statistics"
 	"github.com/kalyan3104/k-chain-go/update"
 	logger "github.com/kalyan3104/k-chain-logger-go"
@@ -512,6 +513,7 @@ func (ccf *consensusComponentsFactory) createArgsBaseAccountsSyncer(trieStorageM
 		UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 		AppStatusHandler:                  disabled.NewAppStatusHandler(),
 		EnableEpochsHandler:               ccf.coreComponents.EnableEpochsHandler(),
+		CheckpointsStorer:                 storageDisabled.NewPersister(),
 	}
 }
 
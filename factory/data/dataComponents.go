@@ -104,6 +104,7 @@ func (dcf *dataComponentsFactory) Create() (*dataComponents, error) {
 		ShardCoordinator: dcf.shardCoordinator,
 		Marshalizer:      dcf.core.InternalMarshalizer(),
 		PathManager:      dcf.core.PathHandler(),
+		AppStatusHandler: dcf.statusCore.AppStatusHandler(),
 	}
 	datapool, err = dataRetrieverFactory.NewDataPoolFromConfig(dataPoolArgs)
 	if err != nil {
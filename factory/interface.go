@@ -264,6 +264,7 @@ type NetworkComponentsHandler interface {
 type TransactionEvaluator interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	TraceTransactionExecution(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error)
 	IsInterfaceNil() bool
 }
 
@@ -335,6 +336,7 @@ type StateComponentsHolder interface {
 	TriesContainer() common.TriesHolder
 	TrieStorageManagers() map[string]common.StorageManager
 	MissingTrieNodesNotifier() common.MissingTrieNodesNotifier
+	FlatState() state.FlatStateHandler
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -14,6 +14,7 @@ type StateComponentsHolderStub struct {
 	TriesContainerCalled           func() common.TriesHolder
 	TrieStorageManagersCalled      func() map[string]common.StorageManager
 	MissingTrieNodesNotifierCalled func() common.MissingTrieNodesNotifier
+	FlatStateCalled                func() state.FlatStateHandler
 }
 
 // PeerAccounts -
@@ -79,6 +80,15 @@ func (s *StateComponentsHolderStub) MissingTrieNodesNotifier() common.MissingTri
 	return nil
 }
 
+// FlatState -
+func (s *StateComponentsHolderStub) FlatState() state.FlatStateHandler {
+	if s.FlatStateCalled != nil {
+		return s.FlatStateCalled()
+	}
+
+	return nil
+}
+
 // Close -
 func (s *StateComponentsHolderStub) Close() error {
 	return nil
@@ -323,6 +323,11 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		return nil, err
 	}
 
+	txSelectionPolicy, err := preprocess.NewTxSelectionPolicy(pcf.config.TxSelectionPolicy, pcf.coreData.EconomicsData())
+	if err != nil {
+		return nil, err
+	}
+
 	preProcFactory, err := shard.NewPreProcessorsContainerFactory(
 		pcf.bootstrapComponents.ShardCoordinator(),
 		pcf.data.StorageService(),
@@ -346,6 +351,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		scheduledTxsExecutionHandler,
 		processedMiniBlocksTracker,
 		pcf.txExecutionOrderHandler,
+		txSelectionPolicy,
 	)
 	if err != nil {
 		return nil, err
@@ -633,6 +639,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	txSelectionPolicy, err := preprocess.NewTxSelectionPolicy(pcf.config.TxSelectionPolicy, pcf.coreData.EconomicsData())
+	if err != nil {
+		return nil, err
+	}
+
 	preProcFactory, err := metachain.NewPreProcessorsContainerFactory(
 		pcf.bootstrapComponents.ShardCoordinator(),
 		pcf.data.StorageService(),
@@ -654,6 +665,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		scheduledTxsExecutionHandler,
 		processedMiniBlocksTracker,
 		pcf.txExecutionOrderHandler,
+		txSelectionPolicy,
 	)
 	if err != nil {
 		return nil, err
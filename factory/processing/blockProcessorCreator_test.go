@@ -211,6 +211,7 @@ func createAccountAdapter(
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, err := state.NewAccountsDB(args)
 	if err != nil {
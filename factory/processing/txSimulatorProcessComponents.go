@@ -4,6 +4,7 @@ import (
 	"github.com/kalyan3104/k-chain-core-go/core"
 	dataBlock "github.com/kalyan3104/k-chain-core-go/data/block"
 	"github.com/kalyan3104/k-chain-go/common/disabled"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	bootstrapDisabled "github.com/kalyan3104/k-chain-go/epochStart/bootstrap/disabled"
 	"github.com/kalyan3104/k-chain-go/factory"
 	"github.com/kalyan3104/k-chain-go/genesis"
@@ -18,6 +19,9 @@ import (
 	"github.com/kalyan3104/k-chain-go/process/transactionEvaluator"
 	"github.com/kalyan3104/k-chain-go/process/transactionLog"
 	"github.com/kalyan3104/k-chain-go/state"
+	stateDisabled "github.com/kalyan3104/k-chain-go/state/disabled"
+	factoryState "github.com/kalyan3104/k-chain-go/state/factory"
+	disabledPruning "github.com/kalyan3104/k-chain-go/state/storagePruningManager/disabled"
 	"github.com/kalyan3104/k-chain-go/state/syncer"
 	"github.com/kalyan3104/k-chain-go/storage"
 	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
@@ -72,19 +76,56 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		return nil, nil, err
 	}
 
+	historicalAccounts, accountFactory, err := pcf.createHistoricalAccountsAdapterForSimulation()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
-		FeeHandler:          pcf.coreData.EconomicsData(),
-		TxSimulator:         txSimulator,
-		Accounts:            simulationAccountsDB,
-		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
-		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
-		BlockChain:          pcf.data.Blockchain(),
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             pcf.coreData.EconomicsData(),
+		TxSimulator:            txSimulator,
+		Accounts:               simulationAccountsDB,
+		HistoricalAccounts:     historicalAccounts,
+		AccountFactory:         accountFactory,
+		ShardCoordinator:       pcf.bootstrapComponents.ShardCoordinator(),
+		EnableEpochsHandler:    pcf.coreData.EnableEpochsHandler(),
+		BlockChain:             pcf.data.Blockchain(),
+		AddressPubKeyConverter: pcf.coreData.AddressPubKeyConverter(),
 	})
 
 	return apiTransactionEvaluator, vmContainerFactory, err
 }
 
+// createHistoricalAccountsAdapterForSimulation creates a dedicated accounts adapter with history, read on the
+// historical states when the executed transactions are traced, together with the factory of the missing accounts
+func (pcf *processComponentsFactory) createHistoricalAccountsAdapterForSimulation() (state.AccountsAdapterAPI, state.AccountFactory, error) {
+	accountFactory, err := factoryState.NewAccountCreator(factoryState.ArgsAccountCreator{
+		Hasher:              pcf.coreData.Hasher(),
+		Marshaller:          pcf.coreData.InternalMarshalizer(),
+		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	historicalAccounts, err := factoryState.CreateAccountsAdapterAPIOnHistorical(state.ArgsAccountsDB{
+		Trie:                  pcf.state.TriesContainer().Get([]byte(dataRetriever.UserAccountsUnit.String())),
+		Hasher:                pcf.coreData.Hasher(),
+		Marshaller:            pcf.coreData.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: disabledPruning.NewDisabledStoragePruningManager(),
+		AddressConverter:      pcf.coreData.AddressPubKeyConverter(),
+		SnapshotsManager:      stateDisabled.NewDisabledSnapshotsManager(),
+		FlatState:             stateDisabled.NewDisabledFlatState(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return historicalAccounts, accountFactory, nil
+}
+
 func (pcf *processComponentsFactory) createArgsTxSimulatorProcessor(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
@@ -222,6 +263,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	}
 
 	args.TransactionProcessor = txProcessor
+	args.SCRProcessor = scProcessor
 	args.IntermediateProcContainer = intermediateProcessorsContainer
 
 	return args, vmContainerFactory, txTypeHandler, nil
@@ -404,6 +446,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 	}
 
 	args.TransactionProcessor = txProcessor
+	args.SCRProcessor = scProcessor
 	args.IntermediateProcContainer = intermediateProcessorsContainer
 
 	return args, vmContainerFactory, txTypeHandler, nil
@@ -13,8 +13,10 @@ import (
 	"github.com/kalyan3104/k-chain-go/state"
 	"github.com/kalyan3104/k-chain-go/state/disabled"
 	factoryState "github.com/kalyan3104/k-chain-go/state/factory"
+	"github.com/kalyan3104/k-chain-go/state/flatState"
 	"github.com/kalyan3104/k-chain-go/state/iteratorChannelsProvider"
 	"github.com/kalyan3104/k-chain-go/state/lastSnapshotMarker"
+	"github.com/kalyan3104/k-chain-go/state/portableSnapshot"
 	"github.com/kalyan3104/k-chain-go/state/stateMetrics"
 	"github.com/kalyan3104/k-chain-go/state/storagePruningManager"
 	"github.com/kalyan3104/k-chain-go/state/storagePruningManager/evictionWaitingList"
@@ -51,6 +53,7 @@ type stateComponents struct {
 	accountsAdapter          state.AccountsAdapter
 	accountsAdapterAPI       state.AccountsAdapter
 	accountsRepository       state.AccountsRepository
+	flatState                state.FlatStateHandler
 	triesContainer           common.TriesHolder
 	trieStorageManagers      map[string]common.StorageManager
 	missingTrieNodesNotifier common.MissingTrieNodesNotifier
@@ -91,7 +94,12 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		return nil, err
 	}
 
-	accountsAdapter, accountsAdapterAPI, accountsRepository, err := scf.createAccountsAdapters(triesContainer)
+	userFlatState, err := scf.createFlatState()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsAdapter, accountsAdapterAPI, accountsRepository, err := scf.createAccountsAdapters(triesContainer, userFlatState)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +114,7 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		accountsAdapter:          accountsAdapter,
 		accountsAdapterAPI:       accountsAdapterAPI,
 		accountsRepository:       accountsRepository,
+		flatState:                userFlatState,
 		triesContainer:           triesContainer,
 		trieStorageManagers:      trieStorageManagers,
 		missingTrieNodesNotifier: syncer.NewMissingTrieNodesNotifier(),
@@ -116,6 +125,7 @@ func (scf *stateComponentsFactory) createSnapshotManager(
 	accountFactory state.AccountFactory,
 	stateMetrics state.StateMetrics,
 	iteratorChannelsProvider state.IteratorChannelsProvider,
+	portableSnapshotExporter state.PortableSnapshotExporter,
 ) (state.SnapshotsManager, error) {
 	if !scf.config.StateTriesConfig.SnapshotsEnabled {
 		return disabled.NewDisabledSnapshotsManager(), nil
@@ -132,11 +142,52 @@ func (scf *stateComponentsFactory) createSnapshotManager(
 		AccountFactory:           accountFactory,
 		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
 		StateStatsHandler:        scf.statusCore.StateStatsHandler(),
+		PortableSnapshotExporter: portableSnapshotExporter,
 	}
 	return state.NewSnapshotsManager(argsSnapshotsManager)
 }
 
-func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.TriesHolder) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
+func (scf *stateComponentsFactory) createPortableSnapshotExporter(accountFactory state.AccountFactory) (state.PortableSnapshotExporter, error) {
+	exporterConfig := scf.config.PortableStateSnapshot
+	if !exporterConfig.ExportEnabled {
+		return disabled.NewDisabledPortableSnapshotExporter(), nil
+	}
+
+	metaBlockStorer, err := scf.storageService.GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	args := portableSnapshot.ArgsStateSnapshotExporter{
+		ExportDirectory:      exporterConfig.ExportDirectory,
+		MaxChunkSizeInBytes:  exporterConfig.MaxChunkSizeInBytes,
+		MaxTrieLevelInMemory: scf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
+		MetaBlockStorer:      metaBlockStorer,
+		Marshaller:           scf.core.InternalMarshalizer(),
+		Hasher:               scf.core.Hasher(),
+		AccountFactory:       accountFactory,
+		EnableEpochsHandler:  scf.core.EnableEpochsHandler(),
+	}
+	return portableSnapshot.NewStateSnapshotExporter(args)
+}
+
+func (scf *stateComponentsFactory) createFlatState() (state.FlatStateHandler, error) {
+	triesConfig := scf.config.StateTriesConfig
+	if !triesConfig.FlatStateEnabled {
+		return disabled.NewDisabledFlatState(), nil
+	}
+
+	args := flatState.ArgsFlatState{
+		AccountsCacheCapacity:        triesConfig.FlatStateAccountsCacheCapacity,
+		DataTriesValuesCacheCapacity: triesConfig.FlatStateDataTriesValuesCacheCapacity,
+	}
+	return flatState.NewFlatState(args)
+}
+
+func (scf *stateComponentsFactory) createAccountsAdapters(
+	triesContainer common.TriesHolder,
+	userFlatState state.FlatStateHandler,
+) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
 	argsAccCreator := factoryState.ArgsAccountCreator{
 		Hasher:              scf.core.Hasher(),
 		Marshaller:          scf.core.InternalMarshalizer(),
@@ -163,7 +214,17 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		return nil, nil, nil, err
 	}
 
-	snapshotsManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewUserStateIteratorChannelsProvider())
+	portableSnapshotExporter, err := scf.createPortableSnapshotExporter(accountFactory)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	snapshotsManager, err := scf.createSnapshotManager(
+		accountFactory,
+		sm,
+		iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		portableSnapshotExporter,
+	)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -176,12 +237,20 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		StoragePruningManager: storagePruning,
 		AddressConverter:      scf.core.AddressPubKeyConverter(),
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             userFlatState,
+		NumCommitWorkers:      scf.config.StateTriesConfig.NumStateTrieCommitWorkers,
 	}
 	accountsAdapter, err := state.NewAccountsDB(argsProcessingAccountsDB)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("%w: %s", errors.ErrAccountsAdapterCreation, err.Error())
 	}
 
+	// the API accounts adapters only read the flat state, which follows the processing accounts adapter
+	apiFlatState, err := flatState.NewReadOnlyFlatState(userFlatState)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	argsAPIAccountsDB := state.ArgsAccountsDB{
 		Trie:                  merkleTrie,
 		Hasher:                scf.core.Hasher(),
@@ -190,6 +259,7 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		StoragePruningManager: storagePruning,
 		AddressConverter:      scf.core.AddressPubKeyConverter(),
 		SnapshotsManager:      disabled.NewDisabledSnapshotsManager(),
+		FlatState:             apiFlatState,
 	}
 
 	accountsAdapterApiOnFinal, err := factoryState.CreateAccountsAdapterAPIOnFinal(argsAPIAccountsDB, scf.chainHandler)
@@ -202,6 +272,8 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		return nil, nil, nil, fmt.Errorf("accounts adapter API on current: %w: %s", errors.ErrAccountsAdapterCreation, err.Error())
 	}
 
+	// the historical queries are always served from the tries
+	argsAPIAccountsDB.FlatState = disabled.NewDisabledFlatState()
 	accountsAdapterApiOnHistorical, err := factoryState.CreateAccountsAdapterAPIOnHistorical(argsAPIAccountsDB)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("accounts adapter API on historical: %w: %s", errors.ErrAccountsAdapterCreation, err.Error())
@@ -239,7 +311,12 @@ func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.Tries
 		return nil, err
 	}
 
-	snapshotManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider())
+	snapshotManager, err := scf.createSnapshotManager(
+		accountFactory,
+		sm,
+		iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider(),
+		disabled.NewDisabledPortableSnapshotExporter(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +329,7 @@ func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.Tries
 		StoragePruningManager: storagePruning,
 		AddressConverter:      scf.core.AddressPubKeyConverter(),
 		SnapshotsManager:      snapshotManager,
+		FlatState:             disabled.NewDisabledFlatState(),
 	}
 	peerAdapter, err := state.NewPeerAccountsDB(argsProcessingPeerAccountsDB)
 	if err != nil {
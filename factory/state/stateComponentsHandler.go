@@ -93,6 +93,9 @@ func (msc *managedStateComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.missingTrieNodesNotifier) {
 		return errors.ErrNilMissingTrieNodesNotifier
 	}
+	if check.IfNil(msc.flatState) {
+		return errors.ErrNilFlatState
+	}
 
 	return nil
 }
@@ -214,6 +217,18 @@ func (msc *managedStateComponents) MissingTrieNodesNotifier() common.MissingTrie
 	return msc.stateComponents.missingTrieNodesNotifier
 }
 
+// FlatState returns the flat state kept beside the user accounts trie
+func (msc *managedStateComponents) FlatState() state.FlatStateHandler {
+	msc.mutStateComponents.RLock()
+	defer msc.mutStateComponents.RUnlock()
+
+	if msc.stateComponents == nil {
+		return nil
+	}
+
+	return msc.stateComponents.flatState
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (msc *managedStateComponents) IsInterfaceNil() bool {
 	return msc == nil
@@ -34,6 +34,7 @@ func createAccountAdapter(
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      addressConverter,
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 
 	adb, err := state.NewAccountsDB(args)
@@ -510,6 +510,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, enableEpoc
 		disabledScheduledTxsExecutionHandler,
 		disabledProcessedMiniBlocksTracker,
 		arg.TxExecutionOrderHandler,
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	if err != nil {
 		return nil, err
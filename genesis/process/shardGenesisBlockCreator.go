@@ -597,6 +597,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		disabledScheduledTxsExecutionHandler,
 		disabledProcessedMiniBlocksTracker,
 		arg.TxExecutionOrderHandler,
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	if err != nil {
 		return nil, err
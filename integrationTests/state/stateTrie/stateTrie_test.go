@@ -34,6 +34,7 @@ import (
 	dcdtCommon "github.com/kalyan3104/k-chain-go/integrationTests/vm/dcdt"
 	"github.com/kalyan3104/k-chain-go/sharding"
 	"github.com/kalyan3104/k-chain-go/state"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	"github.com/kalyan3104/k-chain-go/state/factory"
 	"github.com/kalyan3104/k-chain-go/state/iteratorChannelsProvider"
 	"github.com/kalyan3104/k-chain-go/state/lastSnapshotMarker"
@@ -1064,15 +1065,16 @@ func createAccounts(
 	}
 	accCreator, _ := factory.NewAccountCreator(argsAccCreator)
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           &marshallerMock.MarshalizerMock{},
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &stateMock.StateMetricsStub{},
-		AccountFactory:       accCreator,
-		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &stateMock.StateMetricsStub{},
+		AccountFactory:           accCreator,
+		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &stateMock.PortableSnapshotExporterStub{},
 	})
 	argsAccountsDB := state.ArgsAccountsDB{
 		Trie:                  tr,
@@ -1082,6 +1084,7 @@ func createAccounts(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -2737,15 +2740,16 @@ func createAccountsDBTestSetup() *state.AccountsDB {
 	accCreator, _ := factory.NewAccountCreator(argsAccCreator)
 
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           &marshallerMock.MarshalizerMock{},
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &stateMock.StateMetricsStub{},
-		AccountFactory:       accCreator,
-		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &stateMock.StateMetricsStub{},
+		AccountFactory:           accCreator,
+		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &stateMock.PortableSnapshotExporterStub{},
 	})
 
 	argsAccountsDB := state.ArgsAccountsDB{
@@ -2756,6 +2760,7 @@ func createAccountsDBTestSetup() *state.AccountsDB {
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -129,6 +129,7 @@ func testNodeRequestInterceptTrieNodesWithMessenger(t *testing.T, version int) {
 		TimeoutHandler:            testscommon.NewTimeoutHandlerMock(timeout),
 		MaxHardCapForMissingNodes: 10000,
 		CheckNodesOnDisk:          false,
+		CheckpointsStorer:         testscommon.NewMemDbMock(),
 	}
 	trieSyncer, _ := trie.CreateTrieSyncer(arg, version)
 
@@ -253,6 +254,7 @@ func testNodeRequestInterceptTrieNodesWithMessengerNotSyncingShouldErr(t *testin
 		TimeoutHandler:            testscommon.NewTimeoutHandlerMock(timeout),
 		MaxHardCapForMissingNodes: 10000,
 		CheckNodesOnDisk:          false,
+		CheckpointsStorer:         testscommon.NewMemDbMock(),
 	}
 	trieSyncer, _ := trie.CreateTrieSyncer(arg, version)
 
@@ -614,6 +616,7 @@ func getUserAccountSyncerArgs(node *integrationTests.TestProcessorNode, version
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  integrationTests.TestAppStatusHandler,
 			EnableEpochsHandler:               node.EnableEpochsHandler,
+			CheckpointsStorer:                 testscommon.NewMemDbMock(),
 		},
 		ShardId:                0,
 		Throttler:              thr,
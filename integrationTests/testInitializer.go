@@ -44,6 +44,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/sharding/nodesCoordinator"
 	"github.com/kalyan3104/k-chain-go/state"
 	"github.com/kalyan3104/k-chain-go/state/accounts"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	"github.com/kalyan3104/k-chain-go/state/factory"
 	"github.com/kalyan3104/k-chain-go/state/iteratorChannelsProvider"
 	"github.com/kalyan3104/k-chain-go/state/lastSnapshotMarker"
@@ -467,15 +468,16 @@ func CreateAccountsDBWithEnableEpochsHandler(
 	spm, _ := storagePruningManager.NewStoragePruningManager(ewl, 10)
 
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           TestMarshalizer,
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &testStorage.StateMetricsStub{},
-		AccountFactory:       accountFactory,
-		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               TestMarshalizer,
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &testStorage.StateMetricsStub{},
+		AccountFactory:           accountFactory,
+		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &testStorage.PortableSnapshotExporterStub{},
 	})
 
 	args := state.ArgsAccountsDB{
@@ -486,6 +488,7 @@ func CreateAccountsDBWithEnableEpochsHandler(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, _ := state.NewAccountsDB(args)
 
@@ -1772,6 +1772,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		scheduledTxsExecutionHandler,
 		processedMiniBlocksTracker,
 		tpn.TxExecutionOrderHandler,
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -2040,6 +2041,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors(gasMap map[string]map[stri
 		scheduledTxsExecutionHandler,
 		processedMiniBlocksTracker,
 		tpn.TxExecutionOrderHandler,
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/kalyan3104/k-chain-go/api/groups"
+	"github.com/kalyan3104/k-chain-go/api/middleware/disabled"
 	"github.com/kalyan3104/k-chain-go/api/shared"
 	"github.com/kalyan3104/k-chain-go/config"
 	nodeFacade "github.com/kalyan3104/k-chain-go/facade"
@@ -19,10 +20,11 @@ import (
 	"github.com/kalyan3104/k-chain-go/process/smartContract/builtInFunctions"
 	"github.com/kalyan3104/k-chain-go/process/transactionEvaluator"
 	"github.com/kalyan3104/k-chain-go/process/txstatus"
+	"github.com/kalyan3104/k-chain-go/state"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/kalyan3104/k-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/kalyan3104/k-chain-go/testscommon/genesisMocks"
-	"github.com/kalyan3104/k-chain-go/testscommon/state"
+	stateMock "github.com/kalyan3104/k-chain-go/testscommon/state"
 	"github.com/kalyan3104/k-chain-go/vm/systemSmartContracts/defaults"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -104,11 +106,12 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo", "/bootstrapstatus", "/connected-peers-ratings", "/managed-keys/count", "/managed-keys", "/loaded-keys", "/managed-keys/eligible", "/managed-keys/waiting", "/waiting-epochs-left/:key"},
 		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/code-hash", "/:address/key/:key", "/:address/dcdt", "/:address/dcdt/:tokenIdentifier"},
 		"hardfork":    {"/trigger"},
+		"jsonrpc":     {"/http", "/ws"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool"},
+		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool", "/:txhash/trace", "/simulate/trace"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
 
@@ -172,6 +175,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 
 	argSimulator := transactionEvaluator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
+		SCRProcessor:              tpn.ScProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
 		AddressPubKeyConverter:    TestAddressPubkeyConverter,
 		ShardCoordinator:          tpn.ShardCoordinator,
@@ -188,14 +192,23 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState)
 	log.LogIfError(err)
 
+	historicalAccounts, err := state.NewAccountsDBApiWithHistory(tpn.AccntState)
+	log.LogIfError(err)
+
+	accountFactory, err := getAccountFactory(UserAccount, tpn.EnableEpochsHandler)
+	log.LogIfError(err)
+
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
-		FeeHandler:          tpn.EconomicsData,
-		TxSimulator:         txSimulator,
-		Accounts:            wrappedAccounts,
-		ShardCoordinator:    tpn.ShardCoordinator,
-		EnableEpochsHandler: tpn.EnableEpochsHandler,
-		BlockChain:          tpn.BlockChain,
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             tpn.EconomicsData,
+		TxSimulator:            txSimulator,
+		Accounts:               wrappedAccounts,
+		HistoricalAccounts:     historicalAccounts,
+		AccountFactory:         accountFactory,
+		ShardCoordinator:       tpn.ShardCoordinator,
+		EnableEpochsHandler:    tpn.EnableEpochsHandler,
+		BlockChain:             tpn.BlockChain,
+		AddressPubKeyConverter: TestAddressPubkeyConverter,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	log.LogIfError(err)
@@ -255,7 +268,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		LogsFacade:                   logsFacade,
 		ReceiptsRepository:           receiptsRepository,
 		AlteredAccountsProvider:      &testscommon.AlteredAccountsProviderStub{},
-		AccountsRepository:           &state.AccountsRepositoryStub{},
+		AccountsRepository:           &stateMock.AccountsRepositoryStub{},
 		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
 		EnableEpochsHandler:          &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 	}
@@ -281,6 +294,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
 		NodesCoordinator:         tpn.NodesCoordinator,
+		SubscriptionsHandler:     &testscommon.SubscriptionsHandlerStub{},
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -319,6 +333,16 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["hardfork"] = hardforkGroup
 	}
 
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade, disabled.NewDisabledCallsThrottler())
+	if err == nil {
+		groupsMap["jsonrpc"] = jsonRpcGroup
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	if err == nil {
+		groupsMap["logs"] = logsGroup
+	}
+
 	networkGroup, err := groups.NewNetworkGroup(facade)
 	if err == nil {
 		groupsMap["network"] = networkGroup
@@ -334,6 +358,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["proof"] = proofGroup
 	}
 
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	if err == nil {
+		groupsMap["subscriptions"] = subscriptionsGroup
+	}
+
 	transactionGroup, err := groups.NewTransactionGroup(facade)
 	if err == nil {
 		groupsMap["transaction"] = transactionGroup
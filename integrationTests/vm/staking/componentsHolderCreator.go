@@ -27,6 +27,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/sharding"
 	"github.com/kalyan3104/k-chain-go/sharding/nodesCoordinator"
 	"github.com/kalyan3104/k-chain-go/state"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	stateFactory "github.com/kalyan3104/k-chain-go/state/factory"
 	"github.com/kalyan3104/k-chain-go/state/storagePruningManager"
 	"github.com/kalyan3104/k-chain-go/state/storagePruningManager/evictionWaitingList"
@@ -215,6 +216,7 @@ func createAccountsDB(
 		StoragePruningManager: spm,
 		AddressConverter:      coreComponents.AddressPubKeyConverter(),
 		SnapshotsManager:      &stateTests.SnapshotsManagerStub{},
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDb)
 	return adb
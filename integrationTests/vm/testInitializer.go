@@ -50,6 +50,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/process/transactionLog"
 	"github.com/kalyan3104/k-chain-go/sharding"
 	"github.com/kalyan3104/k-chain-go/state"
+	stateFactory "github.com/kalyan3104/k-chain-go/state/factory"
 	"github.com/kalyan3104/k-chain-go/storage"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
 	"github.com/kalyan3104/k-chain-go/storage/txcache"
@@ -992,6 +993,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 	proxyProcessor, _ := processProxy.NewTestSmartContractProcessorProxy(argsNewSCProcessor, epochNotifierInstance)
 	argsNewTxProcessor.ScProcessor = proxyProcessor
+	txSimulatorProcessorArgs.SCRProcessor = proxyProcessor
 	argsNewTxProcessor.Accounts = simulationAccountsDB
 
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(argsNewTxProcessor)
@@ -1006,14 +1008,31 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		return nil, err
 	}
 
+	historicalAccounts, err := state.NewAccountsDBApiWithHistory(accnts)
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := stateFactory.NewAccountCreator(stateFactory.ArgsAccountCreator{
+		Hasher:              integrationtests.TestHasher,
+		Marshaller:          integrationtests.TestMarshalizer,
+		EnableEpochsHandler: enableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
-		FeeHandler:          economicsData,
-		TxSimulator:         txSimulator,
-		Accounts:            simulationAccountsDB,
-		ShardCoordinator:    shardCoordinator,
-		EnableEpochsHandler: argsNewSCProcessor.EnableEpochsHandler,
-		BlockChain:          chainHandler,
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             economicsData,
+		TxSimulator:            txSimulator,
+		Accounts:               simulationAccountsDB,
+		HistoricalAccounts:     historicalAccounts,
+		AccountFactory:         accountFactory,
+		ShardCoordinator:       shardCoordinator,
+		EnableEpochsHandler:    argsNewSCProcessor.EnableEpochsHandler,
+		BlockChain:             chainHandler,
+		AddressPubKeyConverter: pubkeyConv,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	if err != nil {
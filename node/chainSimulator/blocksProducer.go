@@ -0,0 +1,237 @@
+package chainSimulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core/atomic"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+)
+
+const (
+	minBlocksProductionInterval = 10 * time.Millisecond
+	// delayAfterTransactionAdded allows the transactions sent in bulk to all reach the pools before the block is produced
+	delayAfterTransactionAdded = 20 * time.Millisecond
+)
+
+type blocksGenerator interface {
+	GenerateBlocks(numOfBlocks int) error
+}
+
+type blocksProducer struct {
+	generator          blocksGenerator
+	chanTxAdded        chan struct{}
+	notificationsMuted atomic.Flag
+	mutState           sync.RWMutex
+	config             *dtos.BlocksProductionConfig
+	paused             bool
+	cancelFunc         func()
+	chanLoopClosed     chan struct{}
+}
+
+func newBlocksProducer(generator blocksGenerator) *blocksProducer {
+	return &blocksProducer{
+		generator:   generator,
+		chanTxAdded: make(chan struct{}, 1),
+	}
+}
+
+func checkBlocksProductionConfig(config dtos.BlocksProductionConfig) error {
+	switch config.Mode {
+	case dtos.BlocksProductionOnInterval:
+		interval := time.Duration(config.IntervalInMillis) * time.Millisecond
+		if interval < minBlocksProductionInterval {
+			return fmt.Errorf("%w, provided %v, minimum %v", errInvalidBlocksProductionInterval, interval, minBlocksProductionInterval)
+		}
+		return nil
+	case dtos.BlocksProductionOnTransaction:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errInvalidBlocksProductionMode, config.Mode)
+	}
+}
+
+// start will begin the automatic blocks production. The optional onStarting function is called right before the
+// production loop is launched, only if the production was not already started, and can abort the start by returning an error
+func (producer *blocksProducer) start(config dtos.BlocksProductionConfig, onStarting func() error) error {
+	err := checkBlocksProductionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	producer.mutState.Lock()
+	defer producer.mutState.Unlock()
+
+	if producer.config != nil {
+		return errBlocksProductionAlreadyStarted
+	}
+	if onStarting != nil {
+		err = onStarting()
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	producer.config = &config
+	producer.paused = false
+	producer.cancelFunc = cancel
+	producer.chanLoopClosed = make(chan struct{})
+
+	// drop the notifications received while the production was stopped
+	select {
+	case <-producer.chanTxAdded:
+	default:
+	}
+
+	go producer.produceBlocks(ctx, config, producer.chanLoopClosed)
+
+	log.Debug("chain simulator: automatic blocks production started",
+		"mode", config.Mode, "interval in millis", config.IntervalInMillis)
+
+	return nil
+}
+
+func (producer *blocksProducer) produceBlocks(ctx context.Context, config dtos.BlocksProductionConfig, chanLoopClosed chan struct{}) {
+	defer close(chanLoopClosed)
+
+	var chanTicker <-chan time.Time
+	var chanTxAdded chan struct{}
+	if config.Mode == dtos.BlocksProductionOnInterval {
+		ticker := time.NewTicker(time.Duration(config.IntervalInMillis) * time.Millisecond)
+		defer ticker.Stop()
+
+		chanTicker = ticker.C
+	} else {
+		chanTxAdded = producer.chanTxAdded
+	}
+
+	for {
+		delay := time.Duration(0)
+		select {
+		case <-ctx.Done():
+			return
+		case <-chanTicker:
+		case <-chanTxAdded:
+			delay = delayAfterTransactionAdded
+		}
+
+		if producer.isPaused() {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		// the notifications received meanwhile are covered by the block that is about to be produced
+		select {
+		case <-chanTxAdded:
+		default:
+		}
+
+		err := producer.generator.GenerateBlocks(1)
+		if err != nil {
+			log.Error("chain simulator: automatic blocks production failed", "error", err)
+		}
+	}
+}
+
+func (producer *blocksProducer) notifyTransactionAdded(_ []byte, _ interface{}) {
+	if producer.notificationsMuted.IsSet() {
+		return
+	}
+
+	notify(producer.chanTxAdded)
+}
+
+// muteNotifications will ignore the transactions added in the pools until unmuteNotifications is called, so that
+// restoring the pools content does not trigger new blocks
+func (producer *blocksProducer) muteNotifications() {
+	producer.notificationsMuted.SetValue(true)
+}
+
+func (producer *blocksProducer) unmuteNotifications() {
+	producer.notificationsMuted.Reset()
+}
+
+func notify(chanNotify chan struct{}) {
+	select {
+	case chanNotify <- struct{}{}:
+	default:
+	}
+}
+
+func (producer *blocksProducer) isPaused() bool {
+	producer.mutState.RLock()
+	defer producer.mutState.RUnlock()
+
+	return producer.paused
+}
+
+func (producer *blocksProducer) setPaused(paused bool) error {
+	producer.mutState.Lock()
+	defer producer.mutState.Unlock()
+
+	if producer.config == nil {
+		return errBlocksProductionNotStarted
+	}
+
+	producer.paused = paused
+	if !paused {
+		// transactions might have landed in the pools while paused
+		notify(producer.chanTxAdded)
+	}
+
+	return nil
+}
+
+func (producer *blocksProducer) pause() error {
+	return producer.setPaused(true)
+}
+
+func (producer *blocksProducer) resume() error {
+	return producer.setPaused(false)
+}
+
+// stop will cancel the production loop and will wait for the block in progress, if any, to be finished
+func (producer *blocksProducer) stop() error {
+	producer.mutState.Lock()
+	if producer.config == nil {
+		producer.mutState.Unlock()
+		return errBlocksProductionNotStarted
+	}
+
+	producer.cancelFunc()
+	chanLoopClosed := producer.chanLoopClosed
+	producer.config = nil
+	producer.paused = false
+	producer.mutState.Unlock()
+
+	<-chanLoopClosed
+
+	log.Debug("chain simulator: automatic blocks production stopped")
+
+	return nil
+}
+
+func (producer *blocksProducer) status() dtos.BlocksProductionStatus {
+	producer.mutState.RLock()
+	defer producer.mutState.RUnlock()
+
+	if producer.config == nil {
+		return dtos.BlocksProductionStatus{}
+	}
+
+	config := *producer.config
+
+	return dtos.BlocksProductionStatus{
+		Running: true,
+		Paused:  producer.paused,
+		Config:  &config,
+	}
+}
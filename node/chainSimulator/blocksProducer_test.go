@@ -0,0 +1,181 @@
+package chainSimulator
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blocksGeneratorStub struct {
+	numCalls uint32
+}
+
+func (stub *blocksGeneratorStub) GenerateBlocks(_ int) error {
+	atomic.AddUint32(&stub.numCalls, 1)
+	return nil
+}
+
+func (stub *blocksGeneratorStub) getNumCalls() uint32 {
+	return atomic.LoadUint32(&stub.numCalls)
+}
+
+func TestCheckBlocksProductionConfig(t *testing.T) {
+	t.Parallel()
+
+	err := checkBlocksProductionConfig(dtos.BlocksProductionConfig{Mode: "unknown"})
+	assert.True(t, errors.Is(err, errInvalidBlocksProductionMode))
+
+	err = checkBlocksProductionConfig(dtos.BlocksProductionConfig{Mode: dtos.BlocksProductionOnInterval, IntervalInMillis: 1})
+	assert.True(t, errors.Is(err, errInvalidBlocksProductionInterval))
+
+	err = checkBlocksProductionConfig(dtos.BlocksProductionConfig{Mode: dtos.BlocksProductionOnInterval, IntervalInMillis: 100})
+	assert.Nil(t, err)
+
+	err = checkBlocksProductionConfig(dtos.BlocksProductionConfig{Mode: dtos.BlocksProductionOnTransaction})
+	assert.Nil(t, err)
+}
+
+func TestBlocksProducer_NotStartedShouldError(t *testing.T) {
+	t.Parallel()
+
+	producer := newBlocksProducer(&blocksGeneratorStub{})
+	assert.Equal(t, errBlocksProductionNotStarted, producer.pause())
+	assert.Equal(t, errBlocksProductionNotStarted, producer.resume())
+	assert.Equal(t, errBlocksProductionNotStarted, producer.stop())
+	assert.Equal(t, dtos.BlocksProductionStatus{}, producer.status())
+}
+
+func TestBlocksProducer_OnInterval(t *testing.T) {
+	t.Parallel()
+
+	generator := &blocksGeneratorStub{}
+	producer := newBlocksProducer(generator)
+	config := dtos.BlocksProductionConfig{
+		Mode:             dtos.BlocksProductionOnInterval,
+		IntervalInMillis: 10,
+	}
+	err := producer.start(config, nil)
+	require.Nil(t, err)
+	assert.Equal(t, errBlocksProductionAlreadyStarted, producer.start(config, nil))
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Greater(t, generator.getNumCalls(), uint32(1))
+
+	err = producer.pause()
+	require.Nil(t, err)
+	status := producer.status()
+	assert.True(t, status.Running)
+	assert.True(t, status.Paused)
+	assert.Equal(t, &config, status.Config)
+
+	time.Sleep(time.Millisecond * 20)
+	numCallsWhilePaused := generator.getNumCalls()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, numCallsWhilePaused, generator.getNumCalls())
+
+	err = producer.resume()
+	require.Nil(t, err)
+	time.Sleep(time.Millisecond * 100)
+	assert.Greater(t, generator.getNumCalls(), numCallsWhilePaused)
+
+	err = producer.stop()
+	require.Nil(t, err)
+	numCallsAfterStop := generator.getNumCalls()
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, numCallsAfterStop, generator.getNumCalls())
+	assert.False(t, producer.status().Running)
+}
+
+func TestBlocksProducer_OnTransaction(t *testing.T) {
+	t.Parallel()
+
+	generator := &blocksGeneratorStub{}
+	producer := newBlocksProducer(generator)
+
+	// notifications received before start should be ignored
+	producer.notifyTransactionAdded(nil, nil)
+
+	err := producer.start(dtos.BlocksProductionConfig{Mode: dtos.BlocksProductionOnTransaction}, nil)
+	require.Nil(t, err)
+
+	time.Sleep(delayAfterTransactionAdded * 3)
+	assert.Equal(t, uint32(0), generator.getNumCalls())
+
+	producer.notifyTransactionAdded(nil, nil)
+	producer.notifyTransactionAdded(nil, nil)
+	time.Sleep(delayAfterTransactionAdded * 5)
+	assert.Equal(t, uint32(1), generator.getNumCalls())
+
+	err = producer.pause()
+	require.Nil(t, err)
+	producer.notifyTransactionAdded(nil, nil)
+	time.Sleep(delayAfterTransactionAdded * 3)
+	assert.Equal(t, uint32(1), generator.getNumCalls())
+
+	// the transaction that landed while paused should trigger a block on resume
+	err = producer.resume()
+	require.Nil(t, err)
+	time.Sleep(delayAfterTransactionAdded * 5)
+	assert.Equal(t, uint32(2), generator.getNumCalls())
+
+	err = producer.stop()
+	require.Nil(t, err)
+}
+
+func TestBlocksProducer_StartShouldConfigureOnlyIfNotStarted(t *testing.T) {
+	t.Parallel()
+
+	producer := newBlocksProducer(&blocksGeneratorStub{})
+	config := dtos.BlocksProductionConfig{Mode: dtos.BlocksProductionOnTransaction}
+
+	expectedErr := errors.New("expected error")
+	err := producer.start(config, func() error {
+		return expectedErr
+	})
+	require.Equal(t, expectedErr, err)
+	assert.False(t, producer.status().Running)
+
+	numOnStartingCalls := 0
+	onStarting := func() error {
+		numOnStartingCalls++
+		return nil
+	}
+	err = producer.start(config, onStarting)
+	require.Nil(t, err)
+	assert.True(t, producer.status().Running)
+
+	err = producer.start(config, onStarting)
+	require.Equal(t, errBlocksProductionAlreadyStarted, err)
+	assert.Equal(t, 1, numOnStartingCalls)
+
+	err = producer.stop()
+	require.Nil(t, err)
+}
+
+func TestBlocksProducer_MutedNotificationsShouldNotProduceBlocks(t *testing.T) {
+	t.Parallel()
+
+	generator := &blocksGeneratorStub{}
+	producer := newBlocksProducer(generator)
+
+	err := producer.start(dtos.BlocksProductionConfig{Mode: dtos.BlocksProductionOnTransaction}, nil)
+	require.Nil(t, err)
+
+	producer.muteNotifications()
+	producer.notifyTransactionAdded(nil, nil)
+	time.Sleep(delayAfterTransactionAdded * 3)
+	assert.Equal(t, uint32(0), generator.getNumCalls())
+
+	producer.unmuteNotifications()
+	producer.notifyTransactionAdded(nil, nil)
+	time.Sleep(delayAfterTransactionAdded * 5)
+	assert.Equal(t, uint32(1), generator.getNumCalls())
+
+	err = producer.stop()
+	require.Nil(t, err)
+}
@@ -56,6 +56,7 @@ type ArgsChainSimulator struct {
 	RoundsPerEpoch           core.OptionalUint64
 	ApiInterface             components.APIConfigurator
 	AlterConfigsFunction     func(cfg *config.Configs)
+	Fork                     *ArgsFork
 }
 
 type simulator struct {
@@ -68,6 +69,10 @@ type simulator struct {
 	nodes                  map[uint32]process.NodeHandler
 	numOfShards            uint32
 	mutex                  sync.RWMutex
+	snapshots              map[uint64]struct{}
+	nextSnapshotID         uint64
+	blocksProducer         *blocksProducer
+	fork                   *forkState
 }
 
 // NewChainSimulator will create a new instance of simulator
@@ -82,6 +87,17 @@ func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
 		chanStopNodeProcess:    make(chan endProcess.ArgEndProcess),
 		mutex:                  sync.RWMutex{},
 		initialStakedKeys:      make(map[string]*dtos.BLSKey),
+		snapshots:              make(map[uint64]struct{}),
+	}
+
+	if args.Fork != nil {
+		fork, err := loadForkState(*args.Fork, args.NumOfShards)
+		if err != nil {
+			return nil, err
+		}
+
+		fork.applyOnArgs(&args)
+		instance.fork = fork
 	}
 
 	err := instance.createChainHandlers(args)
@@ -89,6 +105,20 @@ func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
 		return nil, err
 	}
 
+	if instance.fork != nil {
+		err = instance.applyForkOnNodes(instance.fork)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instance.blocksProducer = newBlocksProducer(instance)
+	for _, node := range instance.nodes {
+		dataPool := node.GetDataComponents().Datapool()
+		dataPool.Transactions().RegisterOnAdded(instance.blocksProducer.notifyTransactionAdded)
+		dataPool.UnsignedTransactions().RegisterOnAdded(instance.blocksProducer.notifyTransactionAdded)
+	}
+
 	return instance, nil
 }
 
@@ -112,12 +142,14 @@ func (s *simulator) createChainHandlers(args ArgsChainSimulator) error {
 	}
 
 	for idx := 0; idx < int(args.NumOfShards)+1; idx++ {
+		nodeShardID := uint32(idx - 1)
 		shardIDStr := fmt.Sprintf("%d", idx-1)
 		if idx == 0 {
+			nodeShardID = core.MetachainShardId
 			shardIDStr = "metachain"
 		}
 
-		node, errCreate := s.createTestNode(*outputConfigs, args, shardIDStr)
+		node, errCreate := s.createTestNode(*outputConfigs, args, shardIDStr, nodeShardID)
 		if errCreate != nil {
 			return errCreate
 		}
@@ -176,8 +208,20 @@ func computeStartTimeBaseOnInitialRound(args ArgsChainSimulator) int64 {
 }
 
 func (s *simulator) createTestNode(
-	outputConfigs configs.ArgsConfigsSimulator, args ArgsChainSimulator, shardIDStr string,
+	outputConfigs configs.ArgsConfigsSimulator, args ArgsChainSimulator, shardIDStr string, shardID uint32,
 ) (process.NodeHandler, error) {
+	initialNonce := args.InitialNonce
+	var trieNodesProviders []components.TrieNodesProvider
+	if s.fork != nil {
+		var err error
+		trieNodesProviders, err = s.fork.trieNodesProviders(shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		initialNonce = s.fork.initialNonce(shardID)
+	}
+
 	argsTestOnlyProcessorNode := components.ArgsTestOnlyProcessingNode{
 		Configs:                outputConfigs.Configs,
 		ChanStopNodeProcess:    s.chanStopNodeProcess,
@@ -188,10 +232,11 @@ func (s *simulator) createTestNode(
 		APIInterface:           args.ApiInterface,
 		BypassTxSignatureCheck: args.BypassTxSignatureCheck,
 		InitialRound:           args.InitialRound,
-		InitialNonce:           args.InitialNonce,
+		InitialNonce:           initialNonce,
 		MinNodesPerShard:       args.MinNodesPerShard,
 		MinNodesMeta:           args.MetaChainMinNodes,
 		RoundDurationInMillis:  args.RoundDurationInMillis,
+		TrieNodesProviders:     trieNodesProviders,
 	}
 
 	return components.NewTestOnlyProcessingNode(argsTestOnlyProcessorNode)
@@ -451,6 +496,11 @@ func (s *simulator) SetStateMultiple(stateSlice []*dtos.AddressState) error {
 		if err != nil {
 			return err
 		}
+
+		err = s.setTokensGlobalState(addressBytes, stateValue)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -482,6 +532,157 @@ func (s *simulator) RemoveAccounts(addresses []string) error {
 	return nil
 }
 
+// Snapshot will save the state of all the nodes (accounts tries, blockchain headers, data pools and the round handler)
+// and will return the identifier that can be later used to revert to this state
+func (s *simulator) Snapshot() (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id, err := s.takeSnapshotOnAllNodes()
+	if err != nil {
+		return 0, err
+	}
+
+	s.snapshots[id] = struct{}{}
+	log.Debug("chain simulator: snapshot taken", "id", id)
+
+	return id, nil
+}
+
+// RevertToSnapshot will restore the state of all the nodes to the one saved under the provided snapshot identifier.
+// The snapshot is kept so the same state can be reverted to multiple times. If any of the nodes fails to revert, all
+// the nodes are brought back to the state they had before the call
+func (s *simulator) RevertToSnapshot(id uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, found := s.snapshots[id]
+	if !found {
+		return fmt.Errorf("%w, id %d", errSnapshotNotFound, id)
+	}
+
+	// restoring the pools should not trigger the blocks production on transactions
+	s.blocksProducer.muteNotifications()
+	defer s.blocksProducer.unmuteNotifications()
+
+	rollbackID, err := s.takeSnapshotOnAllNodes()
+	if err != nil {
+		return fmt.Errorf("%w while saving the current state before reverting", err)
+	}
+	defer s.releaseSnapshotOnAllNodes(rollbackID)
+
+	for shardID, node := range s.nodes {
+		err = node.RevertToSnapshot(id)
+		if err != nil {
+			s.rollbackAllNodes(rollbackID)
+			return fmt.Errorf("%w while reverting to snapshot for shard %d", err, shardID)
+		}
+	}
+
+	log.Debug("chain simulator: reverted to snapshot", "id", id)
+
+	return nil
+}
+
+// ReleaseSnapshot will free the state saved under the provided snapshot identifier. The snapshot can not be reverted to
+// afterwards
+func (s *simulator) ReleaseSnapshot(id uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, found := s.snapshots[id]
+	if !found {
+		return fmt.Errorf("%w, id %d", errSnapshotNotFound, id)
+	}
+
+	s.releaseSnapshotOnAllNodes(id)
+	delete(s.snapshots, id)
+	log.Debug("chain simulator: snapshot released", "id", id)
+
+	return nil
+}
+
+func (s *simulator) takeSnapshotOnAllNodes() (uint64, error) {
+	id := s.nextSnapshotID
+	s.nextSnapshotID++
+
+	for shardID, node := range s.nodes {
+		err := node.TakeSnapshot(id)
+		if err != nil {
+			s.releaseSnapshotOnAllNodes(id)
+			return 0, fmt.Errorf("%w while taking snapshot for shard %d", err, shardID)
+		}
+	}
+
+	return id, nil
+}
+
+func (s *simulator) releaseSnapshotOnAllNodes(id uint64) {
+	for _, node := range s.nodes {
+		node.ReleaseSnapshot(id)
+	}
+}
+
+func (s *simulator) rollbackAllNodes(rollbackID uint64) {
+	for shardID, node := range s.nodes {
+		err := node.RevertToSnapshot(rollbackID)
+		if err != nil {
+			log.Error("chain simulator: could not roll back the node after a failed revert",
+				"shard", shardID, "error", err)
+		}
+	}
+}
+
+// StartBlocksProduction will start producing blocks on all shards, either at a fixed interval, either as soon as
+// transactions land in the pools. The blocks timestamps are configured before the first automatically produced block
+func (s *simulator) StartBlocksProduction(config dtos.BlocksProductionConfig) error {
+	err := checkBlocksProductionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	// the timestamps are configured only if the production is not already started, so a rejected call changes nothing
+	return s.blocksProducer.start(config, func() error {
+		return s.setTimeStampsConfig(config.UseWallClockTimeStamps, time.Duration(config.TimeStampOffsetInSeconds)*time.Second)
+	})
+}
+
+// PauseBlocksProduction will pause the automatic blocks production. Blocks can still be generated on demand
+func (s *simulator) PauseBlocksProduction() error {
+	return s.blocksProducer.pause()
+}
+
+// ResumeBlocksProduction will resume the paused automatic blocks production
+func (s *simulator) ResumeBlocksProduction() error {
+	return s.blocksProducer.resume()
+}
+
+// StopBlocksProduction will stop the automatic blocks production. The blocks timestamps configuration is kept
+func (s *simulator) StopBlocksProduction() error {
+	return s.blocksProducer.stop()
+}
+
+// GetBlocksProductionStatus returns the status of the automatic blocks production
+func (s *simulator) GetBlocksProductionStatus() dtos.BlocksProductionStatus {
+	return s.blocksProducer.status()
+}
+
+func (s *simulator) setTimeStampsConfig(useWallClock bool, offset time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for shardID, node := range s.nodes {
+		configurator, ok := node.GetCoreComponents().RoundHandler().(timeStampsConfigurator)
+		if !ok {
+			return fmt.Errorf("%w for shard %d", errNotATimeStampsConfigurator, shardID)
+		}
+
+		configurator.SetTimeStampsConfig(useWallClock, offset)
+	}
+
+	return nil
+}
+
 // SendTxAndGenerateBlockTilTxIsExecuted will send the provided transaction and generate block until the transaction is executed
 func (s *simulator) SendTxAndGenerateBlockTilTxIsExecuted(txToSend *transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) (*transaction.ApiTransactionResult, error) {
 	result, err := s.SendTxsAndGenerateBlocksTilAreExecuted([]*transaction.Transaction{txToSend}, maxNumOfBlocksToGenerateWhenExecutingTx)
@@ -628,6 +829,9 @@ func (s *simulator) GetAccount(address dtos.WalletAddress) (api.AccountResponse,
 
 // Close will stop and close the simulator
 func (s *simulator) Close() {
+	// the blocks production must be stopped before acquiring the mutex as the production loop might wait for it
+	_ = s.blocksProducer.stop()
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -2,6 +2,7 @@ package chainSimulator
 
 import (
 	"encoding/base64"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -13,7 +14,9 @@ import (
 	"github.com/kalyan3104/k-chain-go/node/chainSimulator/components/api"
 	"github.com/kalyan3104/k-chain-go/node/chainSimulator/configs"
 	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	chainSimulatorProcess "github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
 	"github.com/kalyan3104/k-chain-go/process"
+	chainSimulatorMocks "github.com/kalyan3104/k-chain-go/testscommon/chainSimulator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -447,6 +450,93 @@ func TestChainSimulator_GetAccount(t *testing.T) {
 	assert.Equal(t, "38", account.Balance)
 }
 
+func TestChainSimulator_SetStateWithTokens(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	address := "moa1qtc600lryvytxuy4h7vn7xmsy5tw6vuw3tskr75cwnmv4mnyjgsqhpjd5z"
+	err = chainSimulator.SetStateMultiple([]*dtos.AddressState{
+		{
+			Address: address,
+			Tokens: []*dtos.TokenState{
+				{
+					Identifier:  "TKN-123456",
+					Balance:     "1000",
+					NumDecimals: 18,
+				},
+				{
+					Identifier: "NFT-123456",
+					Nonce:      2,
+					Balance:    "1",
+					MetaData: &dtos.TokenMetaData{
+						Name:      "my nft",
+						Creator:   address,
+						Royalties: 500,
+						URIs:      []string{"https://uri"},
+					},
+				},
+			},
+			TokenRoles: map[string][]string{
+				"TKN-123456": {core.DCDTRoleLocalMint},
+				"NFT-123456": {core.DCDTRoleNFTCreate},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	nodeHandler := chainSimulator.GetNodeHandler(0)
+	fungibleToken, _, err := nodeHandler.GetFacadeHandler().GetDCDTData(address, "TKN-123456", 0, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, "1000", fungibleToken.Value.String())
+
+	nft, _, err := nodeHandler.GetFacadeHandler().GetDCDTData(address, "NFT-123456", 2, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, "1", nft.Value.String())
+	require.Equal(t, []byte("my nft"), nft.TokenMetaData.Name)
+	require.Equal(t, uint32(500), nft.TokenMetaData.Royalties)
+
+	metaNodeHandler := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	roles, _, err := metaNodeHandler.GetFacadeHandler().GetDCDTsRoles(address, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{core.DCDTRoleLocalMint}, roles["TKN-123456"])
+	require.Equal(t, []string{core.DCDTRoleNFTCreate}, roles["NFT-123456"])
+
+	fungibleTokens, err := metaNodeHandler.GetFacadeHandler().GetAllIssuedDCDTs(core.FungibleDCDT)
+	require.Nil(t, err)
+	require.Contains(t, fungibleTokens, "TKN-123456")
+
+	nonFungibleTokens, err := metaNodeHandler.GetFacadeHandler().GetAllIssuedDCDTs(core.NonFungibleDCDT)
+	require.Nil(t, err)
+	require.Contains(t, nonFungibleTokens, "NFT-123456")
+}
+
 func TestSimulator_SendTransactions(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
@@ -542,6 +632,195 @@ func TestSimulator_SendTransactions(t *testing.T) {
 	})
 }
 
+func TestSimulator_SnapshotAndRevert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.RevertToSnapshot(0)
+	require.ErrorIs(t, err, errSnapshotNotFound)
+
+	oneRewa := big.NewInt(1000000000000000000)
+	initialMinting := big.NewInt(0).Mul(oneRewa, big.NewInt(100))
+	transferValue := big.NewInt(0).Mul(oneRewa, big.NewInt(5))
+
+	sender, err := chainSimulator.GenerateAndMintWalletAddress(0, initialMinting)
+	require.Nil(t, err)
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, initialMinting)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.Snapshot()
+	require.Nil(t, err)
+
+	nodeHandler := chainSimulator.GetNodeHandler(0)
+	nonceAtSnapshot := nodeHandler.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	roundAtSnapshot := nodeHandler.GetCoreComponents().RoundHandler().Index()
+
+	maxNumOfBlockToGenerateWhenExecutingTx := 15
+	for i := 0; i < 2; i++ {
+		tx := generateTransaction(sender.Bytes, 0, receiver.Bytes, transferValue, "", 50000)
+		_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, maxNumOfBlockToGenerateWhenExecutingTx)
+		require.Nil(t, err)
+
+		account, errGet := chainSimulator.GetAccount(receiver)
+		require.Nil(t, errGet)
+		require.Equal(t, big.NewInt(0).Add(initialMinting, transferValue).String(), account.Balance)
+
+		err = chainSimulator.RevertToSnapshot(snapshotID)
+		require.Nil(t, err)
+
+		require.Equal(t, nonceAtSnapshot, nodeHandler.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+		require.Equal(t, roundAtSnapshot, nodeHandler.GetCoreComponents().RoundHandler().Index())
+
+		err = chainSimulator.GenerateBlocks(1)
+		require.Nil(t, err)
+
+		account, errGet = chainSimulator.GetAccount(receiver)
+		require.Nil(t, errGet)
+		require.Equal(t, initialMinting.String(), account.Balance)
+
+		err = chainSimulator.RevertToSnapshot(snapshotID)
+		require.Nil(t, err)
+	}
+
+	err = chainSimulator.ReleaseSnapshot(snapshotID)
+	require.Nil(t, err)
+
+	err = chainSimulator.RevertToSnapshot(snapshotID)
+	require.ErrorIs(t, err, errSnapshotNotFound)
+}
+
+func TestSimulator_RevertToSnapshotFailureShouldRollbackAllNodes(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	revertedIDs := make(map[uint32][]uint64)
+	releasedIDs := make(map[uint32][]uint64)
+	createNode := func(shardID uint32) *chainSimulatorMocks.NodeHandlerMock {
+		return &chainSimulatorMocks.NodeHandlerMock{
+			RevertToSnapshotCalled: func(id uint64) error {
+				revertedIDs[shardID] = append(revertedIDs[shardID], id)
+				if shardID == 1 && id == 0 {
+					return expectedErr
+				}
+
+				return nil
+			},
+			ReleaseSnapshotCalled: func(id uint64) {
+				releasedIDs[shardID] = append(releasedIDs[shardID], id)
+			},
+		}
+	}
+
+	s := &simulator{
+		nodes: map[uint32]chainSimulatorProcess.NodeHandler{
+			0: createNode(0),
+			1: createNode(1),
+		},
+		snapshots:      map[uint64]struct{}{0: {}},
+		nextSnapshotID: 1,
+		blocksProducer: newBlocksProducer(nil),
+	}
+
+	err := s.RevertToSnapshot(0)
+	require.ErrorIs(t, err, expectedErr)
+
+	// the state saved before reverting (snapshot 1) is restored on all the nodes, then released
+	require.Contains(t, revertedIDs[0], uint64(1))
+	require.Equal(t, []uint64{0, 1}, revertedIDs[1])
+	require.Equal(t, []uint64{1}, releasedIDs[0])
+	require.Equal(t, []uint64{1}, releasedIDs[1])
+
+	_, found := s.snapshots[0]
+	require.True(t, found)
+}
+
+func TestSimulator_SnapshotFailureShouldReleasePartialSnapshots(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numReleased := 0
+	s := &simulator{
+		nodes: map[uint32]chainSimulatorProcess.NodeHandler{
+			0: &chainSimulatorMocks.NodeHandlerMock{
+				TakeSnapshotCalled: func(id uint64) error {
+					return expectedErr
+				},
+				ReleaseSnapshotCalled: func(id uint64) {
+					numReleased++
+				},
+			},
+			1: &chainSimulatorMocks.NodeHandlerMock{
+				ReleaseSnapshotCalled: func(id uint64) {
+					numReleased++
+				},
+			},
+		},
+		snapshots: make(map[uint64]struct{}),
+	}
+
+	_, err := s.Snapshot()
+	require.ErrorIs(t, err, expectedErr)
+	require.Equal(t, 2, numReleased)
+	require.Empty(t, s.snapshots)
+}
+
+func TestSimulator_ReleaseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	releasedIDs := make([]uint64, 0)
+	s := &simulator{
+		nodes: map[uint32]chainSimulatorProcess.NodeHandler{
+			0: &chainSimulatorMocks.NodeHandlerMock{
+				ReleaseSnapshotCalled: func(id uint64) {
+					releasedIDs = append(releasedIDs, id)
+				},
+			},
+		},
+		snapshots:      make(map[uint64]struct{}),
+		blocksProducer: newBlocksProducer(nil),
+	}
+
+	err := s.ReleaseSnapshot(0)
+	require.ErrorIs(t, err, errSnapshotNotFound)
+
+	id, err := s.Snapshot()
+	require.Nil(t, err)
+
+	err = s.ReleaseSnapshot(id)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{id}, releasedIDs)
+
+	err = s.RevertToSnapshot(id)
+	require.ErrorIs(t, err, errSnapshotNotFound)
+}
+
 func generateTransaction(sender []byte, nonce uint64, receiver []byte, value *big.Int, data string, gasLimit uint64) *transaction.Transaction {
 	minGasPrice := uint64(1000000000)
 	txVersion := uint32(1)
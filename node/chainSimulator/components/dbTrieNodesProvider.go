@@ -0,0 +1,80 @@
+package components
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
+)
+
+var errNoTrieNodesDBPaths = errors.New("no trie nodes database paths provided")
+
+// ArgsDBTrieNodesProvider holds the arguments needed to create a new instance of dbTrieNodesProvider
+type ArgsDBTrieNodesProvider struct {
+	DBConfig config.DBConfig
+	DBPaths  []string
+}
+
+type dbTrieNodesProvider struct {
+	persisters []storage.Persister
+}
+
+// NewDBTrieNodesProvider opens the provided trie databases, usually the AccountsTrie directories of a stopped
+// observer, and returns an instance able to search trie nodes in them, in the order the paths were provided
+func NewDBTrieNodesProvider(args ArgsDBTrieNodesProvider) (*dbTrieNodesProvider, error) {
+	if len(args.DBPaths) == 0 {
+		return nil, errNoTrieNodesDBPaths
+	}
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(args.DBConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &dbTrieNodesProvider{
+		persisters: make([]storage.Persister, 0, len(args.DBPaths)),
+	}
+	for _, path := range args.DBPaths {
+		persister, errCreate := persisterFactory.Create(path)
+		if errCreate != nil {
+			_ = provider.Close()
+			return nil, fmt.Errorf("%w while opening %s", errCreate, path)
+		}
+
+		provider.persisters = append(provider.persisters, persister)
+	}
+
+	return provider, nil
+}
+
+// GetTrieNode returns the trie node from the first database that holds it
+func (provider *dbTrieNodesProvider) GetTrieNode(hash []byte) ([]byte, error) {
+	for _, persister := range provider.persisters {
+		value, err := persister.Get(hash)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Close closes the opened databases
+func (provider *dbTrieNodesProvider) Close() error {
+	var lastErr error
+	for _, persister := range provider.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *dbTrieNodesProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,65 @@
+package components
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage"
+	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDB(t *testing.T, dbConfig config.DBConfig, path string, key []byte, value []byte) {
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+
+	err = persister.Put(key, value)
+	require.Nil(t, err)
+	require.Nil(t, persister.Close())
+}
+
+func TestDBTrieNodesProvider(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewDBTrieNodesProvider(ArgsDBTrieNodesProvider{})
+	require.Equal(t, errNoTrieNodesDBPaths, err)
+	require.Nil(t, provider)
+
+	dbConfig := config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+	dir := t.TempDir()
+	firstPath := filepath.Join(dir, "Epoch_1", "AccountsTrie")
+	secondPath := filepath.Join(dir, "Epoch_0", "AccountsTrie")
+	createTestDB(t, dbConfig, firstPath, []byte("key1"), []byte("value1"))
+	createTestDB(t, dbConfig, secondPath, []byte("key0"), []byte("value0"))
+
+	provider, err = NewDBTrieNodesProvider(ArgsDBTrieNodesProvider{
+		DBConfig: dbConfig,
+		DBPaths:  []string{firstPath, secondPath},
+	})
+	require.Nil(t, err)
+	require.False(t, provider.IsInterfaceNil())
+
+	value, err := provider.GetTrieNode([]byte("key1"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value1"), value)
+
+	value, err = provider.GetTrieNode([]byte("key0"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value0"), value)
+
+	value, err = provider.GetTrieNode([]byte("missing key"))
+	require.Equal(t, storage.ErrKeyNotFound, err)
+	require.Nil(t, value)
+
+	require.Nil(t, provider.Close())
+}
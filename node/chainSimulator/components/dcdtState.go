@@ -0,0 +1,230 @@
+package components
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/state"
+)
+
+var (
+	errNilTokenState           = errors.New("nil token state")
+	errEmptyTokenIdentifier    = errors.New("empty token identifier")
+	errInvalidTokenType        = errors.New("invalid token type")
+	errInvalidTokenBalance     = errors.New("invalid token balance")
+	errInvalidTokenNonce       = errors.New("invalid token nonce")
+	errUnexpectedTokenMetaData = errors.New("metadata can not be set for a fungible token")
+)
+
+// ComputeDCDTTokenKey returns the key under which an account holds the provided DCDT token (or token instance, if nonce > 0)
+func ComputeDCDTTokenKey(tokenIdentifier string, nonce uint64) []byte {
+	key := []byte(core.ProtectedKeyPrefix + core.DCDTKeyIdentifier + tokenIdentifier)
+	if nonce > 0 {
+		key = append(key, big.NewInt(0).SetUint64(nonce).Bytes()...)
+	}
+
+	return key
+}
+
+// ComputeDCDTRolesKey returns the key under which an account holds its local roles for the provided DCDT token
+func ComputeDCDTRolesKey(tokenIdentifier string) []byte {
+	return []byte(core.ProtectedKeyPrefix + core.DCDTRoleIdentifier + core.DCDTKeyIdentifier + tokenIdentifier)
+}
+
+func computeDCDTLatestNonceKey(tokenIdentifier string) []byte {
+	return []byte(core.ProtectedKeyPrefix + core.DCDTNFTLatestNonceIdentifier + tokenIdentifier)
+}
+
+// GetTokenType returns the token type as stored by the DCDT system smart contract. If not provided, the type is
+// computed from the nonce: fungible for nonce 0, non-fungible otherwise
+func GetTokenType(tokenState *dtos.TokenState) (string, error) {
+	if tokenState == nil {
+		return "", errNilTokenState
+	}
+
+	switch tokenState.Type {
+	case "":
+		if tokenState.Nonce == 0 {
+			return core.FungibleDCDT, nil
+		}
+		return core.NonFungibleDCDT, nil
+	case core.FungibleDCDT:
+		if tokenState.Nonce != 0 {
+			return "", fmt.Errorf("%w for fungible token %s", errInvalidTokenNonce, tokenState.Identifier)
+		}
+		return tokenState.Type, nil
+	case core.NonFungibleDCDT, core.SemiFungibleDCDT, common.MetaDCDT:
+		if tokenState.Nonce == 0 {
+			return "", fmt.Errorf("%w for %s token %s", errInvalidTokenNonce, tokenState.Type, tokenState.Identifier)
+		}
+		return tokenState.Type, nil
+	default:
+		return "", fmt.Errorf("%w %s for token %s", errInvalidTokenType, tokenState.Type, tokenState.Identifier)
+	}
+}
+
+// CreateDCDigitalToken converts the provided token state in the form an account stores it
+func CreateDCDigitalToken(tokenState *dtos.TokenState, pubKeyConverter core.PubkeyConverter) (*dcdt.DCDigitalToken, error) {
+	if tokenState == nil {
+		return nil, errNilTokenState
+	}
+	if len(tokenState.Identifier) == 0 {
+		return nil, errEmptyTokenIdentifier
+	}
+
+	_, err := GetTokenType(tokenState)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := big.NewInt(0).SetString(tokenState.Balance, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%w for token %s", errInvalidTokenBalance, tokenState.Identifier)
+	}
+
+	if tokenState.Nonce == 0 {
+		if tokenState.MetaData != nil {
+			return nil, fmt.Errorf("%w, token %s", errUnexpectedTokenMetaData, tokenState.Identifier)
+		}
+
+		return &dcdt.DCDigitalToken{
+			Type:  uint32(core.Fungible),
+			Value: value,
+		}, nil
+	}
+
+	metaData, err := createMetaData(tokenState.Nonce, tokenState.MetaData, pubKeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for token %s, nonce %d", err, tokenState.Identifier, tokenState.Nonce)
+	}
+
+	return &dcdt.DCDigitalToken{
+		Type:          uint32(core.NonFungible),
+		Value:         value,
+		TokenMetaData: metaData,
+	}, nil
+}
+
+// IsMetaDataSavedOnSystemAccount returns true if the metadata of the NFT/SFT/MetaDCDT instances is kept by the system
+// account instead of the accounts holding the instances
+func IsMetaDataSavedOnSystemAccount(enableEpochsHandler common.EnableEpochsHandler) bool {
+	return enableEpochsHandler.IsFlagEnabled(common.SaveToSystemAccountFlag)
+}
+
+func createMetaData(nonce uint64, tokenMetaData *dtos.TokenMetaData, pubKeyConverter core.PubkeyConverter) (*dcdt.MetaData, error) {
+	metaData := &dcdt.MetaData{
+		Nonce: nonce,
+	}
+	if tokenMetaData == nil {
+		return metaData, nil
+	}
+
+	var err error
+	if len(tokenMetaData.Creator) > 0 {
+		metaData.Creator, err = pubKeyConverter.Decode(tokenMetaData.Creator)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode creator, error: %w", err)
+		}
+	}
+
+	metaData.Hash, err = hex.DecodeString(tokenMetaData.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode hash, error: %w", err)
+	}
+
+	metaData.Attributes, err = base64.StdEncoding.DecodeString(tokenMetaData.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode attributes, error: %w", err)
+	}
+
+	metaData.Name = []byte(tokenMetaData.Name)
+	metaData.Royalties = tokenMetaData.Royalties
+	for _, uri := range tokenMetaData.URIs {
+		metaData.URIs = append(metaData.URIs, []byte(uri))
+	}
+
+	return metaData, nil
+}
+
+func (node *testOnlyProcessingNode) setTokensForAccount(userAccount state.UserAccountHandler, addressState *dtos.AddressState) error {
+	marshaller := node.CoreComponentsHolder.InternalMarshalizer()
+	pubKeyConverter := node.CoreComponentsHolder.AddressPubKeyConverter()
+	metaDataOnSystemAccount := IsMetaDataSavedOnSystemAccount(node.CoreComponentsHolder.EnableEpochsHandler())
+
+	latestNonces := make(map[string]uint64)
+	for _, tokenState := range addressState.Tokens {
+		dcdtData, err := CreateDCDigitalToken(tokenState, pubKeyConverter)
+		if err != nil {
+			return err
+		}
+		if metaDataOnSystemAccount {
+			// the holder only keeps the balance, the metadata of the instance lives on the system account
+			dcdtData.TokenMetaData = nil
+		}
+
+		dcdtDataBytes, err := marshaller.Marshal(dcdtData)
+		if err != nil {
+			return err
+		}
+
+		err = userAccount.SaveKeyValue(ComputeDCDTTokenKey(tokenState.Identifier, tokenState.Nonce), dcdtDataBytes)
+		if err != nil {
+			return err
+		}
+
+		if tokenState.Nonce > latestNonces[tokenState.Identifier] {
+			latestNonces[tokenState.Identifier] = tokenState.Nonce
+		}
+	}
+
+	for tokenIdentifier, roles := range addressState.TokenRoles {
+		if len(tokenIdentifier) == 0 {
+			return errEmptyTokenIdentifier
+		}
+
+		dcdtRoles := &dcdt.DCDTRoles{}
+		for _, role := range roles {
+			dcdtRoles.Roles = append(dcdtRoles.Roles, []byte(role))
+		}
+
+		dcdtRolesBytes, err := marshaller.Marshal(dcdtRoles)
+		if err != nil {
+			return err
+		}
+
+		err = userAccount.SaveKeyValue(ComputeDCDTRolesKey(tokenIdentifier), dcdtRolesBytes)
+		if err != nil {
+			return err
+		}
+
+		// a creator holding seeded instances should continue the nonce sequence instead of overwriting them
+		latestNonce, found := latestNonces[tokenIdentifier]
+		if !found || !hasRole(roles, core.DCDTRoleNFTCreate) {
+			continue
+		}
+
+		err = userAccount.SaveKeyValue(computeDCDTLatestNonceKey(tokenIdentifier), big.NewInt(0).SetUint64(latestNonce).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
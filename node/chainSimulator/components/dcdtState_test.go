@@ -0,0 +1,165 @@
+package components
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeDCDTTokenKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte(core.ProtectedKeyPrefix+core.DCDTKeyIdentifier+"TKN-123456"), ComputeDCDTTokenKey("TKN-123456", 0))
+	assert.Equal(t, []byte(core.ProtectedKeyPrefix+core.DCDTKeyIdentifier+"NFT-123456\x01\x00"), ComputeDCDTTokenKey("NFT-123456", 256))
+}
+
+func TestComputeDCDTRolesKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte(core.ProtectedKeyPrefix+core.DCDTRoleIdentifier+core.DCDTKeyIdentifier+"TKN-123456"), ComputeDCDTRolesKey("TKN-123456"))
+}
+
+func TestGetTokenType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil token state should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GetTokenType(nil)
+		require.Equal(t, errNilTokenState, err)
+	})
+	t.Run("empty type should be computed from nonce", func(t *testing.T) {
+		t.Parallel()
+
+		tokenType, err := GetTokenType(&dtos.TokenState{})
+		require.Nil(t, err)
+		require.Equal(t, core.FungibleDCDT, tokenType)
+
+		tokenType, err = GetTokenType(&dtos.TokenState{Nonce: 1})
+		require.Nil(t, err)
+		require.Equal(t, core.NonFungibleDCDT, tokenType)
+	})
+	t.Run("nonce not matching the type should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GetTokenType(&dtos.TokenState{Type: core.FungibleDCDT, Nonce: 1})
+		require.ErrorIs(t, err, errInvalidTokenNonce)
+
+		_, err = GetTokenType(&dtos.TokenState{Type: core.SemiFungibleDCDT})
+		require.ErrorIs(t, err, errInvalidTokenNonce)
+	})
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GetTokenType(&dtos.TokenState{Type: "unknown"})
+		require.ErrorIs(t, err, errInvalidTokenType)
+	})
+	t.Run("meta token should work", func(t *testing.T) {
+		t.Parallel()
+
+		tokenType, err := GetTokenType(&dtos.TokenState{Type: common.MetaDCDT, Nonce: 3})
+		require.Nil(t, err)
+		require.Equal(t, common.MetaDCDT, tokenType)
+	})
+}
+
+func TestIsMetaDataSavedOnSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, IsMetaDataSavedOnSystemAccount(enableEpochsHandlerMock.NewEnableEpochsHandlerStub()))
+	require.True(t, IsMetaDataSavedOnSystemAccount(enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.SaveToSystemAccountFlag)))
+}
+
+func TestCreateDCDigitalToken(t *testing.T) {
+	t.Parallel()
+
+	pubKeyConverter := testscommon.NewPubkeyConverterMock(32)
+
+	t.Run("empty identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcdtData, err := CreateDCDigitalToken(&dtos.TokenState{Balance: "1"}, pubKeyConverter)
+		require.Equal(t, errEmptyTokenIdentifier, err)
+		require.Nil(t, dcdtData)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcdtData, err := CreateDCDigitalToken(&dtos.TokenState{Identifier: "TKN-123456", Balance: "-1"}, pubKeyConverter)
+		require.ErrorIs(t, err, errInvalidTokenBalance)
+		require.Nil(t, dcdtData)
+
+		dcdtData, err = CreateDCDigitalToken(&dtos.TokenState{Identifier: "TKN-123456"}, pubKeyConverter)
+		require.ErrorIs(t, err, errInvalidTokenBalance)
+		require.Nil(t, dcdtData)
+	})
+	t.Run("fungible token with metadata should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcdtData, err := CreateDCDigitalToken(&dtos.TokenState{
+			Identifier: "TKN-123456",
+			Balance:    "10",
+			MetaData:   &dtos.TokenMetaData{},
+		}, pubKeyConverter)
+		require.ErrorIs(t, err, errUnexpectedTokenMetaData)
+		require.Nil(t, dcdtData)
+	})
+	t.Run("fungible token should work", func(t *testing.T) {
+		t.Parallel()
+
+		dcdtData, err := CreateDCDigitalToken(&dtos.TokenState{Identifier: "TKN-123456", Balance: "10"}, pubKeyConverter)
+		require.Nil(t, err)
+		require.Equal(t, uint32(core.Fungible), dcdtData.Type)
+		require.Equal(t, big.NewInt(10), dcdtData.Value)
+		require.Nil(t, dcdtData.TokenMetaData)
+	})
+	t.Run("non fungible token should work", func(t *testing.T) {
+		t.Parallel()
+
+		creator := "0000000000000000000000000000000000000000000000000000000000000001"
+		dcdtData, err := CreateDCDigitalToken(&dtos.TokenState{
+			Identifier: "NFT-123456",
+			Nonce:      7,
+			Balance:    "1",
+			MetaData: &dtos.TokenMetaData{
+				Name:       "name",
+				Creator:    creator,
+				Royalties:  1000,
+				Hash:       "aabb",
+				URIs:       []string{"uri1", "uri2"},
+				Attributes: "YXR0cmlidXRlcw==",
+			},
+		}, pubKeyConverter)
+		require.Nil(t, err)
+		require.Equal(t, uint32(core.NonFungible), dcdtData.Type)
+		require.Equal(t, big.NewInt(1), dcdtData.Value)
+		require.Equal(t, uint64(7), dcdtData.TokenMetaData.Nonce)
+		require.Equal(t, []byte("name"), dcdtData.TokenMetaData.Name)
+		require.Equal(t, append(make([]byte, 31), 1), dcdtData.TokenMetaData.Creator)
+		require.Equal(t, uint32(1000), dcdtData.TokenMetaData.Royalties)
+		require.Equal(t, []byte{0xaa, 0xbb}, dcdtData.TokenMetaData.Hash)
+		require.Equal(t, [][]byte{[]byte("uri1"), []byte("uri2")}, dcdtData.TokenMetaData.URIs)
+		require.Equal(t, []byte("attributes"), dcdtData.TokenMetaData.Attributes)
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		dcdtData, err := CreateDCDigitalToken(&dtos.TokenState{
+			Identifier: "NFT-123456",
+			Nonce:      7,
+			Balance:    "1",
+			MetaData: &dtos.TokenMetaData{
+				Hash: "not hex",
+			},
+		}, pubKeyConverter)
+		require.NotNil(t, err)
+		require.Nil(t, dcdtData)
+	})
+}
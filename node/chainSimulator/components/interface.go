@@ -16,3 +16,10 @@ type SyncedBroadcastNetworkHandler interface {
 type APIConfigurator interface {
 	RestApiInterface(shardID uint32) string
 }
+
+// TrieNodesProvider defines a source able to provide the trie nodes that are missing from the local storage
+type TrieNodesProvider interface {
+	GetTrieNode(hash []byte) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -1,6 +1,7 @@
 package components
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -10,6 +11,10 @@ type manualRoundHandler struct {
 	genesisTimeStamp int64
 	roundDuration    time.Duration
 	initialRound     int64
+
+	mutTimeStamps   sync.RWMutex
+	useWallClock    bool
+	timeStampOffset time.Duration
 }
 
 // NewManualRoundHandler returns a manual round handler instance
@@ -27,6 +32,11 @@ func (handler *manualRoundHandler) IncrementIndex() {
 	atomic.AddInt64(&handler.index, 1)
 }
 
+// SetIndex will set the current round index
+func (handler *manualRoundHandler) SetIndex(index int64) {
+	atomic.StoreInt64(&handler.index, index)
+}
+
 // Index returns the current index
 func (handler *manualRoundHandler) Index() int64 {
 	return atomic.LoadInt64(&handler.index)
@@ -41,11 +51,29 @@ func (handler *manualRoundHandler) BeforeGenesis() bool {
 func (handler *manualRoundHandler) UpdateRound(_ time.Time, _ time.Time) {
 }
 
-// TimeStamp returns the time based of the genesis timestamp and the current round
+// SetTimeStampsConfig sets the way the timestamps are computed: either from the wall clock, either from the genesis
+// timestamp and the current round. The provided offset is added in both cases
+func (handler *manualRoundHandler) SetTimeStampsConfig(useWallClock bool, offset time.Duration) {
+	handler.mutTimeStamps.Lock()
+	handler.useWallClock = useWallClock
+	handler.timeStampOffset = offset
+	handler.mutTimeStamps.Unlock()
+}
+
+// TimeStamp returns the time based of the genesis timestamp and the current round or the wall clock time, if set so
 func (handler *manualRoundHandler) TimeStamp() time.Time {
+	handler.mutTimeStamps.RLock()
+	useWallClock := handler.useWallClock
+	offset := handler.timeStampOffset
+	handler.mutTimeStamps.RUnlock()
+
+	if useWallClock {
+		return time.Unix(time.Now().Add(offset).Unix(), 0)
+	}
+
 	rounds := atomic.LoadInt64(&handler.index)
 	timeFromGenesis := handler.roundDuration * time.Duration(rounds)
-	timestamp := time.Unix(handler.genesisTimeStamp, 0).Add(timeFromGenesis)
+	timestamp := time.Unix(handler.genesisTimeStamp, 0).Add(timeFromGenesis).Add(offset)
 	timestamp = time.Unix(timestamp.Unix()-int64(handler.roundDuration.Seconds())*handler.initialRound, 0)
 	return timestamp
 }
@@ -34,6 +34,9 @@ func TestManualRoundHandler_Operations(t *testing.T) {
 	require.Equal(t, providedIndex, handler.Index())
 	handler.IncrementIndex()
 	require.Equal(t, providedIndex+1, handler.Index())
+	handler.SetIndex(providedIndex + 10)
+	require.Equal(t, providedIndex+10, handler.Index())
+	handler.SetIndex(providedIndex + 1)
 	expectedTimestamp := time.Unix(handler.genesisTimeStamp, 0).Add(providedRoundDuration)
 	require.Equal(t, expectedTimestamp, handler.TimeStamp())
 	require.Equal(t, providedRoundDuration, handler.TimeDuration())
@@ -42,3 +45,23 @@ func TestManualRoundHandler_Operations(t *testing.T) {
 	require.False(t, handler.BeforeGenesis())
 	handler.UpdateRound(time.Now(), time.Now()) // for coverage only
 }
+
+func TestManualRoundHandler_SetTimeStampsConfig(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(1000, 0)
+	providedRoundDuration := time.Second
+	handler := NewManualRoundHandler(genesisTime.Unix(), providedRoundDuration, 0)
+	handler.IncrementIndex()
+
+	handler.SetTimeStampsConfig(false, time.Hour)
+	require.Equal(t, genesisTime.Add(providedRoundDuration).Add(time.Hour), handler.TimeStamp())
+
+	handler.SetTimeStampsConfig(true, 0)
+	timestamp := handler.TimeStamp()
+	require.LessOrEqual(t, time.Since(timestamp), time.Second*2)
+
+	handler.SetTimeStampsConfig(true, -time.Hour)
+	timestamp = handler.TimeStamp()
+	require.LessOrEqual(t, time.Since(timestamp.Add(time.Hour)), time.Second*2)
+}
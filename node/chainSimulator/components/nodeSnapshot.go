@@ -0,0 +1,468 @@
+package components
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	chainData "github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/storage"
+)
+
+var (
+	errSnapshotNotFound       = errors.New("snapshot not found")
+	errSnapshotAlreadyExists  = errors.New("snapshot already exists")
+	errSnapshotEpochMismatch  = errors.New("can not revert to a snapshot taken in a different epoch")
+	errNotAManualRoundHandler = errors.New("round handler is not a manual round handler")
+)
+
+type roundIndexSetter interface {
+	SetIndex(index int64)
+}
+
+type revertedMiniblocksMetadataUnmarker interface {
+	UnmarkRevertedMiniblocksMetadata(blockHeader chainData.HeaderHandler, blockBody chainData.BodyHandler)
+}
+
+type pooledData struct {
+	cacheID string
+	key     []byte
+	value   interface{}
+	size    int
+}
+
+type headerWithHash struct {
+	header chainData.HeaderHandler
+	hash   []byte
+}
+
+type nodeSnapshot struct {
+	roundIndex            int64
+	epoch                 uint32
+	currentHeader         chainData.HeaderHandler
+	currentHeaderHash     []byte
+	currentRootHash       []byte
+	finalNonce            uint64
+	finalHash             []byte
+	finalRootHash         []byte
+	accountsRootHash      []byte
+	peerAccountsRootHash  []byte
+	crossNotarizedHeaders map[uint32]*headerWithHash
+	selfNotarizedHeaders  map[uint32]*headerWithHash
+	trackedHeaders        []*headerWithHash
+	transactions          []*pooledData
+	unsignedTransactions  []*pooledData
+	rewardTransactions    []*pooledData
+	miniBlocks            []*pooledData
+	headers               []*headerWithHash
+	storedData            map[dataRetriever.UnitType]map[string][]byte
+}
+
+// TakeSnapshot will save the current state of the node under the provided identifier
+func (node *testOnlyProcessingNode) TakeSnapshot(id uint64) error {
+	node.mutSnapshots.Lock()
+	defer node.mutSnapshots.Unlock()
+
+	_, exists := node.snapshots[id]
+	if exists {
+		return fmt.Errorf("%w for id %d", errSnapshotAlreadyExists, id)
+	}
+
+	snapshot := &nodeSnapshot{
+		roundIndex:        node.CoreComponentsHolder.RoundHandler().Index(),
+		epoch:             node.CoreComponentsHolder.EnableEpochsHandler().GetCurrentEpoch(),
+		currentHeader:     node.ChainHandler.GetCurrentBlockHeader(),
+		currentHeaderHash: node.ChainHandler.GetCurrentBlockHeaderHash(),
+		currentRootHash:   node.ChainHandler.GetCurrentBlockRootHash(),
+	}
+	snapshot.finalNonce, snapshot.finalHash, snapshot.finalRootHash = node.ChainHandler.GetFinalBlockInfo()
+
+	var err error
+	snapshot.accountsRootHash, err = node.StateComponentsHolder.AccountsAdapter().RootHash()
+	if err != nil {
+		return err
+	}
+
+	if node.GetShardCoordinator().SelfId() == core.MetachainShardId {
+		snapshot.peerAccountsRootHash, err = node.StateComponentsHolder.PeerAccounts().RootHash()
+		if err != nil {
+			return err
+		}
+	}
+
+	node.snapshotBlockTracker(snapshot)
+
+	marshaller := node.CoreComponentsHolder.InternalMarshalizer()
+	snapshot.transactions = node.snapshotShardedPool(node.DataPool.Transactions(), marshaller)
+	snapshot.unsignedTransactions = node.snapshotShardedPool(node.DataPool.UnsignedTransactions(), marshaller)
+	snapshot.rewardTransactions = node.snapshotShardedPool(node.DataPool.RewardTransactions(), marshaller)
+	snapshot.miniBlocks = snapshotCacher(node.DataPool.MiniBlocks(), marshaller)
+	snapshot.headers = node.snapshotHeadersPool()
+	snapshot.storedData = node.snapshotStorage()
+
+	node.snapshots[id] = snapshot
+
+	return nil
+}
+
+// ReleaseSnapshot will free the state saved under the provided identifier. Releasing an unknown snapshot has no effect
+func (node *testOnlyProcessingNode) ReleaseSnapshot(id uint64) {
+	node.mutSnapshots.Lock()
+	delete(node.snapshots, id)
+	node.mutSnapshots.Unlock()
+}
+
+// RevertToSnapshot will restore the state of the node saved under the provided identifier
+func (node *testOnlyProcessingNode) RevertToSnapshot(id uint64) error {
+	node.mutSnapshots.RLock()
+	snapshot, found := node.snapshots[id]
+	node.mutSnapshots.RUnlock()
+	if !found {
+		return fmt.Errorf("%w for id %d", errSnapshotNotFound, id)
+	}
+
+	currentEpoch := node.CoreComponentsHolder.EnableEpochsHandler().GetCurrentEpoch()
+	if currentEpoch != snapshot.epoch {
+		return fmt.Errorf("%w, snapshot epoch %d, current epoch %d", errSnapshotEpochMismatch, snapshot.epoch, currentEpoch)
+	}
+
+	roundHandler, ok := node.CoreComponentsHolder.RoundHandler().(roundIndexSetter)
+	if !ok {
+		return errNotAManualRoundHandler
+	}
+
+	err := node.revertBlocksFromHistory(snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = node.StateComponentsHolder.AccountsAdapter().RecreateTrie(snapshot.accountsRootHash)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshot.peerAccountsRootHash) > 0 {
+		err = node.StateComponentsHolder.PeerAccounts().RecreateTrie(snapshot.peerAccountsRootHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = node.ChainHandler.SetCurrentBlockHeaderAndRootHash(snapshot.currentHeader, snapshot.currentRootHash)
+	if err != nil {
+		return err
+	}
+	node.ChainHandler.SetCurrentBlockHeaderHash(snapshot.currentHeaderHash)
+	node.ChainHandler.SetFinalBlockInfo(snapshot.finalNonce, snapshot.finalHash, snapshot.finalRootHash)
+
+	roundHandler.SetIndex(snapshot.roundIndex)
+
+	node.ProcessComponentsHolder.ForkDetector().RestoreToGenesis()
+	node.restoreBlockTracker(snapshot)
+
+	node.restoreShardedPool(node.DataPool.Transactions(), snapshot.transactions)
+	node.restoreShardedPool(node.DataPool.UnsignedTransactions(), snapshot.unsignedTransactions)
+	node.restoreShardedPool(node.DataPool.RewardTransactions(), snapshot.rewardTransactions)
+	restoreCacher(node.DataPool.MiniBlocks(), snapshot.miniBlocks)
+	node.restoreHeadersPool(snapshot.headers)
+	node.DataPool.CurrentBlockTxs().Clean()
+
+	return node.restoreStorage(snapshot.storedData)
+}
+
+// revertBlocksFromHistory notifies the history repository about the blocks committed after the snapshot, so the
+// transactions included in them will be properly indexed if executed again. The genesis block is never reverted
+func (node *testOnlyProcessingNode) revertBlocksFromHistory(snapshot *nodeSnapshot) error {
+	historyRepository := node.ProcessComponentsHolder.HistoryRepository()
+	if !historyRepository.IsEnabled() {
+		return nil
+	}
+
+	snapshotNonce := uint64(0)
+	if !check.IfNil(snapshot.currentHeader) {
+		snapshotNonce = snapshot.currentHeader.GetNonce()
+	}
+
+	unmarker, canUnmark := historyRepository.(revertedMiniblocksMetadataUnmarker)
+	shardID := node.GetShardCoordinator().SelfId()
+	marshaller := node.CoreComponentsHolder.InternalMarshalizer()
+	header := node.ChainHandler.GetCurrentBlockHeader()
+	for !check.IfNil(header) && header.GetNonce() > snapshotNonce {
+		body, err := node.getBlockBodyFromStorage(header, marshaller)
+		if err != nil {
+			return err
+		}
+
+		err = historyRepository.RevertBlock(header, body)
+		if err != nil {
+			return err
+		}
+		if canUnmark {
+			unmarker.UnmarkRevertedMiniblocksMetadata(header, body)
+		}
+
+		header, err = process.GetHeaderFromStorage(shardID, header.GetPrevHash(), marshaller, node.StoreService)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (node *testOnlyProcessingNode) getBlockBodyFromStorage(header chainData.HeaderHandler, marshaller marshal.Marshalizer) (*block.Body, error) {
+	miniBlocksStorer, err := node.StoreService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &block.Body{}
+	for _, miniBlockHash := range header.GetMiniBlockHeadersHashes() {
+		buff, errGet := miniBlocksStorer.Get(miniBlockHash)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = marshaller.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (node *testOnlyProcessingNode) allShardIDs() []uint32 {
+	numShards := node.GetShardCoordinator().NumberOfShards()
+	shardIDs := make([]uint32, 0, numShards+1)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+func (node *testOnlyProcessingNode) snapshotBlockTracker(snapshot *nodeSnapshot) {
+	blockTracker := node.ProcessComponentsHolder.BlockTracker()
+
+	snapshot.crossNotarizedHeaders = make(map[uint32]*headerWithHash)
+	snapshot.selfNotarizedHeaders = make(map[uint32]*headerWithHash)
+	snapshot.trackedHeaders = make([]*headerWithHash, 0)
+	for _, shardID := range node.allShardIDs() {
+		header, hash, err := blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err == nil {
+			snapshot.crossNotarizedHeaders[shardID] = &headerWithHash{header: header, hash: hash}
+		}
+
+		header, hash, err = blockTracker.GetLastSelfNotarizedHeader(shardID)
+		if err == nil {
+			snapshot.selfNotarizedHeaders[shardID] = &headerWithHash{header: header, hash: hash}
+		}
+
+		headers, hashes := blockTracker.GetTrackedHeaders(shardID)
+		for idx := range headers {
+			snapshot.trackedHeaders = append(snapshot.trackedHeaders, &headerWithHash{header: headers[idx], hash: hashes[idx]})
+		}
+	}
+}
+
+func (node *testOnlyProcessingNode) restoreBlockTracker(snapshot *nodeSnapshot) {
+	blockTracker := node.ProcessComponentsHolder.BlockTracker()
+	blockTracker.RestoreToGenesis()
+
+	for shardID, notarized := range snapshot.crossNotarizedHeaders {
+		_, genesisHash, err := blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err == nil && bytes.Equal(genesisHash, notarized.hash) {
+			continue
+		}
+
+		blockTracker.AddCrossNotarizedHeader(shardID, notarized.header, notarized.hash)
+	}
+
+	for shardID, notarized := range snapshot.selfNotarizedHeaders {
+		_, genesisHash, err := blockTracker.GetLastSelfNotarizedHeader(shardID)
+		if err == nil && bytes.Equal(genesisHash, notarized.hash) {
+			continue
+		}
+
+		blockTracker.AddSelfNotarizedHeader(shardID, notarized.header, notarized.hash)
+	}
+
+	for _, tracked := range snapshot.trackedHeaders {
+		blockTracker.AddTrackedHeader(tracked.header, tracked.hash)
+	}
+}
+
+func (node *testOnlyProcessingNode) snapshotShardedPool(pool dataRetriever.ShardedDataCacherNotifier, marshaller marshal.Marshalizer) []*pooledData {
+	result := make([]*pooledData, 0)
+	seenKeys := make(map[string]struct{})
+	for _, senderShardID := range node.allShardIDs() {
+		for _, receiverShardID := range node.allShardIDs() {
+			cacheID := process.ShardCacherIdentifier(senderShardID, receiverShardID)
+			cacher := pool.ShardDataStore(cacheID)
+			if check.IfNil(cacher) {
+				continue
+			}
+
+			for _, key := range cacher.Keys() {
+				_, seen := seenKeys[string(key)]
+				if seen {
+					continue
+				}
+
+				value, found := cacher.Peek(key)
+				if !found {
+					continue
+				}
+
+				seenKeys[string(key)] = struct{}{}
+				result = append(result, &pooledData{
+					cacheID: cacheID,
+					key:     key,
+					value:   value,
+					size:    computeSize(marshaller, value),
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+func (node *testOnlyProcessingNode) restoreShardedPool(pool dataRetriever.ShardedDataCacherNotifier, data []*pooledData) {
+	pool.Clear()
+	for _, entry := range data {
+		pool.AddData(entry.key, entry.value, entry.size, entry.cacheID)
+	}
+}
+
+func snapshotCacher(cacher storage.Cacher, marshaller marshal.Marshalizer) []*pooledData {
+	keys := cacher.Keys()
+	result := make([]*pooledData, 0, len(keys))
+	for _, key := range keys {
+		value, found := cacher.Peek(key)
+		if !found {
+			continue
+		}
+
+		result = append(result, &pooledData{
+			key:   key,
+			value: value,
+			size:  computeSize(marshaller, value),
+		})
+	}
+
+	return result
+}
+
+func restoreCacher(cacher storage.Cacher, data []*pooledData) {
+	cacher.Clear()
+	for _, entry := range data {
+		cacher.Put(entry.key, entry.value, entry.size)
+	}
+}
+
+func (node *testOnlyProcessingNode) snapshotHeadersPool() []*headerWithHash {
+	headersPool := node.DataPool.Headers()
+	result := make([]*headerWithHash, 0, headersPool.Len())
+	for _, shardID := range node.allShardIDs() {
+		for _, nonce := range headersPool.Nonces(shardID) {
+			headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
+			if err != nil {
+				continue
+			}
+
+			for idx := range headers {
+				result = append(result, &headerWithHash{header: headers[idx], hash: hashes[idx]})
+			}
+		}
+	}
+
+	return result
+}
+
+func (node *testOnlyProcessingNode) restoreHeadersPool(headers []*headerWithHash) {
+	headersPool := node.DataPool.Headers()
+	headersPool.Clear()
+	for _, entry := range headers {
+		headersPool.AddHeader(entry.hash, entry.header)
+	}
+}
+
+// isSnapshotStorageUnit returns false for the tries units: their data is addressed by hash, so the recreated tries
+// will simply ignore the nodes written after the snapshot
+func isSnapshotStorageUnit(unitType dataRetriever.UnitType) bool {
+	return unitType != dataRetriever.UserAccountsUnit && unitType != dataRetriever.PeerAccountsUnit
+}
+
+func (node *testOnlyProcessingNode) snapshotStorage() map[dataRetriever.UnitType]map[string][]byte {
+	result := make(map[dataRetriever.UnitType]map[string][]byte)
+	for unitType, storer := range node.StoreService.GetAllStorers() {
+		if !isSnapshotStorageUnit(unitType) {
+			continue
+		}
+
+		unitData := make(map[string][]byte)
+		storer.RangeKeys(func(key []byte, value []byte) bool {
+			unitData[string(key)] = value
+			return true
+		})
+		result[unitType] = unitData
+	}
+
+	return result
+}
+
+// restoreStorage removes the data written after the snapshot, so the transactions and blocks from the reverted
+// rounds are no longer reported by the node
+func (node *testOnlyProcessingNode) restoreStorage(storedData map[dataRetriever.UnitType]map[string][]byte) error {
+	for unitType, storer := range node.StoreService.GetAllStorers() {
+		if !isSnapshotStorageUnit(unitType) {
+			continue
+		}
+
+		unitData := storedData[unitType]
+		keysToRemove := make([][]byte, 0)
+		storer.RangeKeys(func(key []byte, value []byte) bool {
+			_, found := unitData[string(key)]
+			if !found {
+				keysToRemove = append(keysToRemove, key)
+			}
+			return true
+		})
+
+		for _, key := range keysToRemove {
+			err := storer.Remove(key)
+			if err != nil {
+				return fmt.Errorf("%w while restoring the %s storage unit", err, unitType.String())
+			}
+		}
+
+		for key, value := range unitData {
+			err := storer.Put([]byte(key), value)
+			if err != nil {
+				return fmt.Errorf("%w while restoring the %s storage unit", err, unitType.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+func computeSize(marshaller marshal.Marshalizer, value interface{}) int {
+	buff, err := marshaller.Marshal(value)
+	if err != nil {
+		return 0
+	}
+
+	return len(buff)
+}
@@ -0,0 +1,92 @@
+package components
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	getTrieNodeEndpoint           = "/proof/trie-node/"
+	defaultObserverRequestTimeout = 10 * time.Second
+)
+
+var errEmptyObserverURL = errors.New("empty observer URL")
+
+type trieNodeResponse struct {
+	Data struct {
+		Node string `json:"node"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type observerTrieNodesProvider struct {
+	observerURL string
+	httpClient  *http.Client
+}
+
+// NewObserverTrieNodesProvider returns an instance able to fetch trie nodes from the REST API of an observer
+// synchronized on the same shard
+func NewObserverTrieNodesProvider(observerURL string) (*observerTrieNodesProvider, error) {
+	if len(observerURL) == 0 {
+		return nil, errEmptyObserverURL
+	}
+
+	return &observerTrieNodesProvider{
+		observerURL: strings.TrimSuffix(observerURL, "/"),
+		httpClient: &http.Client{
+			Timeout: defaultObserverRequestTimeout,
+		},
+	}, nil
+}
+
+// GetTrieNode requests the encoded trie node from the observer
+func (provider *observerTrieNodesProvider) GetTrieNode(hash []byte) ([]byte, error) {
+	url := provider.observerURL + getTrieNodeEndpoint + hex.EncodeToString(hash)
+	resp, err := provider.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		errClose := resp.Body.Close()
+		if errClose != nil {
+			log.Warn("observerTrieNodesProvider: close response body", "error", errClose)
+		}
+	}()
+
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &trieNodeResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("observer responded with status code %d, error: %s", resp.StatusCode, response.Error)
+	}
+
+	return hex.DecodeString(response.Data.Node)
+}
+
+// Close closes the idle connections
+func (provider *observerTrieNodesProvider) Close() error {
+	provider.httpClient.CloseIdleConnections()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *observerTrieNodesProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
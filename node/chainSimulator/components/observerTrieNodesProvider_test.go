@@ -0,0 +1,50 @@
+package components
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObserverTrieNodesProvider(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewObserverTrieNodesProvider("")
+	require.Equal(t, errEmptyObserverURL, err)
+	require.Nil(t, provider)
+
+	provider, err = NewObserverTrieNodesProvider("http://localhost:8080/")
+	require.Nil(t, err)
+	require.False(t, provider.IsInterfaceNil())
+	require.Equal(t, "http://localhost:8080", provider.observerURL)
+	require.Nil(t, provider.Close())
+}
+
+func TestObserverTrieNodesProvider_GetTrieNode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/proof/trie-node/aabb" {
+			_, _ = w.Write([]byte(`{"data":{"node":"0102"},"error":"","code":"successful"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"data":null,"error":"key not found","code":"internal_issue"}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewObserverTrieNodesProvider(server.URL)
+	require.Nil(t, err)
+
+	node, err := provider.GetTrieNode([]byte{0xaa, 0xbb})
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, node)
+
+	node, err = provider.GetTrieNode([]byte{0xcc})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "key not found")
+	require.Nil(t, node)
+}
@@ -29,6 +29,7 @@ type stateComponentsHolder struct {
 	triesContainer           common.TriesHolder
 	triesStorageManager      map[string]common.StorageManager
 	missingTrieNodesNotifier common.MissingTrieNodesNotifier
+	flatState                state.FlatStateHandler
 	stateComponentsCloser    io.Closer
 }
 
@@ -70,6 +71,7 @@ func CreateStateComponents(args ArgsStateComponents) (*stateComponentsHolder, er
 		triesContainer:           stateComp.TriesContainer(),
 		triesStorageManager:      stateComp.TrieStorageManagers(),
 		missingTrieNodesNotifier: stateComp.MissingTrieNodesNotifier(),
+		flatState:                stateComp.FlatState(),
 		stateComponentsCloser:    stateComp,
 	}, nil
 }
@@ -109,6 +111,11 @@ func (s *stateComponentsHolder) MissingTrieNodesNotifier() common.MissingTrieNod
 	return s.missingTrieNodesNotifier
 }
 
+// FlatState will return the flat state
+func (s *stateComponentsHolder) FlatState() state.FlatStateHandler {
+	return s.flatState
+}
+
 // Close will close the state components
 func (s *stateComponentsHolder) Close() error {
 	return s.stateComponentsCloser.Close()
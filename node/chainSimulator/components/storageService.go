@@ -6,6 +6,17 @@ import (
 
 // CreateStore creates a storage service for shard nodes
 func CreateStore(numOfShards uint32) dataRetriever.StorageService {
+	return CreateStoreWithTrieNodesProviders(numOfShards, nil)
+}
+
+// CreateStoreWithTrieNodesProviders creates a storage service for shard nodes that will lazily fetch the missing
+// accounts trie nodes from the provided sources
+func CreateStoreWithTrieNodesProviders(numOfShards uint32, trieNodesProviders []TrieNodesProvider) dataRetriever.StorageService {
+	userAccountsUnit := CreateMemUnitForTries()
+	if len(trieNodesProviders) > 0 {
+		userAccountsUnit = CreateMemUnitForTriesWithFallback(trieNodesProviders)
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.MiniBlockUnit, CreateMemUnit())
@@ -20,7 +31,7 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.ReceiptsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ScheduledSCRsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.TxLogsUnit, CreateMemUnit())
-	store.AddStorer(dataRetriever.UserAccountsUnit, CreateMemUnitForTries())
+	store.AddStorer(dataRetriever.UserAccountsUnit, userAccountsUnit)
 	store.AddStorer(dataRetriever.PeerAccountsUnit, CreateMemUnitForTries())
 	store.AddStorer(dataRetriever.DCDTSuppliesUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.RoundHdrHashDataUnit, CreateMemUnit())
@@ -29,6 +40,8 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.EpochByHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ResultsHashesByTxHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.EventsByAddressUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.TimestampToNonceUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -36,6 +36,8 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.EpochByHashUnit,
 		dataRetriever.ResultsHashesByTxHashUnit,
 		dataRetriever.TrieEpochRootHashUnit,
+		dataRetriever.EventsByAddressUnit,
+		dataRetriever.TimestampToNonceUnit,
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.UnitType(101), // shard 2
 	}
@@ -0,0 +1,87 @@
+package components
+
+import (
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/storage"
+)
+
+type storerWithFallback struct {
+	storage.Storer
+	providers []TrieNodesProvider
+}
+
+// CreateMemUnitForTriesWithFallback returns a storer used on tries instances that will lazily fetch the missing trie
+// nodes from the provided sources, in the provided order. The fetched nodes are kept in memory for further use
+func CreateMemUnitForTriesWithFallback(providers []TrieNodesProvider) storage.Storer {
+	return &trieStorage{
+		Storer: newStorerWithFallback(CreateMemUnit(), providers),
+	}
+}
+
+func newStorerWithFallback(storer storage.Storer, providers []TrieNodesProvider) *storerWithFallback {
+	nonNilProviders := make([]TrieNodesProvider, 0, len(providers))
+	for _, provider := range providers {
+		if !check.IfNil(provider) {
+			nonNilProviders = append(nonNilProviders, provider)
+		}
+	}
+
+	return &storerWithFallback{
+		Storer:    storer,
+		providers: nonNilProviders,
+	}
+}
+
+// Get returns the value from the local storer. If not found, the value is requested from the fallback providers
+func (swf *storerWithFallback) Get(key []byte) ([]byte, error) {
+	value, err := swf.Storer.Get(key)
+	if err == nil {
+		return value, nil
+	}
+
+	for _, provider := range swf.providers {
+		value, errGet := provider.GetTrieNode(key)
+		if errGet != nil || len(value) == 0 {
+			continue
+		}
+
+		errPut := swf.Storer.Put(key, value)
+		if errPut != nil {
+			log.Warn("storerWithFallback: could not cache the fetched trie node", "error", errPut)
+		}
+
+		return value, nil
+	}
+
+	return nil, err
+}
+
+// Has returns nil if the key is found either in the local storer or in one of the fallback providers
+func (swf *storerWithFallback) Has(key []byte) error {
+	_, err := swf.Get(key)
+
+	return err
+}
+
+// Close closes the local storer and the fallback providers
+func (swf *storerWithFallback) Close() error {
+	var lastErr error
+	for _, provider := range swf.providers {
+		err := provider.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	err := swf.Storer.Close()
+	if err != nil {
+		return err
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (swf *storerWithFallback) IsInterfaceNil() bool {
+	return swf == nil
+}
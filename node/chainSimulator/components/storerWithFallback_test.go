@@ -0,0 +1,105 @@
+package components
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type trieNodesProviderStub struct {
+	GetTrieNodeCalled func(hash []byte) ([]byte, error)
+	CloseCalled       func() error
+}
+
+func (stub *trieNodesProviderStub) GetTrieNode(hash []byte) ([]byte, error) {
+	if stub.GetTrieNodeCalled != nil {
+		return stub.GetTrieNodeCalled(hash)
+	}
+
+	return nil, errors.New("not found")
+}
+
+func (stub *trieNodesProviderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+func (stub *trieNodesProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestCreateMemUnitForTriesWithFallback(t *testing.T) {
+	t.Parallel()
+
+	t.Run("local value should not query the providers", func(t *testing.T) {
+		t.Parallel()
+
+		provider := &trieNodesProviderStub{
+			GetTrieNodeCalled: func(hash []byte) ([]byte, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		storer := CreateMemUnitForTriesWithFallback([]TrieNodesProvider{provider})
+		err := storer.Put([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+
+		value, err := storer.Get([]byte("key"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("value"), value)
+	})
+	t.Run("missing value should be fetched from the first provider holding it and cached", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		firstProvider := &trieNodesProviderStub{}
+		secondProvider := &trieNodesProviderStub{
+			GetTrieNodeCalled: func(hash []byte) ([]byte, error) {
+				numCalls++
+				return append([]byte("value for "), hash...), nil
+			},
+		}
+		storer := CreateMemUnitForTriesWithFallback([]TrieNodesProvider{nil, firstProvider, secondProvider})
+
+		value, err := storer.Get([]byte("key"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("value for key"), value)
+
+		require.Nil(t, storer.Has([]byte("key")))
+		value, err = storer.GetFromEpoch([]byte("key"), 0)
+		require.Nil(t, err)
+		require.Equal(t, []byte("value for key"), value)
+		require.Equal(t, 1, numCalls)
+	})
+	t.Run("value missing from all providers should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := CreateMemUnitForTriesWithFallback([]TrieNodesProvider{&trieNodesProviderStub{}})
+
+		value, err := storer.Get([]byte("key"))
+		require.NotNil(t, err)
+		require.Nil(t, value)
+		require.NotNil(t, storer.Has([]byte("key")))
+	})
+	t.Run("close should close the providers", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		closeCalled := false
+		provider := &trieNodesProviderStub{
+			CloseCalled: func() error {
+				closeCalled = true
+				return expectedErr
+			},
+		}
+		storer := CreateMemUnitForTriesWithFallback([]TrieNodesProvider{provider})
+
+		err := storer.Close()
+		require.Equal(t, expectedErr, err)
+		require.True(t, closeCalled)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	chainData "github.com/kalyan3104/k-chain-core-go/data"
@@ -46,6 +47,7 @@ type ArgsTestOnlyProcessingNode struct {
 	MinNodesPerShard       uint32
 	MinNodesMeta           uint32
 	RoundDurationInMillis  uint64
+	TrieNodesProviders     []TrieNodesProvider
 }
 
 type testOnlyProcessingNode struct {
@@ -70,14 +72,18 @@ type testOnlyProcessingNode struct {
 
 	httpServer    shared.UpgradeableHttpServerHandler
 	facadeHandler shared.FacadeHandler
+
+	mutSnapshots sync.RWMutex
+	snapshots    map[uint64]*nodeSnapshot
 }
 
 // NewTestOnlyProcessingNode creates a new instance of a node that is able to only process transactions
 func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProcessingNode, error) {
 	instance := &testOnlyProcessingNode{
 		ArgumentsParser: smartContract.NewArgumentParser(),
-		StoreService:    CreateStore(args.NumShards),
+		StoreService:    CreateStoreWithTrieNodesProviders(args.NumShards, args.TrieNodesProviders),
 		closeHandler:    NewCloseHandler(),
+		snapshots:       make(map[uint64]*nodeSnapshot),
 	}
 
 	var err error
@@ -263,6 +269,7 @@ func (node *testOnlyProcessingNode) createDataPool(args ArgsTestOnlyProcessingNo
 		ShardCoordinator: node.BootstrapComponentsHolder.ShardCoordinator(),
 		Marshalizer:      node.CoreComponentsHolder.InternalMarshalizer(),
 		PathManager:      node.CoreComponentsHolder.PathHandler(),
+		AppStatusHandler: node.StatusCoreComponents.AppStatusHandler(),
 	}
 
 	node.DataPool, err = dataRetrieverFactory.NewDataPoolFromConfig(argsDataPool)
@@ -468,6 +475,11 @@ func (node *testOnlyProcessingNode) SetStateForAddress(address []byte, addressSt
 		return err
 	}
 
+	err = node.setTokensForAccount(userAccount, addressState)
+	if err != nil {
+		return err
+	}
+
 	err = node.setScDataIfNeeded(address, userAccount, addressState)
 	if err != nil {
 		return err
@@ -110,6 +110,9 @@ func CreateChainSimulatorConfigs(args ArgsChainSimulatorConfigs) (*ArgsConfigsSi
 
 	// set compatible trie configs
 	configs.GeneralConfig.StateTriesConfig.SnapshotsEnabled = false
+	// pruning is disabled so the old root hashes are still available when reverting to a chain simulator snapshot
+	configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled = false
+	configs.GeneralConfig.StateTriesConfig.PeerStatePruningEnabled = false
 
 	// enable db lookup extension
 	configs.GeneralConfig.DbLookupExtensions.Enabled = true
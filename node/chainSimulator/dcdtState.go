@@ -0,0 +1,220 @@
+package chainSimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/components"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/vm"
+	"github.com/kalyan3104/k-chain-go/vm/systemSmartContracts"
+)
+
+// setTokensGlobalState will write the tokens data that does not live in the holder's account: the NFT metadata kept
+// on the system account of each shard (once the metadata is no longer saved on the holder's account) and the token
+// registry entries of the DCDT system smart contract
+func (s *simulator) setTokensGlobalState(address []byte, addressState *dtos.AddressState) error {
+	if len(addressState.Tokens) == 0 && len(addressState.TokenRoles) == 0 {
+		return nil
+	}
+
+	systemAccountKeys, err := s.computeSystemAccountTokensKeys(addressState.Tokens)
+	if err != nil {
+		return err
+	}
+	if len(systemAccountKeys) > 0 {
+		err = s.setKeyValueSystemAccount(systemAccountKeys)
+		if err != nil {
+			return err
+		}
+	}
+
+	registryKeys, err := s.computeTokensRegistryKeys(address, addressState)
+	if err != nil {
+		return err
+	}
+
+	return s.nodes[core.MetachainShardId].SetKeyValueForAddress(vm.DCDTSCAddress, registryKeys)
+}
+
+func (s *simulator) computeSystemAccountTokensKeys(tokens []*dtos.TokenState) (map[string]string, error) {
+	coreComponents := s.nodes[core.MetachainShardId].GetCoreComponents()
+	marshaller := coreComponents.InternalMarshalizer()
+
+	keyValueMap := make(map[string]string)
+	if !components.IsMetaDataSavedOnSystemAccount(coreComponents.EnableEpochsHandler()) {
+		return keyValueMap, nil
+	}
+
+	for _, tokenState := range tokens {
+		dcdtData, err := components.CreateDCDigitalToken(tokenState, coreComponents.AddressPubKeyConverter())
+		if err != nil {
+			return nil, err
+		}
+		if tokenState.Nonce == 0 {
+			continue
+		}
+
+		dcdtDataOnSystemAccount := &dcdt.DCDigitalToken{
+			Type:          dcdtData.Type,
+			Value:         big.NewInt(0),
+			TokenMetaData: dcdtData.TokenMetaData,
+			Reserved:      []byte{1},
+		}
+		dcdtDataBytes, err := marshaller.Marshal(dcdtDataOnSystemAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		key := components.ComputeDCDTTokenKey(tokenState.Identifier, tokenState.Nonce)
+		keyValueMap[hex.EncodeToString(key)] = hex.EncodeToString(dcdtDataBytes)
+	}
+
+	return keyValueMap, nil
+}
+
+func (s *simulator) computeTokensRegistryKeys(address []byte, addressState *dtos.AddressState) (map[string]string, error) {
+	metaNode := s.nodes[core.MetachainShardId]
+	marshaller := metaNode.GetCoreComponents().InternalMarshalizer()
+
+	account, err := metaNode.GetStateComponents().AccountsAdapter().GetExistingAccount(vm.DCDTSCAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the DCDT system smart contract account", err)
+	}
+	dcdtSCAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, errors.New("cannot cast AccountHandler to UserAccountHandler")
+	}
+
+	registry := make(map[string]*systemSmartContracts.DCDTDataV2)
+	getTokenData := func(tokenIdentifier string) (*systemSmartContracts.DCDTDataV2, error) {
+		tokenData, found := registry[tokenIdentifier]
+		if found {
+			return tokenData, nil
+		}
+
+		tokenData, errLoad := loadTokenData(dcdtSCAccount, marshaller, tokenIdentifier)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+		if tokenData == nil {
+			tokenData = newTokenData(address, tokenIdentifier)
+		}
+		registry[tokenIdentifier] = tokenData
+
+		return tokenData, nil
+	}
+
+	for _, tokenState := range addressState.Tokens {
+		tokenData, errGet := getTokenData(tokenState.Identifier)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		tokenType, errGet := components.GetTokenType(tokenState)
+		if errGet != nil {
+			return nil, errGet
+		}
+		tokenData.TokenType = []byte(tokenType)
+		if tokenState.NumDecimals > 0 {
+			tokenData.NumDecimals = tokenState.NumDecimals
+		}
+	}
+
+	for tokenIdentifier, roles := range addressState.TokenRoles {
+		tokenData, errGet := getTokenData(tokenIdentifier)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		setSpecialRoles(tokenData, address, roles)
+	}
+
+	keyValueMap := make(map[string]string)
+	for tokenIdentifier, tokenData := range registry {
+		tokenDataBytes, errMarshal := marshaller.Marshal(tokenData)
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+
+		keyValueMap[hex.EncodeToString([]byte(tokenIdentifier))] = hex.EncodeToString(tokenDataBytes)
+	}
+
+	return keyValueMap, nil
+}
+
+func loadTokenData(
+	dcdtSCAccount state.UserAccountHandler,
+	marshaller marshal.Marshalizer,
+	tokenIdentifier string,
+) (*systemSmartContracts.DCDTDataV2, error) {
+	tokenDataBytes, _, err := dcdtSCAccount.RetrieveValue([]byte(tokenIdentifier))
+	if err != nil {
+		return nil, err
+	}
+	if len(tokenDataBytes) == 0 {
+		return nil, nil
+	}
+
+	tokenData := &systemSmartContracts.DCDTDataV2{}
+	err = marshaller.Unmarshal(tokenData, tokenDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenData, nil
+}
+
+// newTokenData creates a registry entry mimicking a token issued by the provided address with all the
+// properties enabled, so the token can be further managed through the DCDT system smart contract
+func newTokenData(owner []byte, tokenIdentifier string) *systemSmartContracts.DCDTDataV2 {
+	ticker := tokenIdentifier
+	separatorIndex := strings.Index(tokenIdentifier, "-")
+	if separatorIndex > 0 {
+		ticker = tokenIdentifier[:separatorIndex]
+	}
+
+	return &systemSmartContracts.DCDTDataV2{
+		OwnerAddress:       owner,
+		TokenName:          []byte(ticker),
+		TickerName:         []byte(ticker),
+		TokenType:          []byte(core.FungibleDCDT),
+		Mintable:           true,
+		Burnable:           true,
+		CanPause:           true,
+		CanFreeze:          true,
+		CanWipe:            true,
+		Upgradable:         true,
+		CanChangeOwner:     true,
+		MintedValue:        big.NewInt(0),
+		BurntValue:         big.NewInt(0),
+		CanAddSpecialRoles: true,
+	}
+}
+
+func setSpecialRoles(tokenData *systemSmartContracts.DCDTDataV2, address []byte, roles []string) {
+	rolesBytes := make([][]byte, 0, len(roles))
+	for _, role := range roles {
+		rolesBytes = append(rolesBytes, []byte(role))
+	}
+
+	for _, specialRoles := range tokenData.SpecialRoles {
+		if bytes.Equal(specialRoles.Address, address) {
+			specialRoles.Roles = rolesBytes
+			return
+		}
+	}
+
+	tokenData.SpecialRoles = append(tokenData.SpecialRoles, &systemSmartContracts.DCDTRoles{
+		Address: address,
+		Roles:   rolesBytes,
+	})
+}
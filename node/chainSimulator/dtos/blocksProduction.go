@@ -0,0 +1,24 @@
+package dtos
+
+const (
+	// BlocksProductionOnInterval is the automatic blocks production mode in which blocks are produced at a fixed interval
+	BlocksProductionOnInterval = "interval"
+	// BlocksProductionOnTransaction is the automatic blocks production mode in which blocks are produced as soon as
+	// transactions land in the pools
+	BlocksProductionOnTransaction = "on-transaction"
+)
+
+// BlocksProductionConfig holds the configuration of the automatic blocks production
+type BlocksProductionConfig struct {
+	Mode                     string `json:"mode"`
+	IntervalInMillis         uint64 `json:"intervalInMillis,omitempty"`
+	UseWallClockTimeStamps   bool   `json:"useWallClockTimestamps,omitempty"`
+	TimeStampOffsetInSeconds int64  `json:"timestampOffsetInSeconds,omitempty"`
+}
+
+// BlocksProductionStatus holds the current status of the automatic blocks production
+type BlocksProductionStatus struct {
+	Running bool                    `json:"running"`
+	Paused  bool                    `json:"paused"`
+	Config  *BlocksProductionConfig `json:"config,omitempty"`
+}
@@ -2,14 +2,36 @@ package dtos
 
 // AddressState will hold the address state
 type AddressState struct {
-	Address          string            `json:"address"`
-	Nonce            *uint64           `json:"nonce,omitempty"`
-	Balance          string            `json:"balance,omitempty"`
-	Code             string            `json:"code,omitempty"`
-	RootHash         string            `json:"rootHash,omitempty"`
-	CodeMetadata     string            `json:"codeMetadata,omitempty"`
-	CodeHash         string            `json:"codeHash,omitempty"`
-	DeveloperRewards string            `json:"developerReward,omitempty"`
-	Owner            string            `json:"ownerAddress,omitempty"`
-	Keys             map[string]string `json:"keys,omitempty"`
+	Address          string              `json:"address"`
+	Nonce            *uint64             `json:"nonce,omitempty"`
+	Balance          string              `json:"balance,omitempty"`
+	Code             string              `json:"code,omitempty"`
+	RootHash         string              `json:"rootHash,omitempty"`
+	CodeMetadata     string              `json:"codeMetadata,omitempty"`
+	CodeHash         string              `json:"codeHash,omitempty"`
+	DeveloperRewards string              `json:"developerReward,omitempty"`
+	Owner            string              `json:"ownerAddress,omitempty"`
+	Keys             map[string]string   `json:"keys,omitempty"`
+	Tokens           []*TokenState       `json:"tokens,omitempty"`
+	TokenRoles       map[string][]string `json:"tokenRoles,omitempty"`
+}
+
+// TokenState will hold the balance of a fungible DCDT token or of a NFT/SFT/MetaDCDT instance
+type TokenState struct {
+	Identifier  string         `json:"identifier"`
+	Type        string         `json:"type,omitempty"`
+	Nonce       uint64         `json:"nonce,omitempty"`
+	Balance     string         `json:"balance"`
+	NumDecimals uint32         `json:"numDecimals,omitempty"`
+	MetaData    *TokenMetaData `json:"metaData,omitempty"`
+}
+
+// TokenMetaData will hold the metadata of a NFT/SFT/MetaDCDT instance
+type TokenMetaData struct {
+	Name       string   `json:"name,omitempty"`
+	Creator    string   `json:"creator,omitempty"`
+	Royalties  uint32   `json:"royalties,omitempty"`
+	Hash       string   `json:"hash,omitempty"`
+	URIs       []string `json:"uris,omitempty"`
+	Attributes string   `json:"attributes,omitempty"`
 }
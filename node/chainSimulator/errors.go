@@ -9,4 +9,17 @@ var (
 	errEmptySliceOfTxs       = errors.New("empty slice of transactions to send")
 	errNilTransaction        = errors.New("nil transaction")
 	errInvalidMaxNumOfBlocks = errors.New("invalid max number of blocks to generate")
+	errSnapshotNotFound      = errors.New("snapshot not found")
+
+	errInvalidBlocksProductionMode     = errors.New("invalid blocks production mode")
+	errInvalidBlocksProductionInterval = errors.New("invalid blocks production interval")
+	errBlocksProductionAlreadyStarted  = errors.New("automatic blocks production already started")
+	errBlocksProductionNotStarted      = errors.New("automatic blocks production not started")
+	errNotATimeStampsConfigurator      = errors.New("round handler does not allow timestamps configuration")
+
+	errMissingForkDBPath    = errors.New("missing fork database path")
+	errMissingForkDB        = errors.New("missing fork database")
+	errForkEpochMismatch    = errors.New("fork epoch mismatch")
+	errShardHeadersNotFound = errors.New("shard headers not found")
+	errNoTrieNodesSource    = errors.New("no trie nodes source")
 )
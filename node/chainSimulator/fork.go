@@ -0,0 +1,492 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	marshalFactory "github.com/kalyan3104/k-chain-core-go/marshal/factory"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/components"
+	chainSimulatorProcess "github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/storage"
+	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/pathmanager"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/kalyan3104/k-chain-go/vm"
+	"github.com/kalyan3104/k-chain-go/vm/systemSmartContracts"
+)
+
+const (
+	metaBlockDBFilePath        = "MetaBlock"
+	metaHdrHashNonceDBFilePath = "MetaHdrHashNonce"
+	blockHeadersDBFilePath     = "BlockHeaders"
+	accountsTrieDBFilePath     = "AccountsTrie"
+
+	// maxMetaBlocksToTraverse limits the search of the shard headers notarized before the fork metachain block
+	maxMetaBlocksToTraverse = 1000
+)
+
+var forkDBConfig = config.DBConfig{
+	Type:              string(storageunit.LvlDBSerial),
+	BatchDelaySeconds: 2,
+	MaxBatchSize:      100,
+	MaxOpenFiles:      10,
+}
+
+// ArgsFork holds the arguments needed to start the chain simulator from the state of existing nodes.
+// The nodes owning the databases must be stopped, as the databases can not be opened by two processes at once.
+// The validators set is replaced by the simulator's own keys: the blocks led by other validators, elected at the
+// following epochs changes from the forked staking data, are skipped
+type ArgsFork struct {
+	// DBPaths holds, for each shard, the database directory of a node, including the chain ID (<working dir>/db/<chain ID>)
+	DBPaths map[uint32]string
+	// ObserverURLs holds, for each shard, the optional REST API address of an observer used to fetch the trie
+	// nodes missing from the provided databases
+	ObserverURLs map[uint32]string
+	// Epoch is the epoch the fork is taken at. If Nonce is not set, the fork will start from the epoch start metachain block
+	Epoch uint32
+	// Nonce is the optional metachain block nonce the fork is taken at. The block has to belong to the provided epoch
+	Nonce core.OptionalUint64
+}
+
+type forkState struct {
+	args       ArgsFork
+	metaHeader data.MetaHeaderHandler
+	headers    map[uint32]data.HeaderHandler
+}
+
+func loadForkState(args ArgsFork, numOfShards uint32) (*forkState, error) {
+	_, found := args.DBPaths[core.MetachainShardId]
+	if !found {
+		return nil, fmt.Errorf("%w for the metachain", errMissingForkDBPath)
+	}
+	for shardID := uint32(0); shardID < numOfShards; shardID++ {
+		_, found = args.DBPaths[shardID]
+		if !found {
+			return nil, fmt.Errorf("%w for shard %d", errMissingForkDBPath, shardID)
+		}
+	}
+
+	marshaller, err := marshalFactory.NewMarshalizer(marshalFactory.GogoProtobuf)
+	if err != nil {
+		return nil, err
+	}
+
+	metaDB, err := newForkDB(args.DBPaths[core.MetachainShardId], core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	metaHeader, err := metaDB.getForkMetaHeader(args, marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	fork := &forkState{
+		args:       args,
+		metaHeader: metaHeader,
+		headers:    map[uint32]data.HeaderHandler{core.MetachainShardId: metaHeader},
+	}
+
+	shardHeadersHashes, err := metaDB.getNotarizedShardHeadersHashes(metaHeader, numOfShards, marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	for shardID := uint32(0); shardID < numOfShards; shardID++ {
+		shardDB, errCreate := newForkDB(args.DBPaths[shardID], shardID)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		headerBytes, errGet := shardDB.getFromEpochs(blockHeadersDBFilePath, shardHeadersHashes[shardID], currentAndPreviousEpochs(args.Epoch)...)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while loading the header of shard %d, hash %s",
+				errGet, shardID, hex.EncodeToString(shardHeadersHashes[shardID]))
+		}
+
+		fork.headers[shardID], errGet = process.UnmarshalShardHeader(marshaller, headerBytes)
+		if errGet != nil {
+			return nil, errGet
+		}
+	}
+
+	log.Info("chain simulator: forking state",
+		"epoch", metaHeader.GetEpoch(),
+		"metachain nonce", metaHeader.GetNonce(),
+		"round", metaHeader.GetRound())
+
+	return fork, nil
+}
+
+func (fork *forkState) applyOnArgs(args *ArgsChainSimulator) {
+	roundDurationInSeconds := int64(args.RoundDurationInMillis / 1000)
+
+	args.InitialEpoch = fork.metaHeader.GetEpoch()
+	args.InitialRound = int64(fork.metaHeader.GetRound())
+	args.GenesisTimestamp = int64(fork.metaHeader.GetTimeStamp()) - roundDurationInSeconds*args.InitialRound
+}
+
+func (fork *forkState) initialNonce(shardID uint32) uint64 {
+	return fork.headers[shardID].GetNonce()
+}
+
+// trieNodesProviders returns the sources of the accounts trie nodes for the provided shard: the AccountsTrie
+// databases, the newest epochs first, followed by the observer, if any
+func (fork *forkState) trieNodesProviders(shardID uint32) ([]components.TrieNodesProvider, error) {
+	shardDB, err := newForkDB(fork.args.DBPaths[shardID], shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]components.TrieNodesProvider, 0, 2)
+	dbPaths := shardDB.existingPathsForEpochs(accountsTrieDBFilePath)
+	if len(dbPaths) > 0 {
+		dbProvider, errCreate := components.NewDBTrieNodesProvider(components.ArgsDBTrieNodesProvider{
+			DBConfig: forkDBConfig,
+			DBPaths:  dbPaths,
+		})
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		providers = append(providers, dbProvider)
+	}
+
+	observerURL := fork.args.ObserverURLs[shardID]
+	if len(observerURL) > 0 {
+		observerProvider, errCreate := components.NewObserverTrieNodesProvider(observerURL)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		providers = append(providers, observerProvider)
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("%w for shard %d", errNoTrieNodesSource, shardID)
+	}
+
+	return providers, nil
+}
+
+// applyForkOnNodes switches the accounts of each node to the forked state. The metachain keeps its own peer accounts
+// and the staking data of the simulator's validators, so the consensus can be run with the simulator's keys
+func (s *simulator) applyForkOnNodes(fork *forkState) error {
+	for shardID, node := range s.nodes {
+		if shardID == core.MetachainShardId {
+			continue
+		}
+
+		err := recreateAccountsTrie(node, fork.headers[shardID].GetRootHash())
+		if err != nil {
+			return fmt.Errorf("%w while forking the state of shard %d", err, shardID)
+		}
+	}
+
+	metaNode := s.nodes[core.MetachainShardId]
+	stakingData, err := s.getValidatorsStakingData(metaNode)
+	if err != nil {
+		return err
+	}
+
+	err = recreateAccountsTrie(metaNode, fork.metaHeader.GetRootHash())
+	if err != nil {
+		return fmt.Errorf("%w while forking the state of the metachain", err)
+	}
+
+	for _, address := range [][]byte{vm.StakingSCAddress, vm.ValidatorSCAddress} {
+		keyValueMap := stakingData[string(address)]
+		if len(keyValueMap) == 0 {
+			continue
+		}
+
+		err = metaNode.SetKeyValueForAddress(address, keyValueMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func recreateAccountsTrie(node chainSimulatorProcess.NodeHandler, rootHash []byte) error {
+	accountsAdapter := node.GetStateComponents().AccountsAdapter()
+	err := accountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		return err
+	}
+
+	_, err = accountsAdapter.Commit()
+
+	return err
+}
+
+// getValidatorsStakingData returns, for the staking and validator system smart contracts, the hex encoded entries
+// describing the simulator's validators
+func (s *simulator) getValidatorsStakingData(metaNode chainSimulatorProcess.NodeHandler) (map[string]map[string]string, error) {
+	marshaller := metaNode.GetCoreComponents().InternalMarshalizer()
+	accountsAdapter := metaNode.GetStateComponents().AccountsAdapter()
+
+	stakingSCAccount, err := getUserAccount(accountsAdapter, vm.StakingSCAddress)
+	if err != nil {
+		return nil, err
+	}
+	validatorSCAccount, err := getUserAccount(accountsAdapter, vm.ValidatorSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingEntries := make(map[string]string)
+	validatorEntries := make(map[string]string)
+	for _, privateKey := range s.validatorsPrivateKeys {
+		blsKey, errKey := privateKey.GeneratePublic().ToByteArray()
+		if errKey != nil {
+			return nil, errKey
+		}
+
+		stakedDataBytes, _, errKey := stakingSCAccount.RetrieveValue(blsKey)
+		if errKey != nil || len(stakedDataBytes) == 0 {
+			continue
+		}
+		stakingEntries[hex.EncodeToString(blsKey)] = hex.EncodeToString(stakedDataBytes)
+
+		owner, errKey := getStakedKeyOwner(marshaller, stakedDataBytes)
+		if errKey != nil {
+			return nil, errKey
+		}
+
+		validatorDataBytes, _, errKey := validatorSCAccount.RetrieveValue(owner)
+		if errKey != nil || len(validatorDataBytes) == 0 {
+			continue
+		}
+		validatorEntries[hex.EncodeToString(owner)] = hex.EncodeToString(validatorDataBytes)
+	}
+
+	return map[string]map[string]string{
+		string(vm.StakingSCAddress):   stakingEntries,
+		string(vm.ValidatorSCAddress): validatorEntries,
+	}, nil
+}
+
+func getUserAccount(accountsAdapter state.AccountsAdapter, address []byte) (state.UserAccountHandler, error) {
+	account, err := accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast AccountHandler to UserAccountHandler for address %s", hex.EncodeToString(address))
+	}
+
+	return userAccount, nil
+}
+
+func getStakedKeyOwner(marshaller marshal.Marshalizer, stakedDataBytes []byte) ([]byte, error) {
+	stakedData := &systemSmartContracts.StakedDataV2_0{}
+	err := marshaller.Unmarshal(stakedData, stakedDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakedData.OwnerAddress, nil
+}
+
+type forkDB struct {
+	pathManager      *pathmanager.PathManager
+	persisterFactory storage.PersisterFactoryHandler
+	shardIDStr       string
+}
+
+func newForkDB(dbPath string, shardID uint32) (*forkDB, error) {
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(forkDBConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	return &forkDB{
+		pathManager:      pathManager,
+		persisterFactory: persisterFactory,
+		shardIDStr:       core.GetShardIDString(shardID),
+	}, nil
+}
+
+func (db *forkDB) getForkMetaHeader(args ArgsFork, marshaller marshal.Marshalizer) (data.MetaHeaderHandler, error) {
+	key := []byte(core.EpochStartIdentifier(args.Epoch))
+	if args.Nonce.HasValue {
+		nonceBytes := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(args.Nonce.Value)
+
+		var err error
+		key, err = db.get(db.pathManager.PathForStatic(db.shardIDStr, metaHdrHashNonceDBFilePath), nonceBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the hash of the metachain block with nonce %d", err, args.Nonce.Value)
+		}
+	}
+
+	metaHeaderBytes, err := db.getFromEpochs(metaBlockDBFilePath, key, args.Epoch, args.Epoch+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the fork metachain block", err)
+	}
+
+	metaHeader, err := process.UnmarshalMetaHeader(marshaller, metaHeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+	if metaHeader.GetEpoch() != args.Epoch {
+		return nil, fmt.Errorf("%w, requested epoch %d, metachain block epoch %d",
+			errForkEpochMismatch, args.Epoch, metaHeader.GetEpoch())
+	}
+
+	return metaHeader, nil
+}
+
+// getNotarizedShardHeadersHashes returns, for each shard, the hash of the latest shard header notarized up to the
+// provided metachain block
+func (db *forkDB) getNotarizedShardHeadersHashes(
+	metaHeader data.MetaHeaderHandler,
+	numOfShards uint32,
+	marshaller marshal.Marshalizer,
+) (map[uint32][]byte, error) {
+	hashes := make(map[uint32][]byte)
+	nonces := make(map[uint32]uint64)
+	currentHeader := metaHeader
+	for idx := 0; idx < maxMetaBlocksToTraverse; idx++ {
+		for _, shardInfo := range currentHeader.GetShardInfoHandlers() {
+			shardID := shardInfo.GetShardID()
+			_, found := hashes[shardID]
+			if found && nonces[shardID] >= shardInfo.GetNonce() {
+				continue
+			}
+
+			hashes[shardID] = shardInfo.GetHeaderHash()
+			nonces[shardID] = shardInfo.GetNonce()
+		}
+
+		if uint32(len(hashes)) >= numOfShards {
+			return hashes, nil
+		}
+
+		epoch := currentHeader.GetEpoch()
+		prevHeaderBytes, err := db.getFromEpochs(metaBlockDBFilePath, currentHeader.GetPrevHash(), currentAndPreviousEpochs(epoch)...)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the metachain block with nonce %d", err, currentHeader.GetNonce()-1)
+		}
+
+		currentHeader, err = process.UnmarshalMetaHeader(marshaller, prevHeaderBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("%w in the last %d metachain blocks", errShardHeadersNotFound, maxMetaBlocksToTraverse)
+}
+
+// currentAndPreviousEpochs returns the provided epoch and the one before it, if any
+func currentAndPreviousEpochs(epoch uint32) []uint32 {
+	if epoch == 0 {
+		return []uint32{epoch}
+	}
+
+	return []uint32{epoch, epoch - 1}
+}
+
+func (db *forkDB) getFromEpochs(identifier string, key []byte, epochs ...uint32) ([]byte, error) {
+	var err error
+	for _, epoch := range epochs {
+		path := db.pathManager.PathForEpoch(db.shardIDStr, epoch, identifier)
+		if !dirExists(path) {
+			continue
+		}
+
+		var value []byte
+		value, err = db.get(path, key)
+		if err == nil {
+			return value, nil
+		}
+	}
+	if err == nil {
+		err = storage.ErrKeyNotFound
+	}
+
+	return nil, err
+}
+
+func (db *forkDB) get(path string, key []byte) ([]byte, error) {
+	if !dirExists(path) {
+		return nil, fmt.Errorf("%w: %s", errMissingForkDB, path)
+	}
+
+	persister, err := db.persisterFactory.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		errClose := persister.Close()
+		if errClose != nil {
+			log.Warn("forkDB: could not close persister", "path", path, "error", errClose)
+		}
+	}()
+
+	return persister.Get(key)
+}
+
+// existingPathsForEpochs returns the paths of the existing databases of the provided identifier, the newest epoch first
+func (db *forkDB) existingPathsForEpochs(identifier string) []string {
+	entries, err := os.ReadDir(db.pathManager.DatabasePath())
+	if err != nil {
+		return nil
+	}
+
+	epochs := make([]uint32, 0, len(entries))
+	for _, entry := range entries {
+		epochStr := strings.TrimPrefix(entry.Name(), storage.DefaultEpochString+"_")
+		if !entry.IsDir() || epochStr == entry.Name() {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(epochStr, 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+
+	paths := make([]string, 0, len(epochs))
+	for _, epoch := range epochs {
+		path := db.pathManager.PathForEpoch(db.shardIDStr, epoch, identifier)
+		if dirExists(path) {
+			paths = append(paths, filepath.Clean(path))
+		}
+	}
+
+	return paths
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,74 @@
+package chainSimulator
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadForkState_MissingDBPathsShouldError(t *testing.T) {
+	t.Parallel()
+
+	fork, err := loadForkState(ArgsFork{}, 1)
+	require.True(t, errors.Is(err, errMissingForkDBPath))
+	require.Nil(t, fork)
+
+	fork, err = loadForkState(ArgsFork{
+		DBPaths: map[uint32]string{
+			core.MetachainShardId: t.TempDir(),
+		},
+	}, 1)
+	require.True(t, errors.Is(err, errMissingForkDBPath))
+	require.Nil(t, fork)
+}
+
+func TestLoadForkState_MissingMetaBlockShouldError(t *testing.T) {
+	t.Parallel()
+
+	fork, err := loadForkState(ArgsFork{
+		DBPaths: map[uint32]string{
+			core.MetachainShardId: t.TempDir(),
+			0:                     t.TempDir(),
+		},
+		Epoch: 2,
+	}, 1)
+	require.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	require.Nil(t, fork)
+}
+
+func TestForkDB_ExistingPathsForEpochs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, epochDir := range []string{"Epoch_0", "Epoch_10", "Epoch_2"} {
+		err := os.MkdirAll(filepath.Join(dir, epochDir, "Shard_1", accountsTrieDBFilePath), os.ModePerm)
+		require.Nil(t, err)
+	}
+	err := os.MkdirAll(filepath.Join(dir, "Epoch_3", "Shard_0", accountsTrieDBFilePath), os.ModePerm)
+	require.Nil(t, err)
+	err = os.MkdirAll(filepath.Join(dir, "Static", "Shard_1", accountsTrieDBFilePath), os.ModePerm)
+	require.Nil(t, err)
+
+	db, err := newForkDB(dir, 1)
+	require.Nil(t, err)
+
+	paths := db.existingPathsForEpochs(accountsTrieDBFilePath)
+	require.Equal(t, []string{
+		filepath.Join(dir, "Epoch_10", "Shard_1", accountsTrieDBFilePath),
+		filepath.Join(dir, "Epoch_2", "Shard_1", accountsTrieDBFilePath),
+		filepath.Join(dir, "Epoch_0", "Shard_1", accountsTrieDBFilePath),
+	}, paths)
+}
+
+func TestCurrentAndPreviousEpochs(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []uint32{0}, currentAndPreviousEpochs(0))
+	require.Equal(t, []uint32{1, 0}, currentAndPreviousEpochs(1))
+	require.Equal(t, []uint32{7, 6}, currentAndPreviousEpochs(7))
+}
@@ -1,6 +1,10 @@
 package chainSimulator
 
-import "github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+import (
+	"time"
+
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+)
 
 // ChainHandler defines what a chain handler should be able to do
 type ChainHandler interface {
@@ -15,3 +19,7 @@ type ChainSimulator interface {
 	GetNodeHandler(shardID uint32) process.NodeHandler
 	IsInterfaceNil() bool
 }
+
+type timeStampsConfigurator interface {
+	SetTimeStampsConfig(useWallClock bool, offset time.Duration)
+}
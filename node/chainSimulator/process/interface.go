@@ -24,6 +24,9 @@ type NodeHandler interface {
 	SetKeyValueForAddress(addressBytes []byte, state map[string]string) error
 	SetStateForAddress(address []byte, state *dtos.AddressState) error
 	RemoveAccount(address []byte) error
+	TakeSnapshot(id uint64) error
+	RevertToSnapshot(id uint64) error
+	ReleaseSnapshot(id uint64)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -133,8 +133,50 @@ var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("ca
 // ErrTrieOperationsTimeout signals that a trie operation took too long
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
 
+// ErrNilTrieStorageManager signals that a nil trie storage manager has been provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrMissingStateDiffBlockRootHash signals that the root hash of a state to be compared could not be determined
+var ErrMissingStateDiffBlockRootHash = errors.New("missing block root hash for state diff, a block coordinate must be provided")
+
+// ErrTooManyAccountsInStateDiff signals that the state diff would contain too many accounts
+var ErrTooManyAccountsInStateDiff = errors.New("too many accounts in state diff, an address filter should be provided")
+
+// ErrEmptyEventIdentifier signals that an empty event identifier has been provided
+var ErrEmptyEventIdentifier = errors.New("empty event identifier")
+
+// ErrInvalidEventsQueryLimit signals that an invalid limit has been provided for an events query
+var ErrInvalidEventsQueryLimit = errors.New("invalid events query limit")
+
+// ErrInvalidEventsBlockRange signals that an invalid block range has been provided for an events query
+var ErrInvalidEventsBlockRange = errors.New("invalid events block range")
+
+// ErrInvalidEventsCursor signals that an invalid cursor has been provided for an events query
+var ErrInvalidEventsCursor = errors.New("invalid events cursor")
+
+// ErrNilBlockHeader signals that a nil block header has been found
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrEmptyMultiProofKeys signals that no key has been provided for a multi-proof
+var ErrEmptyMultiProofKeys = errors.New("empty keys list for multi-proof")
+
+// ErrTooManyMultiProofKeys signals that too many keys have been provided for a multi-proof
+var ErrTooManyMultiProofKeys = errors.New("too many keys for multi-proof")
+
+// ErrInvalidMultiProofKey signals that a key which is not an account address has been provided for a multi-proof
+var ErrInvalidMultiProofKey = errors.New("invalid key for multi-proof, only account addresses are supported")
+
+// ErrNilIntegrityScrubber signals that a nil integrity scrubber has been provided
+var ErrNilIntegrityScrubber = errors.New("nil integrity scrubber")
+
+// ErrNilTxPoolPersister signals that a nil transactions pool persister has been provided
+var ErrNilTxPoolPersister = errors.New("nil transactions pool persister")
+
+// ErrTransactionsPoolCancellationNotSupported signals that the transactions pool can not drop the cancelled transactions
+var ErrTransactionsPoolCancellationNotSupported = errors.New("the transactions pool does not support cancellations")
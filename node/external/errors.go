@@ -46,3 +46,12 @@ var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrTransactionNotTraceable signals that the requested transaction can not be traced
+var ErrTransactionNotTraceable = errors.New("only the executed normal transactions can be traced")
+
+// ErrInvalidBlockFormat signals that a block was loaded in an unexpected format
+var ErrInvalidBlockFormat = errors.New("invalid block format")
+
+// ErrNilSubscriptionsHandler signals that a nil subscriptions handler has been provided
+var ErrNilSubscriptionsHandler = errors.New("nil subscriptions handler")
@@ -37,6 +37,7 @@ type StatusMetricsHandler interface {
 type TransactionEvaluator interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	TraceTransactionExecution(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error)
 	IsInterfaceNil() bool
 }
 
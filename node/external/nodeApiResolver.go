@@ -1,16 +1,21 @@
 package external
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"math/big"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data"
 	"github.com/kalyan3104/k-chain-core-go/data/alteredAccount"
 	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
 	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/holders"
 	"github.com/kalyan3104/k-chain-go/genesis"
 	"github.com/kalyan3104/k-chain-go/node/external/blockAPI"
 	"github.com/kalyan3104/k-chain-go/process"
@@ -43,6 +48,7 @@ type ArgNodeApiResolver struct {
 	PublicKey                string
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	StorageManagers          []common.StorageManager
+	SubscriptionsHandler     common.SubscriptionsHandler
 }
 
 // nodeApiResolver can resolve API requests
@@ -64,6 +70,7 @@ type nodeApiResolver struct {
 	publicKey                string
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	storageManagers          []common.StorageManager
+	subscriptionsHandler     common.SubscriptionsHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -113,6 +120,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
+	if check.IfNil(arg.SubscriptionsHandler) {
+		return nil, ErrNilSubscriptionsHandler
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
@@ -132,6 +142,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		publicKey:                arg.PublicKey,
 		nodesCoordinator:         arg.NodesCoordinator,
 		storageManagers:          arg.StorageManagers,
+		subscriptionsHandler:     arg.SubscriptionsHandler,
 	}, nil
 }
 
@@ -155,6 +166,161 @@ func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transac
 	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
 }
 
+// SimulateTransactionTrace will execute the provided transaction on the current state and return its execution trace
+func (nar *nodeApiResolver) SimulateTransactionTrace(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	return nar.apiTransactionEvaluator.TraceTransactionExecution(tx, nil)
+}
+
+// TraceTransaction will re-execute an already executed transaction on the state of the block it was included in and
+// return its execution trace. Only the normal transactions that preceded it in the same block are re-executed before it
+func (nar *nodeApiResolver) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	txHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTx, err := nar.apiTransactionHandler.GetTransaction(hash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ok := apiTx.Tx.(*transaction.Transaction)
+	if !ok || apiTx.Type != string(transaction.TxTypeNormal) || len(apiTx.BlockHash) == 0 {
+		return nil, ErrTransactionNotTraceable
+	}
+
+	executionContext, err := nar.createHistoricalExecutionContext(txHash, apiTx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, err := nar.apiTransactionEvaluator.TraceTransactionExecution(tx, executionContext)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.Hash = hash
+	trace.BlockNonce = apiTx.BlockNonce
+	trace.BlockHash = apiTx.BlockHash
+
+	return trace, nil
+}
+
+func (nar *nodeApiResolver) createHistoricalExecutionContext(txHash []byte, blockHash string) (*txSimData.HistoricalExecutionContext, error) {
+	header, err := nar.getInternalHeaderByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	previousHeader, err := nar.getInternalHeaderByHash(hex.EncodeToString(header.GetPrevHash()))
+	if err != nil {
+		return nil, err
+	}
+
+	precedingTransactions, err := nar.getPrecedingTransactions(txHash, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSimData.HistoricalExecutionContext{
+		BlockHeader:           header,
+		StateRootHash:         holders.NewRootHashHolder(previousHeader.GetRootHash(), core.OptionalUint32{Value: previousHeader.GetEpoch(), HasValue: true}),
+		PrecedingTransactions: precedingTransactions,
+	}, nil
+}
+
+func (nar *nodeApiResolver) getInternalHeaderByHash(hash string) (data.HeaderHandler, error) {
+	blockResult, err := nar.GetInternalShardBlockByHash(common.ApiOutputFormatJSON, hash)
+	if errors.Is(err, blockAPI.ErrShardOnlyEndpoint) {
+		blockResult, err = nar.GetInternalMetaBlockByHash(common.ApiOutputFormatJSON, hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := blockResult.(data.HeaderHandler)
+	if !ok {
+		return nil, ErrInvalidBlockFormat
+	}
+
+	return header, nil
+}
+
+// getPrecedingTransactions returns the transactions and the smart contract results executed by the shard in the
+// provided block before the provided transaction, in their execution order
+func (nar *nodeApiResolver) getPrecedingTransactions(txHash []byte, header data.HeaderHandler) ([]data.TransactionHandler, error) {
+	precedingTransactions := make([]data.TransactionHandler, 0)
+	for _, miniBlockHeader := range getExecutedMiniBlockHeaders(header) {
+		miniBlockResult, err := nar.apiInternalBlockHandler.GetInternalMiniBlock(common.ApiOutputFormatJSON, miniBlockHeader.GetHash(), header.GetEpoch())
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlock, ok := miniBlockResult.(*block.MiniBlock)
+		if !ok {
+			return nil, ErrInvalidBlockFormat
+		}
+
+		firstIndex := int(miniBlockHeader.GetIndexOfFirstTxProcessed())
+		lastIndex := int(miniBlockHeader.GetIndexOfLastTxProcessed())
+		for index, hash := range miniBlock.TxHashes {
+			if index < firstIndex || index > lastIndex {
+				continue
+			}
+			if bytes.Equal(hash, txHash) {
+				return precedingTransactions, nil
+			}
+
+			apiTx, err := nar.apiTransactionHandler.GetTransaction(hex.EncodeToString(hash), false)
+			if err != nil {
+				return nil, err
+			}
+
+			precedingTransactions = append(precedingTransactions, apiTx.Tx)
+		}
+	}
+
+	return precedingTransactions, nil
+}
+
+// getExecutedMiniBlockHeaders returns the headers of the miniblocks executed by the shard of the provided block, in
+// their execution order: the transactions miniblocks sent or received by the shard and the incoming smart contract
+// results miniblocks, followed by the scheduled miniblocks. The smart contract results generated by the shard are
+// the outcome of its executed transactions, so they are not executed again
+func getExecutedMiniBlockHeaders(header data.HeaderHandler) []data.MiniBlockHeaderHandler {
+	selfShardID := header.GetShardID()
+	normalMiniBlockHeaders := make([]data.MiniBlockHeaderHandler, 0)
+	scheduledMiniBlockHeaders := make([]data.MiniBlockHeaderHandler, 0)
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		if !isMiniBlockExecutedByShard(miniBlockHeader, selfShardID) {
+			continue
+		}
+
+		switch block.ProcessingType(miniBlockHeader.GetProcessingType()) {
+		case block.Normal:
+			normalMiniBlockHeaders = append(normalMiniBlockHeaders, miniBlockHeader)
+		case block.Scheduled:
+			scheduledMiniBlockHeaders = append(scheduledMiniBlockHeaders, miniBlockHeader)
+		}
+	}
+
+	return append(normalMiniBlockHeaders, scheduledMiniBlockHeaders...)
+}
+
+func isMiniBlockExecutedByShard(miniBlockHeader data.MiniBlockHeaderHandler, selfShardID uint32) bool {
+	senderShardID := miniBlockHeader.GetSenderShardID()
+	receiverShardID := miniBlockHeader.GetReceiverShardID()
+
+	switch block.Type(miniBlockHeader.GetTypeInt32()) {
+	case block.TxBlock:
+		return senderShardID == selfShardID || receiverShardID == selfShardID
+	case block.SmartContractResultBlock:
+		return senderShardID != selfShardID && receiverShardID == selfShardID
+	default:
+		return false
+	}
+}
+
 // Close closes all underlying components
 func (nar *nodeApiResolver) Close() error {
 	for _, sm := range nar.storageManagers {
@@ -166,6 +332,9 @@ func (nar *nodeApiResolver) Close() error {
 		log.LogIfError(err)
 	}
 
+	err := nar.subscriptionsHandler.Close()
+	log.LogIfError(err)
+
 	return nar.scQueryService.Close()
 }
 
@@ -416,6 +585,11 @@ func (nar *nodeApiResolver) GetWaitingEpochsLeftForPublicKey(publicKey string) (
 	return nar.nodesCoordinator.GetWaitingEpochsLeftForPublicKey(pkBytes)
 }
 
+// CreateSubscriber registers a new live data subscriber
+func (nar *nodeApiResolver) CreateSubscriber() (common.SubscriberHandler, error) {
+	return nar.subscriptionsHandler.CreateSubscriber()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
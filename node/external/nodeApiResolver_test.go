@@ -9,7 +9,10 @@ import (
 	"testing"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
+	coreData "github.com/kalyan3104/k-chain-core-go/data"
 	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/smartContractResult"
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
 	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/genesis"
@@ -17,6 +20,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/node/external"
 	"github.com/kalyan3104/k-chain-go/node/mock"
 	"github.com/kalyan3104/k-chain-go/process"
+	txSimData "github.com/kalyan3104/k-chain-go/process/transactionEvaluator/data"
 	"github.com/kalyan3104/k-chain-go/sharding/nodesCoordinator"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/kalyan3104/k-chain-go/testscommon/genesisMocks"
@@ -45,6 +49,7 @@ func createMockArgs() external.ArgNodeApiResolver {
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
 		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		SubscriptionsHandler:     &testscommon.SubscriptionsHandlerStub{},
 	}
 }
 
@@ -136,6 +141,17 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilSubscriptionsHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.SubscriptionsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilSubscriptionsHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -158,11 +174,20 @@ func TestNodeApiResolver_CloseShouldReturnNil(t *testing.T) {
 			return nil
 		},
 	}
+	subscriptionsHandlerCloseCalled := false
+	args.SubscriptionsHandler = &testscommon.SubscriptionsHandlerStub{
+		CloseCalled: func() error {
+			subscriptionsHandlerCloseCalled = true
+
+			return nil
+		},
+	}
 	nar, _ := external.NewNodeApiResolver(args)
 
 	err := nar.Close()
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
+	assert.True(t, subscriptionsHandlerCloseCalled)
 }
 
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
@@ -373,6 +398,215 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_SimulateTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.Transaction{Nonce: 7}
+	expectedTrace := &txSimData.TransactionTrace{Status: transaction.TxStatusSuccess}
+	arg := createMockArgs()
+	arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+		TraceTransactionExecutionCalled: func(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error) {
+			require.Equal(t, providedTx, tx)
+			require.Nil(t, executionContext)
+			return expectedTrace, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	trace, err := nar.SimulateTransactionTrace(providedTx)
+	require.NoError(t, err)
+	require.Equal(t, expectedTrace, trace)
+}
+
+func TestNodeApiResolver_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	precedingTxHash := []byte("preceding tx hash")
+	blockHash := []byte("block hash")
+	prevBlockHash := []byte("prev block hash")
+	miniBlockHash := []byte("miniblock hash")
+	tracedTx := &transaction.Transaction{Nonce: 2}
+	precedingTx := &transaction.Transaction{Nonce: 1}
+
+	createApiTransactionHandler := func(txType transaction.TxType) *mock.TransactionAPIHandlerStub {
+		return &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				switch hash {
+				case hex.EncodeToString(txHash):
+					return &transaction.ApiTransactionResult{
+						Tx:         tracedTx,
+						Type:       string(txType),
+						BlockHash:  hex.EncodeToString(blockHash),
+						BlockNonce: 10,
+					}, nil
+				case hex.EncodeToString(precedingTxHash):
+					return &transaction.ApiTransactionResult{Tx: precedingTx}, nil
+				default:
+					return nil, expectedErr
+				}
+			},
+		}
+	}
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, _ := external.NewNodeApiResolver(createMockArgs())
+
+		trace, err := nar.TraceTransaction("not a hex")
+		require.Nil(t, trace)
+		require.Error(t, err)
+	})
+	t.Run("not a normal transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = createApiTransactionHandler(transaction.TxTypeUnsigned)
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		trace, err := nar.TraceTransaction(hex.EncodeToString(txHash))
+		require.Nil(t, trace)
+		require.Equal(t, external.ErrTransactionNotTraceable, err)
+	})
+	t.Run("should trace on the state of the previous block", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.Header{
+			Nonce:    10,
+			Epoch:    3,
+			PrevHash: prevBlockHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: []byte("rewards"), Type: block.RewardsBlock},
+				{Hash: miniBlockHash, Type: block.TxBlock, TxCount: 3},
+			},
+		}
+		prevHeader := &block.Header{
+			Nonce:    9,
+			Epoch:    2,
+			RootHash: []byte("prev root hash"),
+		}
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = createApiTransactionHandler(transaction.TxTypeNormal)
+		arg.APIInternalBlockHandler = &mock.InternalBlockApiHandlerStub{
+			GetInternalShardBlockByHashCalled: func(format common.ApiOutputFormat, hash []byte) (interface{}, error) {
+				if bytes.Equal(hash, blockHash) {
+					return header, nil
+				}
+				if bytes.Equal(hash, prevBlockHash) {
+					return prevHeader, nil
+				}
+				return nil, expectedErr
+			},
+			GetInternalMiniBlockCalled: func(format common.ApiOutputFormat, hash []byte, epoch uint32) (interface{}, error) {
+				require.Equal(t, miniBlockHash, hash)
+				require.Equal(t, header.Epoch, epoch)
+				return &block.MiniBlock{
+					TxHashes: [][]byte{precedingTxHash, txHash, []byte("following tx hash")},
+				}, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceTransactionExecutionCalled: func(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error) {
+				require.Equal(t, tracedTx, tx)
+				require.Equal(t, header, executionContext.BlockHeader)
+				require.Equal(t, prevHeader.RootHash, executionContext.StateRootHash.GetRootHash())
+				require.Equal(t, prevHeader.Epoch, executionContext.StateRootHash.GetEpoch().Value)
+				require.Equal(t, []coreData.TransactionHandler{precedingTx}, executionContext.PrecedingTransactions)
+				return &txSimData.TransactionTrace{Status: transaction.TxStatusSuccess}, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		trace, err := nar.TraceTransaction(hex.EncodeToString(txHash))
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(txHash), trace.Hash)
+		require.Equal(t, hex.EncodeToString(blockHash), trace.BlockHash)
+		require.Equal(t, uint64(10), trace.BlockNonce)
+		require.Equal(t, transaction.TxStatusSuccess, trace.Status)
+	})
+	t.Run("should replay the transactions executed by the shard in their execution order", func(t *testing.T) {
+		t.Parallel()
+
+		incomingTx := &transaction.Transaction{Nonce: 3}
+		incomingSCR := &smartContractResult.SmartContractResult{Nonce: 4}
+		header := &block.Header{
+			ShardID:  0,
+			PrevHash: prevBlockHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: []byte("scheduled"), Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 0, TxCount: 1},
+				{Hash: []byte("incoming txs"), Type: block.TxBlock, SenderShardID: 1, ReceiverShardID: 0, TxCount: 1},
+				{Hash: []byte("outgoing scrs"), Type: block.SmartContractResultBlock, SenderShardID: 0, ReceiverShardID: 1},
+				{Hash: []byte("intra shard scrs"), Type: block.SmartContractResultBlock, SenderShardID: 0, ReceiverShardID: 0},
+				{Hash: []byte("processed"), Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 0},
+				{Hash: []byte("outgoing txs"), Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 1, TxCount: 1},
+				{Hash: []byte("incoming scrs"), Type: block.SmartContractResultBlock, SenderShardID: 1, ReceiverShardID: 0, TxCount: 2},
+			},
+		}
+		_ = header.MiniBlockHeaders[0].SetProcessingType(int32(block.Scheduled))
+		_ = header.MiniBlockHeaders[4].SetProcessingType(int32(block.Processed))
+		_ = header.MiniBlockHeaders[6].SetConstructionState(int32(block.PartialExecuted))
+		_ = header.MiniBlockHeaders[6].SetIndexOfLastTxProcessed(0)
+		miniBlocks := map[string]*block.MiniBlock{
+			"scheduled":     {TxHashes: [][]byte{txHash}},
+			"incoming txs":  {TxHashes: [][]byte{[]byte("incoming tx hash")}},
+			"outgoing txs":  {TxHashes: [][]byte{precedingTxHash}},
+			"incoming scrs": {TxHashes: [][]byte{[]byte("incoming scr hash"), []byte("not processed scr hash")}},
+		}
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				switch hash {
+				case hex.EncodeToString(txHash):
+					return &transaction.ApiTransactionResult{
+						Tx:        tracedTx,
+						Type:      string(transaction.TxTypeNormal),
+						BlockHash: hex.EncodeToString(blockHash),
+					}, nil
+				case hex.EncodeToString(precedingTxHash):
+					return &transaction.ApiTransactionResult{Tx: precedingTx}, nil
+				case hex.EncodeToString([]byte("incoming tx hash")):
+					return &transaction.ApiTransactionResult{Tx: incomingTx}, nil
+				case hex.EncodeToString([]byte("incoming scr hash")):
+					return &transaction.ApiTransactionResult{Tx: incomingSCR}, nil
+				default:
+					return nil, expectedErr
+				}
+			},
+		}
+		arg.APIInternalBlockHandler = &mock.InternalBlockApiHandlerStub{
+			GetInternalShardBlockByHashCalled: func(format common.ApiOutputFormat, hash []byte) (interface{}, error) {
+				if bytes.Equal(hash, blockHash) {
+					return header, nil
+				}
+				return &block.Header{}, nil
+			},
+			GetInternalMiniBlockCalled: func(format common.ApiOutputFormat, hash []byte, epoch uint32) (interface{}, error) {
+				miniBlock, ok := miniBlocks[string(hash)]
+				if !ok {
+					return nil, expectedErr
+				}
+				return miniBlock, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceTransactionExecutionCalled: func(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error) {
+				expectedPrecedingTransactions := []coreData.TransactionHandler{incomingTx, precedingTx, incomingSCR}
+				require.Equal(t, expectedPrecedingTransactions, executionContext.PrecedingTransactions)
+				return &txSimData.TransactionTrace{Status: transaction.TxStatusSuccess}, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		trace, err := nar.TraceTransaction(hex.EncodeToString(txHash))
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, trace.Status)
+	})
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -935,6 +1169,24 @@ func TestNodeApiResolver_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_CreateSubscriber(t *testing.T) {
+	t.Parallel()
+
+	providedSubscriber := &testscommon.SubscriberStub{}
+	args := createMockArgs()
+	args.SubscriptionsHandler = &testscommon.SubscriptionsHandlerStub{
+		CreateSubscriberCalled: func() (common.SubscriberHandler, error) {
+			return providedSubscriber, nil
+		},
+	}
+	nar, err := external.NewNodeApiResolver(args)
+	require.NoError(t, err)
+
+	subscriber, err := nar.CreateSubscriber()
+	require.NoError(t, err)
+	require.True(t, subscriber == providedSubscriber) // pointer testing
+}
+
 func TestNodeApiResolver_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
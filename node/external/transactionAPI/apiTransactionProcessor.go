@@ -341,10 +341,31 @@ func (atp *apiTransactionProcessor) extractRequestedTxInfo(wrappedTx *txcache.Wr
 	if requestedFieldsHandler.HasValue {
 		tx.TxFields[valueField] = getTxValue(wrappedTx)
 	}
+	if requestedFieldsHandler.HasReplacedTxs {
+		atp.putReplacedTxHashes(tx, wrappedTx.TxHash)
+	}
 
 	return tx
 }
 
+func (atp *apiTransactionProcessor) putReplacedTxHashes(tx common.Transaction, txHash []byte) {
+	replacementsHandler, ok := atp.dataPool.Transactions().(txReplacementsHandler)
+	if !ok {
+		return
+	}
+
+	replacedTxHashes, found := replacementsHandler.GetReplacedTxHashes(txHash)
+	if !found {
+		return
+	}
+
+	encodedTxHashes := make([]string, 0, len(replacedTxHashes))
+	for _, replacedTxHash := range replacedTxHashes {
+		encodedTxHashes = append(encodedTxHashes, hex.EncodeToString(replacedTxHash))
+	}
+	tx.TxFields[replacedTxsField] = encodedTxHashes
+}
+
 func (atp *apiTransactionProcessor) fetchTxsForSender(sender string, senderShard uint32) []*txcache.WrappedTransaction {
 	cacheId := process.ShardCacherIdentifier(senderShard, senderShard)
 	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
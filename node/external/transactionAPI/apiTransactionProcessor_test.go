@@ -844,6 +844,77 @@ func TestApiTransactionProcessor_GetTransactionsPoolForSender(t *testing.T) {
 	}, res)
 }
 
+type shardedDataWithReplacementsStub struct {
+	*testscommon.ShardedDataStub
+	replacements map[string][][]byte
+}
+
+func (stub *shardedDataWithReplacementsStub) GetReplacedTxHashes(txHash []byte) ([][]byte, bool) {
+	replacedTxHashes, found := stub.replacements[string(txHash)]
+	return replacedTxHashes, found
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolForSenderShouldShowReplacedTx(t *testing.T) {
+	t.Parallel()
+
+	txHash0, txHash1 := []byte("txHash0"), []byte("txHash1")
+	replacedTxHash0, replacedTxHash1 := []byte("replacedTxHash0"), []byte("replacedTxHash1")
+	sender := "alice"
+	txCacheIntraShard, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1_048_576, // 1 MB
+		CountPerSenderThreshold:    math.MaxUint32,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       1,
+		MinimumGasPrice:      1,
+		GasProcessingDivisor: 1,
+	})
+	txCacheIntraShard.AddTx(createTx(txHash0, sender, 1))
+	txCacheIntraShard.AddTx(createTx(txHash1, sender, 2))
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &shardedDataWithReplacementsStub{
+				ShardedDataStub: &testscommon.ShardedDataStub{
+					ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+						return txCacheIntraShard
+					},
+				},
+				replacements: map[string][][]byte{
+					string(txHash1): {replacedTxHash0, replacedTxHash1},
+				},
+			}
+		},
+	}
+	args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return []byte(humanReadable), nil
+		},
+	}
+	args.ShardCoordinator = &processMocks.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 1
+		},
+	}
+	atp, _ := NewAPITransactionProcessor(args)
+
+	res, err := atp.GetTransactionsPoolForSender(sender, "nonce,replacedTxHashes")
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 2)
+
+	_, found := res.Transactions[0].TxFields[replacedTxsField]
+	require.False(t, found)
+	expectedReplacedTxHashes := []string{hex.EncodeToString(replacedTxHash0), hex.EncodeToString(replacedTxHash1)}
+	require.Equal(t, expectedReplacedTxHashes, res.Transactions[1].TxFields[replacedTxsField])
+
+	res, err = atp.GetTransactionsPoolForSender(sender, "nonce")
+	require.NoError(t, err)
+	_, found = res.Transactions[1].TxFields[replacedTxsField]
+	require.False(t, found)
+}
+
 func TestApiTransactionProcessor_GetLastPoolNonceForSender(t *testing.T) {
 	t.Parallel()
 
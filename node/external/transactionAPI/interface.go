@@ -30,3 +30,8 @@ type LogsFacade interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// txReplacementsHandler defines the transactions pool able to tell which transactions were replaced by a new one
+type txReplacementsHandler interface {
+	GetReplacedTxHashes(txHash []byte) ([][]byte, bool)
+}
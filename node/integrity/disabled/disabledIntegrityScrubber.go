@@ -0,0 +1,28 @@
+package disabled
+
+import "github.com/kalyan3104/k-chain-go/common"
+
+type integrityScrubber struct{}
+
+// NewDisabledIntegrityScrubber returns a disabled implementation to be used when the scrubber is not enabled
+func NewDisabledIntegrityScrubber() *integrityScrubber {
+	return &integrityScrubber{}
+}
+
+// GetReport returns an empty report, marked as disabled
+func (is *integrityScrubber) GetReport() common.IntegrityReportAPI {
+	return common.IntegrityReportAPI{
+		Enabled: false,
+		Issues:  make([]*common.IntegrityIssueAPI, 0),
+	}
+}
+
+// Close does nothing and returns nil
+func (is *integrityScrubber) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (is *integrityScrubber) IsInterfaceNil() bool {
+	return is == nil
+}
@@ -0,0 +1,42 @@
+package integrity
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilChainHandler signals that a nil chain handler has been provided
+var ErrNilChainHandler = errors.New("nil chain handler")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilUserAccountsTrie signals that a nil user accounts trie has been provided
+var ErrNilUserAccountsTrie = errors.New("nil user accounts trie")
+
+// ErrNilPeerAccountsTrie signals that a nil peer accounts trie has been provided
+var ErrNilPeerAccountsTrie = errors.New("nil peer accounts trie")
+
+// ErrNilMissingTrieNodesNotifier signals that a nil missing trie nodes notifier has been provided
+var ErrNilMissingTrieNodesNotifier = errors.New("nil missing trie nodes notifier")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidIntervalInMinutes signals that an invalid interval between the scrubber passes has been provided
+var ErrInvalidIntervalInMinutes = errors.New("invalid interval in minutes")
+
+// ErrInvalidNumRecentBlocks signals that an invalid number of recent blocks has been provided
+var ErrInvalidNumRecentBlocks = errors.New("invalid number of recent blocks")
+
+// ErrInvalidPause signals that an invalid pause between checks has been provided
+var ErrInvalidPause = errors.New("invalid pause between checks")
+
+// ErrInvalidMaxReportedIssues signals that an invalid maximum number of reported issues has been provided
+var ErrInvalidMaxReportedIssues = errors.New("invalid maximum number of reported issues")
@@ -0,0 +1,500 @@
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/typeConverters"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/errChan"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/state/accounts"
+	"github.com/kalyan3104/k-chain-go/state/parsers"
+	"github.com/kalyan3104/k-chain-go/trie/keyBuilder"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+const (
+	// IssueTypeMissing is the type of the issues reported for the entries that can not be found in the storage
+	IssueTypeMissing = "missing"
+	// IssueTypeCorrupted is the type of the issues reported for the entries that do not match their hash or can not be decoded
+	IssueTypeCorrupted = "corrupted"
+
+	numTrieLeavesBetweenPauses = 1000
+)
+
+var log = logger.GetOrCreate("node/integrity")
+
+// ArgsIntegrityScrubber is the DTO used to create a new integrity scrubber
+type ArgsIntegrityScrubber struct {
+	Config                   config.IntegrityScrubberConfig
+	ShardID                  uint32
+	StorageService           dataRetriever.StorageService
+	ChainHandler             data.ChainHandler
+	Marshaller               marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	Uint64Converter          typeConverters.Uint64ByteSliceConverter
+	UserAccountsTrie         common.Trie
+	PeerAccountsTrie         common.Trie
+	MissingTrieNodesNotifier common.MissingTrieNodesNotifier
+	AppStatusHandler         core.AppStatusHandler
+}
+
+// integrityScrubber periodically verifies, in background, the data of the recent final blocks and the tries of the
+// final root hashes. The missing trie nodes can be requested from the network
+type integrityScrubber struct {
+	config                   config.IntegrityScrubberConfig
+	shardID                  uint32
+	storageService           dataRetriever.StorageService
+	chainHandler             data.ChainHandler
+	marshaller               marshal.Marshalizer
+	hasher                   hashing.Hasher
+	uint64Converter          typeConverters.Uint64ByteSliceConverter
+	userAccountsTrie         common.Trie
+	peerAccountsTrie         common.Trie
+	missingTrieNodesNotifier common.MissingTrieNodesNotifier
+	appStatusHandler         core.AppStatusHandler
+	timeBetweenPasses        time.Duration
+	pauseBetweenChecks       time.Duration
+	cancelFunc               func()
+
+	mutReport      sync.RWMutex
+	lastReport     *common.IntegrityReportAPI
+	passInProgress bool
+}
+
+// NewIntegrityScrubber creates a new integrity scrubber and starts its background go routine
+func NewIntegrityScrubber(args ArgsIntegrityScrubber) (*integrityScrubber, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	is := &integrityScrubber{
+		config:                   args.Config,
+		shardID:                  args.ShardID,
+		storageService:           args.StorageService,
+		chainHandler:             args.ChainHandler,
+		marshaller:               args.Marshaller,
+		hasher:                   args.Hasher,
+		uint64Converter:          args.Uint64Converter,
+		userAccountsTrie:         args.UserAccountsTrie,
+		peerAccountsTrie:         args.PeerAccountsTrie,
+		missingTrieNodesNotifier: args.MissingTrieNodesNotifier,
+		appStatusHandler:         args.AppStatusHandler,
+		timeBetweenPasses:        time.Duration(args.Config.IntervalInMinutes) * time.Minute,
+		pauseBetweenChecks:       time.Duration(args.Config.PauseBetweenChecksInMilliseconds) * time.Millisecond,
+		lastReport:               createEmptyReport(),
+	}
+
+	var ctx context.Context
+	ctx, is.cancelFunc = context.WithCancel(context.Background())
+	go is.startScrubbing(ctx)
+
+	return is, nil
+}
+
+func checkArgs(args ArgsIntegrityScrubber) error {
+	if args.Config.IntervalInMinutes < 1 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidIntervalInMinutes, args.Config.IntervalInMinutes)
+	}
+	if args.Config.NumRecentBlocks == 0 {
+		return ErrInvalidNumRecentBlocks
+	}
+	if args.Config.PauseBetweenChecksInMilliseconds < 0 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidPause, args.Config.PauseBetweenChecksInMilliseconds)
+	}
+	if args.Config.MaxReportedIssues < 1 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidMaxReportedIssues, args.Config.MaxReportedIssues)
+	}
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.ChainHandler) {
+		return ErrNilChainHandler
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return ErrNilUint64Converter
+	}
+	if check.IfNil(args.UserAccountsTrie) {
+		return ErrNilUserAccountsTrie
+	}
+	if check.IfNil(args.PeerAccountsTrie) {
+		return ErrNilPeerAccountsTrie
+	}
+	if check.IfNil(args.MissingTrieNodesNotifier) {
+		return ErrNilMissingTrieNodesNotifier
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+
+	return nil
+}
+
+func createEmptyReport() *common.IntegrityReportAPI {
+	return &common.IntegrityReportAPI{
+		Enabled: true,
+		Issues:  make([]*common.IntegrityIssueAPI, 0),
+	}
+}
+
+func (is *integrityScrubber) startScrubbing(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("integrityScrubber's go routine is stopping...")
+			return
+		case <-time.After(is.timeBetweenPasses):
+		}
+
+		is.doPass(ctx)
+	}
+}
+
+func (is *integrityScrubber) doPass(ctx context.Context) {
+	is.setPassInProgress(true)
+	defer is.setPassInProgress(false)
+
+	startTime := time.Now()
+	report := createEmptyReport()
+	finalNonce, _, finalRootHash := is.chainHandler.GetFinalBlockInfo()
+	log.Debug("integrity scrubber pass started", "final nonce", finalNonce, "final root hash", finalRootHash)
+
+	finalHeader := is.checkRecentBlocks(ctx, report, finalNonce)
+	if is.config.CheckStateTries {
+		is.checkTrie(ctx, report, is.userAccountsTrie, finalRootHash, dataRetriever.UserAccountsUnit, is.config.CheckDataTries)
+
+		metaHeader, isMetaHeader := finalHeader.(data.MetaHeaderHandler)
+		if isMetaHeader && !check.IfNil(metaHeader) {
+			is.checkTrie(ctx, report, is.peerAccountsTrie, metaHeader.GetValidatorStatsRootHash(), dataRetriever.PeerAccountsUnit, false)
+		}
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	report.LastPassTimestamp = time.Now().Unix()
+	report.LastPassDurationInSec = time.Since(startTime).Seconds()
+
+	is.mutReport.Lock()
+	report.NumPasses = is.lastReport.NumPasses + 1
+	is.lastReport = report
+	is.mutReport.Unlock()
+
+	is.appStatusHandler.SetUInt64Value(common.MetricIntegrityNumMissingEntries, report.NumMissingEntries)
+	is.appStatusHandler.SetUInt64Value(common.MetricIntegrityNumCorruptedEntries, report.NumCorruptedEntries)
+	is.appStatusHandler.SetUInt64Value(common.MetricIntegrityLastPassTimestamp, uint64(report.LastPassTimestamp))
+
+	log.Debug("integrity scrubber pass finished",
+		"num headers", report.NumCheckedHeaders,
+		"num miniblocks", report.NumCheckedMiniBlocks,
+		"num transactions", report.NumCheckedTransactions,
+		"num trie leaves", report.NumCheckedTrieLeaves,
+		"num missing entries", report.NumMissingEntries,
+		"num corrupted entries", report.NumCorruptedEntries,
+		"duration", time.Since(startTime))
+}
+
+// checkRecentBlocks verifies the blocks starting with the final one, going backwards. It returns the final header,
+// if it is valid, as the other root hashes of the final state are found in it
+func (is *integrityScrubber) checkRecentBlocks(ctx context.Context, report *common.IntegrityReportAPI, finalNonce uint64) data.HeaderHandler {
+	var finalHeader data.HeaderHandler
+	// the genesis block is not checked as it is not stored as the other blocks
+	for i := uint64(0); i < is.config.NumRecentBlocks && i < finalNonce; i++ {
+		header := is.checkBlock(report, finalNonce-i)
+		if i == 0 {
+			finalHeader = header
+		}
+
+		if !is.pause(ctx) {
+			return nil
+		}
+	}
+
+	return finalHeader
+}
+
+func (is *integrityScrubber) checkBlock(report *common.IntegrityReportAPI, nonce uint64) data.HeaderHandler {
+	nonceHashUnit, headerUnit := is.getHeaderUnits()
+	nonceKey := is.uint64Converter.ToByteSlice(nonce)
+	headerHash, err := is.storageService.Get(nonceHashUnit, nonceKey)
+	if err != nil {
+		is.addIssue(report, IssueTypeMissing, nonceHashUnit, nonceKey, "no header hash for nonce %d", nonce)
+		return nil
+	}
+
+	headerBuff, err := is.storageService.Get(headerUnit, headerHash)
+	if err != nil {
+		is.addIssue(report, IssueTypeMissing, headerUnit, headerHash, "missing header for nonce %d", nonce)
+		return nil
+	}
+	if !bytes.Equal(is.hasher.Compute(string(headerBuff)), headerHash) {
+		is.addIssue(report, IssueTypeCorrupted, headerUnit, headerHash, "header for nonce %d does not match its hash", nonce)
+		return nil
+	}
+
+	header, err := process.UnmarshalHeader(is.shardID, is.marshaller, headerBuff)
+	if err != nil {
+		is.addIssue(report, IssueTypeCorrupted, headerUnit, headerHash, "header for nonce %d cannot be unmarshalled: %s", nonce, err.Error())
+		return nil
+	}
+	if header.GetNonce() != nonce {
+		is.addIssue(report, IssueTypeCorrupted, nonceHashUnit, nonceKey, "the indexed header has nonce %d", header.GetNonce())
+		return nil
+	}
+
+	report.NumCheckedHeaders++
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		is.checkMiniBlock(report, header, miniBlockHeader)
+	}
+
+	return header
+}
+
+func (is *integrityScrubber) checkMiniBlock(report *common.IntegrityReportAPI, header data.HeaderHandler, miniBlockHeader data.MiniBlockHeaderHandler) {
+	miniBlockHash := miniBlockHeader.GetHash()
+	miniBlockBuff, err := is.storageService.Get(dataRetriever.MiniBlockUnit, miniBlockHash)
+	if err != nil {
+		is.addIssue(report, IssueTypeMissing, dataRetriever.MiniBlockUnit, miniBlockHash,
+			"missing miniblock from header with nonce %d", header.GetNonce())
+		return
+	}
+	if !bytes.Equal(is.hasher.Compute(string(miniBlockBuff)), miniBlockHash) {
+		is.addIssue(report, IssueTypeCorrupted, dataRetriever.MiniBlockUnit, miniBlockHash,
+			"miniblock from header with nonce %d does not match its hash", header.GetNonce())
+		return
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = is.marshaller.Unmarshal(miniBlock, miniBlockBuff)
+	if err != nil {
+		is.addIssue(report, IssueTypeCorrupted, dataRetriever.MiniBlockUnit, miniBlockHash,
+			"miniblock from header with nonce %d cannot be unmarshalled: %s", header.GetNonce(), err.Error())
+		return
+	}
+
+	report.NumCheckedMiniBlocks++
+	txUnit, shouldCheckTxs := getTransactionsUnit(miniBlock.Type)
+	if !shouldCheckTxs {
+		return
+	}
+
+	txStorer, err := is.storageService.GetStorer(txUnit)
+	if err != nil {
+		log.Debug("integrityScrubber.checkMiniBlock: cannot get the transactions storer", "unit", txUnit.String(), "error", err)
+		return
+	}
+
+	// only the processed transactions are saved, as a miniblock can be partially executed in a block
+	firstIndex := int(miniBlockHeader.GetIndexOfFirstTxProcessed())
+	lastIndex := int(miniBlockHeader.GetIndexOfLastTxProcessed())
+	for index := firstIndex; index <= lastIndex && index < len(miniBlock.TxHashes); index++ {
+		txHash := miniBlock.TxHashes[index]
+		err = txStorer.Has(txHash)
+		if err != nil {
+			is.addIssue(report, IssueTypeMissing, txUnit, txHash,
+				"missing transaction from miniblock %s, header with nonce %d", hex.EncodeToString(miniBlockHash), header.GetNonce())
+			continue
+		}
+
+		report.NumCheckedTransactions++
+	}
+}
+
+func (is *integrityScrubber) getHeaderUnits() (dataRetriever.UnitType, dataRetriever.UnitType) {
+	if is.shardID == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit, dataRetriever.MetaBlockUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(is.shardID), dataRetriever.BlockHeaderUnit
+}
+
+func getTransactionsUnit(miniBlockType block.Type) (dataRetriever.UnitType, bool) {
+	switch miniBlockType {
+	case block.TxBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+// checkTrie walks the trie with the provided root hash. The walk stops at the first node that can not be loaded, so
+// the following passes will find the next missing nodes, if any
+func (is *integrityScrubber) checkTrie(
+	ctx context.Context,
+	report *common.IntegrityReportAPI,
+	tr common.Trie,
+	rootHash []byte,
+	unit dataRetriever.UnitType,
+	checkDataTries bool,
+) {
+	if common.IsEmptyTrie(rootHash) || ctx.Err() != nil {
+		return
+	}
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := tr.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewDisabledKeyBuilder(), parsers.NewMainTrieLeafParser())
+	if err != nil {
+		is.handleTrieError(ctx, report, rootHash, unit, err)
+		return
+	}
+
+	numLeaves := 0
+	for leaf := range leavesChannels.LeavesChan {
+		report.NumCheckedTrieLeaves++
+		numLeaves++
+		if checkDataTries {
+			is.checkDataTrie(ctx, report, tr, leaf, unit)
+		}
+		if numLeaves%numTrieLeavesBetweenPauses == 0 {
+			is.pause(ctx)
+		}
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		is.handleTrieError(ctx, report, rootHash, unit, err)
+	}
+}
+
+func (is *integrityScrubber) checkDataTrie(
+	ctx context.Context,
+	report *common.IntegrityReportAPI,
+	tr common.Trie,
+	leaf core.KeyValueHolder,
+	unit dataRetriever.UnitType,
+) {
+	accountData := &accounts.UserAccountData{}
+	err := is.marshaller.Unmarshal(accountData, leaf.Value())
+	if err != nil {
+		log.Trace("this must be a leaf with code", "leaf key", leaf.Key(), "error", err)
+		return
+	}
+
+	// the data tries are saved in the same storage as the main trie
+	is.checkTrie(ctx, report, tr, accountData.RootHash, unit, false)
+}
+
+func (is *integrityScrubber) handleTrieError(
+	ctx context.Context,
+	report *common.IntegrityReportAPI,
+	rootHash []byte,
+	unit dataRetriever.UnitType,
+	err error,
+) {
+	if ctx.Err() != nil || core.IsClosingError(err) {
+		return
+	}
+
+	getNodeErr := core.UnwrapGetNodeFromDBErr(err)
+	if !core.IsGetNodeFromDBError(err) || check.IfNilReflect(getNodeErr) {
+		is.addIssue(report, IssueTypeCorrupted, unit, rootHash, "cannot walk the trie: %s", err.Error())
+		return
+	}
+
+	missingKey := getNodeErr.GetKey()
+	is.addIssue(report, IssueTypeMissing, unit, missingKey, "missing node of the trie with root hash %s", hex.EncodeToString(rootHash))
+
+	// the missing trie nodes notifier only handles the nodes of the user accounts tries
+	if is.config.RequestMissingTrieNodes && unit == dataRetriever.UserAccountsUnit {
+		is.missingTrieNodesNotifier.AsyncNotifyMissingTrieNode(missingKey)
+	}
+}
+
+func (is *integrityScrubber) addIssue(
+	report *common.IntegrityReportAPI,
+	issueType string,
+	unit dataRetriever.UnitType,
+	key []byte,
+	format string,
+	args ...interface{},
+) {
+	issue := &common.IntegrityIssueAPI{
+		Type:    issueType,
+		Unit:    unit.String(),
+		Key:     hex.EncodeToString(key),
+		Details: fmt.Sprintf(format, args...),
+	}
+	log.Warn("integrity scrubber found an issue", "type", issue.Type, "unit", issue.Unit, "key", issue.Key, "details", issue.Details)
+
+	if issueType == IssueTypeMissing {
+		report.NumMissingEntries++
+	} else {
+		report.NumCorruptedEntries++
+	}
+
+	if len(report.Issues) < is.config.MaxReportedIssues {
+		report.Issues = append(report.Issues, issue)
+	}
+}
+
+// pause returns false if the scrubber was closed in the meantime
+func (is *integrityScrubber) pause(ctx context.Context) bool {
+	if is.pauseBetweenChecks == 0 {
+		return ctx.Err() == nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(is.pauseBetweenChecks):
+		return true
+	}
+}
+
+func (is *integrityScrubber) setPassInProgress(passInProgress bool) {
+	is.mutReport.Lock()
+	is.passInProgress = passInProgress
+	is.mutReport.Unlock()
+}
+
+// GetReport returns the report of the last finished pass
+func (is *integrityScrubber) GetReport() common.IntegrityReportAPI {
+	is.mutReport.RLock()
+	defer is.mutReport.RUnlock()
+
+	report := *is.lastReport
+	report.PassInProgress = is.passInProgress
+	report.Issues = make([]*common.IntegrityIssueAPI, len(is.lastReport.Issues))
+	copy(report.Issues, is.lastReport.Issues)
+
+	return report
+}
+
+// Close stops the background go routine
+func (is *integrityScrubber) Close() error {
+	is.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (is *integrityScrubber) IsInterfaceNil() bool {
+	return is == nil
+}
@@ -0,0 +1,479 @@
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/state/accounts"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/kalyan3104/k-chain-go/testscommon/genericMocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/hashingMocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
+	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
+	storageStubs "github.com/kalyan3104/k-chain-go/testscommon/storage"
+	trieMock "github.com/kalyan3104/k-chain-go/testscommon/trie"
+	"github.com/kalyan3104/k-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+type testBlockchain struct {
+	args       ArgsIntegrityScrubber
+	store      *genericMocks.ChainStorerMock
+	mainStorer *testscommon.SnapshotPruningStorerMock
+	finalNonce uint64
+	rootHash   []byte
+}
+
+func createMockArgs() ArgsIntegrityScrubber {
+	return ArgsIntegrityScrubber{
+		Config: config.IntegrityScrubberConfig{
+			Enabled:                 true,
+			IntervalInMinutes:       60,
+			NumRecentBlocks:         10,
+			CheckStateTries:         true,
+			CheckDataTries:          true,
+			RequestMissingTrieNodes: true,
+			MaxReportedIssues:       10,
+		},
+		ShardID:                  0,
+		StorageService:           genericMocks.NewChainStorerMock(0),
+		ChainHandler:             &testscommon.ChainHandlerStub{},
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		Hasher:                   &hashingMocks.HasherMock{},
+		Uint64Converter:          uint64ByteSlice.NewBigEndianConverter(),
+		UserAccountsTrie:         &trieMock.TrieStub{},
+		PeerAccountsTrie:         &trieMock.TrieStub{},
+		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		AppStatusHandler:         &statusHandler.AppStatusHandlerStub{},
+	}
+}
+
+// createTestBlockchain saves the provided number of blocks, each one holding a miniblock with two transactions, and
+// a user accounts trie holding an account with a data trie
+func createTestBlockchain(t *testing.T, numBlocks uint64) *testBlockchain {
+	args := createMockArgs()
+	store := genericMocks.NewChainStorerMock(0)
+	args.StorageService = store
+
+	for nonce := uint64(1); nonce <= numBlocks; nonce++ {
+		txHashes := [][]byte{[]byte(fmt.Sprintf("tx%d_0", nonce)), []byte(fmt.Sprintf("tx%d_1", nonce))}
+		for _, txHash := range txHashes {
+			_ = store.Transactions.Put(txHash, []byte("tx"))
+		}
+
+		miniBlockBuff, _ := args.Marshaller.Marshal(&block.MiniBlock{TxHashes: txHashes, Type: block.TxBlock})
+		miniBlockHash := args.Hasher.Compute(string(miniBlockBuff))
+		_ = store.Miniblocks.Put(miniBlockHash, miniBlockBuff)
+
+		header := &block.Header{
+			Nonce: nonce,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: miniBlockHash, TxCount: uint32(len(txHashes)), Type: block.TxBlock},
+			},
+		}
+		headerBuff, _ := args.Marshaller.Marshal(header)
+		headerHash := args.Hasher.Compute(string(headerBuff))
+		_ = store.BlockHeaders.Put(headerHash, headerBuff)
+		_ = store.ShardHdrNonce.Put(args.Uint64Converter.ToByteSlice(nonce), headerHash)
+	}
+
+	storageManagerArgs := storageStubs.GetStorageManagerArgs()
+	mainStorer := testscommon.NewSnapshotPruningStorerMock()
+	storageManagerArgs.MainStorer = mainStorer
+	trieStorage, err := trie.NewTrieStorageManager(storageManagerArgs)
+	require.Nil(t, err)
+	userTrie, err := trie.NewTrie(trieStorage, args.Marshaller, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	dataTrie, _ := userTrie.Recreate(make([]byte, 0))
+	for i := 0; i < 20; i++ {
+		_ = dataTrie.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	_ = dataTrie.Commit()
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	accountBuff, _ := args.Marshaller.Marshal(&accounts.UserAccountData{Address: []byte("address"), RootHash: dataTrieRootHash})
+	_ = userTrie.Update([]byte("address"), accountBuff)
+	for i := 0; i < 20; i++ {
+		_ = userTrie.Update([]byte(fmt.Sprintf("account%d", i)), []byte(fmt.Sprintf("code%d", i)))
+	}
+	_ = userTrie.Commit()
+	rootHash, _ := userTrie.RootHash()
+
+	args.UserAccountsTrie = userTrie
+	args.ChainHandler = &testscommon.ChainHandlerStub{
+		GetFinalBlockInfoCalled: func() (uint64, []byte, []byte) {
+			return numBlocks, []byte("final header hash"), rootHash
+		},
+	}
+
+	return &testBlockchain{
+		args:       args,
+		store:      store,
+		mainStorer: mainStorer,
+		finalNonce: numBlocks,
+		rootHash:   rootHash,
+	}
+}
+
+func getHeaderHash(t *testing.T, chain *testBlockchain, nonce uint64) []byte {
+	headerHash, err := chain.store.ShardHdrNonce.Get(chain.args.Uint64Converter.ToByteSlice(nonce))
+	require.Nil(t, err)
+
+	return headerHash
+}
+
+func getMiniBlockHash(t *testing.T, chain *testBlockchain, nonce uint64) []byte {
+	headerBuff, err := chain.store.BlockHeaders.Get(getHeaderHash(t, chain, nonce))
+	require.Nil(t, err)
+
+	header := &block.Header{}
+	err = chain.args.Marshaller.Unmarshal(header, headerBuff)
+	require.Nil(t, err)
+
+	return header.MiniBlockHeaders[0].Hash
+}
+
+func getNonRootTrieNodeHash(t *testing.T, chain *testBlockchain) []byte {
+	hashes, err := chain.args.UserAccountsTrie.GetAllHashes()
+	require.Nil(t, err)
+
+	for _, hash := range hashes {
+		if !bytes.Equal(hash, chain.rootHash) {
+			return hash
+		}
+	}
+
+	require.Fail(t, "the trie should have more than one node")
+	return nil
+}
+
+func createScrubber(t *testing.T, args ArgsIntegrityScrubber) *integrityScrubber {
+	is, err := NewIntegrityScrubber(args)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = is.Close()
+	})
+
+	return is
+}
+
+func TestNewIntegrityScrubber(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Config.IntervalInMinutes = 0
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.True(t, errors.Is(err, ErrInvalidIntervalInMinutes))
+	})
+	t.Run("invalid number of recent blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Config.NumRecentBlocks = 0
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrInvalidNumRecentBlocks, err)
+	})
+	t.Run("invalid pause should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Config.PauseBetweenChecksInMilliseconds = -1
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.True(t, errors.Is(err, ErrInvalidPause))
+	})
+	t.Run("invalid max reported issues should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Config.MaxReportedIssues = 0
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.True(t, errors.Is(err, ErrInvalidMaxReportedIssues))
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StorageService = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil chain handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ChainHandler = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilChainHandler, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hasher = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Uint64Converter = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilUint64Converter, err)
+	})
+	t.Run("nil user accounts trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.UserAccountsTrie = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilUserAccountsTrie, err)
+	})
+	t.Run("nil peer accounts trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PeerAccountsTrie = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilPeerAccountsTrie, err)
+	})
+	t.Run("nil missing trie nodes notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MissingTrieNodesNotifier = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilMissingTrieNodesNotifier, err)
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AppStatusHandler = nil
+		is, err := NewIntegrityScrubber(args)
+		assert.Nil(t, is)
+		assert.Equal(t, ErrNilAppStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		is, err := NewIntegrityScrubber(createMockArgs())
+		assert.Nil(t, err)
+		assert.False(t, is.IsInterfaceNil())
+
+		report := is.GetReport()
+		assert.True(t, report.Enabled)
+		assert.Zero(t, report.NumPasses)
+		assert.Nil(t, is.Close())
+	})
+}
+
+func TestIntegrityScrubber_DoPass(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy storage should not report issues", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 5)
+		metrics := make(map[string]uint64)
+		mutMetrics := sync.Mutex{}
+		chain.args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				mutMetrics.Lock()
+				metrics[key] = value
+				mutMetrics.Unlock()
+			},
+		}
+		is := createScrubber(t, chain.args)
+
+		is.doPass(context.Background())
+
+		report := is.GetReport()
+		assert.Equal(t, uint64(1), report.NumPasses)
+		assert.False(t, report.PassInProgress)
+		assert.Equal(t, uint64(5), report.NumCheckedHeaders)
+		assert.Equal(t, uint64(5), report.NumCheckedMiniBlocks)
+		assert.Equal(t, uint64(10), report.NumCheckedTransactions)
+		// 21 accounts and 20 data trie leaves
+		assert.Equal(t, uint64(41), report.NumCheckedTrieLeaves)
+		assert.Zero(t, report.NumMissingEntries)
+		assert.Zero(t, report.NumCorruptedEntries)
+		assert.Empty(t, report.Issues)
+
+		mutMetrics.Lock()
+		assert.Equal(t, uint64(0), metrics[common.MetricIntegrityNumMissingEntries])
+		assert.Equal(t, uint64(0), metrics[common.MetricIntegrityNumCorruptedEntries])
+		assert.Equal(t, uint64(report.LastPassTimestamp), metrics[common.MetricIntegrityLastPassTimestamp])
+		mutMetrics.Unlock()
+	})
+	t.Run("should only check the recent blocks", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 5)
+		chain.args.Config.NumRecentBlocks = 2
+		chain.args.Config.CheckStateTries = false
+		is := createScrubber(t, chain.args)
+
+		is.doPass(context.Background())
+
+		report := is.GetReport()
+		assert.Equal(t, uint64(2), report.NumCheckedHeaders)
+		assert.Zero(t, report.NumCheckedTrieLeaves)
+	})
+	t.Run("missing and corrupted blocks data should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 5)
+		chain.args.Config.CheckStateTries = false
+		chain.store.Transactions.ClearAll()
+		_ = chain.store.Miniblocks.Put(getMiniBlockHash(t, chain, 4), []byte("corrupted miniblock"))
+		_ = chain.store.BlockHeaders.Put(getHeaderHash(t, chain, 3), []byte("corrupted header"))
+		_ = chain.store.ShardHdrNonce.Put(chain.args.Uint64Converter.ToByteSlice(2), []byte("missing header hash"))
+		is := createScrubber(t, chain.args)
+
+		is.doPass(context.Background())
+
+		report := is.GetReport()
+		assert.Equal(t, uint64(3), report.NumCheckedHeaders)
+		assert.Equal(t, uint64(2), report.NumCheckedMiniBlocks)
+		assert.Zero(t, report.NumCheckedTransactions)
+		// the transactions of the blocks with nonces 1 and 5 and the header with nonce 2
+		assert.Equal(t, uint64(5), report.NumMissingEntries)
+		assert.Equal(t, uint64(2), report.NumCorruptedEntries)
+		require.Len(t, report.Issues, 7)
+		assert.Equal(t, IssueTypeCorrupted, report.Issues[2].Type)
+		assert.Equal(t, dataRetriever.MiniBlockUnit.String(), report.Issues[2].Unit)
+		assert.Equal(t, IssueTypeCorrupted, report.Issues[3].Type)
+		assert.Equal(t, dataRetriever.BlockHeaderUnit.String(), report.Issues[3].Unit)
+		assert.Equal(t, IssueTypeMissing, report.Issues[4].Type)
+		assert.Equal(t, dataRetriever.BlockHeaderUnit.String(), report.Issues[4].Unit)
+	})
+	t.Run("the number of reported issues should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 5)
+		chain.args.Config.MaxReportedIssues = 3
+		chain.store.Transactions.ClearAll()
+		is := createScrubber(t, chain.args)
+
+		is.doPass(context.Background())
+
+		report := is.GetReport()
+		assert.Equal(t, uint64(10), report.NumMissingEntries)
+		assert.Len(t, report.Issues, 3)
+	})
+	t.Run("missing trie node should be reported and requested", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 1)
+		missingHash := getNonRootTrieNodeHash(t, chain)
+		_ = chain.mainStorer.Remove(missingHash)
+
+		var requestedHash []byte
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		chain.args.MissingTrieNodesNotifier = &testscommon.MissingTrieNodesNotifierStub{
+			AsyncNotifyMissingTrieNodeCalled: func(hash []byte) {
+				requestedHash = hash
+				wg.Done()
+			},
+		}
+		is := createScrubber(t, chain.args)
+
+		is.doPass(context.Background())
+		wg.Wait()
+
+		report := is.GetReport()
+		assert.Equal(t, uint64(1), report.NumMissingEntries)
+		require.Len(t, report.Issues, 1)
+		assert.Equal(t, dataRetriever.UserAccountsUnit.String(), report.Issues[0].Unit)
+		assert.Equal(t, fmt.Sprintf("%x", missingHash), report.Issues[0].Key)
+		assert.Equal(t, missingHash, requestedHash)
+	})
+	t.Run("missing trie node should not be requested if not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 1)
+		_ = chain.mainStorer.Remove(chain.rootHash)
+		chain.args.Config.RequestMissingTrieNodes = false
+		chain.args.MissingTrieNodesNotifier = &testscommon.MissingTrieNodesNotifierStub{
+			AsyncNotifyMissingTrieNodeCalled: func(hash []byte) {
+				assert.Fail(t, "should have not requested the missing trie node")
+			},
+		}
+		is := createScrubber(t, chain.args)
+
+		is.doPass(context.Background())
+
+		report := is.GetReport()
+		assert.Equal(t, uint64(1), report.NumMissingEntries)
+		require.Len(t, report.Issues, 1)
+		assert.Equal(t, fmt.Sprintf("%x", chain.rootHash), report.Issues[0].Key)
+	})
+	t.Run("closed scrubber should not publish the report", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestBlockchain(t, 5)
+		is := createScrubber(t, chain.args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		is.doPass(ctx)
+
+		report := is.GetReport()
+		assert.Zero(t, report.NumPasses)
+		assert.Zero(t, report.NumCheckedHeaders)
+	})
+}
+
+func TestIntegrityScrubber_HandleTrieErrorNotCausedByMissingNode(t *testing.T) {
+	t.Parallel()
+
+	is := createScrubber(t, createMockArgs())
+	report := createEmptyReport()
+
+	is.handleTrieError(context.Background(), report, []byte("rootHash"), dataRetriever.PeerAccountsUnit, expectedErr)
+	is.handleTrieError(context.Background(), report, []byte("rootHash"), dataRetriever.PeerAccountsUnit, core.ErrContextClosing)
+
+	assert.Equal(t, uint64(1), report.NumCorruptedEntries)
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, dataRetriever.PeerAccountsUnit.String(), report.Issues[0].Unit)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/p2p"
 	"github.com/kalyan3104/k-chain-go/update"
 	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
@@ -58,7 +59,24 @@ type HealthService interface {
 	RegisterComponent(component interface{})
 }
 
+// IntegrityScrubber defines the behavior of a component able to verify, in background, the data held by the node's storage
+type IntegrityScrubber interface {
+	GetReport() common.IntegrityReportAPI
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxPoolPersister defines the behavior of a component able to save the transactions pool and reload it after a restart
+type TxPoolPersister interface {
+	Close() error
+	IsInterfaceNil() bool
+}
+
 type accountHandlerWithDataTrieMigrationStatus interface {
 	vmcommon.AccountHandler
 	IsDataTrieMigrated() (bool, error)
 }
+
+type txPoolCancellationsHandler interface {
+	ScheduleCancellation(txHash []byte, sender []byte, nonce uint64)
+}
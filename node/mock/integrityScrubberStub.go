@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/kalyan3104/k-chain-go/common"
+
+// IntegrityScrubberStub -
+type IntegrityScrubberStub struct {
+	GetReportCalled func() common.IntegrityReportAPI
+	CloseCalled     func() error
+}
+
+// GetReport -
+func (stub *IntegrityScrubberStub) GetReport() common.IntegrityReportAPI {
+	if stub.GetReportCalled != nil {
+		return stub.GetReportCalled()
+	}
+
+	return common.IntegrityReportAPI{}
+}
+
+// Close -
+func (stub *IntegrityScrubberStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *IntegrityScrubberStub) IsInterfaceNil() bool {
+	return stub == nil
+}
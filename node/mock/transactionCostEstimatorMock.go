@@ -9,6 +9,7 @@ import (
 type TransactionCostEstimatorMock struct {
 	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecutionCalled    func(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error)
 }
 
 // ComputeTransactionGasLimit -
@@ -28,6 +29,15 @@ func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *trans
 	return &txSimData.SimulationResultsWithVMOutput{}, nil
 }
 
+// TraceTransactionExecution -
+func (tcem *TransactionCostEstimatorMock) TraceTransactionExecution(tx *transaction.Transaction, executionContext *txSimData.HistoricalExecutionContext) (*txSimData.TransactionTrace, error) {
+	if tcem.TraceTransactionExecutionCalled != nil {
+		return tcem.TraceTransactionExecutionCalled(tx, executionContext)
+	}
+
+	return &txSimData.TransactionTrace{}, nil
+}
+
 // IsInterfaceNil -
 func (tcem *TransactionCostEstimatorMock) IsInterfaceNil() bool {
 	return tcem == nil
@@ -31,12 +31,14 @@ import (
 	heartbeatData "github.com/kalyan3104/k-chain-go/heartbeat/data"
 	"github.com/kalyan3104/k-chain-go/node/disabled"
 	"github.com/kalyan3104/k-chain-go/node/external"
+	disabledIntegrity "github.com/kalyan3104/k-chain-go/node/integrity/disabled"
 	"github.com/kalyan3104/k-chain-go/p2p"
 	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/process/dataValidators"
 	"github.com/kalyan3104/k-chain-go/process/smartContract"
 	procTx "github.com/kalyan3104/k-chain-go/process/transaction"
 	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/storage/txcache"
 	"github.com/kalyan3104/k-chain-go/trie"
 	"github.com/kalyan3104/k-chain-go/vm"
 	"github.com/kalyan3104/k-chain-go/vm/systemSmartContracts"
@@ -48,6 +50,9 @@ import (
 const (
 	// dcdtTickerNumChars represents the number of hex-encoded characters of a ticker
 	dcdtTickerNumChars = 6
+
+	// maxKeysInMultiProof represents the maximum number of keys that can be proven in a single multi-proof
+	maxKeysInMultiProof = 1000
 )
 
 var log = logger.GetOrCreate("node")
@@ -106,6 +111,7 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+	integrityScrubber         IntegrityScrubber
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -122,7 +128,8 @@ func (n *Node) ApplyOptions(opts ...Option) error {
 // NewNode creates a new Node instance
 func NewNode(opts ...Option) (*Node, error) {
 	node := &Node{
-		queryHandlers: make(map[string]debug.QueryHandler),
+		queryHandlers:     make(map[string]debug.QueryHandler),
+		integrityScrubber: disabledIntegrity.NewDisabledIntegrityScrubber(),
 	}
 
 	node.closableComponents = make([]mainFactory.Closer, 0)
@@ -708,6 +715,50 @@ func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, err
 	return n.processComponents.TxsSenderHandler().SendBulkTransactions(txs)
 }
 
+// ScheduleTransactionsPoolCancellationForSender schedules the removal from the node's own transactions pool of all the
+// sender's transactions having a nonce higher than the provided one. They are dropped once the cancellation transaction
+// is included in a committed block, so the returned transactions are the ones currently waiting in the pool. The pools
+// of the other nodes are not affected
+func (n *Node) ScheduleTransactionsPoolCancellationForSender(
+	cancellationTxHash []byte,
+	sender []byte,
+	nonce uint64,
+) ([]common.RemovedPoolTransactionApiResponse, error) {
+	txPool := n.dataComponents.Datapool().Transactions()
+	cancellationsHandler, ok := txPool.(txPoolCancellationsHandler)
+	if !ok {
+		return nil, ErrTransactionsPoolCancellationNotSupported
+	}
+
+	senderShard := n.processComponents.ShardCoordinator().ComputeId(sender)
+	cacheID := process.ShardCacherIdentifier(senderShard, senderShard)
+
+	txsToRemove := make([]common.RemovedPoolTransactionApiResponse, 0)
+	txCache, ok := txPool.ShardDataStore(cacheID).(*txcache.TxCache)
+	if ok {
+		for _, wrappedTx := range txCache.GetTransactionsPoolForSender(string(sender)) {
+			if wrappedTx.Tx.GetNonce() <= nonce {
+				continue
+			}
+
+			txsToRemove = append(txsToRemove, common.RemovedPoolTransactionApiResponse{
+				Hash:  hex.EncodeToString(wrappedTx.TxHash),
+				Nonce: wrappedTx.Tx.GetNonce(),
+			})
+		}
+	}
+
+	cancellationsHandler.ScheduleCancellation(cancellationTxHash, sender, nonce)
+
+	log.Debug("node.ScheduleTransactionsPoolCancellationForSender",
+		"sender", sender,
+		"nonce", nonce,
+		"cancellation tx", cancellationTxHash,
+		"num txs to remove", len(txsToRemove))
+
+	return txsToRemove, nil
+}
+
 // ValidateTransaction will validate a transaction
 func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 	err := n.checkSenderIsInShard(tx)
@@ -1169,6 +1220,12 @@ func (n *Node) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return n.networkComponents.PeersRatingMonitor().GetConnectedPeersRatings(n.networkComponents.NetworkMessenger())
 }
 
+// GetIntegrityReport returns the report of the last finished storage integrity scrubber pass
+func (n *Node) GetIntegrityReport() (*common.IntegrityReportAPI, error) {
+	report := n.integrityScrubber.GetReport()
+	return &report, nil
+}
+
 // GetEpochStartDataAPI returns epoch start data of a given epoch
 func (n *Node) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if epoch == 0 {
@@ -1442,6 +1499,74 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given keys and root hash. The nodes shared between the
+// keys are included only once and the absent keys get a nil value, the proof holding their proof of absence.
+// Only the accounts trie is supported, the keys being account addresses: the data tries keys might be stored hashed,
+// so a raw data trie key could be wrongly proven absent
+func (n *Node) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keysBytes, err := n.getMultiProofKeysBytes(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	computedProof, values, err := tr.GetMultiProof(keysBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GetMultiProofResponse{
+		Proof:    computedProof,
+		Values:   values,
+		RootHash: rootHash,
+	}, nil
+}
+
+// VerifyMultiProof verifies the given Merkle multi-proof and returns the proven value of each key, or nil if the
+// proof shows that the key is absent
+func (n *Node) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (bool, [][]byte, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return false, nil, err
+	}
+
+	keysBytes, err := n.getMultiProofKeysBytes(keys)
+	if err != nil {
+		return false, nil, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, nil, err
+	}
+
+	return mpv.VerifyMultiProof(rootHashBytes, keysBytes, proof)
+}
+
+// GetTrieNode returns the encoded accounts trie node (main trie or data trie) for the given hex encoded hash
+func (n *Node) GetTrieNode(hash string) ([]byte, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	trieStorageManager, ok := n.stateComponents.TrieStorageManagers()[dataRetriever.UserAccountsUnit.String()]
+	if !ok {
+		return nil, ErrNilTrieStorageManager
+	}
+
+	return trieStorageManager.Get(hashBytes)
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (n *Node) IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error) {
 	accountHandler, _, err := n.loadUserAccountHandlerByAddress(address, options)
@@ -1513,6 +1638,31 @@ func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse,
 	}, nil
 }
 
+func (n *Node) getMultiProofKeysBytes(keys []string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyMultiProofKeys
+	}
+	if len(keys) > maxKeysInMultiProof {
+		return nil, fmt.Errorf("%w, provided %d, maximum %d", ErrTooManyMultiProofKeys, len(keys), maxKeysInMultiProof)
+	}
+
+	addressLength := n.coreComponents.AddressPubKeyConverter().Len()
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := n.getKeyBytes(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %s", err, key)
+		}
+		if len(keyBytes) != addressLength {
+			return nil, fmt.Errorf("%w, key %s", ErrInvalidMultiProofKey, key)
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
+}
+
 func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	addressBytes, err := n.DecodeAddressPubkey(key)
 	if err == nil {
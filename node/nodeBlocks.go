@@ -7,6 +7,11 @@ import (
 	"github.com/kalyan3104/k-chain-go/process"
 )
 
+// GetBlockNonceByTimestamp returns the nonce of the last block produced at or before the given timestamp
+func (n *Node) GetBlockNonceByTimestamp(timestamp uint64) (uint64, error) {
+	return n.processComponents.HistoryRepository().GetBlockNonceByTimestamp(timestamp)
+}
+
 func (n *Node) getBlockHeaderByNonce(nonce uint64) (data.HeaderHandler, []byte, error) {
 	headerHash, err := n.getBlockHashByNonce(nonce)
 	if err != nil {
@@ -77,3 +77,24 @@ func TestNode_GetBlockHeaderByHash(t *testing.T) {
 		require.Equal(t, blockHeader, header)
 	})
 }
+
+func TestNode_GetBlockNonceByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		GetBlockNonceByTimestampCalled: func(timestamp uint64) (uint64, error) {
+			require.Equal(t, uint64(1700000000), timestamp)
+			return 37, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+
+	nonce, err := n.GetBlockNonceByTimestamp(1700000000)
+	require.Nil(t, err)
+	require.Equal(t, uint64(37), nonce)
+}
@@ -0,0 +1,144 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/dblookupext"
+)
+
+const (
+	defaultEventsQueryLimit  = 100
+	maxEventsQueryLimit      = 1000
+	maxEventsQueryBlockRange = 10000
+	eventsCursorSeparator    = "-"
+)
+
+// GetEvents returns the events emitted by an address, having the provided identifier, within the provided block range.
+// The identifier is required, as the events are indexed by both the address and the identifier. The results are
+// paginated, the returned cursor being used in order to fetch the next page. A page can hold fewer events than the
+// limit, or none, when the topic filter skips many events, the cursor being returned as long as there are blocks left
+func (n *Node) GetEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	query, err := n.createEventsQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := n.processComponents.HistoryRepository().GetEvents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.EventsQueryAPIResponse{
+		Events: make([]*common.IndexedEventAPI, 0),
+	}
+	for _, eventsInBlock := range result.Blocks {
+		blockHash := hex.EncodeToString(eventsInBlock.BlockHash)
+		for _, event := range eventsInBlock.Events {
+			response.Events = append(response.Events, &common.IndexedEventAPI{
+				Address:        options.Address,
+				Identifier:     options.Identifier,
+				Topics:         event.Topics,
+				Data:           event.Data,
+				AdditionalData: event.AdditionalData,
+				TxHash:         hex.EncodeToString(event.TxHash),
+				BlockNonce:     eventsInBlock.BlockNonce,
+				BlockHash:      blockHash,
+			})
+		}
+	}
+	if result.HasMore {
+		response.NextCursor = encodeEventsCursor(result.NextNonce, result.NextIndex)
+	}
+
+	return response, nil
+}
+
+func (n *Node) createEventsQuery(options common.EventsQueryOptions) (dblookupext.EventsQuery, error) {
+	address, err := n.decodeAddressToPubKey(options.Address)
+	if err != nil {
+		return dblookupext.EventsQuery{}, err
+	}
+	if len(options.Identifier) == 0 {
+		return dblookupext.EventsQuery{}, ErrEmptyEventIdentifier
+	}
+
+	topic, err := hex.DecodeString(options.Topic)
+	if err != nil {
+		return dblookupext.EventsQuery{}, fmt.Errorf("%w for topic", err)
+	}
+
+	limit := options.Limit
+	if limit == 0 {
+		limit = defaultEventsQueryLimit
+	}
+	if limit > maxEventsQueryLimit {
+		return dblookupext.EventsQuery{}, fmt.Errorf("%w: provided %d, maximum %d", ErrInvalidEventsQueryLimit, limit, maxEventsQueryLimit)
+	}
+
+	toBlock := options.ToBlock
+	if toBlock == 0 {
+		currentHeader := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+		if check.IfNil(currentHeader) {
+			return dblookupext.EventsQuery{}, ErrNilBlockHeader
+		}
+		toBlock = currentHeader.GetNonce()
+	}
+	if options.FromBlock > toBlock {
+		return dblookupext.EventsQuery{}, fmt.Errorf("%w: fromBlock %d is greater than toBlock %d", ErrInvalidEventsBlockRange, options.FromBlock, toBlock)
+	}
+	if toBlock-options.FromBlock > maxEventsQueryBlockRange {
+		return dblookupext.EventsQuery{}, fmt.Errorf("%w: at most %d blocks can be queried at once", ErrInvalidEventsBlockRange, maxEventsQueryBlockRange)
+	}
+
+	query := dblookupext.EventsQuery{
+		Address:    address,
+		Identifier: []byte(options.Identifier),
+		Topic:      topic,
+		FromNonce:  options.FromBlock,
+		ToNonce:    toBlock,
+		Limit:      int(limit),
+	}
+	if len(options.Cursor) == 0 {
+		return query, nil
+	}
+
+	cursorNonce, cursorIndex, err := decodeEventsCursor(options.Cursor)
+	if err != nil {
+		return dblookupext.EventsQuery{}, err
+	}
+	if cursorNonce < query.FromNonce || cursorNonce > query.ToNonce {
+		return dblookupext.EventsQuery{}, fmt.Errorf("%w: cursor is outside the queried block range", ErrInvalidEventsCursor)
+	}
+	query.FromNonce = cursorNonce
+	query.FromIndex = cursorIndex
+
+	return query, nil
+}
+
+func encodeEventsCursor(nonce uint64, index uint32) string {
+	return fmt.Sprintf("%d%s%d", nonce, eventsCursorSeparator, index)
+}
+
+func decodeEventsCursor(cursor string) (uint64, uint32, error) {
+	parts := strings.Split(cursor, eventsCursorSeparator)
+	if len(parts) != 2 {
+		return 0, 0, ErrInvalidEventsCursor
+	}
+
+	nonce, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrInvalidEventsCursor, err)
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrInvalidEventsCursor, err)
+	}
+
+	return nonce, uint32(index), nil
+}
@@ -0,0 +1,206 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/dblookupext"
+	"github.com/kalyan3104/k-chain-go/node"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	dblookupextMock "github.com/kalyan3104/k-chain-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/require"
+)
+
+var eventsEmitter = bytes.Repeat([]byte{1}, 32)
+
+func createNodeForEvents(historyRepository dblookupext.HistoryRepository) *node.Node {
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = historyRepository
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
+	)
+
+	return n
+}
+
+func createEventsQueryOptions() common.EventsQueryOptions {
+	return common.EventsQueryOptions{
+		Address:    testscommon.RealWorldBech32PubkeyConverter.SilentEncode(eventsEmitter, nil),
+		Identifier: "swap",
+		FromBlock:  10,
+		ToBlock:    20,
+	}
+}
+
+func TestNode_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid options should error", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &dblookupextMock.HistoryRepositoryStub{
+			GetEventsCalled: func(query dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		n := createNodeForEvents(repository)
+
+		options := createEventsQueryOptions()
+		options.Address = "invalid address"
+		_, err := n.GetEvents(options)
+		require.ErrorContains(t, err, "invalid address")
+
+		options = createEventsQueryOptions()
+		options.Identifier = ""
+		_, err = n.GetEvents(options)
+		require.Equal(t, node.ErrEmptyEventIdentifier, err)
+
+		options = createEventsQueryOptions()
+		options.Topic = "not hex"
+		_, err = n.GetEvents(options)
+		require.ErrorContains(t, err, "for topic")
+
+		options = createEventsQueryOptions()
+		options.Limit = 1001
+		_, err = n.GetEvents(options)
+		require.True(t, errors.Is(err, node.ErrInvalidEventsQueryLimit))
+
+		options = createEventsQueryOptions()
+		options.FromBlock = 21
+		_, err = n.GetEvents(options)
+		require.True(t, errors.Is(err, node.ErrInvalidEventsBlockRange))
+
+		options = createEventsQueryOptions()
+		options.ToBlock = 10011
+		_, err = n.GetEvents(options)
+		require.True(t, errors.Is(err, node.ErrInvalidEventsBlockRange))
+
+		options = createEventsQueryOptions()
+		options.Cursor = "15"
+		_, err = n.GetEvents(options)
+		require.True(t, errors.Is(err, node.ErrInvalidEventsCursor))
+
+		options = createEventsQueryOptions()
+		options.Cursor = "15-a"
+		_, err = n.GetEvents(options)
+		require.True(t, errors.Is(err, node.ErrInvalidEventsCursor))
+
+		options = createEventsQueryOptions()
+		options.Cursor = "21-0"
+		_, err = n.GetEvents(options)
+		require.True(t, errors.Is(err, node.ErrInvalidEventsCursor))
+	})
+	t.Run("repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		repository := &dblookupextMock.HistoryRepositoryStub{
+			GetEventsCalled: func(query dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error) {
+				return nil, expectedErr
+			},
+		}
+		n := createNodeForEvents(repository)
+
+		response, err := n.GetEvents(createEventsQueryOptions())
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("defaults should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &dblookupextMock.HistoryRepositoryStub{
+			GetEventsCalled: func(query dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error) {
+				require.Equal(t, uint64(0), query.FromNonce)
+				require.Equal(t, uint64(42), query.ToNonce) // current block
+				require.Equal(t, 100, query.Limit)
+				require.Empty(t, query.Topic)
+				return &dblookupext.EventsQueryResult{}, nil
+			},
+		}
+		n := createNodeForEvents(repository)
+
+		options := createEventsQueryOptions()
+		options.FromBlock = 0
+		options.ToBlock = 0
+		response, err := n.GetEvents(options)
+		require.Nil(t, err)
+		require.Empty(t, response.Events)
+		require.Empty(t, response.NextCursor)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		options := createEventsQueryOptions()
+		options.Topic = "0a0b"
+		options.Cursor = "12-3"
+		options.Limit = 2
+		repository := &dblookupextMock.HistoryRepositoryStub{
+			GetEventsCalled: func(query dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error) {
+				expectedQuery := dblookupext.EventsQuery{
+					Address:    eventsEmitter,
+					Identifier: []byte("swap"),
+					Topic:      []byte{0xa, 0xb},
+					FromNonce:  12,
+					ToNonce:    20,
+					FromIndex:  3,
+					Limit:      2,
+				}
+				require.Equal(t, expectedQuery, query)
+
+				return &dblookupext.EventsQueryResult{
+					Blocks: []*dblookupext.IndexedEventsInBlock{
+						{
+							BlockNonce: 12,
+							BlockHash:  []byte{0xaa},
+							Events: []*dblookupext.IndexedEvent{
+								{TxHash: []byte{0x01}, Topics: [][]byte{{0xa, 0xb}}, Data: []byte("data")},
+							},
+						},
+						{
+							BlockNonce: 15,
+							BlockHash:  []byte{0xbb},
+							Events: []*dblookupext.IndexedEvent{
+								{TxHash: []byte{0x02}, Topics: [][]byte{{0xa, 0xb}}, AdditionalData: [][]byte{[]byte("additional")}},
+							},
+						},
+					},
+					HasMore:   true,
+					NextNonce: 15,
+					NextIndex: 1,
+				}, nil
+			},
+		}
+		n := createNodeForEvents(repository)
+
+		response, err := n.GetEvents(options)
+		require.Nil(t, err)
+		require.Equal(t, "15-1", response.NextCursor)
+		require.Equal(t, []*common.IndexedEventAPI{
+			{
+				Address:    options.Address,
+				Identifier: "swap",
+				Topics:     [][]byte{{0xa, 0xb}},
+				Data:       []byte("data"),
+				TxHash:     hex.EncodeToString([]byte{0x01}),
+				BlockNonce: 12,
+				BlockHash:  "aa",
+			},
+			{
+				Address:        options.Address,
+				Identifier:     "swap",
+				Topics:         [][]byte{{0xa, 0xb}},
+				AdditionalData: [][]byte{[]byte("additional")},
+				TxHash:         hex.EncodeToString([]byte{0x02}),
+				BlockNonce:     15,
+				BlockHash:      "bb",
+			},
+		}, response.Events)
+	})
+}
@@ -2,17 +2,26 @@ package node
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/factory"
+	"github.com/kalyan3104/k-chain-go/node/integrity"
+	disabledIntegrity "github.com/kalyan3104/k-chain-go/node/integrity/disabled"
 	"github.com/kalyan3104/k-chain-go/node/nodeDebugFactory"
+	"github.com/kalyan3104/k-chain-go/node/txpoolPersister"
+	disabledTxPoolPersister "github.com/kalyan3104/k-chain-go/node/txpoolPersister/disabled"
 	"github.com/kalyan3104/k-chain-go/p2p"
+	"github.com/kalyan3104/k-chain-go/process/dataValidators"
 	procFactory "github.com/kalyan3104/k-chain-go/process/factory"
 	"github.com/kalyan3104/k-chain-go/process/throttle/antiflood/blackList"
 	"github.com/kalyan3104/k-chain-go/sharding"
+	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
 )
 
 // prepareOpenTopics will set to the anti flood handler the topics for which
@@ -70,6 +79,30 @@ func CreateNode(
 		return nil, err
 	}
 
+	integrityScrubber, err := createIntegrityScrubber(
+		config.IntegrityScrubber,
+		statusCoreComponents,
+		coreComponents,
+		dataComponents,
+		processComponents,
+		stateComponents,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txPoolPersister, err := createTxPoolPersister(
+		config.TxPoolPersistence,
+		coreComponents,
+		dataComponents,
+		processComponents,
+		stateComponents,
+		consensusComponents,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *Node
 	nd, err = NewNode(
 		WithStatusCoreComponents(statusCoreComponents),
@@ -97,6 +130,8 @@ func CreateNode(
 		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
 		WithImportMode(isInImportMode),
 		WithDCDTNFTStorageHandler(processComponents.DCDTDataStorageHandlerForAPI()),
+		WithIntegrityScrubber(integrityScrubber),
+		WithTxPoolPersister(txPoolPersister),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -123,6 +158,99 @@ func CreateNode(
 	return nd, nil
 }
 
+func createIntegrityScrubber(
+	scrubberConfig config.IntegrityScrubberConfig,
+	statusCoreComponents factory.StatusCoreComponentsHandler,
+	coreComponents factory.CoreComponentsHandler,
+	dataComponents factory.DataComponentsHandler,
+	processComponents factory.ProcessComponentsHandler,
+	stateComponents factory.StateComponentsHandler,
+) (IntegrityScrubber, error) {
+	if !scrubberConfig.Enabled {
+		return disabledIntegrity.NewDisabledIntegrityScrubber(), nil
+	}
+
+	args := integrity.ArgsIntegrityScrubber{
+		Config:                   scrubberConfig,
+		ShardID:                  processComponents.ShardCoordinator().SelfId(),
+		StorageService:           dataComponents.StorageService(),
+		ChainHandler:             dataComponents.Blockchain(),
+		Marshaller:               coreComponents.InternalMarshalizer(),
+		Hasher:                   coreComponents.Hasher(),
+		Uint64Converter:          coreComponents.Uint64ByteSliceConverter(),
+		UserAccountsTrie:         stateComponents.TriesContainer().Get([]byte(dataRetriever.UserAccountsUnit.String())),
+		PeerAccountsTrie:         stateComponents.TriesContainer().Get([]byte(dataRetriever.PeerAccountsUnit.String())),
+		MissingTrieNodesNotifier: stateComponents.MissingTrieNodesNotifier(),
+		AppStatusHandler:         statusCoreComponents.AppStatusHandler(),
+	}
+
+	return integrity.NewIntegrityScrubber(args)
+}
+
+func createTxPoolPersister(
+	persistenceConfig config.TxPoolPersistenceConfig,
+	coreComponents factory.CoreComponentsHandler,
+	dataComponents factory.DataComponentsHandler,
+	processComponents factory.ProcessComponentsHandler,
+	stateComponents factory.StateComponentsHandler,
+	consensusComponents factory.ConsensusComponentsHandler,
+) (TxPoolPersister, error) {
+	shardCoordinator := processComponents.ShardCoordinator()
+	// the metachain does not hold user transactions in its pool
+	if !persistenceConfig.Enabled || shardCoordinator.SelfId() == core.MetachainShardId {
+		return disabledTxPoolPersister.NewDisabledTxPoolPersister(), nil
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		stateComponents.AccountsAdapterAPI(),
+		shardCoordinator,
+		processComponents.WhiteListHandler(),
+		coreComponents.AddressPubKeyConverter(),
+		coreComponents.TxVersionChecker(),
+		common.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transactions need to survive a restart, so the storer never uses a temporary directory
+	dbConfig := persistenceConfig.DB
+	dbConfig.UseTmpAsFilePath = false
+	shardId := core.GetShardIDString(shardCoordinator.SelfId())
+	path := coreComponents.PathHandler().PathForStatic(shardId, dbConfig.FilePath)
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := storageunit.NewDB(persisterFactory, path)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the db for the transactions pool persistence", err)
+	}
+
+	args := txpoolPersister.ArgsTxPoolPersister{
+		TxPool:            dataComponents.Datapool().Transactions(),
+		Storer:            db,
+		Marshaller:        coreComponents.InternalMarshalizer(),
+		Hasher:            coreComponents.Hasher(),
+		ShardCoordinator:  shardCoordinator,
+		TxValidator:       txValidator,
+		FeeHandler:        coreComponents.EconomicsData(),
+		SyncStateProvider: consensusComponents.Bootstrapper(),
+		TxsSender:         processComponents.TxsSenderHandler(),
+		PersistInterval:   time.Duration(persistenceConfig.PersistIntervalInSeconds) * time.Second,
+	}
+
+	persister, err := txpoolPersister.NewTxPoolPersister(args)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return persister, nil
+}
+
 func createAndAttachPeerDenialEvaluators(
 	networkComponents factory.NetworkComponentsHandler,
 	processComponents factory.ProcessComponentsHandler,
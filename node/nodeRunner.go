@@ -57,6 +57,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/sharding/nodesCoordinator"
 	"github.com/kalyan3104/k-chain-go/state/syncer"
 	"github.com/kalyan3104/k-chain-go/storage/cache"
+	storageDisabled "github.com/kalyan3104/k-chain-go/storage/disabled"
 	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
 	trieStatistics "github.com/kalyan3104/k-chain-go/trie/statistics"
@@ -696,6 +697,7 @@ func getBaseAccountSyncerArgs(
 		UserAccountsSyncStatisticsHandler: trieStatistics.NewTrieSyncStatistics(),
 		AppStatusHandler:                  disabled.NewAppStatusHandler(),
 		EnableEpochsHandler:               coreComponents.EnableEpochsHandler(),
+		CheckpointsStorer:                 storageDisabled.NewPersister(),
 	}
 }
 
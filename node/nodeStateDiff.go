@@ -0,0 +1,454 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/errChan"
+	"github.com/kalyan3104/k-chain-go/common/holders"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/state/parsers"
+)
+
+const (
+	maxNumAccountsInStateDiff = 1000
+
+	accountStateDiffStatusCreated  = "created"
+	accountStateDiffStatusDeleted  = "deleted"
+	accountStateDiffStatusModified = "modified"
+)
+
+// GetAccountsStateDiff returns the differences between the accounts states found at the two provided block coordinates.
+// If no address is provided, all the accounts altered between the two states are compared.
+func (n *Node) GetAccountsStateDiff(
+	fromOptions api.AccountQueryOptions,
+	toOptions api.AccountQueryOptions,
+	addresses []string,
+	ctx context.Context,
+) (*common.AccountsStateDiffAPIResponse, error) {
+	fromOptions, err := n.addBlockCoordinatesToStateDiffOptions(fromOptions)
+	if err != nil {
+		return nil, err
+	}
+	toOptions, err = n.addBlockCoordinatesToStateDiffOptions(toOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys, err := n.getPubKeysForStateDiff(fromOptions, toOptions, addresses, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(pubKeys) > maxNumAccountsInStateDiff {
+		return nil, fmt.Errorf("%w: %d accounts, maximum %d", ErrTooManyAccountsInStateDiff, len(pubKeys), maxNumAccountsInStateDiff)
+	}
+
+	// all the accounts of a state are loaded before moving to the other state in order to avoid
+	// recreating the historical trie for each account
+	fromAccounts, err := n.loadAccountsForStateDiff(pubKeys, fromOptions)
+	if err != nil {
+		return nil, err
+	}
+	toAccounts, err := n.loadAccountsForStateDiff(pubKeys, toOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsDiff := make([]*common.AccountStateDiffAPI, 0, len(pubKeys))
+	for idx, pubKey := range pubKeys {
+		accountDiff, errCompute := n.computeAccountStateDiff(pubKey, fromAccounts[idx], toAccounts[idx], ctx)
+		if errCompute != nil {
+			return nil, errCompute
+		}
+		if accountDiff != nil {
+			accountsDiff = append(accountsDiff, accountDiff)
+		}
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	return &common.AccountsStateDiffAPIResponse{
+		FromBlock: stateDiffOptionsToApiBlockInfo(fromOptions),
+		ToBlock:   stateDiffOptionsToApiBlockInfo(toOptions),
+		Accounts:  accountsDiff,
+	}, nil
+}
+
+func (n *Node) addBlockCoordinatesToStateDiffOptions(options api.AccountQueryOptions) (api.AccountQueryOptions, error) {
+	options, err := n.addBlockCoordinatesToAccountQueryOptions(options)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+	if len(options.BlockRootHash) == 0 {
+		return api.AccountQueryOptions{}, ErrMissingStateDiffBlockRootHash
+	}
+
+	return options, nil
+}
+
+func stateDiffOptionsToApiBlockInfo(options api.AccountQueryOptions) api.BlockInfo {
+	blockInfo := holders.NewBlockInfo(options.BlockHash, options.BlockNonce.Value, options.BlockRootHash)
+	return accountBlockInfoToApiResource(blockInfo)
+}
+
+func (n *Node) getPubKeysForStateDiff(
+	fromOptions api.AccountQueryOptions,
+	toOptions api.AccountQueryOptions,
+	addresses []string,
+	ctx context.Context,
+) ([][]byte, error) {
+	if len(addresses) == 0 {
+		return n.getAlteredAccountsPubKeys(fromOptions, toOptions, ctx)
+	}
+
+	pubKeys := make([][]byte, 0, len(addresses))
+	seenPubKeys := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		pubKey, err := n.decodeAddressToPubKey(address)
+		if err != nil {
+			return nil, err
+		}
+
+		_, seen := seenPubKeys[string(pubKey)]
+		if seen {
+			continue
+		}
+
+		seenPubKeys[string(pubKey)] = struct{}{}
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return pubKeys, nil
+}
+
+// getAlteredAccountsPubKeys walks the main tries of the two states in parallel. The leaves are delivered in the
+// order of their keys, so created, deleted or modified accounts are found in a single pass
+func (n *Node) getAlteredAccountsPubKeys(
+	fromOptions api.AccountQueryOptions,
+	toOptions api.AccountQueryOptions,
+	ctx context.Context,
+) ([][]byte, error) {
+	iteratorCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	repository := n.stateComponents.AccountsRepository()
+	fromLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := repository.GetAllLeaves(fromLeaves, iteratorCtx, fromOptions, parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return nil, err
+	}
+
+	toLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = repository.GetAllLeaves(toLeaves, iteratorCtx, toOptions, parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := make([][]byte, 0)
+	fromLeaf, fromOk := <-fromLeaves.LeavesChan
+	toLeaf, toOk := <-toLeaves.LeavesChan
+	for fromOk || toOk {
+		if len(pubKeys) > maxNumAccountsInStateDiff {
+			// no need to continue, the caller will reject the result
+			break
+		}
+
+		compareResult := 0
+		switch {
+		case !toOk:
+			compareResult = -1
+		case !fromOk:
+			compareResult = 1
+		default:
+			compareResult = bytes.Compare(fromLeaf.Key(), toLeaf.Key())
+		}
+
+		switch {
+		case compareResult < 0:
+			pubKeys = append(pubKeys, fromLeaf.Key())
+			fromLeaf, fromOk = <-fromLeaves.LeavesChan
+		case compareResult > 0:
+			pubKeys = append(pubKeys, toLeaf.Key())
+			toLeaf, toOk = <-toLeaves.LeavesChan
+		default:
+			if !bytes.Equal(fromLeaf.Value(), toLeaf.Value()) {
+				pubKeys = append(pubKeys, fromLeaf.Key())
+			}
+			fromLeaf, fromOk = <-fromLeaves.LeavesChan
+			toLeaf, toOk = <-toLeaves.LeavesChan
+		}
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+	if len(pubKeys) > maxNumAccountsInStateDiff {
+		return pubKeys, nil
+	}
+
+	err = fromLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+	err = toLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return pubKeys, nil
+}
+
+func (n *Node) loadAccountsForStateDiff(pubKeys [][]byte, options api.AccountQueryOptions) ([]state.UserAccountHandler, error) {
+	repository := n.stateComponents.AccountsRepository()
+
+	accounts := make([]state.UserAccountHandler, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		account, _, err := repository.GetAccountWithBlockInfo(pubKey, options)
+		if err != nil {
+			_, isAccountNotFound := extractBlockInfoIfErrAccountNotFoundAtBlock(err)
+			if !isAccountNotFound {
+				return nil, err
+			}
+
+			accounts = append(accounts, nil)
+			continue
+		}
+
+		userAccount, err := n.castAccountToUserAccount(account)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, userAccount)
+	}
+
+	return accounts, nil
+}
+
+func (n *Node) computeAccountStateDiff(
+	pubKey []byte,
+	fromAccount state.UserAccountHandler,
+	toAccount state.UserAccountHandler,
+	ctx context.Context,
+) (*common.AccountStateDiffAPI, error) {
+	fromExists := !check.IfNil(fromAccount)
+	toExists := !check.IfNil(toAccount)
+
+	var status string
+	switch {
+	case !fromExists && !toExists:
+		return nil, nil
+	case !fromExists:
+		status = accountStateDiffStatusCreated
+	case !toExists:
+		status = accountStateDiffStatusDeleted
+	default:
+		status = accountStateDiffStatusModified
+	}
+
+	address, err := n.coreComponents.AddressPubKeyConverter().Encode(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	accountDiff := &common.AccountStateDiffAPI{
+		Address:  address,
+		Status:   status,
+		Balance:  createValueDiff(getBalanceForStateDiff(fromAccount), getBalanceForStateDiff(toAccount)),
+		Nonce:    createValueDiff(getNonceForStateDiff(fromAccount), getNonceForStateDiff(toAccount)),
+		CodeHash: createValueDiff(getCodeHashForStateDiff(fromAccount), getCodeHashForStateDiff(toAccount)),
+	}
+
+	if !bytes.Equal(getRootHashForStateDiff(fromAccount), getRootHashForStateDiff(toAccount)) {
+		err = n.addDataTriesDiff(accountDiff, fromAccount, toAccount, ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	isUnchanged := status == accountStateDiffStatusModified &&
+		accountDiff.Balance == nil &&
+		accountDiff.Nonce == nil &&
+		accountDiff.CodeHash == nil &&
+		len(accountDiff.DCDTs) == 0 &&
+		len(accountDiff.Keys) == 0
+	if isUnchanged {
+		return nil, nil
+	}
+
+	return accountDiff, nil
+}
+
+func (n *Node) addDataTriesDiff(
+	accountDiff *common.AccountStateDiffAPI,
+	fromAccount state.UserAccountHandler,
+	toAccount state.UserAccountHandler,
+	ctx context.Context,
+) error {
+	fromKeys, err := n.getKeysForStateDiff(fromAccount, ctx)
+	if err != nil {
+		return err
+	}
+	toKeys, err := n.getKeysForStateDiff(toAccount, ctx)
+	if err != nil {
+		return err
+	}
+
+	allKeys := make([]string, 0, len(fromKeys)+len(toKeys))
+	for key := range fromKeys {
+		allKeys = append(allKeys, key)
+	}
+	for key := range toKeys {
+		_, exists := fromKeys[key]
+		if !exists {
+			allKeys = append(allKeys, key)
+		}
+	}
+	sort.Strings(allKeys)
+
+	dcdtPrefix := hex.EncodeToString([]byte(core.ProtectedKeyPrefix + core.DCDTKeyIdentifier))
+	for _, key := range allKeys {
+		oldValue := fromKeys[key]
+		newValue := toKeys[key]
+		if oldValue == newValue {
+			continue
+		}
+
+		if !strings.HasPrefix(key, dcdtPrefix) {
+			accountDiff.Keys = append(accountDiff.Keys, &common.KeyDiffAPI{
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+			continue
+		}
+
+		dcdtDiff, err := n.createDCDTDiff(key[len(dcdtPrefix):], oldValue, newValue)
+		if err != nil {
+			return err
+		}
+
+		accountDiff.DCDTs = append(accountDiff.DCDTs, dcdtDiff)
+	}
+
+	return nil
+}
+
+func (n *Node) getKeysForStateDiff(userAccount state.UserAccountHandler, ctx context.Context) (map[string]string, error) {
+	if check.IfNil(userAccount) || check.IfNil(userAccount.DataTrie()) {
+		return make(map[string]string), nil
+	}
+
+	return n.getKeys(userAccount, ctx)
+}
+
+func (n *Node) createDCDTDiff(hexTokenKey string, hexOldValue string, hexNewValue string) (*common.DCDTDiffAPI, error) {
+	tokenKey, err := hex.DecodeString(hexTokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdentifier := string(tokenKey)
+	_, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey(tokenKey)
+	if nonce > 0 {
+		tokenIdentifier = adjustNftTokenIdentifier(tokenIdentifier, nonce)
+	}
+
+	oldBalance, err := n.getDCDTBalanceForStateDiff(hexOldValue)
+	if err != nil {
+		return nil, err
+	}
+	newBalance, err := n.getDCDTBalanceForStateDiff(hexNewValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.DCDTDiffAPI{
+		TokenIdentifier: tokenIdentifier,
+		OldBalance:      oldBalance,
+		NewBalance:      newBalance,
+	}, nil
+}
+
+func (n *Node) getDCDTBalanceForStateDiff(hexValue string) (string, error) {
+	value, err := hex.DecodeString(hexValue)
+	if err != nil {
+		return "", err
+	}
+	if len(value) == 0 {
+		return "0", nil
+	}
+
+	dcdtToken := &dcdt.DCDigitalToken{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(dcdtToken, value)
+	if err != nil {
+		return "", err
+	}
+	if dcdtToken.Value == nil {
+		return "0", nil
+	}
+
+	return dcdtToken.Value.String(), nil
+}
+
+func createValueDiff(oldValue string, newValue string) *common.ValueDiffAPI {
+	if oldValue == newValue {
+		return nil
+	}
+
+	return &common.ValueDiffAPI{
+		Old: oldValue,
+		New: newValue,
+	}
+}
+
+func getBalanceForStateDiff(userAccount state.UserAccountHandler) string {
+	if check.IfNil(userAccount) || userAccount.GetBalance() == nil {
+		return big.NewInt(0).String()
+	}
+
+	return userAccount.GetBalance().String()
+}
+
+func getNonceForStateDiff(userAccount state.UserAccountHandler) string {
+	if check.IfNil(userAccount) {
+		return "0"
+	}
+
+	return strconv.FormatUint(userAccount.GetNonce(), 10)
+}
+
+func getCodeHashForStateDiff(userAccount state.UserAccountHandler) string {
+	if check.IfNil(userAccount) {
+		return ""
+	}
+
+	return hex.EncodeToString(userAccount.GetCodeHash())
+}
+
+func getRootHashForStateDiff(userAccount state.UserAccountHandler) []byte {
+	if check.IfNil(userAccount) {
+		return nil
+	}
+
+	return userAccount.GetRootHash()
+}
@@ -0,0 +1,309 @@
+package node_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/keyValStorage"
+	"github.com/kalyan3104/k-chain-core-go/data/api"
+	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/node"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
+	mockState "github.com/kalyan3104/k-chain-go/testscommon/state"
+	trieMock "github.com/kalyan3104/k-chain-go/testscommon/trie"
+	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fromStateRootHash = []byte("from root hash")
+	toStateRootHash   = []byte("to root hash")
+	fromStateOptions  = api.AccountQueryOptions{BlockRootHash: fromStateRootHash}
+	toStateOptions    = api.AccountQueryOptions{BlockRootHash: toStateRootHash}
+)
+
+func createNodeForStateDiff(repository state.AccountsRepository) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsRepo = repository
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+	)
+
+	return n
+}
+
+func sendMainTrieLeaves(leavesChannels *common.TrieIteratorChannels, leaves map[string]string) {
+	keys := make([]string, 0, len(leaves))
+	for key := range leaves {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	go func() {
+		for _, key := range keys {
+			leavesChannels.LeavesChan <- keyValStorage.NewKeyValStorage([]byte(key), []byte(leaves[key]))
+		}
+		close(leavesChannels.LeavesChan)
+		leavesChannels.ErrChan.Close()
+	}()
+}
+
+func setDataTrieLeaves(acc state.UserAccountHandler, rootHash []byte, leaves map[string][]byte) {
+	acc.SetRootHash(rootHash)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		GetAllLeavesOnChannelCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, _ common.KeyBuilder, tlp common.TrieLeafParser) error {
+			go func() {
+				for key, value := range leaves {
+					suffix := append([]byte(key), acc.AddressBytes()...)
+					trieLeaf, _ := tlp.ParseLeaf([]byte(key), append(value, suffix...), core.NotSpecified)
+					leavesChannels.LeavesChan <- trieLeaf
+				}
+				close(leavesChannels.LeavesChan)
+				leavesChannels.ErrChan.Close()
+			}()
+
+			return nil
+		},
+		RootCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	})
+}
+
+func TestNode_GetAccountsStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(&mockState.AccountsRepositoryStub{})
+
+		result, err := n.GetAccountsStateDiff(api.AccountQueryOptions{}, toStateOptions, nil, context.Background())
+		require.Nil(t, result)
+		require.Equal(t, node.ErrMissingStateDiffBlockRootHash, err)
+
+		result, err = n.GetAccountsStateDiff(fromStateOptions, api.AccountQueryOptions{}, nil, context.Background())
+		require.Nil(t, result)
+		require.Equal(t, node.ErrMissingStateDiffBlockRootHash, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(&mockState.AccountsRepositoryStub{})
+
+		result, err := n.GetAccountsStateDiff(fromStateOptions, toStateOptions, []string{"invalid"}, context.Background())
+		require.Nil(t, result)
+		require.NotNil(t, err)
+	})
+	t.Run("main trie iteration fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		repository := &mockState.AccountsRepositoryStub{
+			GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error {
+				if bytes.Equal(options.BlockRootHash, toStateRootHash) {
+					return expectedErr
+				}
+
+				sendMainTrieLeaves(leavesChannels, map[string]string{"key": "value"})
+				return nil
+			},
+		}
+		n := createNodeForStateDiff(repository)
+
+		result, err := n.GetAccountsStateDiff(fromStateOptions, toStateOptions, nil, context.Background())
+		require.Nil(t, result)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("without address filter should compare the altered accounts", func(t *testing.T) {
+		t.Parallel()
+
+		carolPubKey := bytes.Repeat([]byte{0xcc}, 32)
+		danPubKey := bytes.Repeat([]byte{0xdd}, 32)
+		mainTrieLeaves := map[string]map[string]string{
+			string(fromStateRootHash): {
+				string(testscommon.TestPubKeyAlice): "alice",
+				string(testscommon.TestPubKeyBob):   "bob",
+				string(carolPubKey):                 "carol",
+			},
+			string(toStateRootHash): {
+				string(testscommon.TestPubKeyAlice): "alice",
+				string(testscommon.TestPubKeyBob):   "bob modified",
+				string(danPubKey):                   "dan",
+			},
+		}
+
+		fromBob := createAcc(testscommon.TestPubKeyBob)
+		_ = fromBob.AddToBalance(big.NewInt(10))
+		toBob := createAcc(testscommon.TestPubKeyBob)
+		_ = toBob.AddToBalance(big.NewInt(20))
+		toBob.IncreaseNonce(1)
+		fromCarol := createAcc(carolPubKey)
+		_ = fromCarol.AddToBalance(big.NewInt(5))
+		toDan := createAcc(danPubKey)
+		_ = toDan.AddToBalance(big.NewInt(7))
+		toDan.SetCodeHash([]byte("code hash"))
+		accounts := map[string]map[string]state.UserAccountHandler{
+			string(fromStateRootHash): {
+				string(testscommon.TestPubKeyBob): fromBob,
+				string(carolPubKey):               fromCarol,
+			},
+			string(toStateRootHash): {
+				string(testscommon.TestPubKeyBob): toBob,
+				string(danPubKey):                 toDan,
+			},
+		}
+
+		repository := &mockState.AccountsRepositoryStub{
+			GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error {
+				sendMainTrieLeaves(leavesChannels, mainTrieLeaves[string(options.BlockRootHash)])
+				return nil
+			},
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				require.NotEqual(t, testscommon.TestPubKeyAlice, address)
+
+				account, found := accounts[string(options.BlockRootHash)][string(address)]
+				if !found {
+					return nil, nil, state.NewErrAccountNotFoundAtBlock(nil)
+				}
+
+				return account, nil, nil
+			},
+		}
+		n := createNodeForStateDiff(repository)
+
+		result, err := n.GetAccountsStateDiff(fromStateOptions, toStateOptions, nil, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString(fromStateRootHash), result.FromBlock.RootHash)
+		require.Equal(t, hex.EncodeToString(toStateRootHash), result.ToBlock.RootHash)
+
+		carolAddress, _ := testscommon.RealWorldBech32PubkeyConverter.Encode(carolPubKey)
+		danAddress, _ := testscommon.RealWorldBech32PubkeyConverter.Encode(danPubKey)
+		expectedAccounts := []*common.AccountStateDiffAPI{
+			{
+				Address: testscommon.TestAddressBob,
+				Status:  "modified",
+				Balance: &common.ValueDiffAPI{Old: "10", New: "20"},
+				Nonce:   &common.ValueDiffAPI{Old: "0", New: "1"},
+			},
+			{
+				Address: carolAddress,
+				Status:  "deleted",
+				Balance: &common.ValueDiffAPI{Old: "5", New: "0"},
+			},
+			{
+				Address:  danAddress,
+				Status:   "created",
+				Balance:  &common.ValueDiffAPI{Old: "0", New: "7"},
+				CodeHash: &common.ValueDiffAPI{Old: "", New: hex.EncodeToString([]byte("code hash"))},
+			},
+		}
+		sort.Slice(expectedAccounts, func(i, j int) bool {
+			return expectedAccounts[i].Address < expectedAccounts[j].Address
+		})
+		sort.Slice(result.Accounts, func(i, j int) bool {
+			return result.Accounts[i].Address < result.Accounts[j].Address
+		})
+		require.Equal(t, expectedAccounts, result.Accounts)
+	})
+	t.Run("with address filter should compare the data tries", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &marshallerMock.MarshalizerMock{}
+		dcdtKey := []byte(core.ProtectedKeyPrefix + core.DCDTKeyIdentifier + "TKN-abcdef")
+		fromToken, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(10)})
+		toToken, _ := marshaller.Marshal(&dcdt.DCDigitalToken{Value: big.NewInt(15)})
+
+		fromAlice := createAcc(testscommon.TestPubKeyAlice)
+		setDataTrieLeaves(fromAlice, []byte("from data root hash"), map[string][]byte{
+			"key1":          []byte("value1"),
+			"key2":          []byte("value2"),
+			string(dcdtKey): fromToken,
+		})
+		toAlice := createAcc(testscommon.TestPubKeyAlice)
+		setDataTrieLeaves(toAlice, []byte("to data root hash"), map[string][]byte{
+			"key1":          []byte("value1 modified"),
+			"key2":          []byte("value2"),
+			"key3":          []byte("value3"),
+			string(dcdtKey): toToken,
+		})
+		unchangedBob := createAcc(testscommon.TestPubKeyBob)
+
+		repository := &mockState.AccountsRepositoryStub{
+			GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error {
+				require.Fail(t, "should have not iterated the main trie")
+				return nil
+			},
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				if bytes.Equal(address, testscommon.TestPubKeyBob) {
+					return unchangedBob, nil, nil
+				}
+				if bytes.Equal(options.BlockRootHash, fromStateRootHash) {
+					return fromAlice, nil, nil
+				}
+
+				return toAlice, nil, nil
+			},
+		}
+		n := createNodeForStateDiff(repository)
+
+		addresses := []string{testscommon.TestAddressAlice, testscommon.TestAddressBob, testscommon.TestAddressAlice}
+		result, err := n.GetAccountsStateDiff(fromStateOptions, toStateOptions, addresses, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, []*common.AccountStateDiffAPI{
+			{
+				Address: testscommon.TestAddressAlice,
+				Status:  "modified",
+				DCDTs: []*common.DCDTDiffAPI{
+					{
+						TokenIdentifier: "TKN-abcdef",
+						OldBalance:      "10",
+						NewBalance:      "15",
+					},
+				},
+				Keys: []*common.KeyDiffAPI{
+					{
+						Key:      hex.EncodeToString([]byte("key1")),
+						OldValue: hex.EncodeToString([]byte("value1")),
+						NewValue: hex.EncodeToString([]byte("value1 modified")),
+					},
+					{
+						Key:      hex.EncodeToString([]byte("key3")),
+						OldValue: "",
+						NewValue: hex.EncodeToString([]byte("value3")),
+					},
+				},
+			},
+		}, result.Accounts)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &mockState.AccountsRepositoryStub{
+			GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error {
+				sendMainTrieLeaves(leavesChannels, map[string]string{})
+				return nil
+			},
+		}
+		n := createNodeForStateDiff(repository)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result, err := n.GetAccountsStateDiff(fromStateOptions, toStateOptions, nil, ctx)
+		require.Nil(t, result)
+		require.Equal(t, node.ErrTrieOperationsTimeout, err)
+	})
+}
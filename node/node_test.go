@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 	"sync"
@@ -47,6 +48,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/state/parsers"
 	"github.com/kalyan3104/k-chain-go/state/trackableDataTrie"
 	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/txcache"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/kalyan3104/k-chain-go/testscommon/bootstrapMocks"
 	dataRetrieverMock "github.com/kalyan3104/k-chain-go/testscommon/dataRetriever"
@@ -67,6 +69,7 @@ import (
 	mockStorage "github.com/kalyan3104/k-chain-go/testscommon/storage"
 	"github.com/kalyan3104/k-chain-go/testscommon/storageManager"
 	trieMock "github.com/kalyan3104/k-chain-go/testscommon/trie"
+	"github.com/kalyan3104/k-chain-go/testscommon/txcachemocks"
 	"github.com/kalyan3104/k-chain-go/testscommon/txsSenderMock"
 	"github.com/kalyan3104/k-chain-go/vm/systemSmartContracts"
 	crypto "github.com/kalyan3104/k-chain-crypto-go"
@@ -4328,6 +4331,190 @@ func TestNode_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.GetMultiProof("invalidRootHash", []string{"0123"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("empty keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.GetMultiProof("deadbeef", nil)
+		assert.Nil(t, response)
+		assert.Equal(t, node.ErrEmptyMultiProofKeys, err)
+	})
+	t.Run("too many keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.GetMultiProof("deadbeef", make([]string, 1001))
+		assert.Nil(t, response)
+		assert.True(t, errors.Is(err, node.ErrTooManyMultiProofKeys))
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123", "key"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("data trie key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123"})
+		assert.Nil(t, response)
+		assert.True(t, errors.Is(err, node.ErrInvalidMultiProofKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		trieKeys := []string{
+			"bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854",
+			"0042213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854",
+		}
+		values := [][]byte{[]byte("value"), nil}
+		proof := [][]byte{[]byte("valid"), []byte("proof")}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+						require.Equal(t, len(trieKeys), len(keys))
+						for i := range keys {
+							assert.Equal(t, trieKeys[i], hex.EncodeToString(keys[i]))
+						}
+						return proof, values, nil
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		rootHash := "deadbeef"
+		response, err := n.GetMultiProof(rootHash, trieKeys)
+		assert.Nil(t, err)
+		assert.Equal(t, &common.GetMultiProofResponse{
+			Proof:    proof,
+			Values:   values,
+			RootHash: rootHash,
+		}, response)
+	})
+}
+
+func TestNode_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		ok, values, err := n.VerifyMultiProof("invalidRootHash", []string{"0123"}, [][]byte{})
+		assert.False(t, ok)
+		assert.Nil(t, values)
+		assert.NotNil(t, err)
+	})
+	t.Run("empty keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		ok, values, err := n.VerifyMultiProof("deadbeef", nil, [][]byte{})
+		assert.False(t, ok)
+		assert.Nil(t, values)
+		assert.Equal(t, node.ErrEmptyMultiProofKeys, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.Hash = sha256.NewSha256()
+		coreComponents.IntMarsh = &marshal.GogoProtoMarshalizer{}
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(coreComponents),
+		)
+
+		rootHash := "bc2e549d98c31ffe6e9419b933d03b37e84f74c42601412302799d277651a6d8"
+		address := "bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854"
+		absentAddress := "0042213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854"
+		p, _ := hex.DecodeString("0a41040508080f0a0807040b0a0c080409040909040c000a0b03050b09020704050b010600060a0b00050f0e010102040c0e0d090e07090607040703010202040f0b10124c1202000022206182d14320be95434f5508acad9478d3b6cf837bfce7ebfe47c2e860d1b98ca72a20bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af88543202000001")
+		proof := [][]byte{p}
+
+		ok, values, err := n.VerifyMultiProof(rootHash, []string{address, absentAddress}, proof)
+		assert.True(t, ok)
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(values))
+		assert.NotEmpty(t, values[0])
+		assert.Nil(t, values[1])
+	})
+}
+
+func TestNode_GetTrieNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		trieNode, err := n.GetTrieNode("invalid hash")
+		assert.Nil(t, trieNode)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		trieNode, err := n.GetTrieNode("aabb")
+		assert.Nil(t, trieNode)
+		assert.Equal(t, node.ErrNilTrieStorageManager, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTrieNode := []byte("trie node")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.StorageManagers = map[string]common.StorageManager{
+			dataRetriever.UserAccountsUnit.String(): &storageManager.StorageManagerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					assert.Equal(t, []byte{0xaa, 0xbb}, key)
+					return expectedTrieNode, nil
+				},
+			},
+		}
+		n, _ := node.NewNode(node.WithStateComponents(stateComponents))
+
+		trieNode, err := n.GetTrieNode("aabb")
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTrieNode, trieNode)
+	})
+}
+
 func TestNode_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
@@ -4515,6 +4702,132 @@ func TestNode_SendBulkTransactions(t *testing.T) {
 	require.Nil(t, err)
 }
 
+type shardedDataWithCancellationsStub struct {
+	*testscommon.ShardedDataStub
+	scheduleCancellationCalled func(txHash []byte, sender []byte, nonce uint64)
+}
+
+func (stub *shardedDataWithCancellationsStub) ScheduleCancellation(txHash []byte, sender []byte, nonce uint64) {
+	stub.scheduleCancellationCalled(txHash, sender, nonce)
+}
+
+func TestNode_ScheduleTransactionsPoolCancellationForSender(t *testing.T) {
+	t.Parallel()
+
+	createWrappedTx := func(hash string, sender string, nonce uint64) *txcache.WrappedTransaction {
+		return &txcache.WrappedTransaction{
+			Tx: &transaction.Transaction{
+				SndAddr: []byte(sender),
+				Nonce:   nonce,
+				Value:   big.NewInt(0),
+			},
+			TxHash: []byte(hash),
+			Size:   128,
+		}
+	}
+
+	t.Run("should schedule the cancellation and report the transactions with higher nonces", func(t *testing.T) {
+		t.Parallel()
+
+		txCache, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+			Name:                       "test",
+			NumChunks:                  4,
+			NumBytesPerSenderThreshold: 1_048_576, // 1 MB
+			CountPerSenderThreshold:    math.MaxUint32,
+		}, &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       1,
+			MinimumGasPrice:      1,
+			GasProcessingDivisor: 1,
+		})
+		txCache.AddTx(createWrappedTx("txHash2", "alice", 3))
+		txCache.AddTx(createWrappedTx("txHash0", "alice", 1))
+		txCache.AddTx(createWrappedTx("txHash1", "alice", 2))
+		txCache.AddTx(createWrappedTx("txHash3", "alice", 5))
+		txCache.AddTx(createWrappedTx("txHash4", "bob", 5))
+
+		scheduled := false
+		dataComponents := getDefaultDataComponents()
+		dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &shardedDataWithCancellationsStub{
+					ShardedDataStub: &testscommon.ShardedDataStub{
+						ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+							require.Equal(t, "0", cacheID)
+							return txCache
+						},
+						RemoveDataCalled: func(key []byte, cacheID string) {
+							require.Fail(t, "should not have removed transactions before the commit")
+						},
+					},
+					scheduleCancellationCalled: func(txHash []byte, sender []byte, nonce uint64) {
+						require.Equal(t, []byte("cancellationTxHash"), txHash)
+						require.Equal(t, []byte("alice"), sender)
+						require.Equal(t, uint64(2), nonce)
+						scheduled = true
+					},
+				}
+			},
+		}
+		processComponents := getDefaultProcessComponents()
+		processComponents.ShardCoord = mock.NewOneShardCoordinatorMock()
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		res, err := n.ScheduleTransactionsPoolCancellationForSender([]byte("cancellationTxHash"), []byte("alice"), 2)
+		require.NoError(t, err)
+		require.Equal(t, []common.RemovedPoolTransactionApiResponse{
+			{Hash: hex.EncodeToString([]byte("txHash2")), Nonce: 3},
+			{Hash: hex.EncodeToString([]byte("txHash3")), Nonce: 5},
+		}, res)
+		require.True(t, scheduled)
+	})
+	t.Run("no transactions cache should return empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &shardedDataWithCancellationsStub{
+					ShardedDataStub:            &testscommon.ShardedDataStub{},
+					scheduleCancellationCalled: func(txHash []byte, sender []byte, nonce uint64) {},
+				}
+			},
+		}
+		processComponents := getDefaultProcessComponents()
+		processComponents.ShardCoord = mock.NewOneShardCoordinatorMock()
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		res, err := n.ScheduleTransactionsPoolCancellationForSender([]byte("cancellationTxHash"), []byte("alice"), 2)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("pool without cancellations support should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{}
+			},
+		}
+		processComponents := getDefaultProcessComponents()
+		processComponents.ShardCoord = mock.NewOneShardCoordinatorMock()
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		res, err := n.ScheduleTransactionsPoolCancellationForSender([]byte("cancellationTxHash"), []byte("alice"), 2)
+		require.Equal(t, node.ErrTransactionsPoolCancellationNotSupported, err)
+		require.Nil(t, res)
+	})
+}
+
 func TestNode_GetHeartbeats(t *testing.T) {
 	t.Parallel()
 
@@ -4578,6 +4891,41 @@ func TestNode_Getters(t *testing.T) {
 	assert.True(t, n.IsInImportMode())
 }
 
+func TestNode_GetIntegrityReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("the integrity scrubber should be disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		report, err := n.GetIntegrityReport()
+		require.Nil(t, err)
+		require.False(t, report.Enabled)
+		require.Empty(t, report.Issues)
+	})
+	t.Run("should return the report of the integrity scrubber", func(t *testing.T) {
+		t.Parallel()
+
+		providedReport := common.IntegrityReportAPI{
+			Enabled:             true,
+			NumPasses:           3,
+			NumCorruptedEntries: 1,
+		}
+		n, _ := node.NewNode(
+			node.WithIntegrityScrubber(&mock.IntegrityScrubberStub{
+				GetReportCalled: func() common.IntegrityReportAPI {
+					return providedReport
+				},
+			}),
+		)
+
+		report, err := n.GetIntegrityReport()
+		require.Nil(t, err)
+		require.Equal(t, providedReport, *report)
+	})
+}
+
 func TestNode_GetEpochStartDataAPI(t *testing.T) {
 	t.Parallel()
 
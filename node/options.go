@@ -335,3 +335,26 @@ func WithDCDTNFTStorageHandler(storageHandler vmcommon.DCDTNFTStorageHandler) Op
 		return nil
 	}
 }
+
+// WithIntegrityScrubber sets up the storage integrity scrubber for the Node
+func WithIntegrityScrubber(integrityScrubber IntegrityScrubber) Option {
+	return func(n *Node) error {
+		if check.IfNil(integrityScrubber) {
+			return ErrNilIntegrityScrubber
+		}
+		n.integrityScrubber = integrityScrubber
+		n.closableComponents = append(n.closableComponents, integrityScrubber)
+		return nil
+	}
+}
+
+// WithTxPoolPersister sets up the transactions pool persister for the Node
+func WithTxPoolPersister(txPoolPersister TxPoolPersister) Option {
+	return func(n *Node) error {
+		if check.IfNil(txPoolPersister) {
+			return ErrNilTxPoolPersister
+		}
+		n.closableComponents = append(n.closableComponents, txPoolPersister)
+		return nil
+	}
+}
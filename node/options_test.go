@@ -9,7 +9,9 @@ import (
 
 	"github.com/kalyan3104/k-chain-core-go/data/dcdt"
 	"github.com/kalyan3104/k-chain-core-go/data/endProcess"
+	disabledIntegrity "github.com/kalyan3104/k-chain-go/node/integrity/disabled"
 	"github.com/kalyan3104/k-chain-go/node/mock"
+	disabledTxPoolPersister "github.com/kalyan3104/k-chain-go/node/txpoolPersister/disabled"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -290,3 +292,57 @@ func TestWithDCDTNFTStorageHandler(t *testing.T) {
 		assert.Equal(t, dcdtStorer, node.dcdtStorageHandler)
 	})
 }
+
+func TestWithIntegrityScrubber(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil integrity scrubber should error", func(t *testing.T) {
+		t.Parallel()
+
+		node, _ := NewNode()
+		opt := WithIntegrityScrubber(nil)
+		err := opt(node)
+
+		assert.Equal(t, ErrNilIntegrityScrubber, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		integrityScrubber := disabledIntegrity.NewDisabledIntegrityScrubber()
+
+		node, _ := NewNode()
+		opt := WithIntegrityScrubber(integrityScrubber)
+		err := opt(node)
+
+		assert.NoError(t, err)
+		assert.Equal(t, integrityScrubber, node.integrityScrubber)
+		assert.Len(t, node.closableComponents, 1)
+	})
+}
+
+func TestWithTxPoolPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx pool persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		node, _ := NewNode()
+		opt := WithTxPoolPersister(nil)
+		err := opt(node)
+
+		assert.Equal(t, ErrNilTxPoolPersister, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txPoolPersister := disabledTxPoolPersister.NewDisabledTxPoolPersister()
+
+		node, _ := NewNode()
+		opt := WithTxPoolPersister(txPoolPersister)
+		err := opt(node)
+
+		assert.NoError(t, err)
+		assert.Len(t, node.closableComponents, 1)
+		assert.Equal(t, txPoolPersister, node.closableComponents[0])
+	})
+}
@@ -0,0 +1,18 @@
+package disabled
+
+type txPoolPersister struct{}
+
+// NewDisabledTxPoolPersister returns a disabled implementation to be used when the pool persistence is not enabled
+func NewDisabledTxPoolPersister() *txPoolPersister {
+	return &txPoolPersister{}
+}
+
+// Close does nothing and returns nil
+func (tpp *txPoolPersister) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *txPoolPersister) IsInterfaceNil() bool {
+	return tpp == nil
+}
@@ -0,0 +1,39 @@
+package txpoolPersister
+
+import "errors"
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilTxValidator signals that a nil transaction validator has been provided
+var ErrNilTxValidator = errors.New("nil transaction validator")
+
+// ErrNilFeeHandler signals that a nil fee handler has been provided
+var ErrNilFeeHandler = errors.New("nil fee handler")
+
+// ErrNilSyncStateProvider signals that a nil sync state provider has been provided
+var ErrNilSyncStateProvider = errors.New("nil sync state provider")
+
+// ErrNilTxsSender signals that a nil transactions sender has been provided
+var ErrNilTxsSender = errors.New("nil transactions sender")
+
+// ErrStoredTransactionHashMismatch signals that a stored transaction does not match the hash it was saved under
+var ErrStoredTransactionHashMismatch = errors.New("stored transaction does not match its hash")
+
+// ErrStoredTransactionFromOtherShard signals that a stored transaction has the sender in another shard
+var ErrStoredTransactionFromOtherShard = errors.New("stored transaction has the sender in another shard")
+
+// ErrInvalidPersistInterval signals that an invalid interval between the pool snapshots has been provided
+var ErrInvalidPersistInterval = errors.New("invalid persist interval")
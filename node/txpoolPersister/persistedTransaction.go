@@ -0,0 +1,74 @@
+package txpoolPersister
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/sharding"
+)
+
+// persistedTransaction wraps a transaction loaded from the storer so that it can be checked by the same validator
+// used for the intercepted transactions
+type persistedTransaction struct {
+	tx          *transaction.Transaction
+	sndShard    uint32
+	rcvShard    uint32
+	feeComputer process.FeeHandler
+}
+
+func newPersistedTransaction(
+	tx *transaction.Transaction,
+	shardCoordinator sharding.Coordinator,
+	feeComputer process.FeeHandler,
+) *persistedTransaction {
+	sndShard := shardCoordinator.ComputeId(tx.SndAddr)
+	rcvShard := sndShard
+	// same as for the intercepted transactions, the empty receiver address is treated as being in the sender's shard
+	if !isEmptyAddress(tx.RcvAddr) {
+		rcvShard = shardCoordinator.ComputeId(tx.RcvAddr)
+	}
+
+	return &persistedTransaction{
+		tx:          tx,
+		sndShard:    sndShard,
+		rcvShard:    rcvShard,
+		feeComputer: feeComputer,
+	}
+}
+
+func isEmptyAddress(address []byte) bool {
+	return bytes.Equal(address, make([]byte, len(address)))
+}
+
+// SenderShardId returns the shard of the sender
+func (pt *persistedTransaction) SenderShardId() uint32 {
+	return pt.sndShard
+}
+
+// ReceiverShardId returns the shard of the receiver
+func (pt *persistedTransaction) ReceiverShardId() uint32 {
+	return pt.rcvShard
+}
+
+// Nonce returns the transaction nonce
+func (pt *persistedTransaction) Nonce() uint64 {
+	return pt.tx.Nonce
+}
+
+// SenderAddress returns the transaction sender address
+func (pt *persistedTransaction) SenderAddress() []byte {
+	return pt.tx.SndAddr
+}
+
+// Fee returns the estimated cost of the transaction
+func (pt *persistedTransaction) Fee() *big.Int {
+	return pt.feeComputer.ComputeTxFee(pt.tx)
+}
+
+// Transaction returns the wrapped transaction
+func (pt *persistedTransaction) Transaction() data.TransactionHandler {
+	return pt.tx
+}
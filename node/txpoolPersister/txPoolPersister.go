@@ -0,0 +1,349 @@
+package txpoolPersister
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/dataRetriever"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/sharding"
+	"github.com/kalyan3104/k-chain-go/storage"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+const timeBetweenSyncStateChecks = time.Second
+
+var log = logger.GetOrCreate("node/txpoolPersister")
+
+// SyncStateProvider is able to tell if the node is synchronized
+type SyncStateProvider interface {
+	GetNodeState() common.NodeState
+	IsInterfaceNil() bool
+}
+
+// ArgsTxPoolPersister is the DTO used to create a new transactions pool persister
+type ArgsTxPoolPersister struct {
+	TxPool            dataRetriever.ShardedDataCacherNotifier
+	Storer            storage.Persister
+	Marshaller        marshal.Marshalizer
+	Hasher            hashing.Hasher
+	ShardCoordinator  sharding.Coordinator
+	TxValidator       process.TxValidator
+	FeeHandler        process.FeeHandler
+	SyncStateProvider SyncStateProvider
+	TxsSender         process.TxsSenderHandler
+	PersistInterval   time.Duration
+}
+
+// txPoolPersister saves the pending transactions sent from the node's shard, periodically and on close, and reloads
+// them in the pool after a restart, once the node is synchronized. The reloaded transactions are validated against
+// the current state, so the ones already executed or no longer affordable are dropped. The valid ones are propagated
+// again, as the other nodes might have dropped them in the meantime
+type txPoolPersister struct {
+	txPool            dataRetriever.ShardedDataCacherNotifier
+	storer            storage.Persister
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	shardCoordinator  sharding.Coordinator
+	txValidator       process.TxValidator
+	feeHandler        process.FeeHandler
+	syncStateProvider SyncStateProvider
+	txsSender         process.TxsSenderHandler
+	persistInterval   time.Duration
+	cancelFunc        func()
+
+	mutOperations sync.Mutex
+	loaded        bool
+	closed        bool
+}
+
+// NewTxPoolPersister creates a new transactions pool persister and starts its background go routine
+func NewTxPoolPersister(args ArgsTxPoolPersister) (*txPoolPersister, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tpp := &txPoolPersister{
+		txPool:            args.TxPool,
+		storer:            args.Storer,
+		marshaller:        args.Marshaller,
+		hasher:            args.Hasher,
+		shardCoordinator:  args.ShardCoordinator,
+		txValidator:       args.TxValidator,
+		feeHandler:        args.FeeHandler,
+		syncStateProvider: args.SyncStateProvider,
+		txsSender:         args.TxsSender,
+		persistInterval:   args.PersistInterval,
+	}
+
+	var ctx context.Context
+	ctx, tpp.cancelFunc = context.WithCancel(context.Background())
+	go tpp.startProcessing(ctx)
+
+	return tpp, nil
+}
+
+func checkArgs(args ArgsTxPoolPersister) error {
+	if check.IfNil(args.TxPool) {
+		return ErrNilTxPool
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.TxValidator) {
+		return ErrNilTxValidator
+	}
+	if check.IfNil(args.FeeHandler) {
+		return ErrNilFeeHandler
+	}
+	if check.IfNil(args.SyncStateProvider) {
+		return ErrNilSyncStateProvider
+	}
+	if check.IfNil(args.TxsSender) {
+		return ErrNilTxsSender
+	}
+	if args.PersistInterval < time.Second {
+		return fmt.Errorf("%w, provided %v", ErrInvalidPersistInterval, args.PersistInterval)
+	}
+
+	return nil
+}
+
+func (tpp *txPoolPersister) startProcessing(ctx context.Context) {
+	// the stored transactions can only be validated against an up-to-date state
+	for tpp.syncStateProvider.GetNodeState() != common.NsSynchronized {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolPersister's go routine is stopping...")
+			return
+		case <-time.After(timeBetweenSyncStateChecks):
+		}
+	}
+
+	tpp.loadTransactions()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolPersister's go routine is stopping...")
+			return
+		case <-time.After(tpp.persistInterval):
+		}
+
+		tpp.persistTransactions()
+	}
+}
+
+// loadTransactions adds the valid stored transactions in the pool, propagates them and removes the other ones from
+// the storer
+func (tpp *txPoolPersister) loadTransactions() {
+	tpp.mutOperations.Lock()
+	defer tpp.mutOperations.Unlock()
+
+	if tpp.closed {
+		return
+	}
+
+	storedTxs := tpp.getStoredTransactions()
+	loadedTxs := make([]*transaction.Transaction, 0, len(storedTxs))
+	for txHash, txBuff := range storedTxs {
+		tx, err := tpp.loadTransaction([]byte(txHash), txBuff)
+		if err != nil {
+			log.Trace("txPoolPersister: dropping stored transaction", "hash", []byte(txHash), "error", err)
+			tpp.removeFromStorer([]byte(txHash))
+			continue
+		}
+
+		loadedTxs = append(loadedTxs, tx)
+	}
+
+	tpp.loaded = true
+	log.Debug("txPoolPersister: loaded the stored transactions", "num stored", len(storedTxs), "num loaded", len(loadedTxs))
+
+	tpp.propagateTransactions(loadedTxs)
+}
+
+func (tpp *txPoolPersister) propagateTransactions(txs []*transaction.Transaction) {
+	if len(txs) == 0 {
+		return
+	}
+
+	numSent, err := tpp.txsSender.SendBulkTransactions(txs)
+	if err != nil {
+		log.Warn("txPoolPersister: cannot propagate the loaded transactions", "error", err)
+		return
+	}
+
+	log.Debug("txPoolPersister: propagated the loaded transactions", "num sent", numSent)
+}
+
+func (tpp *txPoolPersister) getStoredTransactions() map[string][]byte {
+	storedTxs := make(map[string][]byte)
+	tpp.storer.RangeKeys(func(key []byte, val []byte) bool {
+		storedTxs[string(key)] = val
+		return true
+	})
+
+	return storedTxs
+}
+
+func (tpp *txPoolPersister) loadTransaction(txHash []byte, txBuff []byte) (*transaction.Transaction, error) {
+	tx := &transaction.Transaction{}
+	err := tpp.marshaller.Unmarshal(tx, txBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	computedHash, err := core.CalculateHash(tpp.marshaller, tpp.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(computedHash, txHash) {
+		return nil, ErrStoredTransactionHashMismatch
+	}
+
+	persistedTx := newPersistedTransaction(tx, tpp.shardCoordinator, tpp.feeHandler)
+	if persistedTx.SenderShardId() != tpp.shardCoordinator.SelfId() {
+		return nil, fmt.Errorf("%w, sender shard %d", ErrStoredTransactionFromOtherShard, persistedTx.SenderShardId())
+	}
+
+	err = tpp.txValidator.CheckTxValidity(persistedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheId := process.ShardCacherIdentifier(persistedTx.SenderShardId(), persistedTx.ReceiverShardId())
+	tpp.txPool.AddData(txHash, tx, len(txBuff), cacheId)
+
+	return tx, nil
+}
+
+// persistTransactions saves the pooled transactions sent from the node's shard and removes the stored ones that
+// are no longer in the pool
+func (tpp *txPoolPersister) persistTransactions() {
+	tpp.mutOperations.Lock()
+	defer tpp.mutOperations.Unlock()
+
+	if tpp.closed {
+		return
+	}
+
+	tpp.doPersist()
+}
+
+func (tpp *txPoolPersister) doPersist() {
+	pooledTxs := tpp.getPooledTransactions()
+
+	staleKeys := make([][]byte, 0)
+	tpp.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		_, isPooled := pooledTxs[string(key)]
+		if isPooled {
+			// already saved, the content of a transaction can not change for the same hash
+			delete(pooledTxs, string(key))
+			return true
+		}
+
+		staleKeys = append(staleKeys, key)
+		return true
+	})
+
+	// the removal is done after the iteration as some persisters hold a lock while ranging over the keys
+	for _, key := range staleKeys {
+		tpp.removeFromStorer(key)
+	}
+
+	numSaved := 0
+	for txHash, tx := range pooledTxs {
+		txBuff, err := tpp.marshaller.Marshal(tx)
+		if err != nil {
+			log.Warn("txPoolPersister: cannot marshal transaction", "hash", []byte(txHash), "error", err)
+			continue
+		}
+
+		err = tpp.storer.Put([]byte(txHash), txBuff)
+		if err != nil {
+			log.Warn("txPoolPersister: cannot save transaction", "hash", []byte(txHash), "error", err)
+			continue
+		}
+
+		numSaved++
+	}
+
+	log.Debug("txPoolPersister: persisted the transactions pool", "num saved", numSaved, "num removed", len(staleKeys))
+}
+
+func (tpp *txPoolPersister) getPooledTransactions() map[string]*transaction.Transaction {
+	pooledTxs := make(map[string]*transaction.Transaction)
+	selfShard := tpp.shardCoordinator.SelfId()
+	for _, txHash := range tpp.txPool.Keys() {
+		value, ok := tpp.txPool.SearchFirstData(txHash)
+		if !ok {
+			continue
+		}
+
+		tx, ok := value.(*transaction.Transaction)
+		if !ok {
+			continue
+		}
+
+		// the transactions coming from other shards are the responsibility of the nodes in those shards
+		if tpp.shardCoordinator.ComputeId(tx.SndAddr) != selfShard {
+			continue
+		}
+
+		pooledTxs[string(txHash)] = tx
+	}
+
+	return pooledTxs
+}
+
+func (tpp *txPoolPersister) removeFromStorer(key []byte) {
+	err := tpp.storer.Remove(key)
+	if err != nil {
+		log.Debug("txPoolPersister: cannot remove stored transaction", "hash", key, "error", err)
+	}
+}
+
+// Close stops the background go routine, saves a last snapshot of the pool and closes the storer. The snapshot is
+// skipped if the stored transactions were not loaded yet, so they are kept for the next start
+func (tpp *txPoolPersister) Close() error {
+	tpp.cancelFunc()
+
+	tpp.mutOperations.Lock()
+	defer tpp.mutOperations.Unlock()
+
+	if tpp.closed {
+		return nil
+	}
+
+	if tpp.loaded {
+		tpp.doPersist()
+	}
+	tpp.closed = true
+
+	return tpp.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *txPoolPersister) IsInterfaceNil() bool {
+	return tpp == nil
+}
@@ -0,0 +1,466 @@
+package txpoolPersister
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/process/mock"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/economicsmocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/hashingMocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
+	"github.com/kalyan3104/k-chain-go/testscommon/txsSenderMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+// the shard coordinator used in tests places the addresses starting with "s0" in shard 0, all the others in shard 1
+func createShardCoordinator() *testscommon.ShardsCoordinatorMock {
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if strings.HasPrefix(string(address), "s0") {
+			return 0
+		}
+
+		return 1
+	}
+
+	return shardCoordinator
+}
+
+func createMockArgs() ArgsTxPoolPersister {
+	return ArgsTxPoolPersister{
+		TxPool:           testscommon.NewShardedDataCacheNotifierMock(),
+		Storer:           testscommon.NewMemDbMock(),
+		Marshaller:       &marshallerMock.MarshalizerMock{},
+		Hasher:           &hashingMocks.HasherMock{},
+		ShardCoordinator: createShardCoordinator(),
+		TxValidator: &mock.TxValidatorStub{
+			CheckTxValidityCalled: func(interceptedTx process.InterceptedTransactionHandler) error {
+				return nil
+			},
+		},
+		FeeHandler: &economicsmocks.EconomicsHandlerStub{},
+		SyncStateProvider: &mock.BootstrapperStub{
+			GetNodeStateCalled: func() common.NodeState {
+				return common.NsNotSynchronized
+			},
+		},
+		TxsSender:       &txsSenderMock.TxsSenderHandlerMock{},
+		PersistInterval: time.Minute,
+	}
+}
+
+func createTx(nonce uint64, sender string, receiver string) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte(sender),
+		RcvAddr:  []byte(receiver),
+		Value:    big.NewInt(0),
+		GasPrice: 1000000000,
+		GasLimit: 50000,
+	}
+}
+
+func storeTx(t *testing.T, args ArgsTxPoolPersister, tx *transaction.Transaction) []byte {
+	txBuff, err := args.Marshaller.Marshal(tx)
+	require.Nil(t, err)
+	txHash := args.Hasher.Compute(string(txBuff))
+	err = args.Storer.Put(txHash, txBuff)
+	require.Nil(t, err)
+
+	return txHash
+}
+
+func addTxInPool(t *testing.T, args ArgsTxPoolPersister, tx *transaction.Transaction, cacheId string) []byte {
+	txHash, err := core.CalculateHash(args.Marshaller, args.Hasher, tx)
+	require.Nil(t, err)
+	args.TxPool.AddData(txHash, tx, 0, cacheId)
+
+	return txHash
+}
+
+func TestNewTxPoolPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxPool = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilTxPool, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Storer = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Hasher = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ShardCoordinator = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil tx validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxValidator = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilTxValidator, err)
+	})
+	t.Run("nil fee handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.FeeHandler = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilFeeHandler, err)
+	})
+	t.Run("nil sync state provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SyncStateProvider = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilSyncStateProvider, err)
+	})
+	t.Run("nil txs sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxsSender = nil
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.Equal(t, ErrNilTxsSender, err)
+	})
+	t.Run("invalid persist interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PersistInterval = time.Millisecond
+		tpp, err := NewTxPoolPersister(args)
+		assert.Nil(t, tpp)
+		assert.ErrorIs(t, err, ErrInvalidPersistInterval)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tpp, err := NewTxPoolPersister(createMockArgs())
+		assert.Nil(t, err)
+		assert.False(t, tpp.IsInterfaceNil())
+
+		assert.Nil(t, tpp.Close())
+	})
+}
+
+func TestTxPoolPersister_LoadTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should not load before the node is synchronized", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		_ = storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+
+		tpp, _ := NewTxPoolPersister(args)
+		time.Sleep(timeBetweenSyncStateChecks / 2)
+		assert.Empty(t, args.TxPool.Keys())
+
+		_ = tpp.Close()
+		assert.Empty(t, args.TxPool.Keys())
+	})
+	t.Run("should load once the node is synchronized", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		txHash := storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+
+		isSynced := atomic.Bool{}
+		args.SyncStateProvider = &mock.BootstrapperStub{
+			GetNodeStateCalled: func() common.NodeState {
+				if isSynced.Load() {
+					return common.NsSynchronized
+				}
+
+				return common.NsNotSynchronized
+			},
+		}
+
+		tpp, _ := NewTxPoolPersister(args)
+		defer func() {
+			_ = tpp.Close()
+		}()
+
+		isSynced.Store(true)
+		time.Sleep(timeBetweenSyncStateChecks + time.Second/2)
+
+		_, found := args.TxPool.SearchFirstData(txHash)
+		assert.True(t, found)
+	})
+	t.Run("should drop the invalid and corrupted transactions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		validIntraShardTxHash := storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+		validCrossShardTxHash := storeTx(t, args, createTx(2, "s0sender", "s1receiver"))
+		validEmptyReceiverTxHash := storeTx(t, args, createTx(3, "s0sender", string(make([]byte, 10))))
+		invalidTxHash := storeTx(t, args, createTx(0, "s0sender", "s0receiver"))
+		otherShardTxHash := storeTx(t, args, createTx(1, "s1sender", "s0receiver"))
+		corruptedTxHash := []byte("corrupted tx hash")
+		_ = args.Storer.Put(corruptedTxHash, []byte("corrupted tx"))
+		mismatchedHash := []byte("mismatched hash")
+		txBuff, _ := args.Marshaller.Marshal(createTx(4, "s0sender", "s0receiver"))
+		_ = args.Storer.Put(mismatchedHash, txBuff)
+
+		args.TxValidator = &mock.TxValidatorStub{
+			CheckTxValidityCalled: func(interceptedTx process.InterceptedTransactionHandler) error {
+				if interceptedTx.Nonce() == 0 {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+		var sentTxs []*transaction.Transaction
+		args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				sentTxs = txs
+				return uint64(len(txs)), nil
+			},
+		}
+		tpp, _ := NewTxPoolPersister(args)
+		tpp.loadTransactions()
+		_ = tpp.Close()
+
+		// the valid transactions are propagated again
+		sentNonces := make([]uint64, 0, len(sentTxs))
+		for _, tx := range sentTxs {
+			sentNonces = append(sentNonces, tx.Nonce)
+		}
+		assert.ElementsMatch(t, []uint64{1, 2, 3}, sentNonces)
+
+		_, found := args.TxPool.ShardDataStore("0").Get(validIntraShardTxHash)
+		assert.True(t, found)
+		_, found = args.TxPool.ShardDataStore("0_1").Get(validCrossShardTxHash)
+		assert.True(t, found)
+		_, found = args.TxPool.ShardDataStore("0").Get(validEmptyReceiverTxHash)
+		assert.True(t, found)
+		assert.Equal(t, 3, len(args.TxPool.Keys()))
+
+		for _, droppedHash := range [][]byte{invalidTxHash, otherShardTxHash, corruptedTxHash, mismatchedHash} {
+			assert.NotNil(t, args.Storer.Has(droppedHash))
+		}
+	})
+	t.Run("the fee should be computed by the fee handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		_ = storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+		args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+			ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+				return big.NewInt(37)
+			},
+		}
+		args.TxValidator = &mock.TxValidatorStub{
+			CheckTxValidityCalled: func(interceptedTx process.InterceptedTransactionHandler) error {
+				if interceptedTx.Fee().Cmp(big.NewInt(37)) != 0 {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+
+		tpp, _ := NewTxPoolPersister(args)
+		tpp.loadTransactions()
+		_ = tpp.Close()
+
+		assert.Equal(t, 1, len(args.TxPool.Keys()))
+	})
+	t.Run("propagation failure should keep the loaded transactions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		txHash := storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+		args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+
+		tpp, _ := NewTxPoolPersister(args)
+		tpp.loadTransactions()
+		_ = tpp.Close()
+
+		_, found := args.TxPool.SearchFirstData(txHash)
+		assert.True(t, found)
+	})
+	t.Run("no valid transaction should not propagate", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, nil
+			},
+		}
+
+		tpp, _ := NewTxPoolPersister(args)
+		tpp.loadTransactions()
+		_ = tpp.Close()
+	})
+}
+
+func TestTxPoolPersister_LoadTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hash mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		tpp, _ := NewTxPoolPersister(args)
+		defer func() {
+			_ = tpp.Close()
+		}()
+
+		txBuff, _ := args.Marshaller.Marshal(createTx(1, "s0sender", "s0receiver"))
+		tx, err := tpp.loadTransaction([]byte("mismatched hash"), txBuff)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrStoredTransactionHashMismatch, err)
+	})
+	t.Run("sender from other shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		tpp, _ := NewTxPoolPersister(args)
+		defer func() {
+			_ = tpp.Close()
+		}()
+
+		storedTx := createTx(1, "s1sender", "s0receiver")
+		txBuff, _ := args.Marshaller.Marshal(storedTx)
+		txHash, _ := core.CalculateHash(args.Marshaller, args.Hasher, storedTx)
+		tx, err := tpp.loadTransaction(txHash, txBuff)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrStoredTransactionFromOtherShard)
+	})
+}
+
+func TestTxPoolPersister_PersistTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should save the source-me transactions and remove the stale ones", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		staleTxHash := storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+		alreadyStoredTx := createTx(2, "s0sender", "s0receiver")
+		alreadyStoredTxHash := storeTx(t, args, alreadyStoredTx)
+
+		tpp, _ := NewTxPoolPersister(args)
+		tpp.loadTransactions()
+		args.TxPool.RemoveData(staleTxHash, "0")
+
+		intraShardTxHash := addTxInPool(t, args, createTx(3, "s0sender", "s0receiver"), "0")
+		crossShardTxHash := addTxInPool(t, args, createTx(4, "s0sender", "s1receiver"), "0_1")
+		incomingTxHash := addTxInPool(t, args, createTx(1, "s1sender", "s0receiver"), "1_0")
+
+		tpp.persistTransactions()
+
+		assert.NotNil(t, args.Storer.Has(staleTxHash))
+		assert.Nil(t, args.Storer.Has(alreadyStoredTxHash))
+		assert.Nil(t, args.Storer.Has(intraShardTxHash))
+		assert.Nil(t, args.Storer.Has(crossShardTxHash))
+		assert.NotNil(t, args.Storer.Has(incomingTxHash))
+
+		storedBuff, _ := args.Storer.Get(intraShardTxHash)
+		storedTx := &transaction.Transaction{}
+		_ = args.Marshaller.Unmarshal(storedTx, storedBuff)
+		assert.Equal(t, uint64(3), storedTx.Nonce)
+
+		_ = tpp.Close()
+	})
+	t.Run("close should persist if the stored transactions were loaded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		tpp, _ := NewTxPoolPersister(args)
+		tpp.loadTransactions()
+
+		txHash := addTxInPool(t, args, createTx(1, "s0sender", "s0receiver"), "0")
+
+		err := tpp.Close()
+		assert.Nil(t, err)
+		assert.Nil(t, args.Storer.Has(txHash))
+	})
+	t.Run("close should not overwrite the stored transactions if they were not loaded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		storedTxHash := storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+
+		tpp, _ := NewTxPoolPersister(args)
+		pooledTxHash := addTxInPool(t, args, createTx(2, "s0sender", "s0receiver"), "0")
+
+		err := tpp.Close()
+		assert.Nil(t, err)
+		assert.Nil(t, args.Storer.Has(storedTxHash))
+		assert.NotNil(t, args.Storer.Has(pooledTxHash))
+	})
+	t.Run("operations after close should not use the storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		storedTxHash := storeTx(t, args, createTx(1, "s0sender", "s0receiver"))
+		tpp, _ := NewTxPoolPersister(args)
+		_ = tpp.Close()
+
+		tpp.loadTransactions()
+		tpp.persistTransactions()
+		assert.Empty(t, args.TxPool.Keys())
+		assert.Nil(t, args.Storer.Has(storedTxHash))
+	})
+}
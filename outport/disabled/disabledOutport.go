@@ -57,6 +57,11 @@ func (n *disabledOutport) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// UnsubscribeDriver does nothing
+func (n *disabledOutport) UnsubscribeDriver(_ outport.Driver) error {
+	return nil
+}
+
 // HasDrivers does nothing
 func (n *disabledOutport) HasDrivers() bool {
 	return false
@@ -5,6 +5,12 @@ import "errors"
 // ErrNilDriver signals that a nil driver has been provided
 var ErrNilDriver = errors.New("nil driver")
 
+// ErrDriverNotSubscribed signals that the provided driver is not subscribed to the outport
+var ErrDriverNotSubscribed = errors.New("driver not subscribed")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
 // ErrNilArgsOutportFactory signals that arguments that are needed for elastic driver factory are nil
 var ErrNilArgsOutportFactory = errors.New("nil args outport driver factory")
 
@@ -0,0 +1,80 @@
+package factory
+
+import (
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/outport"
+	"github.com/kalyan3104/k-chain-go/outport/subscriptions"
+	"github.com/kalyan3104/k-chain-go/outport/subscriptions/disabled"
+)
+
+// ArgsSubscriptionsHandlerFactory defines the args needed for the websocket subscriptions handler creation
+type ArgsSubscriptionsHandlerFactory struct {
+	Config                 config.WebSocketSubscriptionsConfig
+	Marshaller             marshal.Marshalizer
+	AddressPubkeyConverter core.PubkeyConverter
+	OutportHandler         outport.OutportHandler
+}
+
+// CreateSubscriptionsHandler will create the websocket subscriptions handler. If enabled, the handler is
+// subscribed as a driver on the provided outport handler, otherwise a disabled handler is returned
+func CreateSubscriptionsHandler(args ArgsSubscriptionsHandlerFactory) (common.SubscriptionsHandler, error) {
+	if !args.Config.Enabled {
+		return disabled.NewDisabledSubscriptionsHandler(), nil
+	}
+	if check.IfNil(args.OutportHandler) {
+		return nil, outport.ErrNilOutportHandler
+	}
+
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := subscriptions.NewSubscriptionsDriver(subscriptions.ArgsSubscriptionsDriver{
+		Marshaller:             args.Marshaller,
+		AddressPubkeyConverter: args.AddressPubkeyConverter,
+		BlockContainer:         blockContainer,
+		Config:                 args.Config,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.OutportHandler.SubscribeDriver(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscribedSubscriptionsHandler{
+		SubscriptionsHandler: driver,
+		driver:               driver,
+		outportHandler:       args.OutportHandler,
+	}, nil
+}
+
+// subscribedSubscriptionsHandler unsubscribes the subscriptions driver from the outport handler when closed, so that
+// a closed driver does not keep receiving the committed blocks
+type subscribedSubscriptionsHandler struct {
+	common.SubscriptionsHandler
+	driver         outport.Driver
+	outportHandler outport.OutportHandler
+}
+
+// Close unsubscribes the driver from the outport handler and closes it
+func (ssh *subscribedSubscriptionsHandler) Close() error {
+	err := ssh.outportHandler.UnsubscribeDriver(ssh.driver)
+	if err != nil {
+		log.Debug("subscribedSubscriptionsHandler.Close: cannot unsubscribe the driver", "error", err)
+	}
+
+	return ssh.driver.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssh *subscribedSubscriptionsHandler) IsInterfaceNil() bool {
+	return ssh == nil
+}
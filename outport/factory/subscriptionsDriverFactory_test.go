@@ -0,0 +1,97 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/outport"
+	"github.com/kalyan3104/k-chain-go/outport/subscriptions"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/kalyan3104/k-chain-go/testscommon/outport"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSubscriptionsHandlerFactory() ArgsSubscriptionsHandlerFactory {
+	return ArgsSubscriptionsHandlerFactory{
+		Config: config.WebSocketSubscriptionsConfig{
+			Enabled:                       true,
+			MaxSubscribers:                10,
+			MaxSubscriptionsPerSubscriber: 10,
+			MaxTxHashesPerSubscription:    10,
+			EventsBufferSize:              10,
+		},
+		Marshaller:             &marshallerMock.MarshalizerMock{},
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		OutportHandler:         &outportStub.OutportStub{},
+	}
+}
+
+func TestCreateSubscriptionsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should return the disabled handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHandlerFactory()
+		args.Config.Enabled = false
+		args.OutportHandler = nil
+
+		handler, err := CreateSubscriptionsHandler(args)
+		require.Nil(t, err)
+		require.Equal(t, "*disabled.disabledSubscriptionsHandler", fmt.Sprintf("%T", handler))
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHandlerFactory()
+		args.OutportHandler = nil
+
+		handler, err := CreateSubscriptionsHandler(args)
+		require.Nil(t, handler)
+		require.Equal(t, outport.ErrNilOutportHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var subscribedDriver outport.Driver
+		args := createMockArgsSubscriptionsHandlerFactory()
+		args.OutportHandler = &outportStub.OutportStub{
+			SubscribeDriverCalled: func(driver outport.Driver) error {
+				subscribedDriver = driver
+				return nil
+			},
+		}
+
+		handler, err := CreateSubscriptionsHandler(args)
+		require.Nil(t, err)
+		require.Equal(t, "*factory.subscribedSubscriptionsHandler", fmt.Sprintf("%T", handler))
+		require.Equal(t, "*subscriptions.subscriptionsDriver", fmt.Sprintf("%T", subscribedDriver))
+	})
+	t.Run("close should unsubscribe and close the driver", func(t *testing.T) {
+		t.Parallel()
+
+		var subscribedDriver, unsubscribedDriver outport.Driver
+		args := createMockArgsSubscriptionsHandlerFactory()
+		args.OutportHandler = &outportStub.OutportStub{
+			SubscribeDriverCalled: func(driver outport.Driver) error {
+				subscribedDriver = driver
+				return nil
+			},
+			UnsubscribeDriverCalled: func(driver outport.Driver) error {
+				unsubscribedDriver = driver
+				return nil
+			},
+		}
+
+		handler, _ := CreateSubscriptionsHandler(args)
+		err := handler.Close()
+		require.Nil(t, err)
+		require.True(t, subscribedDriver == unsubscribedDriver)
+
+		subscriber, err := handler.CreateSubscriber()
+		require.Nil(t, subscriber)
+		require.Equal(t, subscriptions.ErrSubscriptionsHandlerClosed, err)
+	})
+}
@@ -34,6 +34,7 @@ type OutportHandler interface {
 	SaveAccounts(accounts *outportcore.Accounts)
 	FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock)
 	SubscribeDriver(driver Driver) error
+	UnsubscribeDriver(driver Driver) error
 	HasDrivers() bool
 	Close() error
 	IsInterfaceNil() bool
@@ -393,6 +393,29 @@ func (o *outport) SubscribeDriver(driver Driver) error {
 	return nil
 }
 
+// UnsubscribeDriver removes a previously subscribed driver from the outport. The driver is not closed
+func (o *outport) UnsubscribeDriver(driver Driver) error {
+	if check.IfNil(driver) {
+		return ErrNilDriver
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	for idx, subscribedDriver := range o.drivers {
+		if subscribedDriver != driver {
+			continue
+		}
+
+		o.drivers = append(o.drivers[:idx:idx], o.drivers[idx+1:]...)
+		log.Debug("outport.UnsubscribeDriver driver removed", "driver", driverString(driver))
+
+		return nil
+	}
+
+	return ErrDriverNotSubscribed
+}
+
 func driverString(driver Driver) string {
 	return fmt.Sprintf("%T", driver)
 }
@@ -397,6 +397,51 @@ func TestOutport_SubscribeDriver(t *testing.T) {
 	})
 }
 
+func TestOutport_UnsubscribeDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+
+		err := outportHandler.UnsubscribeDriver(nil)
+		require.Equal(t, ErrNilDriver, err)
+	})
+	t.Run("not subscribed driver should error", func(t *testing.T) {
+		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+		_ = outportHandler.SubscribeDriver(&mock.DriverStub{})
+
+		err := outportHandler.UnsubscribeDriver(&mock.DriverStub{})
+		require.Equal(t, ErrDriverNotSubscribed, err)
+		require.True(t, outportHandler.HasDrivers())
+	})
+	t.Run("should work", func(t *testing.T) {
+		outportHandler, _ := NewOutport(minimumRetrialInterval, outportcore.OutportConfig{})
+
+		numSaveBlockCalls := 0
+		driver1 := &mock.DriverStub{}
+		driver2 := &mock.DriverStub{
+			SaveBlockCalled: func(args *outportcore.OutportBlock) error {
+				numSaveBlockCalls++
+				return nil
+			},
+		}
+		_ = outportHandler.SubscribeDriver(driver1)
+		_ = outportHandler.SubscribeDriver(driver2)
+
+		err := outportHandler.UnsubscribeDriver(driver1)
+		require.Nil(t, err)
+		require.True(t, outportHandler.HasDrivers())
+
+		err = outportHandler.SaveBlock(createSaveBlockArgs())
+		require.Nil(t, err)
+		require.Equal(t, 1, numSaveBlockCalls)
+
+		err = outportHandler.UnsubscribeDriver(driver2)
+		require.Nil(t, err)
+		require.False(t, outportHandler.HasDrivers())
+	})
+}
+
 func TestOutport_Close(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/outport/subscriptions"
+)
+
+type disabledSubscriptionsHandler struct {
+}
+
+// NewDisabledSubscriptionsHandler returns a subscriptions handler that does not accept subscribers
+func NewDisabledSubscriptionsHandler() *disabledSubscriptionsHandler {
+	return &disabledSubscriptionsHandler{}
+}
+
+// CreateSubscriber returns ErrSubscriptionsDisabled
+func (dsh *disabledSubscriptionsHandler) CreateSubscriber() (common.SubscriberHandler, error) {
+	return nil, subscriptions.ErrSubscriptionsDisabled
+}
+
+// Close returns nil
+func (dsh *disabledSubscriptionsHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsh *disabledSubscriptionsHandler) IsInterfaceNil() bool {
+	return dsh == nil
+}
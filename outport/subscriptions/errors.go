@@ -0,0 +1,44 @@
+package subscriptions
+
+import (
+	"errors"
+)
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilBlockContainerHandler signals that a nil block container handler has been provided
+var ErrNilBlockContainerHandler = errors.New("nil block container handler")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrSubscriptionsDisabled signals that the live data subscriptions are not enabled on this node
+var ErrSubscriptionsDisabled = errors.New("websocket subscriptions are disabled")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrTooManySubscriptions signals that a subscriber has reached its maximum number of subscriptions
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+// ErrInvalidSubscriptionTopic signals that an unknown subscription topic has been provided
+var ErrInvalidSubscriptionTopic = errors.New("invalid subscription topic")
+
+// ErrNoTxHashesProvided signals that a transaction status subscription was requested without any hash
+var ErrNoTxHashesProvided = errors.New("no transaction hashes provided")
+
+// ErrTooManyTxHashes signals that a transaction status subscription was requested with too many hashes
+var ErrTooManyTxHashes = errors.New("too many transaction hashes")
+
+// ErrSubscriptionNotFound signals that the provided subscription ID is not known
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// ErrSubscriberClosed signals that the subscriber has been closed
+var ErrSubscriberClosed = errors.New("subscriber closed")
+
+// ErrSubscriptionsHandlerClosed signals that the subscriptions handler has been closed
+var ErrSubscriptionsHandlerClosed = errors.New("subscriptions handler closed")
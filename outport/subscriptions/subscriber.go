@@ -0,0 +1,212 @@
+package subscriptions
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/kalyan3104/k-chain-go/common"
+)
+
+type subscription struct {
+	id          string
+	request     common.SubscriptionRequest
+	txHashes    map[string]struct{}
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+}
+
+type subscriber struct {
+	id                 uint64
+	maxSubscriptions   int
+	maxTxHashes        int
+	onClose            func(id uint64)
+	mut                sync.Mutex
+	subscriptions      map[string]*subscription
+	lastSubscriptionID uint64
+	events             chan *common.SubscriptionEvent
+	closed             bool
+}
+
+func newSubscriber(id uint64, cfg subscriberConfig, onClose func(id uint64)) *subscriber {
+	return &subscriber{
+		id:               id,
+		maxSubscriptions: cfg.maxSubscriptions,
+		maxTxHashes:      cfg.maxTxHashes,
+		onClose:          onClose,
+		subscriptions:    make(map[string]*subscription),
+		events:           make(chan *common.SubscriptionEvent, cfg.eventsBufferSize),
+	}
+}
+
+// Subscribe registers a new subscription and returns its ID
+func (s *subscriber) Subscribe(request common.SubscriptionRequest) (string, error) {
+	err := s.checkRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed {
+		return "", ErrSubscriberClosed
+	}
+	if len(s.subscriptions) >= s.maxSubscriptions {
+		return "", fmt.Errorf("%w, maximum %d", ErrTooManySubscriptions, s.maxSubscriptions)
+	}
+
+	s.lastSubscriptionID++
+	sub := &subscription{
+		id:          strconv.FormatUint(s.lastSubscriptionID, 10),
+		request:     request,
+		txHashes:    sliceToSet(request.TxHashes),
+		addresses:   sliceToSet(request.Addresses),
+		identifiers: sliceToSet(request.Identifiers),
+	}
+	s.subscriptions[sub.id] = sub
+
+	return sub.id, nil
+}
+
+func (s *subscriber) checkRequest(request common.SubscriptionRequest) error {
+	switch request.Topic {
+	case common.NewHeadersSubscriptionTopic, common.FinalizedBlocksSubscriptionTopic, common.ContractEventsSubscriptionTopic:
+		return nil
+	case common.TxStatusSubscriptionTopic:
+		if len(request.TxHashes) == 0 {
+			return ErrNoTxHashesProvided
+		}
+		if len(request.TxHashes) > s.maxTxHashes {
+			return fmt.Errorf("%w, provided %d, maximum %d", ErrTooManyTxHashes, len(request.TxHashes), s.maxTxHashes)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidSubscriptionTopic, request.Topic)
+	}
+}
+
+// Unsubscribe removes the subscription with the provided ID
+func (s *subscriber) Unsubscribe(subscriptionID string) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	_, found := s.subscriptions[subscriptionID]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrSubscriptionNotFound, subscriptionID)
+	}
+
+	delete(s.subscriptions, subscriptionID)
+
+	return nil
+}
+
+// Events returns the channel on which the notifications are delivered. The channel is closed when the subscriber is closed
+func (s *subscriber) Events() <-chan *common.SubscriptionEvent {
+	return s.events
+}
+
+func (s *subscriber) notifyHeader(notification *common.HeaderNotificationAPI) bool {
+	return s.notify(common.NewHeadersSubscriptionTopic, notification, func(sub *subscription) bool {
+		return matchesShard(sub, notification.ShardID)
+	})
+}
+
+func (s *subscriber) notifyFinalizedBlock(notification *common.FinalizedBlockNotificationAPI) bool {
+	return s.notify(common.FinalizedBlocksSubscriptionTopic, notification, func(sub *subscription) bool {
+		return matchesShard(sub, notification.ShardID)
+	})
+}
+
+func (s *subscriber) notifyTxStatus(notification *common.TxStatusNotificationAPI) bool {
+	return s.notify(common.TxStatusSubscriptionTopic, notification, func(sub *subscription) bool {
+		_, found := sub.txHashes[notification.Hash]
+		return found
+	})
+}
+
+func (s *subscriber) notifyContractEvent(notification *common.ContractEventNotificationAPI) bool {
+	return s.notify(common.ContractEventsSubscriptionTopic, notification, func(sub *subscription) bool {
+		return matchesShard(sub, notification.ShardID) &&
+			matchesSet(sub.addresses, notification.Address) &&
+			matchesSet(sub.identifiers, notification.Identifier)
+	})
+}
+
+// notify will push the notification for every matching subscription and will return false if the
+// subscriber could not keep up and was closed
+func (s *subscriber) notify(topic common.SubscriptionTopic, data interface{}, matches func(sub *subscription) bool) bool {
+	s.mut.Lock()
+	if s.closed {
+		s.mut.Unlock()
+		return false
+	}
+
+	for _, sub := range s.subscriptions {
+		if sub.request.Topic != topic || !matches(sub) {
+			continue
+		}
+
+		event := &common.SubscriptionEvent{
+			SubscriptionID: sub.id,
+			Topic:          topic,
+			Data:           data,
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			s.mut.Unlock()
+			log.Debug("subscriber is too slow, closing", "subscriber", s.id)
+			_ = s.Close()
+			return false
+		}
+	}
+	s.mut.Unlock()
+
+	return true
+}
+
+// Close will close the events channel and will remove the subscriber from its subscriptions handler
+func (s *subscriber) Close() error {
+	s.mut.Lock()
+	if s.closed {
+		s.mut.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.subscriptions = make(map[string]*subscription)
+	close(s.events)
+	s.mut.Unlock()
+
+	s.onClose(s.id)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *subscriber) IsInterfaceNil() bool {
+	return s == nil
+}
+
+func matchesShard(sub *subscription, shardID uint32) bool {
+	return sub.request.ShardID == nil || *sub.request.ShardID == shardID
+}
+
+func matchesSet(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[value]
+	return found
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
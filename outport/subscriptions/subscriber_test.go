@@ -0,0 +1,94 @@
+package subscriptions_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/outport/subscriptions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriber_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+		subscriber, _ := sd.CreateSubscriber()
+
+		id, err := subscriber.Subscribe(common.SubscriptionRequest{Topic: "unknown"})
+		require.Empty(t, id)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidSubscriptionTopic))
+	})
+	t.Run("tx status without hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+		subscriber, _ := sd.CreateSubscriber()
+
+		_, err := subscriber.Subscribe(common.SubscriptionRequest{Topic: common.TxStatusSubscriptionTopic})
+		require.Equal(t, subscriptions.ErrNoTxHashesProvided, err)
+	})
+	t.Run("tx status with too many hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+		subscriber, _ := sd.CreateSubscriber()
+
+		_, err := subscriber.Subscribe(common.SubscriptionRequest{
+			Topic:    common.TxStatusSubscriptionTopic,
+			TxHashes: []string{"a", "b", "c"},
+		})
+		require.True(t, errors.Is(err, subscriptions.ErrTooManyTxHashes))
+	})
+	t.Run("too many subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+		subscriber, _ := sd.CreateSubscriber()
+
+		ids := make(map[string]struct{})
+		for i := 0; i < 3; i++ {
+			id, err := subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic})
+			require.NoError(t, err)
+			ids[id] = struct{}{}
+		}
+		require.Equal(t, 3, len(ids))
+
+		_, err := subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic})
+		require.True(t, errors.Is(err, subscriptions.ErrTooManySubscriptions))
+	})
+}
+
+func TestSubscriber_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+	subscriber, _ := sd.CreateSubscriber()
+
+	err := subscriber.Unsubscribe("missing")
+	require.True(t, errors.Is(err, subscriptions.ErrSubscriptionNotFound))
+
+	id, _ := subscriber.Subscribe(common.SubscriptionRequest{Topic: common.FinalizedBlocksSubscriptionTopic})
+	err = subscriber.Unsubscribe(id)
+	require.NoError(t, err)
+
+	err = subscriber.Unsubscribe(id)
+	require.True(t, errors.Is(err, subscriptions.ErrSubscriptionNotFound))
+}
+
+func TestSubscriber_Close(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+	subscriber, _ := sd.CreateSubscriber()
+
+	require.NoError(t, subscriber.Close())
+	require.NoError(t, subscriber.Close())
+
+	_, ok := <-subscriber.Events()
+	require.False(t, ok)
+	require.False(t, subscriber.IsInterfaceNil())
+}
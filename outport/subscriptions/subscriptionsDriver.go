@@ -0,0 +1,457 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/atomic"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/outport"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+const maxRememberedHeaders = 1000
+
+// ArgsSubscriptionsDriver defines the arguments needed for the subscriptions driver creation
+type ArgsSubscriptionsDriver struct {
+	Marshaller             marshal.Marshalizer
+	AddressPubkeyConverter core.PubkeyConverter
+	BlockContainer         BlockContainerHandler
+	Config                 config.WebSocketSubscriptionsConfig
+}
+
+type subscriberConfig struct {
+	maxSubscriptions int
+	maxTxHashes      int
+	eventsBufferSize int
+}
+
+type subscriptionsDriver struct {
+	marshaller             marshal.Marshalizer
+	addressPubkeyConverter core.PubkeyConverter
+	blockContainer         BlockContainerHandler
+	maxSubscribers         int
+	subscriberCfg          subscriberConfig
+	closed                 atomic.Flag
+
+	mutSubscribers   sync.RWMutex
+	subscribers      map[uint64]*subscriber
+	lastSubscriberID uint64
+
+	mutHeaders       sync.Mutex
+	headerNonces     map[string]uint64
+	headerHashesFIFO []string
+}
+
+// NewSubscriptionsDriver creates an outport driver that pushes the committed data to the live subscribers
+func NewSubscriptionsDriver(args ArgsSubscriptionsDriver) (*subscriptionsDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsDriver{
+		marshaller:             args.Marshaller,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		blockContainer:         args.BlockContainer,
+		maxSubscribers:         int(args.Config.MaxSubscribers),
+		subscriberCfg: subscriberConfig{
+			maxSubscriptions: int(args.Config.MaxSubscriptionsPerSubscriber),
+			maxTxHashes:      int(args.Config.MaxTxHashesPerSubscription),
+			eventsBufferSize: int(args.Config.EventsBufferSize),
+		},
+		subscribers:  make(map[uint64]*subscriber),
+		headerNonces: make(map[string]uint64),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsDriver) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNilReflect(args.BlockContainer) {
+		return ErrNilBlockContainerHandler
+	}
+	if args.Config.MaxSubscribers == 0 {
+		return fmt.Errorf("%w for MaxSubscribers", ErrInvalidValue)
+	}
+	if args.Config.MaxSubscriptionsPerSubscriber == 0 {
+		return fmt.Errorf("%w for MaxSubscriptionsPerSubscriber", ErrInvalidValue)
+	}
+	if args.Config.MaxTxHashesPerSubscription == 0 {
+		return fmt.Errorf("%w for MaxTxHashesPerSubscription", ErrInvalidValue)
+	}
+	if args.Config.EventsBufferSize == 0 {
+		return fmt.Errorf("%w for EventsBufferSize", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// CreateSubscriber registers a new subscriber
+func (sd *subscriptionsDriver) CreateSubscriber() (common.SubscriberHandler, error) {
+	sd.mutSubscribers.Lock()
+	defer sd.mutSubscribers.Unlock()
+
+	if sd.closed.IsSet() {
+		return nil, ErrSubscriptionsHandlerClosed
+	}
+	if len(sd.subscribers) >= sd.maxSubscribers {
+		return nil, fmt.Errorf("%w, maximum %d", ErrTooManySubscribers, sd.maxSubscribers)
+	}
+
+	sd.lastSubscriberID++
+	sub := newSubscriber(sd.lastSubscriberID, sd.subscriberCfg, sd.removeSubscriber)
+	sd.subscribers[sub.id] = sub
+
+	return sub, nil
+}
+
+func (sd *subscriptionsDriver) removeSubscriber(id uint64) {
+	sd.mutSubscribers.Lock()
+	delete(sd.subscribers, id)
+	sd.mutSubscribers.Unlock()
+}
+
+func (sd *subscriptionsDriver) getSubscribers() []*subscriber {
+	sd.mutSubscribers.RLock()
+	defer sd.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscriber, 0, len(sd.subscribers))
+	for _, sub := range sd.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	return subscribers
+}
+
+// SaveBlock pushes the new header, the transactions statuses and the contract events to the subscribers
+func (sd *subscriptionsDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	if sd.closed.IsSet() {
+		return nil
+	}
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return nil
+	}
+
+	blockData := outportBlock.BlockData
+	header, err := sd.getHeader(blockData)
+	if err != nil {
+		// returning the error would make the outport retry the same block indefinitely
+		log.Warn("subscriptionsDriver.SaveBlock: cannot decode header", "error", err)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(blockData.HeaderHash)
+	sd.rememberHeader(blockHash, header.GetNonce())
+
+	subscribers := sd.getSubscribers()
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	headerNotification := &common.HeaderNotificationAPI{
+		ShardID:   header.GetShardID(),
+		Epoch:     header.GetEpoch(),
+		Round:     header.GetRound(),
+		Nonce:     header.GetNonce(),
+		Hash:      blockHash,
+		PrevHash:  hex.EncodeToString(header.GetPrevHash()),
+		TimeStamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+	txStatuses := sd.computeTxStatuses(outportBlock, headerNotification)
+	contractEvents := sd.extractContractEvents(outportBlock, headerNotification)
+
+	for _, sub := range subscribers {
+		notifyBlockData(sub, headerNotification, txStatuses, contractEvents)
+	}
+
+	return nil
+}
+
+func notifyBlockData(
+	sub *subscriber,
+	headerNotification *common.HeaderNotificationAPI,
+	txStatuses []*common.TxStatusNotificationAPI,
+	contractEvents []*common.ContractEventNotificationAPI,
+) {
+	if !sub.notifyHeader(headerNotification) {
+		return
+	}
+	for _, txStatus := range txStatuses {
+		if !sub.notifyTxStatus(txStatus) {
+			return
+		}
+	}
+	for _, contractEvent := range contractEvents {
+		if !sub.notifyContractEvent(contractEvent) {
+			return
+		}
+	}
+}
+
+func (sd *subscriptionsDriver) getHeader(blockData *outport.BlockData) (data.HeaderHandler, error) {
+	creator, err := sd.blockContainer.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, err
+	}
+
+	header := creator.CreateNewHeader()
+	err = sd.marshaller.Unmarshal(header, blockData.HeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (sd *subscriptionsDriver) computeTxStatuses(
+	outportBlock *outport.OutportBlock,
+	headerNotification *common.HeaderNotificationAPI,
+) []*common.TxStatusNotificationAPI {
+	pool := outportBlock.TransactionPool
+	if pool == nil {
+		return nil
+	}
+
+	failedTxs := make(map[string]struct{})
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+		for _, event := range logData.Log.Events {
+			if event != nil && string(event.Identifier) == core.SignalErrorOperation {
+				failedTxs[logData.TxHash] = struct{}{}
+			}
+		}
+	}
+
+	crossShardTxs := extractCrossShardTxsFromSource(outportBlock.BlockData.Body, headerNotification.ShardID)
+	notifications := make([]*common.TxStatusNotificationAPI, 0, len(pool.Transactions)+len(pool.InvalidTxs))
+	for txHash := range pool.Transactions {
+		status := transaction.TxStatusSuccess
+		_, isFailed := failedTxs[txHash]
+		_, isCrossShard := crossShardTxs[txHash]
+		switch {
+		case isFailed:
+			status = transaction.TxStatusFail
+		case isCrossShard:
+			status = transaction.TxStatusPending
+		}
+
+		notifications = append(notifications, newTxStatusNotification(txHash, status, headerNotification))
+	}
+	for txHash := range pool.InvalidTxs {
+		notifications = append(notifications, newTxStatusNotification(txHash, transaction.TxStatusInvalid, headerNotification))
+	}
+
+	return notifications
+}
+
+func extractCrossShardTxsFromSource(body *block.Body, shardID uint32) map[string]struct{} {
+	crossShardTxs := make(map[string]struct{})
+	if body == nil {
+		return crossShardTxs
+	}
+
+	for _, mb := range body.MiniBlocks {
+		isCrossShardFromSource := mb.SenderShardID == shardID &&
+			mb.ReceiverShardID != shardID &&
+			mb.ReceiverShardID != core.AllShardId
+		if !isCrossShardFromSource {
+			continue
+		}
+
+		for _, txHash := range mb.TxHashes {
+			crossShardTxs[hex.EncodeToString(txHash)] = struct{}{}
+		}
+	}
+
+	return crossShardTxs
+}
+
+func newTxStatusNotification(
+	txHash string,
+	status transaction.TxStatus,
+	headerNotification *common.HeaderNotificationAPI,
+) *common.TxStatusNotificationAPI {
+	return &common.TxStatusNotificationAPI{
+		Hash:       txHash,
+		Status:     string(status),
+		ShardID:    headerNotification.ShardID,
+		BlockNonce: headerNotification.Nonce,
+		BlockHash:  headerNotification.Hash,
+	}
+}
+
+func (sd *subscriptionsDriver) extractContractEvents(
+	outportBlock *outport.OutportBlock,
+	headerNotification *common.HeaderNotificationAPI,
+) []*common.ContractEventNotificationAPI {
+	pool := outportBlock.TransactionPool
+	if pool == nil {
+		return nil
+	}
+
+	notifications := make([]*common.ContractEventNotificationAPI, 0)
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			notifications = append(notifications, &common.ContractEventNotificationAPI{
+				TxHash:     logData.TxHash,
+				Address:    sd.addressPubkeyConverter.SilentEncode(event.Address, log),
+				Identifier: string(event.Identifier),
+				Topics:     event.Topics,
+				Data:       event.Data,
+				ShardID:    headerNotification.ShardID,
+				BlockNonce: headerNotification.Nonce,
+				BlockHash:  headerNotification.Hash,
+			})
+		}
+	}
+
+	return notifications
+}
+
+func (sd *subscriptionsDriver) rememberHeader(hash string, nonce uint64) {
+	sd.mutHeaders.Lock()
+	defer sd.mutHeaders.Unlock()
+
+	_, exists := sd.headerNonces[hash]
+	if exists {
+		return
+	}
+
+	sd.headerNonces[hash] = nonce
+	sd.headerHashesFIFO = append(sd.headerHashesFIFO, hash)
+	if len(sd.headerHashesFIFO) > maxRememberedHeaders {
+		delete(sd.headerNonces, sd.headerHashesFIFO[0])
+		sd.headerHashesFIFO = sd.headerHashesFIFO[1:]
+	}
+}
+
+func (sd *subscriptionsDriver) getRememberedNonce(hash string) uint64 {
+	sd.mutHeaders.Lock()
+	defer sd.mutHeaders.Unlock()
+
+	return sd.headerNonces[hash]
+}
+
+func (sd *subscriptionsDriver) forgetHeader(hash string) {
+	sd.mutHeaders.Lock()
+	defer sd.mutHeaders.Unlock()
+
+	delete(sd.headerNonces, hash)
+}
+
+// RevertIndexedBlock will forget the reverted header, so it will not be reported when finalized
+func (sd *subscriptionsDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	sd.forgetHeader(hex.EncodeToString(blockData.HeaderHash))
+
+	return nil
+}
+
+// FinalizedBlock pushes the finalized block to the subscribers
+func (sd *subscriptionsDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if sd.closed.IsSet() {
+		return nil
+	}
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(finalizedBlock.HeaderHash)
+	notification := &common.FinalizedBlockNotificationAPI{
+		ShardID: finalizedBlock.ShardID,
+		Nonce:   sd.getRememberedNonce(blockHash),
+		Hash:    blockHash,
+	}
+
+	for _, sub := range sd.getSubscribers() {
+		sub.notifyFinalizedBlock(notification)
+	}
+
+	return nil
+}
+
+// SaveRoundsInfo returns nil
+func (sd *subscriptionsDriver) SaveRoundsInfo(_ *outport.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (sd *subscriptionsDriver) SaveValidatorsPubKeys(_ *outport.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (sd *subscriptionsDriver) SaveValidatorsRating(_ *outport.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (sd *subscriptionsDriver) SaveAccounts(_ *outport.Accounts) error {
+	return nil
+}
+
+// GetMarshaller returns internal marshaller
+func (sd *subscriptionsDriver) GetMarshaller() marshal.Marshalizer {
+	return sd.marshaller
+}
+
+// SetCurrentSettings will do nothing
+func (sd *subscriptionsDriver) SetCurrentSettings(_ outport.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler will do nothing
+func (sd *subscriptionsDriver) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close will close all the subscribers. Once closed, no new subscriber is accepted and the blocks received from the
+// outport are ignored. Calling it more than once has no effect
+func (sd *subscriptionsDriver) Close() error {
+	sd.mutSubscribers.Lock()
+	wasClosed := sd.closed.SetReturningPrevious()
+	sd.mutSubscribers.Unlock()
+	if wasClosed {
+		return nil
+	}
+
+	for _, sub := range sd.getSubscribers() {
+		_ = sub.Close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *subscriptionsDriver) IsInterfaceNil() bool {
+	return sd == nil
+}
@@ -0,0 +1,387 @@
+package subscriptions_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/outport"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/outport/subscriptions"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSubscriptionsDriverArgs() subscriptions.ArgsSubscriptionsDriver {
+	container := block.NewEmptyBlockCreatorsContainer()
+	_ = container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+
+	return subscriptions.ArgsSubscriptionsDriver{
+		Marshaller:             &marshallerMock.MarshalizerMock{},
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		BlockContainer:         container,
+		Config: config.WebSocketSubscriptionsConfig{
+			Enabled:                       true,
+			MaxSubscribers:                2,
+			MaxSubscriptionsPerSubscriber: 3,
+			MaxTxHashesPerSubscription:    2,
+			EventsBufferSize:              10,
+		},
+	}
+}
+
+func createOutportBlock(t *testing.T, args subscriptions.ArgsSubscriptionsDriver, header *block.Header, headerHash []byte) *outport.OutportBlock {
+	headerBytes, err := args.Marshaller.Marshal(header)
+	require.NoError(t, err)
+
+	return &outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			ShardID:     header.ShardID,
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+			HeaderHash:  headerHash,
+			Body:        &block.Body{},
+		},
+		TransactionPool: &outport.TransactionPool{},
+	}
+}
+
+func readEvents(subscriber common.SubscriberHandler) []*common.SubscriptionEvent {
+	events := make([]*common.SubscriptionEvent, 0)
+	for {
+		select {
+		case event, ok := <-subscriber.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestNewSubscriptionsDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		args.Marshaller = nil
+
+		sd, err := subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.Equal(t, subscriptions.ErrNilMarshaller, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		args.AddressPubkeyConverter = nil
+
+		sd, err := subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.Equal(t, subscriptions.ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil block container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		args.BlockContainer = nil
+
+		sd, err := subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.Equal(t, subscriptions.ErrNilBlockContainerHandler, err)
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		args.Config.MaxSubscribers = 0
+		sd, err := subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidValue))
+
+		args = createMockSubscriptionsDriverArgs()
+		args.Config.MaxSubscriptionsPerSubscriber = 0
+		sd, err = subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidValue))
+
+		args = createMockSubscriptionsDriverArgs()
+		args.Config.MaxTxHashesPerSubscription = 0
+		sd, err = subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidValue))
+
+		args = createMockSubscriptionsDriverArgs()
+		args.Config.EventsBufferSize = 0
+		sd, err = subscriptions.NewSubscriptionsDriver(args)
+		require.Nil(t, sd)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sd, err := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+		require.NoError(t, err)
+		require.False(t, sd.IsInterfaceNil())
+	})
+}
+
+func TestSubscriptionsDriver_CreateSubscriber(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+
+	subscriber1, err := sd.CreateSubscriber()
+	require.NoError(t, err)
+	_, err = sd.CreateSubscriber()
+	require.NoError(t, err)
+
+	_, err = sd.CreateSubscriber()
+	require.True(t, errors.Is(err, subscriptions.ErrTooManySubscribers))
+
+	// closing a subscriber frees a slot
+	require.NoError(t, subscriber1.Close())
+	_, err = sd.CreateSubscriber()
+	require.NoError(t, err)
+}
+
+func TestSubscriptionsDriver_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("undecodable header should not error", func(t *testing.T) {
+		t.Parallel()
+
+		sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+		subscriber, _ := sd.CreateSubscriber()
+		_, _ = subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic})
+
+		err := sd.SaveBlock(&outport.OutportBlock{
+			BlockData: &outport.BlockData{
+				HeaderType:  string(core.MetaHeader),
+				HeaderBytes: []byte("not a header"),
+			},
+		})
+		require.NoError(t, err)
+		require.Empty(t, readEvents(subscriber))
+
+		require.NoError(t, sd.SaveBlock(nil))
+		require.NoError(t, sd.SaveBlock(&outport.OutportBlock{}))
+	})
+	t.Run("should notify headers filtered by shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		sd, _ := subscriptions.NewSubscriptionsDriver(args)
+		subscriber, _ := sd.CreateSubscriber()
+		shard0 := uint32(0)
+		shard1 := uint32(1)
+		subID, _ := subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic, ShardID: &shard1})
+		_, _ = subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic, ShardID: &shard0})
+
+		header := &block.Header{ShardID: 1, Nonce: 37, Round: 38, Epoch: 2, TxCount: 5, PrevHash: []byte("prev")}
+		err := sd.SaveBlock(createOutportBlock(t, args, header, []byte("hash")))
+		require.NoError(t, err)
+
+		events := readEvents(subscriber)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, subID, events[0].SubscriptionID)
+		assert.Equal(t, common.NewHeadersSubscriptionTopic, events[0].Topic)
+		assert.Equal(t, &common.HeaderNotificationAPI{
+			ShardID:  1,
+			Epoch:    2,
+			Round:    38,
+			Nonce:    37,
+			Hash:     hex.EncodeToString([]byte("hash")),
+			PrevHash: hex.EncodeToString([]byte("prev")),
+			NumTxs:   5,
+		}, events[0].Data)
+	})
+	t.Run("should notify transactions statuses", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		sd, _ := subscriptions.NewSubscriptionsDriver(args)
+		subscriber, _ := sd.CreateSubscriber()
+
+		successTx := []byte("success")
+		crossShardTx := []byte("cross")
+		failedTx := []byte("failed")
+		invalidTx := []byte("invalid")
+		_, _ = subscriber.Subscribe(common.SubscriptionRequest{
+			Topic:    common.TxStatusSubscriptionTopic,
+			TxHashes: []string{hex.EncodeToString(successTx), hex.EncodeToString(crossShardTx)},
+		})
+		_, _ = subscriber.Subscribe(common.SubscriptionRequest{
+			Topic:    common.TxStatusSubscriptionTopic,
+			TxHashes: []string{hex.EncodeToString(failedTx), hex.EncodeToString(invalidTx)},
+		})
+
+		header := &block.Header{ShardID: 0, Nonce: 10}
+		outportBlock := createOutportBlock(t, args, header, []byte("hash"))
+		outportBlock.BlockData.Body = &block.Body{
+			MiniBlocks: []*block.MiniBlock{
+				{SenderShardID: 0, ReceiverShardID: 0, TxHashes: [][]byte{successTx, failedTx}},
+				{SenderShardID: 0, ReceiverShardID: 1, TxHashes: [][]byte{crossShardTx}},
+			},
+		}
+		outportBlock.TransactionPool = &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				hex.EncodeToString(successTx):             {},
+				hex.EncodeToString(crossShardTx):          {},
+				hex.EncodeToString(failedTx):              {},
+				hex.EncodeToString([]byte("not-tracked")): {},
+			},
+			InvalidTxs: map[string]*outport.TxInfo{
+				hex.EncodeToString(invalidTx): {},
+			},
+			Logs: []*outport.LogData{
+				{
+					TxHash: hex.EncodeToString(failedTx),
+					Log: &transaction.Log{
+						Events: []*transaction.Event{{Identifier: []byte(core.SignalErrorOperation)}},
+					},
+				},
+			},
+		}
+
+		err := sd.SaveBlock(outportBlock)
+		require.NoError(t, err)
+
+		statuses := make(map[string]string)
+		for _, event := range readEvents(subscriber) {
+			require.Equal(t, common.TxStatusSubscriptionTopic, event.Topic)
+			notification := event.Data.(*common.TxStatusNotificationAPI)
+			assert.Equal(t, uint64(10), notification.BlockNonce)
+			statuses[notification.Hash] = notification.Status
+		}
+
+		assert.Equal(t, map[string]string{
+			hex.EncodeToString(successTx):    string(transaction.TxStatusSuccess),
+			hex.EncodeToString(crossShardTx): string(transaction.TxStatusPending),
+			hex.EncodeToString(failedTx):     string(transaction.TxStatusFail),
+			hex.EncodeToString(invalidTx):    string(transaction.TxStatusInvalid),
+		}, statuses)
+	})
+	t.Run("should notify contract events filtered by address and identifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		sd, _ := subscriptions.NewSubscriptionsDriver(args)
+		subscriber, _ := sd.CreateSubscriber()
+
+		contract := []byte("contract")
+		subID, _ := subscriber.Subscribe(common.SubscriptionRequest{
+			Topic:       common.ContractEventsSubscriptionTopic,
+			Addresses:   []string{hex.EncodeToString(contract)},
+			Identifiers: []string{"swap"},
+		})
+
+		header := &block.Header{ShardID: 0, Nonce: 10}
+		outportBlock := createOutportBlock(t, args, header, []byte("hash"))
+		outportBlock.TransactionPool.Logs = []*outport.LogData{
+			{
+				TxHash: "aabb",
+				Log: &transaction.Log{
+					Events: []*transaction.Event{
+						{Address: contract, Identifier: []byte("swap"), Topics: [][]byte{[]byte("topic")}, Data: []byte("data")},
+						{Address: contract, Identifier: []byte("deposit")},
+						{Address: []byte("other"), Identifier: []byte("swap")},
+					},
+				},
+			},
+		}
+
+		err := sd.SaveBlock(outportBlock)
+		require.NoError(t, err)
+
+		events := readEvents(subscriber)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, subID, events[0].SubscriptionID)
+		assert.Equal(t, &common.ContractEventNotificationAPI{
+			TxHash:     "aabb",
+			Address:    hex.EncodeToString(contract),
+			Identifier: "swap",
+			Topics:     [][]byte{[]byte("topic")},
+			Data:       []byte("data"),
+			ShardID:    0,
+			BlockNonce: 10,
+			BlockHash:  hex.EncodeToString([]byte("hash")),
+		}, events[0].Data)
+	})
+	t.Run("slow subscriber should be closed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscriptionsDriverArgs()
+		args.Config.EventsBufferSize = 1
+		sd, _ := subscriptions.NewSubscriptionsDriver(args)
+		subscriber, _ := sd.CreateSubscriber()
+		_, _ = subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic})
+
+		_ = sd.SaveBlock(createOutportBlock(t, args, &block.Header{Nonce: 1}, []byte("hash1")))
+		_ = sd.SaveBlock(createOutportBlock(t, args, &block.Header{Nonce: 2}, []byte("hash2")))
+
+		events := readEvents(subscriber)
+		require.Equal(t, 1, len(events))
+		_, ok := <-subscriber.Events()
+		require.False(t, ok)
+
+		_, err := subscriber.Subscribe(common.SubscriptionRequest{Topic: common.NewHeadersSubscriptionTopic})
+		require.Equal(t, subscriptions.ErrSubscriberClosed, err)
+	})
+}
+
+func TestSubscriptionsDriver_FinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSubscriptionsDriverArgs()
+	sd, _ := subscriptions.NewSubscriptionsDriver(args)
+	subscriber, _ := sd.CreateSubscriber()
+	_, _ = subscriber.Subscribe(common.SubscriptionRequest{Topic: common.FinalizedBlocksSubscriptionTopic})
+
+	_ = sd.SaveBlock(createOutportBlock(t, args, &block.Header{Nonce: 7}, []byte("hash1")))
+	_ = sd.SaveBlock(createOutportBlock(t, args, &block.Header{Nonce: 8}, []byte("hash2")))
+	_ = sd.RevertIndexedBlock(&outport.BlockData{HeaderHash: []byte("hash2")})
+
+	require.NoError(t, sd.FinalizedBlock(&outport.FinalizedBlock{ShardID: 0, HeaderHash: []byte("hash1")}))
+	require.NoError(t, sd.FinalizedBlock(&outport.FinalizedBlock{ShardID: 0, HeaderHash: []byte("hash2")}))
+
+	events := readEvents(subscriber)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, &common.FinalizedBlockNotificationAPI{
+		ShardID: 0,
+		Nonce:   7,
+		Hash:    hex.EncodeToString([]byte("hash1")),
+	}, events[0].Data)
+	// the reverted block is no longer known, so its nonce can not be reported
+	assert.Equal(t, &common.FinalizedBlockNotificationAPI{
+		ShardID: 0,
+		Hash:    hex.EncodeToString([]byte("hash2")),
+	}, events[1].Data)
+}
+
+func TestSubscriptionsDriver_Close(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := subscriptions.NewSubscriptionsDriver(createMockSubscriptionsDriverArgs())
+	subscriber, _ := sd.CreateSubscriber()
+
+	require.NoError(t, sd.Close())
+	_, ok := <-subscriber.Events()
+	require.False(t, ok)
+
+	// once closed, no new subscriber is accepted and closing again has no effect
+	subscriber, err := sd.CreateSubscriber()
+	require.Nil(t, subscriber)
+	require.Equal(t, subscriptions.ErrSubscriptionsHandlerClosed, err)
+	require.NoError(t, sd.Close())
+}
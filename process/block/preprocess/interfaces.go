@@ -20,6 +20,15 @@ type TxCache interface {
 	IsInterfaceNil() bool
 }
 
+// TxSelectionPolicy defines the way the transactions are selected from the cache when a block is proposed. It decides
+// which transactions fit in the block's gas bandwidth, not their order in the miniblocks, which is always by sender
+// and nonce, as the validators process the block the same way
+type TxSelectionPolicy interface {
+	SelectTransactions(txCache TxCache) []*txcache.WrappedTransaction
+	HasMoveBalancePriority() bool
+	IsInterfaceNil() bool
+}
+
 // BlockTracker defines the functionality for node to track the blocks which are received from network
 type BlockTracker interface {
 	IsShardStuck(shardID uint32) bool
@@ -1,17 +1,16 @@
 package preprocess
 
 import (
-	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/storage"
 	"github.com/kalyan3104/k-chain-go/storage/txcache"
 )
 
 // TODO: Refactor "transactions.go" to not require the components in this file anymore
 // createSortedTransactionsProvider is a "simple factory" for "SortedTransactionsProvider" objects
-func createSortedTransactionsProvider(cache storage.Cacher) SortedTransactionsProvider {
+func createSortedTransactionsProvider(cache storage.Cacher, txSelectionPolicy TxSelectionPolicy) SortedTransactionsProvider {
 	txCache, isTxCache := cache.(TxCache)
 	if isTxCache {
-		return newAdapterTxCacheToSortedTransactionsProvider(txCache)
+		return newAdapterTxCacheToSortedTransactionsProvider(txCache, txSelectionPolicy)
 	}
 
 	log.Error("Could not create a real [SortedTransactionsProvider], will create a disabled one")
@@ -20,20 +19,22 @@ func createSortedTransactionsProvider(cache storage.Cacher) SortedTransactionsPr
 
 // adapterTxCacheToSortedTransactionsProvider adapts a "TxCache" to the "SortedTransactionsProvider" interface
 type adapterTxCacheToSortedTransactionsProvider struct {
-	txCache TxCache
+	txCache           TxCache
+	txSelectionPolicy TxSelectionPolicy
 }
 
-func newAdapterTxCacheToSortedTransactionsProvider(txCache TxCache) *adapterTxCacheToSortedTransactionsProvider {
+func newAdapterTxCacheToSortedTransactionsProvider(txCache TxCache, txSelectionPolicy TxSelectionPolicy) *adapterTxCacheToSortedTransactionsProvider {
 	adapter := &adapterTxCacheToSortedTransactionsProvider{
-		txCache: txCache,
+		txCache:           txCache,
+		txSelectionPolicy: txSelectionPolicy,
 	}
 
 	return adapter
 }
 
-// GetSortedTransactions gets the transactions from the cache
+// GetSortedTransactions gets the transactions from the cache, as selected by the configured policy
 func (adapter *adapterTxCacheToSortedTransactionsProvider) GetSortedTransactions() []*txcache.WrappedTransaction {
-	txs := adapter.txCache.SelectTransactionsWithBandwidth(process.MaxNumOfTxsToSelect, process.NumTxPerSenderBatchForFillingMiniblock, process.MaxGasBandwidthPerBatchPerSender)
+	txs := adapter.txSelectionPolicy.SelectTransactions(adapter.txCache)
 	return txs
 }
 
@@ -62,6 +62,7 @@ type transactions struct {
 	emptyAddress                 []byte
 	txTypeHandler                process.TxTypeHandler
 	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	txSelectionPolicy            TxSelectionPolicy
 }
 
 // ArgsTransactionPreProcessor holds the arguments to create a txs pre processor
@@ -86,6 +87,7 @@ type ArgsTransactionPreProcessor struct {
 	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
 	ProcessedMiniBlocksTracker   process.ProcessedMiniBlocksTracker
 	TxExecutionOrderHandler      common.TxExecutionOrderHandler
+	TxSelectionPolicy            TxSelectionPolicy
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -159,6 +161,9 @@ func NewTransactionPreprocessor(
 	if check.IfNil(args.TxExecutionOrderHandler) {
 		return nil, process.ErrNilTxExecutionOrderHandler
 	}
+	if check.IfNil(args.TxSelectionPolicy) {
+		return nil, process.ErrNilTxSelectionPolicy
+	}
 
 	bpp := basePreProcess{
 		hasher:      args.Hasher,
@@ -187,6 +192,7 @@ func NewTransactionPreprocessor(
 		blockType:                    args.BlockType,
 		txTypeHandler:                args.TxTypeHandler,
 		scheduledTxsExecutionHandler: args.ScheduledTxsExecutionHandler,
+		txSelectionPolicy:            args.TxSelectionPolicy,
 	}
 
 	txs.chRcvAllTxs = make(chan bool)
@@ -936,7 +942,7 @@ func (txs *transactions) notifyTransactionProviderIfNeeded() {
 			continue
 		}
 
-		sortedTransactionsProvider := createSortedTransactionsProvider(txShardPool)
+		sortedTransactionsProvider := createSortedTransactionsProvider(txShardPool, txs.txSelectionPolicy)
 		sortedTransactionsProvider.NotifyAccountNonce([]byte(senderAddress), account.GetNonce())
 	}
 	txs.accountTxsShards.RUnlock()
@@ -1461,12 +1467,14 @@ func (txs *transactions) computeSortedTxs(
 		return nil, nil, process.ErrNilTxDataPool
 	}
 
-	sortedTransactionsProvider := createSortedTransactionsProvider(txShardPool)
+	sortedTransactionsProvider := createSortedTransactionsProvider(txShardPool, txs.txSelectionPolicy)
 	log.Debug("computeSortedTxs.GetSortedTransactions")
 	sortedTxs := sortedTransactionsProvider.GetSortedTransactions()
 
 	// TODO: this could be moved to SortedTransactionsProvider
-	selectedTxs, remainingTxs := txs.preFilterTransactionsWithMoveBalancePriority(sortedTxs, gasBandwidth)
+	selectedTxs, remainingTxs := txs.preFilterTransactionsWithPolicy(sortedTxs, gasBandwidth)
+	// the policy only decides which transactions fit in the gas bandwidth, as the validators sort the transactions by
+	// sender and nonce as well when they process the block
 	txs.sortTransactionsBySenderAndNonce(selectedTxs, randomness)
 
 	return selectedTxs, remainingTxs, nil
@@ -1778,6 +1786,19 @@ func (txs *transactions) filterMoveBalance(transactions []*txcache.WrappedTransa
 	return selectedTxs, skippedTxs, gasEstimation
 }
 
+// preFilterTransactionsWithPolicy filters the transactions in the order given by the selection policy, unless the
+// policy keeps the priority of the move balance operations
+func (txs *transactions) preFilterTransactionsWithPolicy(
+	transactions []*txcache.WrappedTransaction,
+	gasBandwidth uint64,
+) ([]*txcache.WrappedTransaction, []*txcache.WrappedTransaction) {
+	if txs.txSelectionPolicy.HasMoveBalancePriority() {
+		return txs.preFilterTransactionsWithMoveBalancePriority(transactions, gasBandwidth)
+	}
+
+	return txs.prefilterTransactions(nil, transactions, 0, gasBandwidth)
+}
+
 // preFilterTransactions filters the transactions prioritising the move balance operations
 func (txs *transactions) preFilterTransactionsWithMoveBalancePriority(
 	transactions []*txcache.WrappedTransaction,
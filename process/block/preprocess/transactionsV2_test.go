@@ -76,6 +76,7 @@ func createTransactionPreprocessor() *transactions {
 		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
 		ProcessedMiniBlocksTracker:   &testscommon.ProcessedMiniBlocksTrackerStub{},
 		TxExecutionOrderHandler:      &commonMocks.TxExecutionOrderHandlerStub{},
+		TxSelectionPolicy:            NewDefaultTxSelectionPolicy(),
 	}
 
 	preprocessor, _ := NewTransactionPreprocessor(txPreProcArgs)
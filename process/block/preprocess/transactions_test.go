@@ -22,6 +22,7 @@ import (
 	"github.com/kalyan3104/k-chain-core-go/hashing/sha256"
 	"github.com/kalyan3104/k-chain-core-go/marshal"
 	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/process/mock"
@@ -38,6 +39,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
 	stateMock "github.com/kalyan3104/k-chain-go/testscommon/state"
 	storageStubs "github.com/kalyan3104/k-chain-go/testscommon/storage"
+	"github.com/kalyan3104/k-chain-go/testscommon/txcachemocks"
 	"github.com/kalyan3104/k-chain-go/vm"
 	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -238,6 +240,7 @@ func createDefaultTransactionsProcessorArgs() ArgsTransactionPreProcessor {
 		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
 		ProcessedMiniBlocksTracker:   &testscommon.ProcessedMiniBlocksTrackerStub{},
 		TxExecutionOrderHandler:      &commonMocks.TxExecutionOrderHandlerStub{},
+		TxSelectionPolicy:            NewDefaultTxSelectionPolicy(),
 	}
 }
 
@@ -446,6 +449,16 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilProcessedMiniBlocksTracker
 	assert.Equal(t, process.ErrNilProcessedMiniBlocksTracker, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilTxSelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultTransactionsProcessorArgs()
+	args.TxSelectionPolicy = nil
+	txs, err := NewTransactionPreprocessor(args)
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilTxSelectionPolicy, err)
+}
+
 func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2233,3 +2246,406 @@ func TestTransactions_getIndexesOfLastTxProcessed(t *testing.T) {
 		assert.Equal(t, mbh.GetIndexOfLastTxProcessed(), pi.indexOfLastTxProcessedByProposer)
 	})
 }
+
+const moveBalanceGasLimitForSelection = 50000
+
+func createWrappedTxForSelection(sender string, nonce uint64, gasPrice uint64) *txcache.WrappedTransaction {
+	return createWrappedTxForSelectionWithGasLimit(sender, nonce, gasPrice, moveBalanceGasLimitForSelection)
+}
+
+func createWrappedTxForSelectionWithGasLimit(sender string, nonce uint64, gasPrice uint64, gasLimit uint64) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			SndAddr:  []byte(sender),
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			GasLimit: gasLimit,
+		},
+		TxHash: []byte(fmt.Sprintf("%s-%d", sender, nonce)),
+	}
+}
+
+// createFeeHandlerForSelection charges the gas above the move balance one with 1% of the gas price
+func createFeeHandlerForSelection() *economicsmocks.EconomicsHandlerStub {
+	return &economicsmocks.EconomicsHandlerStub{
+		ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+			moveFee := big.NewInt(0).SetUint64(moveBalanceGasLimitForSelection * tx.GetGasPrice())
+			processingGas := tx.GetGasLimit() - moveBalanceGasLimitForSelection
+			processingFee := big.NewInt(0).SetUint64(processingGas * tx.GetGasPrice() / 100)
+
+			return moveFee.Add(moveFee, processingFee)
+		},
+	}
+}
+
+func getSelectedTxHashes(txs []*txcache.WrappedTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
+
+// createTxCacheStubForSelection returns a cache already yielding the candidates in the order the real cache would do
+func createTxCacheStubForSelection(candidates []*txcache.WrappedTransaction, batchSizeHandler func(batchSizePerSender int)) *txcachemocks.TxCacheStub {
+	return &txcachemocks.TxCacheStub{
+		SelectTransactionsWithBandwidthCalled: func(numRequested int, batchSizePerSender int, bandwidthPerSender uint64) []*txcache.WrappedTransaction {
+			batchSizeHandler(batchSizePerSender)
+			return candidates
+		},
+	}
+}
+
+func TestNewTxSelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewTxSelectionPolicy(config.TxSelectionPolicyConfig{Type: DefaultTxSelectionPolicyType}, createFeeHandlerForSelection())
+		assert.Nil(t, err)
+		assert.IsType(t, &defaultTxSelectionPolicy{}, policy)
+
+		policy, err = NewTxSelectionPolicy(config.TxSelectionPolicyConfig{}, createFeeHandlerForSelection())
+		assert.Nil(t, err)
+		assert.IsType(t, &defaultTxSelectionPolicy{}, policy)
+	})
+	t.Run("sender fairness policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewTxSelectionPolicy(config.TxSelectionPolicyConfig{Type: SenderFairnessTxSelectionPolicyType, MaxTxsPerSender: 5}, createFeeHandlerForSelection())
+		assert.Nil(t, err)
+		assert.IsType(t, &senderFairnessTxSelectionPolicy{}, policy)
+
+		policy, err = NewTxSelectionPolicy(config.TxSelectionPolicyConfig{Type: SenderFairnessTxSelectionPolicyType}, createFeeHandlerForSelection())
+		assert.Nil(t, policy)
+		assert.ErrorIs(t, err, process.ErrInvalidTxSelectionPolicy)
+	})
+	t.Run("fee per gas policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewTxSelectionPolicy(config.TxSelectionPolicyConfig{Type: FeePerGasTxSelectionPolicyType}, createFeeHandlerForSelection())
+		assert.Nil(t, err)
+		assert.IsType(t, &feePerGasTxSelectionPolicy{}, policy)
+
+		policy, err = NewTxSelectionPolicy(config.TxSelectionPolicyConfig{Type: FeePerGasTxSelectionPolicyType}, nil)
+		assert.Nil(t, policy)
+		assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+	})
+	t.Run("unknown policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewTxSelectionPolicy(config.TxSelectionPolicyConfig{Type: "unknown"}, createFeeHandlerForSelection())
+		assert.Nil(t, policy)
+		assert.ErrorIs(t, err, process.ErrInvalidTxSelectionPolicy)
+	})
+}
+
+func TestDefaultTxSelectionPolicy_SelectTransactions(t *testing.T) {
+	t.Parallel()
+
+	candidates := []*txcache.WrappedTransaction{
+		createWrappedTxForSelection("alice", 1, 10),
+		createWrappedTxForSelection("alice", 2, 50),
+		createWrappedTxForSelection("bob", 1, 20),
+	}
+	txCache := &txcachemocks.TxCacheStub{
+		SelectTransactionsWithBandwidthCalled: func(numRequested int, batchSizePerSender int, bandwidthPerSender uint64) []*txcache.WrappedTransaction {
+			assert.Equal(t, process.MaxNumOfTxsToSelect, numRequested)
+			assert.Equal(t, process.NumTxPerSenderBatchForFillingMiniblock, batchSizePerSender)
+			assert.Equal(t, uint64(process.MaxGasBandwidthPerBatchPerSender), bandwidthPerSender)
+			return candidates
+		},
+	}
+
+	policy := NewDefaultTxSelectionPolicy()
+	assert.False(t, policy.IsInterfaceNil())
+	assert.Equal(t, []string{"alice-1", "alice-2", "bob-1"}, getSelectedTxHashes(policy.SelectTransactions(txCache)))
+}
+
+func TestSenderFairnessTxSelectionPolicy_SelectTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should interleave the senders and cap their transactions", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []*txcache.WrappedTransaction{
+			createWrappedTxForSelection("alice", 1, 10),
+			createWrappedTxForSelection("alice", 2, 10),
+			createWrappedTxForSelection("alice", 3, 10),
+			createWrappedTxForSelection("bob", 7, 10),
+			createWrappedTxForSelection("carol", 4, 10),
+			createWrappedTxForSelection("carol", 5, 10),
+		}
+		txCache := createTxCacheStubForSelection(candidates, func(batchSizePerSender int) {
+			assert.Equal(t, 1, batchSizePerSender)
+		})
+
+		policy, err := NewSenderFairnessTxSelectionPolicy(2)
+		require.Nil(t, err)
+		assert.False(t, policy.IsInterfaceNil())
+
+		expected := []string{"alice-1", "bob-7", "carol-4", "alice-2", "carol-5"}
+		assert.Equal(t, expected, getSelectedTxHashes(policy.SelectTransactions(txCache)))
+	})
+	t.Run("a cap higher than the number of transactions should select all of them", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []*txcache.WrappedTransaction{
+			createWrappedTxForSelection("alice", 1, 10),
+			createWrappedTxForSelection("alice", 2, 10),
+			createWrappedTxForSelection("bob", 1, 10),
+		}
+		txCache := createTxCacheStubForSelection(candidates, func(_ int) {})
+
+		policy, _ := NewSenderFairnessTxSelectionPolicy(100)
+		assert.Equal(t, []string{"alice-1", "bob-1", "alice-2"}, getSelectedTxHashes(policy.SelectTransactions(txCache)))
+	})
+	t.Run("empty cache should return no transaction", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewSenderFairnessTxSelectionPolicy(2)
+		assert.Empty(t, policy.SelectTransactions(&txcachemocks.TxCacheStub{}))
+	})
+}
+
+func TestNewFeePerGasTxSelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics fee handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewFeePerGasTxSelectionPolicy(nil)
+		assert.Nil(t, policy)
+		assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+		assert.Nil(t, err)
+		assert.False(t, policy.IsInterfaceNil())
+	})
+}
+
+func TestFeePerGasTxSelectionPolicy_SelectTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should select the higher fees per gas first, keeping the order of each sender", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []*txcache.WrappedTransaction{
+			createWrappedTxForSelection("alice", 1, 10),
+			createWrappedTxForSelection("alice", 2, 50),
+			createWrappedTxForSelection("bob", 1, 20),
+			createWrappedTxForSelection("carol", 1, 20),
+			createWrappedTxForSelection("carol", 2, 30),
+			createWrappedTxForSelection("dave", 1, 40),
+		}
+		txCache := &txcachemocks.TxCacheStub{
+			SelectTransactionsWithBandwidthCalled: func(numRequested int, batchSizePerSender int, bandwidthPerSender uint64) []*txcache.WrappedTransaction {
+				assert.Equal(t, maxNumOfCandidatesForFeePerGas, numRequested)
+				assert.Equal(t, process.NumTxPerSenderBatchForFillingMiniblock, batchSizePerSender)
+				return candidates
+			},
+		}
+
+		policy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+
+		// alice's second transaction, even if paying the most, can not be selected before her first one
+		expected := []string{"dave-1", "bob-1", "carol-1", "carol-2", "alice-1", "alice-2"}
+		assert.Equal(t, expected, getSelectedTxHashes(policy.SelectTransactions(txCache)))
+	})
+	t.Run("should account for the processing gas price modifier", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []*txcache.WrappedTransaction{
+			createWrappedTxForSelectionWithGasLimit("alice", 1, 20, moveBalanceGasLimitForSelection+1000000),
+			createWrappedTxForSelection("bob", 1, 10),
+		}
+		txCache := createTxCacheStubForSelection(candidates, func(_ int) {})
+
+		policy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+
+		// alice pays a higher gas price, but most of her gas is charged for processing, at 1% of the price
+		assert.Equal(t, []string{"bob-1", "alice-1"}, getSelectedTxHashes(policy.SelectTransactions(txCache)))
+	})
+	t.Run("the selection should be deterministic", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := make([]*txcache.WrappedTransaction, 0)
+		for i := 0; i < 50; i++ {
+			sender := fmt.Sprintf("sender%d", i%7)
+			candidates = append(candidates, createWrappedTxForSelection(sender, uint64(i), uint64(i%3)))
+		}
+		txCache := createTxCacheStubForSelection(candidates, func(_ int) {})
+
+		policy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+		firstSelection := getSelectedTxHashes(policy.SelectTransactions(txCache))
+		require.Equal(t, len(candidates), len(firstSelection))
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, firstSelection, getSelectedTxHashes(policy.SelectTransactions(txCache)))
+		}
+	})
+	t.Run("empty cache should return no transaction", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+		assert.Empty(t, policy.SelectTransactions(&txcachemocks.TxCacheStub{}))
+	})
+}
+
+func TestTxSelectionPolicies_HasMoveBalancePriority(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, NewDefaultTxSelectionPolicy().HasMoveBalancePriority())
+
+	senderFairnessPolicy, _ := NewSenderFairnessTxSelectionPolicy(1)
+	assert.False(t, senderFairnessPolicy.HasMoveBalancePriority())
+
+	feePerGasPolicy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+	assert.False(t, feePerGasPolicy.HasMoveBalancePriority())
+}
+
+// createProposedMiniBlockTxHashes proposes a miniblock from the transactions added to the intra shard pool, using the
+// provided policy, and returns the hashes of the transactions in the miniblock, in their order
+func createProposedMiniBlockTxHashes(
+	t *testing.T,
+	policy TxSelectionPolicy,
+	transactions []*transaction.Transaction,
+	gasBandwidth uint64,
+) []string {
+	maxGasLimitPerBlock := uint64(100 * moveBalanceGasLimitForSelection)
+	economicsFee := feeHandlerMock()
+	economicsFee.MaxGasLimitPerBlockCalled = func(_ uint32) uint64 {
+		return maxGasLimitPerBlock
+	}
+	economicsFee.MaxGasLimitPerMiniBlockCalled = func() uint64 {
+		return maxGasLimitPerBlock
+	}
+	economicsFee.MaxGasLimitPerBlockForSafeCrossShardCalled = func() uint64 {
+		return maxGasLimitPerBlock
+	}
+	economicsFee.MaxGasLimitPerMiniBlockForSafeCrossShardCalled = func() uint64 {
+		return maxGasLimitPerBlock
+	}
+	economicsFee.MinGasLimitCalled = func() uint64 {
+		return moveBalanceGasLimitForSelection
+	}
+
+	totalGasProvided := uint64(0)
+	args := createDefaultTransactionsProcessorArgs()
+	args.TxDataPool, _ = dataRetrieverMock.CreateTxPool(2, 0)
+	args.TxSelectionPolicy = policy
+	args.EconomicsFee = economicsFee
+	args.TxProcessor = &testscommon.TxProcessorMock{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			return 0, nil
+		}}
+	args.GasHandler = &mock.GasHandlerMock{
+		SetGasProvidedCalled: func(gasProvided uint64, hash []byte) {
+			totalGasProvided += gasProvided
+		},
+		TotalGasProvidedCalled: func() uint64 {
+			return totalGasProvided
+		},
+		ComputeGasProvidedByTxCalled: func(txSenderShardId uint32, txReceiverShardId uint32, txHandler data.TransactionHandler) (uint64, uint64, error) {
+			return txHandler.GetGasLimit(), txHandler.GetGasLimit(), nil
+		},
+		SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
+		TotalGasRefundedCalled: func() uint64 {
+			return 0
+		},
+	}
+	txs, _ := NewTransactionPreprocessor(args)
+	require.NotNil(t, txs)
+
+	names := make(map[string]string)
+	strCache := process.ShardCacherIdentifier(0, 0)
+	for _, tx := range transactions {
+		txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+		args.TxDataPool.AddData(txHash, tx, tx.Size(), strCache)
+		names[string(txHash)] = fmt.Sprintf("%s-%d", tx.SndAddr, tx.Nonce)
+	}
+
+	sortedTxs, _, err := txs.computeSortedTxs(0, 0, gasBandwidth, []byte("randomness"))
+	require.Nil(t, err)
+	miniBlocks, _, err := txs.createAndProcessMiniBlocksFromMeV1(haveTimeTrue, isShardStuckFalse, isMaxBlockSizeReachedFalse, sortedTxs)
+	require.Nil(t, err)
+	require.Len(t, miniBlocks, 1)
+
+	txNames := make([]string, 0, len(miniBlocks[0].TxHashes))
+	for _, txHash := range miniBlocks[0].TxHashes {
+		txNames = append(txNames, names[string(txHash)])
+	}
+
+	return txNames
+}
+
+func TestTransactions_ComputeSortedTxsWithTxSelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	createTx := func(sender string, nonce uint64, gasPrice uint64, data string) *transaction.Transaction {
+		return &transaction.Transaction{
+			SndAddr:  []byte(sender),
+			RcvAddr:  []byte(sender),
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			GasLimit: moveBalanceGasLimitForSelection,
+			Data:     []byte(data),
+			Value:    big.NewInt(0),
+		}
+	}
+	transactions := []*transaction.Transaction{
+		createTx("alice", 1, 10, ""),
+		createTx("alice", 2, 50, ""),
+		createTx("alice", 3, 10, ""),
+		createTx("bob", 1, 20, ""),
+		createTx("carol", 1, 30, "call"),
+		createTx("dave", 1, 40, ""),
+	}
+	gasBandwidth := uint64(3 * moveBalanceGasLimitForSelection)
+
+	t.Run("default policy should prioritize the move balance transactions", func(t *testing.T) {
+		t.Parallel()
+
+		// the move balance transactions are all selected, leaving no gas bandwidth for carol's call
+		txNames := createProposedMiniBlockTxHashes(t, NewDefaultTxSelectionPolicy(), transactions, gasBandwidth)
+		assert.Equal(t, []string{"alice-1", "alice-2", "alice-3", "bob-1", "dave-1"}, txNames)
+	})
+	t.Run("fee per gas policy should fill the gas bandwidth with the best paying transactions", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+		txNames := createProposedMiniBlockTxHashes(t, policy, transactions, gasBandwidth)
+
+		// alice's second transaction pays the most, but it can not be selected before her first one. In the
+		// miniblock, the transactions are sorted by sender and nonce
+		assert.Equal(t, []string{"bob-1", "carol-1", "dave-1"}, txNames)
+
+		txNames = createProposedMiniBlockTxHashes(t, policy, transactions, gasBandwidth+2*moveBalanceGasLimitForSelection)
+		assert.Equal(t, []string{"alice-1", "alice-2", "bob-1", "carol-1", "dave-1"}, txNames)
+	})
+	t.Run("sender fairness policy should cap the transactions of each sender", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewSenderFairnessTxSelectionPolicy(1)
+		txNames := createProposedMiniBlockTxHashes(t, policy, transactions, 10*gasBandwidth)
+		assert.Equal(t, []string{"alice-1", "bob-1", "carol-1", "dave-1"}, txNames)
+	})
+}
+
+func TestAdapterTxCacheToSortedTransactionsProvider_GetSortedTransactionsShouldUseThePolicy(t *testing.T) {
+	t.Parallel()
+
+	candidates := []*txcache.WrappedTransaction{
+		createWrappedTxForSelection("alice", 1, 10),
+		createWrappedTxForSelection("bob", 1, 20),
+	}
+	txCache := createTxCacheStubForSelection(candidates, func(_ int) {})
+
+	policy, _ := NewFeePerGasTxSelectionPolicy(createFeeHandlerForSelection())
+	adapter := newAdapterTxCacheToSortedTransactionsProvider(txCache, policy)
+	assert.Equal(t, []string{"bob-1", "alice-1"}, getSelectedTxHashes(adapter.GetSortedTransactions()))
+}
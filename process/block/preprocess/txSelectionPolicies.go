@@ -0,0 +1,265 @@
+package preprocess
+
+import (
+	"container/heap"
+	"fmt"
+	"math/big"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/storage/txcache"
+)
+
+const (
+	// DefaultTxSelectionPolicyType selects the transactions as ordered by the cache, based on the senders' scores
+	DefaultTxSelectionPolicyType = "default"
+	// SenderFairnessTxSelectionPolicyType selects the transactions in a round-robin manner between the senders
+	SenderFairnessTxSelectionPolicyType = "sender-fairness"
+	// FeePerGasTxSelectionPolicyType selects the transactions paying the most per unit of gas first
+	FeePerGasTxSelectionPolicyType = "fee-per-gas"
+)
+
+// maxNumOfCandidatesForFeePerGas is the number of transactions drawn from the cache by the fee per gas policy, higher
+// than the number of selected ones, so that the well paying transactions of the senders with lower scores are reached
+const maxNumOfCandidatesForFeePerGas = 4 * process.MaxNumOfTxsToSelect
+
+// NewTxSelectionPolicy creates the transaction selection policy defined in the provided config
+func NewTxSelectionPolicy(cfg config.TxSelectionPolicyConfig, economicsFee process.FeeHandler) (TxSelectionPolicy, error) {
+	switch cfg.Type {
+	case DefaultTxSelectionPolicyType, "":
+		return NewDefaultTxSelectionPolicy(), nil
+	case SenderFairnessTxSelectionPolicyType:
+		policy, err := NewSenderFairnessTxSelectionPolicy(cfg.MaxTxsPerSender)
+		if err != nil {
+			return nil, err
+		}
+
+		return policy, nil
+	case FeePerGasTxSelectionPolicyType:
+		policy, err := NewFeePerGasTxSelectionPolicy(economicsFee)
+		if err != nil {
+			return nil, err
+		}
+
+		return policy, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidTxSelectionPolicy, cfg.Type)
+	}
+}
+
+// defaultTxSelectionPolicy keeps the order of the cache, where the senders with better scores get larger batches
+type defaultTxSelectionPolicy struct {
+}
+
+// NewDefaultTxSelectionPolicy creates the default transaction selection policy
+func NewDefaultTxSelectionPolicy() *defaultTxSelectionPolicy {
+	return &defaultTxSelectionPolicy{}
+}
+
+// SelectTransactions selects the transactions from the cache, in batches per sender
+func (policy *defaultTxSelectionPolicy) SelectTransactions(txCache TxCache) []*txcache.WrappedTransaction {
+	return txCache.SelectTransactionsWithBandwidth(process.MaxNumOfTxsToSelect, process.NumTxPerSenderBatchForFillingMiniblock, process.MaxGasBandwidthPerBatchPerSender)
+}
+
+// HasMoveBalancePriority returns true, as the move balance transactions are the first to fit in the gas bandwidth
+func (policy *defaultTxSelectionPolicy) HasMoveBalancePriority() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *defaultTxSelectionPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
+
+// senderFairnessTxSelectionPolicy interleaves the senders, one transaction at a time, and caps the number of
+// transactions selected for each sender, so that a few very active senders can not fill the whole block
+type senderFairnessTxSelectionPolicy struct {
+	maxTxsPerSender int
+}
+
+// NewSenderFairnessTxSelectionPolicy creates a transaction selection policy which treats all the senders equally
+func NewSenderFairnessTxSelectionPolicy(maxTxsPerSender uint32) (*senderFairnessTxSelectionPolicy, error) {
+	if maxTxsPerSender == 0 {
+		return nil, fmt.Errorf("%w: the maximum number of transactions per sender should be positive", process.ErrInvalidTxSelectionPolicy)
+	}
+
+	return &senderFairnessTxSelectionPolicy{
+		maxTxsPerSender: int(maxTxsPerSender),
+	}, nil
+}
+
+// HasMoveBalancePriority returns false, as the transactions fit in the gas bandwidth in the order of the rounds
+func (policy *senderFairnessTxSelectionPolicy) HasMoveBalancePriority() bool {
+	return false
+}
+
+// SelectTransactions selects the transactions from the cache, one per sender at each round. The order of the
+// transactions of the same sender is kept, so that the nonces remain in sequence
+func (policy *senderFairnessTxSelectionPolicy) SelectTransactions(txCache TxCache) []*txcache.WrappedTransaction {
+	candidates := txCache.SelectTransactionsWithBandwidth(process.MaxNumOfTxsToSelect, 1, process.MaxGasBandwidthPerBatchPerSender)
+	txsBySender := groupTransactionsBySender(candidates)
+
+	selected := make([]*txcache.WrappedTransaction, 0, len(candidates))
+	for round := 0; round < policy.maxTxsPerSender; round++ {
+		selectedInRound := 0
+		for _, senderTxs := range txsBySender {
+			if round >= len(senderTxs) {
+				continue
+			}
+
+			selected = append(selected, senderTxs[round])
+			selectedInRound++
+		}
+
+		if selectedInRound == 0 {
+			break
+		}
+	}
+
+	return selected
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *senderFairnessTxSelectionPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
+
+// feePerGasTxSelectionPolicy selects the transactions paying the most for a unit of gas first, so that the fees
+// collected for the block's gas bandwidth are maximized. The candidates are drawn from a larger part of the cache than
+// the other policies do, not only from the senders with the best scores
+type feePerGasTxSelectionPolicy struct {
+	economicsFee process.FeeHandler
+}
+
+// NewFeePerGasTxSelectionPolicy creates a transaction selection policy which selects the transactions by their fee per
+// unit of gas
+func NewFeePerGasTxSelectionPolicy(economicsFee process.FeeHandler) (*feePerGasTxSelectionPolicy, error) {
+	if check.IfNil(economicsFee) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+
+	return &feePerGasTxSelectionPolicy{
+		economicsFee: economicsFee,
+	}, nil
+}
+
+// HasMoveBalancePriority returns false, as the transactions fit in the gas bandwidth by their fee per unit of gas
+func (policy *feePerGasTxSelectionPolicy) HasMoveBalancePriority() bool {
+	return false
+}
+
+// SelectTransactions greedily selects the transactions with the highest fee per unit of gas. The fee is computed by
+// the economics handler, so the processing gas price modifier is accounted for. A transaction is only selected after
+// the previous ones of the same sender, so a sender's low paying transaction delays its following ones. On equal fees
+// per gas, the order of the cache is kept
+func (policy *feePerGasTxSelectionPolicy) SelectTransactions(txCache TxCache) []*txcache.WrappedTransaction {
+	candidates := txCache.SelectTransactionsWithBandwidth(maxNumOfCandidatesForFeePerGas, process.NumTxPerSenderBatchForFillingMiniblock, process.MaxGasBandwidthPerBatchPerSender)
+	txsBySender := groupTransactionsBySender(candidates)
+
+	queues := make(senderQueues, 0, len(txsBySender))
+	for index, senderTxs := range txsBySender {
+		queue := &senderQueue{index: index, txs: senderTxs}
+		policy.computeNextFee(queue)
+		queues = append(queues, queue)
+	}
+	heap.Init(&queues)
+
+	numToSelect := core.MinInt(len(candidates), process.MaxNumOfTxsToSelect)
+	selected := make([]*txcache.WrappedTransaction, 0, numToSelect)
+	for queues.Len() > 0 && len(selected) < numToSelect {
+		queue := queues[0]
+		selected = append(selected, queue.txs[0])
+
+		queue.txs = queue.txs[1:]
+		if len(queue.txs) == 0 {
+			heap.Pop(&queues)
+			continue
+		}
+
+		policy.computeNextFee(queue)
+		heap.Fix(&queues, 0)
+	}
+
+	return selected
+}
+
+func (policy *feePerGasTxSelectionPolicy) computeNextFee(queue *senderQueue) {
+	queue.nextFee = policy.economicsFee.ComputeTxFee(queue.txs[0].Tx)
+	queue.nextGasLimit = big.NewInt(0).SetUint64(queue.txs[0].Tx.GetGasLimit())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *feePerGasTxSelectionPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
+
+// groupTransactionsBySender returns the transactions of each sender, in the order the senders first appear, keeping
+// the relative order of each sender's transactions
+func groupTransactionsBySender(txs []*txcache.WrappedTransaction) [][]*txcache.WrappedTransaction {
+	senderIndexes := make(map[string]int)
+	txsBySender := make([][]*txcache.WrappedTransaction, 0)
+	for _, tx := range txs {
+		sender := string(tx.Tx.GetSndAddr())
+		index, found := senderIndexes[sender]
+		if !found {
+			index = len(txsBySender)
+			senderIndexes[sender] = index
+			txsBySender = append(txsBySender, make([]*txcache.WrappedTransaction, 0))
+		}
+
+		txsBySender[index] = append(txsBySender[index], tx)
+	}
+
+	return txsBySender
+}
+
+// senderQueue holds the not yet selected transactions of a sender, along with the fee and the gas limit of the next one
+type senderQueue struct {
+	index        int
+	txs          []*txcache.WrappedTransaction
+	nextFee      *big.Int
+	nextGasLimit *big.Int
+}
+
+// senderQueues is a heap of the senders, by the fee per gas of their next transaction
+type senderQueues []*senderQueue
+
+// Len returns the number of senders
+func (queues senderQueues) Len() int {
+	return len(queues)
+}
+
+// Less returns true if the next transaction of the sender at position i should be selected before the one at position j
+func (queues senderQueues) Less(i, j int) bool {
+	// feeI / gasLimitI > feeJ / gasLimitJ, compared without the integer division losses
+	weightedFeeI := big.NewInt(0).Mul(queues[i].nextFee, queues[j].nextGasLimit)
+	weightedFeeJ := big.NewInt(0).Mul(queues[j].nextFee, queues[i].nextGasLimit)
+	comparison := weightedFeeI.Cmp(weightedFeeJ)
+	if comparison != 0 {
+		return comparison > 0
+	}
+
+	return queues[i].index < queues[j].index
+}
+
+// Swap swaps the senders at the provided positions
+func (queues senderQueues) Swap(i, j int) {
+	queues[i], queues[j] = queues[j], queues[i]
+}
+
+// Push adds a sender
+func (queues *senderQueues) Push(x interface{}) {
+	*queues = append(*queues, x.(*senderQueue))
+}
+
+// Pop removes the last sender
+func (queues *senderQueues) Pop() interface{} {
+	old := *queues
+	n := len(old)
+	queue := old[n-1]
+	*queues = old[:n-1]
+
+	return queue
+}
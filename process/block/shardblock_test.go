@@ -32,6 +32,7 @@ import (
 	processOutport "github.com/kalyan3104/k-chain-go/outport/process"
 	"github.com/kalyan3104/k-chain-go/process"
 	blproc "github.com/kalyan3104/k-chain-go/process/block"
+	"github.com/kalyan3104/k-chain-go/process/block/preprocess"
 	"github.com/kalyan3104/k-chain-go/process/block/processedMb"
 	"github.com/kalyan3104/k-chain-go/process/coordinator"
 	"github.com/kalyan3104/k-chain-go/process/factory/shard"
@@ -478,6 +479,7 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := factory.Create()
 
@@ -700,6 +702,7 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := factory.Create()
 
@@ -2596,6 +2599,7 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := factory.Create()
 
@@ -2705,6 +2709,7 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := factory.Create()
 
@@ -3100,6 +3105,7 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := factory.Create()
 
@@ -3282,6 +3288,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := factory.Create()
 
@@ -24,6 +24,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/process/block/preprocess"
 	"github.com/kalyan3104/k-chain-go/process/block/processedMb"
 	"github.com/kalyan3104/k-chain-go/process/factory"
 	"github.com/kalyan3104/k-chain-go/process/factory/shard"
@@ -558,6 +559,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -657,6 +659,7 @@ func createPreProcessorContainerWithDataPool(
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -926,6 +929,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1111,6 +1115,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactionsNilPreP
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1220,6 +1225,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1758,6 +1764,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1885,6 +1892,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -2025,6 +2033,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
@@ -2166,6 +2175,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 	container, _ := preFactory.Create()
 
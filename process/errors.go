@@ -1170,6 +1170,12 @@ var ErrMaxCallsReached = errors.New("max calls reached")
 // ErrNilTxExecutionOrderHandler signals that a nil transaction execution order handler was provided
 var ErrNilTxExecutionOrderHandler = errors.New("nil transaction execution order handler")
 
+// ErrNilTxSelectionPolicy signals that a nil transaction selection policy was provided
+var ErrNilTxSelectionPolicy = errors.New("nil transaction selection policy")
+
+// ErrInvalidTxSelectionPolicy signals that an invalid transaction selection policy was provided
+var ErrInvalidTxSelectionPolicy = errors.New("invalid transaction selection policy")
+
 // ErrWrongTransactionType signals that transaction is invalid
 var ErrWrongTransactionType = errors.New("invalid transaction type")
 
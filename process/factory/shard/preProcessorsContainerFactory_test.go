@@ -5,6 +5,7 @@ import (
 
 	"github.com/kalyan3104/k-chain-go/dataRetriever"
 	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/process/block/preprocess"
 	"github.com/kalyan3104/k-chain-go/process/mock"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	commonMock "github.com/kalyan3104/k-chain-go/testscommon/common"
@@ -47,6 +48,7 @@ func TestNewPreProcessorsContainerFactory_NilShardCoordinator(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -79,6 +81,7 @@ func TestNewPreProcessorsContainerFactory_NilStore(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilStore, err)
@@ -111,6 +114,7 @@ func TestNewPreProcessorsContainerFactory_NilMarshalizer(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -143,6 +147,7 @@ func TestNewPreProcessorsContainerFactory_NilHasher(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -175,6 +180,7 @@ func TestNewPreProcessorsContainerFactory_NilDataPool(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
@@ -207,6 +213,7 @@ func TestNewPreProcessorsContainerFactory_NilAddrConv(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
@@ -239,6 +246,7 @@ func TestNewPreProcessorsContainerFactory_NilAccounts(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -271,6 +279,7 @@ func TestNewPreProcessorsContainerFactory_NilTxProcessor(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilTxProcessor, err)
@@ -303,6 +312,7 @@ func TestNewPreProcessorsContainerFactory_NilSCProcessor(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -335,6 +345,7 @@ func TestNewPreProcessorsContainerFactory_NilSCR(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractResultProcessor, err)
@@ -367,6 +378,7 @@ func TestNewPreProcessorsContainerFactory_NilRewardTxProcessor(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilRewardsTxProcessor, err)
@@ -399,6 +411,7 @@ func TestNewPreProcessorsContainerFactory_NilRequestHandler(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilRequestHandler, err)
@@ -431,6 +444,7 @@ func TestNewPreProcessorsContainerFactory_NilFeeHandler(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -463,6 +477,7 @@ func TestNewPreProcessorsContainerFactory_NilGasHandler(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilGasHandler, err)
@@ -495,6 +510,7 @@ func TestNewPreProcessorsContainerFactory_NilBlockTracker(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilBlockTracker, err)
@@ -527,6 +543,7 @@ func TestNewPreProcessorsContainerFactory_NilBlockSizeComputationHandler(t *test
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilBlockSizeComputationHandler, err)
@@ -559,6 +576,7 @@ func TestNewPreProcessorsContainerFactory_NilBalanceComputationHandler(t *testin
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
@@ -591,6 +609,7 @@ func TestNewPreProcessorsContainerFactory_NilEnableEpochsHandler(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilEnableEpochsHandler, err)
@@ -623,6 +642,7 @@ func TestNewPreProcessorsContainerFactory_NilTxTypeHandler(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilTxTypeHandler, err)
@@ -655,6 +675,7 @@ func TestNewPreProcessorsContainerFactory_NilScheduledTxsExecutionHandler(t *tes
 		nil,
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilScheduledTxsExecutionHandler, err)
@@ -687,6 +708,7 @@ func TestNewPreProcessorsContainerFactory_NilProcessedMiniBlocksTracker(t *testi
 		&testscommon.ScheduledTxsExecutionStub{},
 		nil,
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilProcessedMiniBlocksTracker, err)
@@ -719,12 +741,46 @@ func TestNewPreProcessorsContainerFactory_NilTxExecutionOrderHandler(t *testing.
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		nil,
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Equal(t, process.ErrNilTxExecutionOrderHandler, err)
 	assert.Nil(t, ppcm)
 }
 
+func TestNewPreProcessorsContainerFactory_NilTxSelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	ppcm, err := NewPreProcessorsContainerFactory(
+		mock.NewMultiShardsCoordinatorMock(3),
+		&storageStubs.ChainStorerStub{},
+		&mock.MarshalizerMock{},
+		&hashingMocks.HasherMock{},
+		dataRetrieverMock.NewPoolsHolderMock(),
+		createMockPubkeyConverter(),
+		&stateMock.AccountsStub{},
+		&testscommon.RequestHandlerStub{},
+		&testscommon.TxProcessorMock{},
+		&testscommon.SCProcessorMock{},
+		&testscommon.SmartContractResultsProcessorMock{},
+		&testscommon.RewardTxProcessorMock{},
+		&economicsmocks.EconomicsHandlerStub{},
+		&testscommon.GasHandlerStub{},
+		&mock.BlockTrackerMock{},
+		&testscommon.BlockSizeComputationStub{},
+		&testscommon.BalanceComputationStub{},
+		&enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		&testscommon.TxTypeHandlerMock{},
+		&testscommon.ScheduledTxsExecutionStub{},
+		&testscommon.ProcessedMiniBlocksTrackerStub{},
+		&commonMock.TxExecutionOrderHandlerStub{},
+		nil,
+	)
+
+	assert.Equal(t, process.ErrNilTxSelectionPolicy, err)
+	assert.Nil(t, ppcm)
+}
+
 func TestNewPreProcessorsContainerFactory(t *testing.T) {
 	t.Parallel()
 
@@ -751,6 +807,7 @@ func TestNewPreProcessorsContainerFactory(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Nil(t, err)
@@ -788,6 +845,7 @@ func TestPreProcessorsContainerFactory_CreateErrTxPreproc(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Nil(t, err)
@@ -831,6 +889,7 @@ func TestPreProcessorsContainerFactory_CreateErrScrPreproc(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Nil(t, err)
@@ -877,6 +936,7 @@ func TestPreProcessorsContainerFactory_Create(t *testing.T) {
 		&testscommon.ScheduledTxsExecutionStub{},
 		&testscommon.ProcessedMiniBlocksTrackerStub{},
 		&commonMock.TxExecutionOrderHandlerStub{},
+		preprocess.NewDefaultTxSelectionPolicy(),
 	)
 
 	assert.Nil(t, err)
@@ -2,13 +2,15 @@ package mock
 
 import (
 	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/smartContractResult"
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
 	txSimData "github.com/kalyan3104/k-chain-go/process/transactionEvaluator/data"
 )
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
+	ProcessTxCalled  func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
+	ProcessSCRCalled func(scr *smartContractResult.SmartContractResult, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
 }
 
 // ProcessTx -
@@ -20,6 +22,15 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction, curr
 	return nil, nil
 }
 
+// ProcessSCR -
+func (tss *TransactionSimulatorStub) ProcessSCR(scr *smartContractResult.SmartContractResult, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if tss.ProcessSCRCalled != nil {
+		return tss.ProcessSCRCalled(scr, currentHeader)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
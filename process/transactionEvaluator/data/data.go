@@ -1,7 +1,9 @@
 package data
 
 import (
+	"github.com/kalyan3104/k-chain-core-go/data"
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/common"
 	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
 )
 
@@ -10,3 +12,51 @@ type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
 	VMOutput *vmcommon.VMOutput `json:"-"`
 }
+
+// HistoricalExecutionContext holds the data needed to re-execute a transaction on the state it was executed on
+type HistoricalExecutionContext struct {
+	BlockHeader data.HeaderHandler
+	// StateRootHash is the root hash of the state the block was executed on, usually the one of the previous block
+	StateRootHash common.RootHashHolder
+	// PrecedingTransactions are the transactions and the smart contract results executed by the shard in the same
+	// block, before the traced transaction
+	PrecedingTransactions []data.TransactionHandler
+}
+
+// TransactionTrace is the data transfer object which holds the execution tree of a transaction
+type TransactionTrace struct {
+	Hash       string                                         `json:"hash,omitempty"`
+	Status     transaction.TxStatus                           `json:"status"`
+	FailReason string                                         `json:"failReason,omitempty"`
+	BlockNonce uint64                                         `json:"blockNonce,omitempty"`
+	BlockHash  string                                         `json:"blockHash,omitempty"`
+	RootHash   string                                         `json:"rootHash,omitempty"`
+	Call       *TraceCall                                     `json:"call"`
+	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
+}
+
+// TraceCall holds the details of one level of the execution tree
+type TraceCall struct {
+	Caller        string                `json:"caller"`
+	Callee        string                `json:"callee"`
+	Function      string                `json:"function,omitempty"`
+	Arguments     []string              `json:"arguments,omitempty"`
+	Value         string                `json:"value"`
+	CallType      string                `json:"callType"`
+	CrossShard    bool                  `json:"crossShard,omitempty"`
+	GasProvided   uint64                `json:"gasProvided"`
+	GasConsumed   uint64                `json:"gasConsumed"`
+	ReturnCode    string                `json:"returnCode,omitempty"`
+	ReturnMessage string                `json:"returnMessage,omitempty"`
+	StorageDiffs  []*StorageDiff        `json:"storageDiffs,omitempty"`
+	Logs          []*transaction.Events `json:"logs,omitempty"`
+	Calls         []*TraceCall          `json:"calls,omitempty"`
+}
+
+// StorageDiff holds a storage change of an account, with hex encoded key and values
+type StorageDiff struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
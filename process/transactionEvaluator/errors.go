@@ -28,3 +28,12 @@ var ErrNilHasher = errors.New("nil hasher provided")
 
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrNilHistoricalAccountsAdapter signals that a nil historical accounts adapter has been provided
+var ErrNilHistoricalAccountsAdapter = errors.New("nil historical accounts adapter")
+
+// ErrNilHistoricalExecutionContext signals that an incomplete historical execution context has been provided
+var ErrNilHistoricalExecutionContext = errors.New("nil block header or state root hash in historical execution context")
+
+// ErrUnsupportedPrecedingTransaction signals that a preceding transaction of an unsupported type has been provided
+var ErrUnsupportedPrecedingTransaction = errors.New("unsupported preceding transaction type")
@@ -0,0 +1,73 @@
+package transactionEvaluator
+
+import (
+	"errors"
+
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/state"
+	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+)
+
+// historicalAccountsSource reads the accounts from an accounts adapter with history, always on the same historical
+// state. It is used as the accounts source of the simulation accounts adapter, which caches the loaded accounts and
+// keeps the changes made by the executed transactions, so only the read operations are needed
+type historicalAccountsSource struct {
+	state.AccountsAdapterAPI
+	rootHashHolder common.RootHashHolder
+	accountFactory state.AccountFactory
+}
+
+func newHistoricalAccountsSource(
+	accountsAdapter state.AccountsAdapterAPI,
+	rootHashHolder common.RootHashHolder,
+	accountFactory state.AccountFactory,
+) *historicalAccountsSource {
+	return &historicalAccountsSource{
+		AccountsAdapterAPI: accountsAdapter,
+		rootHashHolder:     rootHashHolder,
+		accountFactory:     accountFactory,
+	}
+}
+
+// GetExistingAccount returns the account as it was on the historical state
+func (source *historicalAccountsSource) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, _, err := source.GetAccountWithBlockInfo(address, source.rootHashHolder)
+	if isErrAccountNotFoundAtBlock(err) {
+		return nil, state.ErrAccNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// LoadAccount returns the account as it was on the historical state, or a new account if it did not exist yet
+func (source *historicalAccountsSource) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := source.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return source.accountFactory.CreateAccount(address)
+	}
+
+	return account, err
+}
+
+// GetCode returns the code as it was on the historical state
+func (source *historicalAccountsSource) GetCode(codeHash []byte) []byte {
+	code, _, err := source.GetCodeWithBlockInfo(codeHash, source.rootHashHolder)
+	if err != nil {
+		log.Warn("historicalAccountsSource.GetCode", "error", err)
+	}
+
+	return code
+}
+
+// RootHash returns the root hash of the historical state
+func (source *historicalAccountsSource) RootHash() ([]byte, error) {
+	return source.rootHashHolder.GetRootHash(), nil
+}
+
+func isErrAccountNotFoundAtBlock(err error) bool {
+	var errAccountNotFoundAtBlock *state.ErrAccountNotFoundAtBlock
+	return errors.As(err, &errAccountNotFoundAtBlock)
+}
@@ -0,0 +1,76 @@
+package transactionEvaluator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/holders"
+	"github.com/kalyan3104/k-chain-go/state"
+	stateMock "github.com/kalyan3104/k-chain-go/testscommon/state"
+	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalAccountsSource(t *testing.T) {
+	t.Parallel()
+
+	providedRootHash := []byte("historical root hash")
+	rootHashHolder := holders.NewRootHashHolder(providedRootHash, core.OptionalUint32{})
+	existingAddress := []byte("existing")
+	accountsAdapter := &stateMock.AccountsStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			require.Equal(t, providedRootHash, options.GetRootHash())
+			blockInfo := holders.NewBlockInfo(nil, 0, options.GetRootHash())
+			if string(address) != string(existingAddress) {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(blockInfo)
+			}
+
+			return &stateMock.UserAccountStub{Address: address, Balance: big.NewInt(10)}, blockInfo, nil
+		},
+		GetCodeWithBlockInfoCalled: func(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error) {
+			require.Equal(t, providedRootHash, options.GetRootHash())
+			return []byte("code"), nil, nil
+		},
+	}
+	accountFactory := &stateMock.AccountsFactoryStub{
+		CreateAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return &stateMock.UserAccountStub{Address: address}, nil
+		},
+	}
+	source := newHistoricalAccountsSource(accountsAdapter, rootHashHolder, accountFactory)
+
+	t.Run("GetExistingAccount", func(t *testing.T) {
+		t.Parallel()
+
+		account, err := source.GetExistingAccount(existingAddress)
+		require.Nil(t, err)
+		require.Equal(t, existingAddress, account.AddressBytes())
+
+		account, err = source.GetExistingAccount([]byte("missing"))
+		require.Nil(t, account)
+		require.Equal(t, state.ErrAccNotFound, err)
+	})
+	t.Run("LoadAccount should create the missing accounts", func(t *testing.T) {
+		t.Parallel()
+
+		account, err := source.LoadAccount(existingAddress)
+		require.Nil(t, err)
+		require.Equal(t, big.NewInt(10), account.(*stateMock.UserAccountStub).Balance)
+
+		account, err = source.LoadAccount([]byte("missing"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("missing"), account.AddressBytes())
+		require.Nil(t, account.(*stateMock.UserAccountStub).Balance)
+	})
+	t.Run("GetCode and RootHash", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []byte("code"), source.GetCode([]byte("code hash")))
+
+		rootHash, err := source.RootHash()
+		require.Nil(t, err)
+		require.Equal(t, providedRootHash, rootHash)
+	})
+}
@@ -2,6 +2,7 @@ package transactionEvaluator
 
 import (
 	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-go/state"
 	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
 	datafield "github.com/kalyan3104/k-chain-vm-common-go/parsers/dataField"
 )
@@ -17,3 +18,9 @@ type TransactionProcessor interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// SimulationAccountsAdapter defines the accounts adapter used when simulating transactions
+type SimulationAccountsAdapter interface {
+	state.AccountsAdapterWithClean
+	SetAccountsSource(accountsDB state.AccountsAdapter)
+}
@@ -15,6 +15,7 @@ type simulationAccountsDB struct {
 	mutex            sync.RWMutex
 	cachedAccounts   map[string]vmcommon.AccountHandler
 	originalAccounts state.AccountsAdapter
+	accountsSource   state.AccountsAdapter
 }
 
 // NewSimulationAccountsDB returns a new instance of simulationAccountsDB
@@ -27,6 +28,7 @@ func NewSimulationAccountsDB(accountsDB state.AccountsAdapter) (*simulationAccou
 		mutex:            sync.RWMutex{},
 		cachedAccounts:   make(map[string]vmcommon.AccountHandler),
 		originalAccounts: accountsDB,
+		accountsSource:   accountsDB,
 	}, nil
 }
 
@@ -42,7 +44,7 @@ func (r *simulationAccountsDB) StartSnapshotIfNeeded() error {
 
 // GetCode returns the code for the given account
 func (r *simulationAccountsDB) GetCode(codeHash []byte) []byte {
-	return r.originalAccounts.GetCode(codeHash)
+	return r.getAccountsSource().GetCode(codeHash)
 }
 
 // GetExistingAccount will call the original accounts' function with the same name
@@ -52,7 +54,7 @@ func (r *simulationAccountsDB) GetExistingAccount(address []byte) (vmcommon.Acco
 		return cachedAccount, nil
 	}
 
-	account, err := r.originalAccounts.GetExistingAccount(address)
+	account, err := r.getAccountsSource().GetExistingAccount(address)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +66,7 @@ func (r *simulationAccountsDB) GetExistingAccount(address []byte) (vmcommon.Acco
 
 // GetAccountFromBytes will call the original accounts' function with the same name
 func (r *simulationAccountsDB) GetAccountFromBytes(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
+	return r.getAccountsSource().GetAccountFromBytes(address, accountBytes)
 }
 
 // LoadAccount will call the original accounts' function with the same name
@@ -74,7 +76,7 @@ func (r *simulationAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHand
 		return cachedAccount, nil
 	}
 
-	account, err := r.originalAccounts.LoadAccount(address)
+	account, err := r.getAccountsSource().LoadAccount(address)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +109,7 @@ func (r *simulationAccountsDB) Commit() ([]byte, error) {
 
 // JournalLen will call the original accounts' function with the same name
 func (r *simulationAccountsDB) JournalLen() int {
-	return r.originalAccounts.JournalLen()
+	return r.getAccountsSource().JournalLen()
 }
 
 // RevertToSnapshot won't do anything as write operations are disabled on this component
@@ -117,7 +119,7 @@ func (r *simulationAccountsDB) RevertToSnapshot(_ int) error {
 
 // RootHash will call the original accounts' function with the same name
 func (r *simulationAccountsDB) RootHash() ([]byte, error) {
-	return r.originalAccounts.RootHash()
+	return r.getAccountsSource().RootHash()
 }
 
 // RecreateTrie won't do anything as write operations are disabled on this component
@@ -144,12 +146,12 @@ func (r *simulationAccountsDB) SnapshotState(_ []byte, _ uint32) {
 
 // IsPruningEnabled will call the original accounts' function with the same name
 func (r *simulationAccountsDB) IsPruningEnabled() bool {
-	return r.originalAccounts.IsPruningEnabled()
+	return r.getAccountsSource().IsPruningEnabled()
 }
 
 // GetAllLeaves will call the original accounts' function with the same name
 func (r *simulationAccountsDB) GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
-	return r.originalAccounts.GetAllLeaves(leavesChannels, ctx, rootHash, trieLeafParser)
+	return r.getAccountsSource().GetAllLeaves(leavesChannels, ctx, rootHash, trieLeafParser)
 }
 
 // RecreateAllTries will return an error which indicates that this operation is not supported
@@ -182,6 +184,27 @@ func (r *simulationAccountsDB) IsInterfaceNil() bool {
 	return r == nil
 }
 
+// SetAccountsSource will make the component read the accounts from the provided accounts adapter (e.g. one recreated
+// on a historical state). Providing a nil accounts adapter will switch back to the original accounts adapter.
+// The cached accounts are dropped, as they were loaded from the previous source
+func (r *simulationAccountsDB) SetAccountsSource(accountsDB state.AccountsAdapter) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.accountsSource = r.originalAccounts
+	if !check.IfNil(accountsDB) {
+		r.accountsSource = accountsDB
+	}
+	r.cachedAccounts = make(map[string]vmcommon.AccountHandler)
+}
+
+func (r *simulationAccountsDB) getAccountsSource() state.AccountsAdapter {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.accountsSource
+}
+
 // CleanCache will clean the internal map with the cached accounts
 func (r *simulationAccountsDB) CleanCache() {
 	r.mutex.Lock()
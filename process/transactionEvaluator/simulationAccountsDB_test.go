@@ -155,3 +155,32 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func TestSimulationAccountsDB_SetAccountsSource(t *testing.T) {
+	t.Parallel()
+
+	originalRootHash := []byte("original root")
+	historicalRootHash := []byte("historical root")
+	originalAccountsDB := &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return originalRootHash, nil
+		},
+	}
+	historicalAccountsDB := &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return historicalRootHash, nil
+		},
+	}
+
+	simAccountsDB, _ := NewSimulationAccountsDB(originalAccountsDB)
+
+	simAccountsDB.SetAccountsSource(historicalAccountsDB)
+	rootHash, err := simAccountsDB.RootHash()
+	require.NoError(t, err)
+	require.Equal(t, historicalRootHash, rootHash)
+
+	simAccountsDB.SetAccountsSource(nil)
+	rootHash, err = simAccountsDB.RootHash()
+	require.NoError(t, err)
+	require.Equal(t, originalRootHash, rootHash)
+}
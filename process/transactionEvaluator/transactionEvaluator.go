@@ -19,6 +19,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/sharding"
 	"github.com/kalyan3104/k-chain-go/state"
 	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+	"github.com/kalyan3104/k-chain-vm-common-go/parsers"
 )
 
 const dummySignature = "01010101"
@@ -27,24 +28,32 @@ const gasUsedSlitString = "gas used = "
 
 // ArgsApiTransactionEvaluator holds the arguments required for creating a new transaction evaluator
 type ArgsApiTransactionEvaluator struct {
-	TxTypeHandler       process.TxTypeHandler
-	FeeHandler          process.FeeHandler
-	TxSimulator         facade.TransactionSimulatorProcessor
-	Accounts            state.AccountsAdapterWithClean
-	ShardCoordinator    sharding.Coordinator
-	EnableEpochsHandler common.EnableEpochsHandler
-	BlockChain          data.ChainHandler
+	TxTypeHandler          process.TxTypeHandler
+	FeeHandler             process.FeeHandler
+	TxSimulator            facade.TransactionSimulatorProcessor
+	Accounts               SimulationAccountsAdapter
+	HistoricalAccounts     state.AccountsAdapterAPI
+	AccountFactory         state.AccountFactory
+	ShardCoordinator       sharding.Coordinator
+	EnableEpochsHandler    common.EnableEpochsHandler
+	BlockChain             data.ChainHandler
+	AddressPubKeyConverter core.PubkeyConverter
 }
 
 type apiTransactionEvaluator struct {
-	accounts            state.AccountsAdapterWithClean
-	shardCoordinator    sharding.Coordinator
-	txTypeHandler       process.TxTypeHandler
-	feeHandler          process.FeeHandler
-	txSimulator         facade.TransactionSimulatorProcessor
-	enableEpochsHandler common.EnableEpochsHandler
-	blockChain          data.ChainHandler
-	mutExecution        sync.RWMutex
+	accounts               SimulationAccountsAdapter
+	historicalAccounts     state.AccountsAdapterAPI
+	accountFactory         state.AccountFactory
+	shardCoordinator       sharding.Coordinator
+	txTypeHandler          process.TxTypeHandler
+	feeHandler             process.FeeHandler
+	txSimulator            facade.TransactionSimulatorProcessor
+	enableEpochsHandler    common.EnableEpochsHandler
+	blockChain             data.ChainHandler
+	addressPubKeyConverter core.PubkeyConverter
+	callArgsParser         process.CallArgumentsParser
+	deployArgsParser       process.DeployArgumentsParser
+	mutExecution           sync.RWMutex
 }
 
 // NewAPITransactionEvaluator will create a new api transaction evaluator
@@ -64,12 +73,21 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	if check.IfNil(args.Accounts) {
 		return nil, process.ErrNilAccountsAdapter
 	}
+	if check.IfNil(args.HistoricalAccounts) {
+		return nil, ErrNilHistoricalAccountsAdapter
+	}
+	if check.IfNil(args.AccountFactory) {
+		return nil, state.ErrNilAccountFactory
+	}
 	if check.IfNil(args.EnableEpochsHandler) {
 		return nil, process.ErrNilEnableEpochsHandler
 	}
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.CleanUpInformativeSCRsFlag,
 	})
@@ -78,13 +96,18 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	}
 
 	tce := &apiTransactionEvaluator{
-		txTypeHandler:       args.TxTypeHandler,
-		feeHandler:          args.FeeHandler,
-		txSimulator:         args.TxSimulator,
-		accounts:            args.Accounts,
-		shardCoordinator:    args.ShardCoordinator,
-		enableEpochsHandler: args.EnableEpochsHandler,
-		blockChain:          args.BlockChain,
+		txTypeHandler:          args.TxTypeHandler,
+		feeHandler:             args.FeeHandler,
+		txSimulator:            args.TxSimulator,
+		accounts:               args.Accounts,
+		historicalAccounts:     args.HistoricalAccounts,
+		accountFactory:         args.AccountFactory,
+		shardCoordinator:       args.ShardCoordinator,
+		enableEpochsHandler:    args.EnableEpochsHandler,
+		blockChain:             args.BlockChain,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		callArgsParser:         parsers.NewCallArgsParser(),
+		deployArgsParser:       parsers.NewDeployArgsParser(),
 	}
 
 	return tce, nil
@@ -15,6 +15,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/process"
 	"github.com/kalyan3104/k-chain-go/process/mock"
 	txSimData "github.com/kalyan3104/k-chain-go/process/transactionEvaluator/data"
+	"github.com/kalyan3104/k-chain-go/state"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/kalyan3104/k-chain-go/testscommon/economicsmocks"
 	"github.com/kalyan3104/k-chain-go/testscommon/enableEpochsHandlerMock"
@@ -25,13 +26,16 @@ import (
 
 func createArgs() ArgsApiTransactionEvaluator {
 	return ArgsApiTransactionEvaluator{
-		TxTypeHandler:       &testscommon.TxTypeHandlerMock{},
-		FeeHandler:          &economicsmocks.EconomicsHandlerStub{},
-		TxSimulator:         &mock.TransactionSimulatorStub{},
-		Accounts:            &stateMock.AccountsStub{},
-		ShardCoordinator:    &mock.ShardCoordinatorStub{},
-		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
-		BlockChain:          &testscommon.ChainHandlerMock{},
+		TxTypeHandler:          &testscommon.TxTypeHandlerMock{},
+		FeeHandler:             &economicsmocks.EconomicsHandlerStub{},
+		TxSimulator:            &mock.TransactionSimulatorStub{},
+		Accounts:               &stateMock.SimulationAccountsStub{},
+		HistoricalAccounts:     &stateMock.AccountsStub{},
+		AccountFactory:         &stateMock.AccountsFactoryStub{},
+		ShardCoordinator:       &mock.ShardCoordinatorStub{},
+		EnableEpochsHandler:    &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		BlockChain:             &testscommon.ChainHandlerMock{},
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
 	}
 }
 
@@ -86,6 +90,39 @@ func TestTransactionEvaluator_NilEnableEpochsHandlerShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrNilEnableEpochsHandler, err)
 }
 
+func TestTransactionEvaluator_NilHistoricalAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.HistoricalAccounts = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilHistoricalAccountsAdapter, err)
+}
+
+func TestTransactionEvaluator_NilAccountFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.AccountFactory = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, state.ErrNilAccountFactory, err)
+}
+
+func TestTransactionEvaluator_NilAddressPubKeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.AddressPubKeyConverter = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+}
+
 func TestTransactionEvaluator_InvalidEnableEpochsHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -131,9 +168,11 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
 		},
 	}
 	tce, err := NewAPITransactionEvaluator(args)
@@ -170,9 +209,11 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 			return nil, simulationErr
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
 		},
 	}
 	tce, _ := NewAPITransactionEvaluator(args)
@@ -206,9 +247,11 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 			}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
 		},
 	}
 	tce, _ := NewAPITransactionEvaluator(args)
@@ -237,9 +280,11 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 			return nil, localErr
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
 		},
 	}
 	tce, _ := NewAPITransactionEvaluator(args)
@@ -267,9 +312,11 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
 		},
 	}
 	tce, err := NewAPITransactionEvaluator(args)
@@ -302,9 +349,11 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 			}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
-		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
-			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+			},
 		},
 	}
 
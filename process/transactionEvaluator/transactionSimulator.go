@@ -27,6 +27,7 @@ var log = logger.GetOrCreate("process/txSimulator")
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
 type ArgsTxSimulator struct {
 	TransactionProcessor      TransactionProcessor
+	SCRProcessor              process.SmartContractResultProcessor
 	IntermediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter    core.PubkeyConverter
 	ShardCoordinator          sharding.Coordinator
@@ -44,6 +45,7 @@ type refundHandler interface {
 type transactionSimulator struct {
 	mutOperation           sync.Mutex
 	txProcessor            TransactionProcessor
+	scrProcessor           process.SmartContractResultProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
@@ -60,6 +62,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.TransactionProcessor) {
 		return nil, ErrNilTxSimulatorProcessor
 	}
+	if check.IfNil(args.SCRProcessor) {
+		return nil, process.ErrNilSmartContractResultProcessor
+	}
 	if check.IfNil(args.IntermediateProcContainer) {
 		return nil, ErrNilIntermediateProcessorContainer
 	}
@@ -87,6 +92,7 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
+		scrProcessor:           args.SCRProcessor,
 		intermProcContainer:    args.IntermediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
@@ -101,6 +107,23 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return ts.process(tx, currentHeader, func() (vmcommon.ReturnCode, error) {
+		return ts.txProcessor.ProcessTransaction(tx)
+	})
+}
+
+// ProcessSCR will process the smart contract result in a special environment, where state-writing is not allowed
+func (ts *transactionSimulator) ProcessSCR(scr *smartContractResult.SmartContractResult, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return ts.process(scr, currentHeader, func() (vmcommon.ReturnCode, error) {
+		return ts.scrProcessor.ProcessSmartContractResult(scr)
+	})
+}
+
+func (ts *transactionSimulator) process(
+	txHandler data.TransactionHandler,
+	currentHeader data.HeaderHandler,
+	processHandler func() (vmcommon.ReturnCode, error),
+) (*txSimData.SimulationResultsWithVMOutput, error) {
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
@@ -109,7 +132,7 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 
 	ts.blockChainHook.SetCurrentHeader(currentHeader)
 
-	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+	retCode, err := processHandler()
 	if err != nil {
 		failReason = err.Error()
 		txStatus = transaction.TxStatusFail
@@ -131,7 +154,7 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 		return nil, err
 	}
 
-	vmOutput, ok := ts.getVMOutputOfTx(tx)
+	vmOutput, ok := ts.getVMOutputOfTx(txHandler)
 	if ok {
 		results.VMOutput = vmOutput
 	}
@@ -160,7 +183,7 @@ func (ts *transactionSimulator) addLogsFromVmOutput(results *txSimData.Simulatio
 	}
 }
 
-func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
+func (ts *transactionSimulator) getVMOutputOfTx(tx data.TransactionHandler) (*vmcommon.VMOutput, bool) {
 	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
 	if err != nil {
 		return nil, false
@@ -49,6 +49,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilTxSimulatorProcessor,
 		},
+		{
+			name: "NilSCRProcessor",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SCRProcessor = nil
+				return args
+			},
+			exError: process.ErrNilSmartContractResultProcessor,
+		},
 		{
 			name: "NilIntermProcessorContainer",
 			argsFunc: func() ArgsTxSimulator {
@@ -139,6 +148,39 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
 
+func TestTransactionSimulator_ProcessSCR(t *testing.T) {
+	t.Parallel()
+
+	scr := &smartContractResult.SmartContractResult{Nonce: 37}
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageunit.NewCache(storageunit.CacheConfig{
+		Type:     storageunit.LRUCache,
+		Capacity: 100,
+	})
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	args.SCRProcessor = &testscommon.SmartContractResultsProcessorMock{
+		ProcessSmartContractResultCalled: func(providedScr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			require.Equal(t, scr, providedScr)
+			scrHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, providedScr)
+			args.VMOutputCacher.Put(scrHash, vmOutput, 0)
+
+			return vmcommon.Ok, nil
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.Fail(t, "should have not processed a transaction")
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessSCR(scr, &block.Header{})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, vmOutput, results.VMOutput)
+}
+
 func TestTransactionSimulator_getVMOutputComputeHashFails(t *testing.T) {
 	t.Parallel()
 
@@ -238,6 +280,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 	})
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
+		SCRProcessor:              &testscommon.SmartContractResultsProcessorMock{},
 		IntermediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:    pubKeyConverter,
 		ShardCoordinator:          mock.NewMultiShardsCoordinatorMock(2),
@@ -0,0 +1,440 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/smartContractResult"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-core-go/data/vm"
+	"github.com/kalyan3104/k-chain-go/process"
+	txSimData "github.com/kalyan3104/k-chain-go/process/transactionEvaluator/data"
+	"github.com/kalyan3104/k-chain-go/state"
+	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+)
+
+const deployFunctionName = "deploy"
+
+type outputTransferWithReceiver struct {
+	receiver []byte
+	transfer vmcommon.OutputTransfer
+}
+
+// TraceTransactionExecution will execute the provided transaction and will return its execution tree. If a historical
+// execution context is provided, the transaction is executed on the state of the block it was included in, after
+// re-executing once the transactions that preceded it in that block. Otherwise, the current state is used
+func (ate *apiTransactionEvaluator) TraceTransactionExecution(
+	tx *transaction.Transaction,
+	executionContext *txSimData.HistoricalExecutionContext,
+) (*txSimData.TransactionTrace, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.SetAccountsSource(nil)
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	currentHeader := ate.getCurrentBlockHeader()
+	precedingTransactions := make([]data.TransactionHandler, 0)
+	if executionContext != nil {
+		err := ate.switchToHistoricalState(executionContext)
+		if err != nil {
+			return nil, err
+		}
+
+		currentHeader = executionContext.BlockHeader
+		precedingTransactions = executionContext.PrecedingTransactions
+	}
+
+	rootHash, err := ate.accounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	precedingStorage, err := ate.executePrecedingTransactions(precedingTransactions, currentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cached accounts hold the changes made by the executed transactions, so they are dropped and the storage
+	// values before the traced transaction are read from the accounts source, over the preceding transactions writes
+	ate.accounts.CleanCache()
+
+	trace := &txSimData.TransactionTrace{
+		Status:     results.Status,
+		FailReason: results.FailReason,
+		RootHash:   hex.EncodeToString(rootHash),
+		ScResults:  results.ScResults,
+		Receipts:   results.Receipts,
+	}
+	trace.Call = ate.createCallsTree(tx, results, precedingStorage)
+
+	return trace, nil
+}
+
+func (ate *apiTransactionEvaluator) switchToHistoricalState(executionContext *txSimData.HistoricalExecutionContext) error {
+	if check.IfNil(executionContext.BlockHeader) || check.IfNil(executionContext.StateRootHash) {
+		return ErrNilHistoricalExecutionContext
+	}
+
+	historicalSource := newHistoricalAccountsSource(ate.historicalAccounts, executionContext.StateRootHash, ate.accountFactory)
+	ate.accounts.SetAccountsSource(historicalSource)
+
+	return nil
+}
+
+// executePrecedingTransactions executes the provided transactions and smart contract results and returns the storage
+// values they have written
+func (ate *apiTransactionEvaluator) executePrecedingTransactions(
+	txs []data.TransactionHandler,
+	currentHeader data.HeaderHandler,
+) (writtenStorage, error) {
+	precedingStorage := make(writtenStorage)
+	for _, txHandler := range txs {
+		results, err := ate.executePrecedingTransaction(txHandler, currentHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		if results != nil {
+			precedingStorage.addStorageUpdates(results.VMOutput)
+		}
+	}
+
+	return precedingStorage, nil
+}
+
+func (ate *apiTransactionEvaluator) executePrecedingTransaction(
+	txHandler data.TransactionHandler,
+	currentHeader data.HeaderHandler,
+) (*txSimData.SimulationResultsWithVMOutput, error) {
+	switch tx := txHandler.(type) {
+	case *transaction.Transaction:
+		return ate.txSimulator.ProcessTx(tx, currentHeader)
+	case *smartContractResult.SmartContractResult:
+		return ate.txSimulator.ProcessSCR(tx, currentHeader)
+	default:
+		return nil, ErrUnsupportedPrecedingTransaction
+	}
+}
+
+func (ate *apiTransactionEvaluator) createCallsTree(
+	tx *transaction.Transaction,
+	results *txSimData.SimulationResultsWithVMOutput,
+	precedingStorage writtenStorage,
+) *txSimData.TraceCall {
+	rootCall := &txSimData.TraceCall{
+		Caller:      ate.addressPubKeyConverter.SilentEncode(tx.SndAddr, log),
+		Callee:      ate.addressPubKeyConverter.SilentEncode(tx.RcvAddr, log),
+		Value:       bigIntToString(tx.Value),
+		CallType:    vm.DirectCall.ToString(),
+		GasProvided: tx.GasLimit,
+	}
+
+	txTypeOnSender, _ := ate.txTypeHandler.ComputeTransactionType(tx)
+	isDeployment := txTypeOnSender == process.SCDeployment
+	if isDeployment {
+		ate.setDeployArguments(rootCall, tx.Data)
+	} else {
+		ate.setFunctionAndArguments(rootCall, tx.Data)
+	}
+
+	vmOutput := results.VMOutput
+	if vmOutput == nil {
+		if len(results.FailReason) == 0 {
+			rootCall.GasConsumed = ate.feeHandler.ComputeGasLimit(tx)
+		}
+
+		return rootCall
+	}
+
+	if tx.GasLimit > vmOutput.GasRemaining {
+		rootCall.GasConsumed = tx.GasLimit - vmOutput.GasRemaining
+	}
+	rootCall.ReturnCode = vmOutput.ReturnCode.String()
+	rootCall.ReturnMessage = vmOutput.ReturnMessage
+
+	callee := tx.RcvAddr
+	if isDeployment {
+		callee = getDeployedContractAddress(tx.SndAddr, vmOutput)
+		rootCall.Callee = ate.addressPubKeyConverter.SilentEncode(callee, log)
+	}
+
+	callsByCallee := map[string]*txSimData.TraceCall{
+		string(callee): rootCall,
+	}
+	ate.addOutputTransfersCalls(rootCall, callsByCallee, vmOutput)
+	ate.addStorageDiffs(rootCall, callsByCallee, vmOutput, precedingStorage)
+	ate.addLogs(rootCall, callsByCallee, vmOutput)
+
+	return rootCall
+}
+
+func (ate *apiTransactionEvaluator) setFunctionAndArguments(call *txSimData.TraceCall, dataField []byte) {
+	if len(dataField) == 0 {
+		return
+	}
+
+	function, arguments, err := ate.callArgsParser.ParseData(string(dataField))
+	if err != nil {
+		return
+	}
+
+	call.Function = function
+	call.Arguments = encodeArguments(arguments)
+}
+
+func (ate *apiTransactionEvaluator) setDeployArguments(call *txSimData.TraceCall, dataField []byte) {
+	call.Function = deployFunctionName
+
+	deployArgs, err := ate.deployArgsParser.ParseData(string(dataField))
+	if err != nil {
+		return
+	}
+
+	call.Arguments = encodeArguments(deployArgs.Arguments)
+}
+
+func getDeployedContractAddress(deployer []byte, vmOutput *vmcommon.VMOutput) []byte {
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		if len(outputAccount.Code) > 0 && bytes.Equal(outputAccount.CodeDeployerAddress, deployer) {
+			return outputAccount.Address
+		}
+	}
+
+	return nil
+}
+
+// addOutputTransfersCalls adds the calls to other accounts, in the order they were made. Each call is attached to the
+// call that had the sender as callee, if any, or to the root call otherwise
+func (ate *apiTransactionEvaluator) addOutputTransfersCalls(
+	rootCall *txSimData.TraceCall,
+	callsByCallee map[string]*txSimData.TraceCall,
+	vmOutput *vmcommon.VMOutput,
+) {
+	transfers := make([]*outputTransferWithReceiver, 0)
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		for _, outputTransfer := range outputAccount.OutputTransfers {
+			transfers = append(transfers, &outputTransferWithReceiver{
+				receiver: outputAccount.Address,
+				transfer: outputTransfer,
+			})
+		}
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].transfer.Index < transfers[j].transfer.Index
+	})
+
+	selfShardID := ate.shardCoordinator.SelfId()
+	for _, entry := range transfers {
+		call := &txSimData.TraceCall{
+			Caller:      ate.addressPubKeyConverter.SilentEncode(entry.transfer.SenderAddress, log),
+			Callee:      ate.addressPubKeyConverter.SilentEncode(entry.receiver, log),
+			Value:       bigIntToString(entry.transfer.Value),
+			CallType:    entry.transfer.CallType.ToString(),
+			CrossShard:  ate.shardCoordinator.ComputeId(entry.receiver) != selfShardID,
+			GasProvided: entry.transfer.GasLimit,
+		}
+		ate.setFunctionAndArguments(call, entry.transfer.Data)
+
+		parentCall, found := callsByCallee[string(entry.transfer.SenderAddress)]
+		if !found {
+			parentCall = rootCall
+		}
+		parentCall.Calls = append(parentCall.Calls, call)
+
+		_, alreadyCalled := callsByCallee[string(entry.receiver)]
+		if alreadyCalled || call.CrossShard {
+			continue
+		}
+
+		callsByCallee[string(entry.receiver)] = call
+		outputAccount, ok := vmOutput.OutputAccounts[string(entry.receiver)]
+		if ok {
+			call.GasConsumed = outputAccount.GasUsed
+		}
+	}
+}
+
+// addStorageDiffs adds the storage changes of each account on its call. The contracts called synchronously without
+// transfers are not reported by the VM as output transfers, so their calls are attached to the root call
+func (ate *apiTransactionEvaluator) addStorageDiffs(
+	rootCall *txSimData.TraceCall,
+	callsByCallee map[string]*txSimData.TraceCall,
+	vmOutput *vmcommon.VMOutput,
+	precedingStorage writtenStorage,
+) {
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		storageDiffs := ate.computeStorageDiffs(outputAccount, precedingStorage)
+		if len(storageDiffs) == 0 {
+			continue
+		}
+
+		call, found := callsByCallee[string(outputAccount.Address)]
+		if !found {
+			call = &txSimData.TraceCall{
+				Caller:      rootCall.Callee,
+				Callee:      ate.addressPubKeyConverter.SilentEncode(outputAccount.Address, log),
+				Value:       "0",
+				CallType:    vm.DirectCall.ToString(),
+				GasConsumed: outputAccount.GasUsed,
+			}
+			rootCall.Calls = append(rootCall.Calls, call)
+			callsByCallee[string(outputAccount.Address)] = call
+		}
+
+		call.StorageDiffs = storageDiffs
+	}
+}
+
+func (ate *apiTransactionEvaluator) computeStorageDiffs(
+	outputAccount *vmcommon.OutputAccount,
+	precedingStorage writtenStorage,
+) []*txSimData.StorageDiff {
+	storageUpdates := make([]*vmcommon.StorageUpdate, 0, len(outputAccount.StorageUpdates))
+	for _, storageUpdate := range outputAccount.StorageUpdates {
+		if storageUpdate.Written {
+			storageUpdates = append(storageUpdates, storageUpdate)
+		}
+	}
+	if len(storageUpdates) == 0 {
+		return nil
+	}
+
+	sort.Slice(storageUpdates, func(i, j int) bool {
+		return bytes.Compare(storageUpdates[i].Offset, storageUpdates[j].Offset) < 0
+	})
+
+	account := ate.getUserAccount(outputAccount.Address)
+	storageDiffs := make([]*txSimData.StorageDiff, 0, len(storageUpdates))
+	for _, storageUpdate := range storageUpdates {
+		oldValue, found := precedingStorage.get(outputAccount.Address, storageUpdate.Offset)
+		if !found {
+			oldValue = retrieveValue(account, storageUpdate.Offset)
+		}
+
+		storageDiffs = append(storageDiffs, &txSimData.StorageDiff{
+			Key:      hex.EncodeToString(storageUpdate.Offset),
+			OldValue: hex.EncodeToString(oldValue),
+			NewValue: hex.EncodeToString(storageUpdate.Data),
+		})
+	}
+
+	return storageDiffs
+}
+
+// writtenStorage holds the last values written in the accounts storage, indexed by address and key
+type writtenStorage map[string]map[string][]byte
+
+func (ws writtenStorage) addStorageUpdates(vmOutput *vmcommon.VMOutput) {
+	if vmOutput == nil {
+		return
+	}
+
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		for _, storageUpdate := range outputAccount.StorageUpdates {
+			if !storageUpdate.Written {
+				continue
+			}
+
+			accountStorage, found := ws[string(outputAccount.Address)]
+			if !found {
+				accountStorage = make(map[string][]byte)
+				ws[string(outputAccount.Address)] = accountStorage
+			}
+			accountStorage[string(storageUpdate.Offset)] = storageUpdate.Data
+		}
+	}
+}
+
+func (ws writtenStorage) get(address []byte, key []byte) ([]byte, bool) {
+	value, found := ws[string(address)][string(key)]
+	return value, found
+}
+
+func (ate *apiTransactionEvaluator) getUserAccount(address []byte) state.UserAccountHandler {
+	account, err := ate.accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	return userAccount
+}
+
+func retrieveValue(account state.UserAccountHandler, key []byte) []byte {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	value, _, err := account.RetrieveValue(key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func (ate *apiTransactionEvaluator) addLogs(
+	rootCall *txSimData.TraceCall,
+	callsByCallee map[string]*txSimData.TraceCall,
+	vmOutput *vmcommon.VMOutput,
+) {
+	for _, entry := range vmOutput.Logs {
+		call, found := callsByCallee[string(entry.Address)]
+		if !found {
+			call = rootCall
+		}
+
+		call.Logs = append(call.Logs, &transaction.Events{
+			Address:    ate.addressPubKeyConverter.SilentEncode(entry.Address, log),
+			Identifier: string(entry.Identifier),
+			Topics:     entry.Topics,
+			Data:       entry.GetFirstDataItem(),
+		})
+	}
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		outputAccounts = append(outputAccounts, outputAccount)
+	}
+
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	return outputAccounts
+}
+
+func encodeArguments(arguments [][]byte) []string {
+	encodedArguments := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		encodedArguments = append(encodedArguments, hex.EncodeToString(argument))
+	}
+
+	return encodedArguments
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
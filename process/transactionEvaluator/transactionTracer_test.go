@@ -0,0 +1,348 @@
+package transactionEvaluator
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/data/smartContractResult"
+	"github.com/kalyan3104/k-chain-core-go/data/transaction"
+	"github.com/kalyan3104/k-chain-core-go/data/vm"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/holders"
+	"github.com/kalyan3104/k-chain-go/process"
+	"github.com/kalyan3104/k-chain-go/process/mock"
+	txSimData "github.com/kalyan3104/k-chain-go/process/transactionEvaluator/data"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/economicsmocks"
+	stateMock "github.com/kalyan3104/k-chain-go/testscommon/state"
+	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	expectedErr     = errors.New("expected error")
+	senderAddress   = []byte("sender..........................")
+	contractAddress = []byte("contract........................")
+	calleeAddress   = []byte("callee..........................")
+)
+
+func createTracerArgs(vmOutput *vmcommon.VMOutput) ArgsApiTransactionEvaluator {
+	args := createArgs()
+	_ = args.BlockChain.SetCurrentBlockHeaderAndRootHash(&block.Header{Nonce: 10}, []byte("root"))
+	args.Accounts = &stateMock.SimulationAccountsStub{
+		AccountsStub: stateMock.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return []byte("root hash"), nil
+			},
+		},
+	}
+	args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+			return process.SCInvoking, process.SCInvoking
+		},
+	}
+	args.TxSimulator = &mock.TransactionSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+			return &txSimData.SimulationResultsWithVMOutput{
+				SimulationResults: transaction.SimulationResults{
+					Status: transaction.TxStatusSuccess,
+				},
+				VMOutput: vmOutput,
+			}, nil
+		},
+	}
+
+	return args
+}
+
+func TestApiTransactionEvaluator_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTracerArgs(nil)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		trace, err := tce.TraceTransactionExecution(&transaction.Transaction{}, nil)
+		require.Nil(t, trace)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("incomplete historical execution context should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTracerArgs(nil)
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		trace, err := tce.TraceTransactionExecution(&transaction.Transaction{}, &txSimData.HistoricalExecutionContext{})
+		require.Nil(t, trace)
+		require.Equal(t, ErrNilHistoricalExecutionContext, err)
+	})
+	t.Run("move balance without vm output should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTracerArgs(nil)
+		args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.MoveBalance, process.MoveBalance
+			},
+		}
+		args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+			ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+				return 50000
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		tx := &transaction.Transaction{
+			SndAddr:  senderAddress,
+			RcvAddr:  calleeAddress,
+			Value:    big.NewInt(100),
+			GasLimit: 70000,
+		}
+		trace, err := tce.TraceTransactionExecution(tx, nil)
+		require.Nil(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, trace.Status)
+		require.Equal(t, hex.EncodeToString([]byte("root hash")), trace.RootHash)
+		require.Equal(t, &txSimData.TraceCall{
+			Caller:      hex.EncodeToString(senderAddress),
+			Callee:      hex.EncodeToString(calleeAddress),
+			Value:       "100",
+			CallType:    vm.DirectCall.ToString(),
+			GasProvided: 70000,
+			GasConsumed: 50000,
+		}, trace.Call)
+	})
+	t.Run("should build the calls tree", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput := &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			GasRemaining: 400000,
+			OutputAccounts: map[string]*vmcommon.OutputAccount{
+				string(contractAddress): {
+					Address: contractAddress,
+					GasUsed: 300000,
+					StorageUpdates: map[string]*vmcommon.StorageUpdate{
+						"key": {Offset: []byte("key"), Data: []byte("new"), Written: true},
+						"aaa": {Offset: []byte("aaa"), Data: []byte("read")},
+					},
+				},
+				string(calleeAddress): {
+					Address: calleeAddress,
+					GasUsed: 200000,
+					OutputTransfers: []vmcommon.OutputTransfer{
+						{
+							Index:         1,
+							Value:         big.NewInt(5),
+							GasLimit:      250000,
+							Data:          []byte("doSomething@01"),
+							CallType:      vm.DirectCall,
+							SenderAddress: contractAddress,
+						},
+					},
+				},
+			},
+			Logs: []*vmcommon.LogEntry{
+				{
+					Address:    calleeAddress,
+					Identifier: []byte("event"),
+					Topics:     [][]byte{[]byte("topic")},
+				},
+			},
+		}
+		args := createTracerArgs(vmOutput)
+		args.Accounts = &stateMock.SimulationAccountsStub{
+			AccountsStub: stateMock.AccountsStub{
+				RootHashCalled: func() ([]byte, error) {
+					return []byte("root hash"), nil
+				},
+				GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+					return &stateMock.UserAccountStub{
+						RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+							return []byte("old"), 0, nil
+						},
+					}, nil
+				},
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		tx := &transaction.Transaction{
+			SndAddr:  senderAddress,
+			RcvAddr:  contractAddress,
+			GasLimit: 1000000,
+			Data:     []byte("callOther@0a0b"),
+		}
+		trace, err := tce.TraceTransactionExecution(tx, nil)
+		require.Nil(t, err)
+
+		rootCall := trace.Call
+		require.Equal(t, "callOther", rootCall.Function)
+		require.Equal(t, []string{"0a0b"}, rootCall.Arguments)
+		require.Equal(t, uint64(600000), rootCall.GasConsumed)
+		require.Equal(t, vmcommon.Ok.String(), rootCall.ReturnCode)
+		require.Equal(t, []*txSimData.StorageDiff{
+			{
+				Key:      hex.EncodeToString([]byte("key")),
+				OldValue: hex.EncodeToString([]byte("old")),
+				NewValue: hex.EncodeToString([]byte("new")),
+			},
+		}, rootCall.StorageDiffs)
+
+		require.Len(t, rootCall.Calls, 1)
+		innerCall := rootCall.Calls[0]
+		require.Equal(t, hex.EncodeToString(contractAddress), innerCall.Caller)
+		require.Equal(t, hex.EncodeToString(calleeAddress), innerCall.Callee)
+		require.Equal(t, "doSomething", innerCall.Function)
+		require.Equal(t, []string{"01"}, innerCall.Arguments)
+		require.Equal(t, "5", innerCall.Value)
+		require.Equal(t, uint64(250000), innerCall.GasProvided)
+		require.Equal(t, uint64(200000), innerCall.GasConsumed)
+		require.False(t, innerCall.CrossShard)
+		require.Len(t, innerCall.Logs, 1)
+		require.Equal(t, "event", innerCall.Logs[0].Identifier)
+	})
+	t.Run("historical execution context should use the historical state", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("historical root hash")
+		providedHeader := &block.Header{Nonce: 5}
+		precedingTx := &transaction.Transaction{Nonce: 1}
+		precedingSCR := &smartContractResult.SmartContractResult{Nonce: 3}
+		tracedTx := &transaction.Transaction{Nonce: 2}
+
+		args := createTracerArgs(nil)
+		args.HistoricalAccounts = &stateMock.AccountsStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				require.Equal(t, providedRootHash, options.GetRootHash())
+				return &stateMock.UserAccountStub{Address: address}, nil, nil
+			},
+		}
+		accountsSources := make([]state.AccountsAdapter, 0)
+		args.Accounts = &stateMock.SimulationAccountsStub{
+			AccountsStub: stateMock.AccountsStub{
+				RootHashCalled: func() ([]byte, error) {
+					return providedRootHash, nil
+				},
+			},
+			SetAccountsSourceCalled: func(accountsDB state.AccountsAdapter) {
+				accountsSources = append(accountsSources, accountsDB)
+			},
+		}
+		processedTxs := make([]data.TransactionHandler, 0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, providedHeader, currentHeader)
+				processedTxs = append(processedTxs, tx)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+			ProcessSCRCalled: func(scr *smartContractResult.SmartContractResult, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, providedHeader, currentHeader)
+				processedTxs = append(processedTxs, scr)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		executionContext := &txSimData.HistoricalExecutionContext{
+			BlockHeader:           providedHeader,
+			StateRootHash:         holders.NewRootHashHolder(providedRootHash, core.OptionalUint32{}),
+			PrecedingTransactions: []data.TransactionHandler{precedingTx, precedingSCR},
+		}
+		trace, err := tce.TraceTransactionExecution(tracedTx, executionContext)
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString(providedRootHash), trace.RootHash)
+		require.Equal(t, []data.TransactionHandler{precedingTx, precedingSCR, tracedTx}, processedTxs)
+		require.Len(t, accountsSources, 2)
+		require.Nil(t, accountsSources[1])
+
+		// the accounts are read from the historical state
+		account, err := accountsSources[0].GetExistingAccount(senderAddress)
+		require.Nil(t, err)
+		require.Equal(t, senderAddress, account.AddressBytes())
+	})
+	t.Run("storage diffs should start from the values written by the preceding transactions", func(t *testing.T) {
+		t.Parallel()
+
+		precedingTx := &transaction.Transaction{Nonce: 1}
+		tracedTx := &transaction.Transaction{Nonce: 2, SndAddr: senderAddress, RcvAddr: contractAddress, GasLimit: 1000}
+
+		args := createTracerArgs(nil)
+		args.Accounts = &stateMock.SimulationAccountsStub{
+			AccountsStub: stateMock.AccountsStub{
+				RootHashCalled: func() ([]byte, error) {
+					return []byte("root hash"), nil
+				},
+				GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+					return &stateMock.UserAccountStub{
+						RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+							return []byte("source"), 0, nil
+						},
+					}, nil
+				},
+			},
+		}
+		createVMOutput := func(values map[string]string) *vmcommon.VMOutput {
+			storageUpdates := make(map[string]*vmcommon.StorageUpdate)
+			for key, value := range values {
+				storageUpdates[key] = &vmcommon.StorageUpdate{Offset: []byte(key), Data: []byte(value), Written: true}
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					string(contractAddress): {
+						Address:        contractAddress,
+						StorageUpdates: storageUpdates,
+					},
+				},
+			}
+		}
+		numProcessedTxs := 0
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				numProcessedTxs++
+				vmOutput := createVMOutput(map[string]string{"a": "preceding"})
+				if tx == tracedTx {
+					vmOutput = createVMOutput(map[string]string{"a": "traced", "b": "traced"})
+				}
+
+				return &txSimData.SimulationResultsWithVMOutput{VMOutput: vmOutput}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		executionContext := &txSimData.HistoricalExecutionContext{
+			BlockHeader:           &block.Header{Nonce: 5},
+			StateRootHash:         holders.NewRootHashHolder([]byte("root hash"), core.OptionalUint32{}),
+			PrecedingTransactions: []data.TransactionHandler{precedingTx},
+		}
+		trace, err := tce.TraceTransactionExecution(tracedTx, executionContext)
+		require.Nil(t, err)
+		require.Equal(t, 2, numProcessedTxs)
+		require.Equal(t, []*txSimData.StorageDiff{
+			{
+				Key:      hex.EncodeToString([]byte("a")),
+				OldValue: hex.EncodeToString([]byte("preceding")),
+				NewValue: hex.EncodeToString([]byte("traced")),
+			},
+			{
+				Key:      hex.EncodeToString([]byte("b")),
+				OldValue: hex.EncodeToString([]byte("source")),
+				NewValue: hex.EncodeToString([]byte("traced")),
+			},
+		}, trace.Call.StorageDiffs)
+	})
+}
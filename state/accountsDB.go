@@ -13,6 +13,7 @@ import (
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/core/throttler"
 	"github.com/kalyan3104/k-chain-core-go/hashing"
 	"github.com/kalyan3104/k-chain-core-go/marshal"
 	"github.com/kalyan3104/k-chain-go/common"
@@ -28,6 +29,8 @@ import (
 const (
 	leavesChannelSize       = 100
 	missingNodesChannelSize = 100
+
+	minNumCommitWorkersForConcurrency = 2
 )
 
 type loadingMeasurements struct {
@@ -87,6 +90,9 @@ type AccountsDB struct {
 	mutOp                sync.RWMutex
 	loadCodeMeasurements *loadingMeasurements
 	addressConverter     core.PubkeyConverter
+	commitThrottler      core.Throttler
+	flatState            FlatStateHandler
+	flatStateTracker     *flatStateTracker
 
 	stackDebug []byte
 }
@@ -102,6 +108,11 @@ type ArgsAccountsDB struct {
 	StoragePruningManager StoragePruningManager
 	AddressConverter      core.PubkeyConverter
 	SnapshotsManager      SnapshotsManager
+	FlatState             FlatStateHandler
+
+	// NumCommitWorkers is the maximum number of go routines committing the data tries. Values lower than 2 keep the
+	// data tries committed one after another on the calling go routine
+	NumCommitWorkers uint
 }
 
 // NewAccountsDB creates a new account manager
@@ -130,9 +141,27 @@ func createAccountsDb(args ArgsAccountsDB) *AccountsDB {
 		},
 		addressConverter: args.AddressConverter,
 		snapshotsManger:  args.SnapshotsManager,
+		commitThrottler:  createCommitThrottler(args.NumCommitWorkers),
+		flatState:        args.FlatState,
+		flatStateTracker: newFlatStateTracker(args.FlatState, args.Trie),
 	}
 }
 
+func createCommitThrottler(numCommitWorkers uint) core.Throttler {
+	if numCommitWorkers < minNumCommitWorkersForConcurrency {
+		return nil
+	}
+
+	// the go routine calling commit also commits data tries, so it is not accounted by the throttler
+	commitThrottler, err := throttler.NewNumGoRoutinesThrottler(int32(numCommitWorkers - 1))
+	if err != nil {
+		log.Warn("cannot create the commit throttler, the data tries will be committed sequentially", "error", err)
+		return nil
+	}
+
+	return commitThrottler
+}
+
 func checkArgsAccountsDB(args ArgsAccountsDB) error {
 	if check.IfNil(args.Trie) {
 		return ErrNilTrie
@@ -155,6 +184,9 @@ func checkArgsAccountsDB(args ArgsAccountsDB) error {
 	if check.IfNil(args.SnapshotsManager) {
 		return ErrNilSnapshotsManager
 	}
+	if check.IfNil(args.FlatState) {
+		return ErrNilFlatState
+	}
 
 	return nil
 }
@@ -435,12 +467,26 @@ func (adb *AccountsDB) loadDataTrieConcurrentSafe(accountHandler baseAccountHand
 	if err != nil {
 		return fmt.Errorf("trie was not found for hash, rootHash = %s, err = %w", hex.EncodeToString(accountHandler.GetRootHash()), err)
 	}
+	dataTrie = adb.wrapDataTrieWithFlatState(dataTrie, accountHandler)
 
 	accountHandler.SetDataTrie(dataTrie)
 	adb.dataTries.Put(accountHandler.AddressBytes(), dataTrie)
 	return nil
 }
 
+func (adb *AccountsDB) wrapDataTrieWithFlatState(dataTrie common.Trie, accountHandler baseAccountHandler) common.Trie {
+	if !adb.flatState.IsEnabled() {
+		return dataTrie
+	}
+
+	dtr, ok := dataTrie.(DataTrie)
+	if !ok {
+		return dataTrie
+	}
+
+	return newFlatStateDataTrie(dtr, accountHandler.AddressBytes(), accountHandler.GetRootHash(), adb.flatState)
+}
+
 // SaveDataTrie is used to save the data trie (not committing it) and to recompute the new Root value
 // If data is not dirtied, method will not create its JournalEntries to keep track of data modification
 func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
@@ -491,6 +537,8 @@ func (adb *AccountsDB) saveAccountToTrie(accountHandler vmcommon.AccountHandler,
 		return err
 	}
 
+	adb.flatStateTracker.markModified(accountHandler.AddressBytes())
+
 	return mainTrie.Update(accountHandler.AddressBytes(), buff)
 }
 
@@ -528,6 +576,8 @@ func (adb *AccountsDB) RemoveAccount(address []byte) error {
 		"address", hex.EncodeToString(address),
 	)
 
+	adb.flatStateTracker.markModified(address)
+
 	return adb.mainTrie.Delete(address)
 }
 
@@ -624,7 +674,7 @@ func (adb *AccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, err
 }
 
 func (adb *AccountsDB) getAccount(address []byte, mainTrie common.Trie) (vmcommon.AccountHandler, error) {
-	val, _, err := mainTrie.Get(address)
+	val, err := adb.getAccountBytes(address, mainTrie)
 	if err != nil {
 		return nil, err
 	}
@@ -645,6 +695,24 @@ func (adb *AccountsDB) getAccount(address []byte, mainTrie common.Trie) (vmcommo
 	return acnt, nil
 }
 
+// getAccountBytes serves the head state reads from the flat state, falling back on the main trie
+func (adb *AccountsDB) getAccountBytes(address []byte, mainTrie common.Trie) ([]byte, error) {
+	accountBytes, found, generation := adb.flatStateTracker.getAccount(address, mainTrie)
+	if found {
+		return accountBytes, nil
+	}
+
+	accountBytes, _, err := mainTrie.Get(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// missing accounts are saved as well, so they are not searched again in the trie
+	adb.flatStateTracker.putAccount(address, accountBytes, mainTrie, generation)
+
+	return accountBytes, nil
+}
+
 // GetExistingAccount returns an existing account if exists or nil if missing
 func (adb *AccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
 	if len(address) == 0 {
@@ -823,19 +891,16 @@ func (adb *AccountsDB) commit() ([]byte, error) {
 	oldHashes := make(common.ModifiedHashes)
 	newHashes := make(common.ModifiedHashes)
 	// Step 1. commit all data tries
-	dataTries := adb.dataTries.GetAll()
-	for i := 0; i < len(dataTries); i++ {
-		err := adb.commitTrie(dataTries[i], oldHashes, newHashes)
-		if err != nil {
-			return nil, err
-		}
+	err := adb.commitDataTries(oldHashes, newHashes)
+	if err != nil {
+		return nil, err
 	}
 	adb.dataTries.Reset()
 
 	oldRoot := adb.mainTrie.GetOldRoot()
 
 	// Step 2. commit main trie
-	err := adb.commitTrie(adb.mainTrie, oldHashes, newHashes)
+	err = adb.commitTrie(adb.mainTrie, oldHashes, newHashes)
 	if err != nil {
 		return nil, err
 	}
@@ -853,6 +918,7 @@ func (adb *AccountsDB) commit() ([]byte, error) {
 
 	adb.lastRootHash = newRoot
 	adb.obsoleteDataTrieHashes = make(map[string][][]byte)
+	adb.flatStateTracker.commit(newRoot)
 
 	log.Trace("accountsDB.Commit ended", "root hash", newRoot)
 
@@ -900,6 +966,69 @@ func (adb *AccountsDB) commitTrie(tr common.Trie, oldHashes common.ModifiedHashe
 	return tr.Commit()
 }
 
+func (adb *AccountsDB) commitDataTries(oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
+	dataTries := adb.dataTries.GetAll()
+	if check.IfNil(adb.commitThrottler) {
+		for i := 0; i < len(dataTries); i++ {
+			err := adb.commitTrie(dataTries[i], oldHashes, newHashes)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// the data tries share no nodes, so they are committed concurrently, each one collecting the modified hashes in
+	// its own maps, merged afterwards
+	var wg sync.WaitGroup
+	mutResults := sync.Mutex{}
+	var commitErr error
+	commitDataTrie := func(dataTrie common.Trie) {
+		dataTrieOldHashes := make(common.ModifiedHashes)
+		dataTrieNewHashes := make(common.ModifiedHashes)
+		err := adb.commitTrie(dataTrie, dataTrieOldHashes, dataTrieNewHashes)
+
+		mutResults.Lock()
+		defer mutResults.Unlock()
+
+		if err != nil {
+			if commitErr == nil {
+				commitErr = err
+			}
+			return
+		}
+
+		for hash := range dataTrieOldHashes {
+			oldHashes[hash] = struct{}{}
+		}
+		for hash := range dataTrieNewHashes {
+			newHashes[hash] = struct{}{}
+		}
+	}
+
+	for _, dataTrie := range dataTries {
+		if !adb.commitThrottler.CanProcess() {
+			commitDataTrie(dataTrie)
+			continue
+		}
+
+		wg.Add(1)
+		adb.commitThrottler.StartProcessing()
+		go func(tr common.Trie) {
+			defer func() {
+				adb.commitThrottler.EndProcessing()
+				wg.Done()
+			}()
+
+			commitDataTrie(tr)
+		}(dataTrie)
+	}
+	wg.Wait()
+
+	return commitErr
+}
+
 // RootHash returns the main trie's root hash
 func (adb *AccountsDB) RootHash() ([]byte, error) {
 	rootHash, err := adb.getMainTrie().RootHash()
@@ -948,6 +1077,8 @@ func (adb *AccountsDB) recreateTrie(options common.RootHashHolder) error {
 	}
 
 	adb.mainTrie = newTrie
+	adb.flatStateTracker.recreate(newTrie, options.GetRootHash())
+
 	return nil
 }
 
@@ -120,9 +120,10 @@ func (accountsDB *accountsDBApiWithHistory) IsPruningEnabled() bool {
 	return false
 }
 
-// GetAllLeaves will return an error
-func (accountsDB *accountsDBApiWithHistory) GetAllLeaves(_ *common.TrieIteratorChannels, _ context.Context, _ []byte, _ common.TrieLeafParser) error {
-	return ErrOperationNotPermitted
+// GetAllLeaves will call the inner accountsAdapter method. The main trie is recreated from the provided root hash
+// by the inner implementation, so the latest recreated root hash is not altered
+func (accountsDB *accountsDBApiWithHistory) GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+	return accountsDB.innerAccountsAdapter.GetAllLeaves(leavesChannels, ctx, rootHash, trieLeafParser)
 }
 
 // RecreateAllTries is a not permitted operation in this implementation and thus, will return an error
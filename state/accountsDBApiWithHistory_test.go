@@ -2,6 +2,7 @@ package state_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -83,7 +84,6 @@ func TestAccountsDBApiWithHistory_NotPermittedOrNotImplementedOperationsDoNotPan
 	accountsApi.SnapshotState(nil, 0)
 
 	assert.Equal(t, false, accountsApi.IsPruningEnabled())
-	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.GetAllLeaves(&common.TrieIteratorChannels{}, nil, nil, nil))
 
 	resultedMap, err := accountsApi.RecreateAllTries(nil)
 	assert.Nil(t, resultedMap)
@@ -233,6 +233,32 @@ func TestAccountsDBApiWithHistory_GetCodeWithBlockInfo(t *testing.T) {
 	})
 }
 
+func TestAccountsDBApiWithHistory_GetAllLeaves(t *testing.T) {
+	t.Parallel()
+
+	providedRootHash := []byte("rootHash")
+	providedChannels := &common.TrieIteratorChannels{}
+	expectedErr := errors.New("expected error")
+	recreateTrieCalled := false
+
+	accountsAdapter := &mockState.AccountsStub{
+		RecreateTrieFromEpochCalled: func(_ common.RootHashHolder) error {
+			recreateTrieCalled = true
+			return nil
+		},
+		GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			assert.True(t, leavesChannels == providedChannels) // pointer testing
+			assert.Equal(t, providedRootHash, rootHash)
+			return expectedErr
+		},
+	}
+
+	accountsApi, _ := state.NewAccountsDBApiWithHistory(accountsAdapter)
+	err := accountsApi.GetAllLeaves(providedChannels, context.Background(), providedRootHash, nil)
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, recreateTrieCalled)
+}
+
 func TestAccountsDBApiWithHistory_GetAccountWithBlockInfoWhenHighConcurrency(t *testing.T) {
 	numTestRuns := 16
 	numRootHashes := 128
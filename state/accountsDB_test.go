@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"math/big"
 	mathRand "math/rand"
 	"strings"
 	"sync"
@@ -25,7 +26,9 @@ import (
 	"github.com/kalyan3104/k-chain-go/process/mock"
 	"github.com/kalyan3104/k-chain-go/state"
 	"github.com/kalyan3104/k-chain-go/state/accounts"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	"github.com/kalyan3104/k-chain-go/state/factory"
+	flatStateLayer "github.com/kalyan3104/k-chain-go/state/flatState"
 	"github.com/kalyan3104/k-chain-go/state/iteratorChannelsProvider"
 	"github.com/kalyan3104/k-chain-go/state/lastSnapshotMarker"
 	"github.com/kalyan3104/k-chain-go/state/parsers"
@@ -57,15 +60,16 @@ func createMockAccountsDBArgs() state.ArgsAccountsDB {
 	}
 
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           &marshallerMock.MarshalizerMock{},
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &stateMock.StateMetricsStub{},
-		AccountFactory:       accCreator,
-		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &stateMock.StateMetricsStub{},
+		AccountFactory:           accCreator,
+		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &stateMock.PortableSnapshotExporterStub{},
 	})
 
 	return state.ArgsAccountsDB{
@@ -80,6 +84,7 @@ func createMockAccountsDBArgs() state.ArgsAccountsDB {
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 }
 
@@ -122,6 +127,30 @@ func getDefaultTrieAndAccountsDbWithCustomDB(db common.BaseStorer) (common.Trie,
 func getDefaultStateComponents(
 	db common.BaseStorer,
 	enableEpochsHandler common.EnableEpochsHandler,
+) (*state.AccountsDB, common.Trie, common.StorageManager) {
+	return getDefaultStateComponentsWithCommitWorkers(db, enableEpochsHandler, 0)
+}
+
+func getDefaultStateComponentsWithCommitWorkers(
+	db common.BaseStorer,
+	enableEpochsHandler common.EnableEpochsHandler,
+	numCommitWorkers uint,
+) (*state.AccountsDB, common.Trie, common.StorageManager) {
+	return createStateComponents(db, enableEpochsHandler, numCommitWorkers, disabledState.NewDisabledFlatState())
+}
+
+func getDefaultStateComponentsWithFlatState(
+	db common.BaseStorer,
+	flatState state.FlatStateHandler,
+) (*state.AccountsDB, common.Trie, common.StorageManager) {
+	return createStateComponents(db, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 0, flatState)
+}
+
+func createStateComponents(
+	db common.BaseStorer,
+	enableEpochsHandler common.EnableEpochsHandler,
+	numCommitWorkers uint,
+	flatState state.FlatStateHandler,
 ) (*state.AccountsDB, common.Trie, common.StorageManager) {
 	generalCfg := config.TrieStorageManagerConfig{
 		PruningBufferLen:      1000,
@@ -149,15 +178,16 @@ func getDefaultStateComponents(
 	accCreator, _ := factory.NewAccountCreator(argsAccCreator)
 
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           marshaller,
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &stateMock.StateMetricsStub{},
-		AccountFactory:       accCreator,
-		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               marshaller,
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &stateMock.StateMetricsStub{},
+		AccountFactory:           accCreator,
+		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &stateMock.PortableSnapshotExporterStub{},
 	})
 
 	argsAccountsDB := state.ArgsAccountsDB{
@@ -168,6 +198,8 @@ func getDefaultStateComponents(
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             flatState,
+		NumCommitWorkers:      numCommitWorkers,
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -247,6 +279,16 @@ func TestNewAccountsDB(t *testing.T) {
 		assert.True(t, check.IfNil(adb))
 		assert.Equal(t, state.ErrNilSnapshotsManager, err)
 	})
+	t.Run("nil flat state should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockAccountsDBArgs()
+		args.FlatState = nil
+
+		adb, err := state.NewAccountsDB(args)
+		assert.True(t, check.IfNil(adb))
+		assert.Equal(t, state.ErrNilFlatState, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -910,6 +952,259 @@ func TestAccountsDB_CommitShouldCallCommitFromTrie(t *testing.T) {
 	assert.Equal(t, 2, commitCalled)
 }
 
+func TestAccountsDB_CommitWithCommitWorkersShouldCommitAllTries(t *testing.T) {
+	t.Parallel()
+
+	numCommitCalls := atomic.Counter{}
+	commitCalled := func() error {
+		numCommitCalls.Increment()
+		return nil
+	}
+	marshaller := &marshallerMock.MarshalizerMock{}
+	serializedAccount, _ := marshaller.Marshal(stateMock.AccountWrapMock{})
+	trieStub := trieMock.TrieStub{
+		CommitCalled: commitCalled,
+		RootCalled: func() (i []byte, e error) {
+			return nil, nil
+		},
+		GetCalled: func(_ []byte) ([]byte, uint32, error) {
+			return serializedAccount, 0, nil
+		},
+		RecreateCalled: func(root []byte) (trie common.Trie, err error) {
+			return &trieMock.TrieStub{
+				GetCalled: func(_ []byte) ([]byte, uint32, error) {
+					return []byte("doge"), 0, nil
+				},
+				UpdateWithVersionCalled: func(key, value []byte, version core.TrieNodeVersion) error {
+					return nil
+				},
+				CommitCalled: commitCalled,
+				RootCalled: func() ([]byte, error) {
+					return nil, nil
+				},
+			}, nil
+		},
+		GetStorageManagerCalled: func() common.StorageManager {
+			return &storageManager.StorageManagerStub{}
+		},
+	}
+
+	args := createMockAccountsDBArgs()
+	args.Trie = &trieStub
+	args.NumCommitWorkers = 4
+	adb, _ := state.NewAccountsDB(args)
+
+	for i := 0; i < 10; i++ {
+		accnt, _ := adb.LoadAccount(bytes.Repeat([]byte{byte(i)}, 32))
+		_ = accnt.(state.UserAccountHandler).SaveKeyValue([]byte("dog"), []byte("puppy"))
+		_ = adb.SaveAccount(accnt)
+	}
+
+	_, err := adb.Commit()
+	assert.Nil(t, err)
+	// one commit for each data trie and one commit for the main trie
+	assert.Equal(t, int64(11), numCommitCalls.Get())
+}
+
+func TestAccountsDB_CommitWithCommitWorkersShouldNotChangeTheRootHash(t *testing.T) {
+	t.Parallel()
+
+	numAccounts := 100
+	numKeysPerAccount := 50
+	commitAccounts := func(numCommitWorkers uint) ([]byte, *state.AccountsDB) {
+		adb, _, _ := getDefaultStateComponentsWithCommitWorkers(
+			testscommon.NewSnapshotPruningStorerMock(),
+			&enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+			numCommitWorkers,
+		)
+
+		for i := 0; i < numAccounts; i++ {
+			accnt, _ := adb.LoadAccount([]byte(fmt.Sprintf("address%d", i)))
+			userAccount := accnt.(state.UserAccountHandler)
+			for j := 0; j < numKeysPerAccount; j++ {
+				_ = userAccount.SaveKeyValue([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d-%d", i, j)))
+			}
+			_ = adb.SaveAccount(userAccount)
+		}
+
+		rootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		return rootHash, adb
+	}
+
+	sequentialRootHash, _ := commitAccounts(0)
+	concurrentRootHash, adb := commitAccounts(8)
+	assert.Equal(t, sequentialRootHash, concurrentRootHash)
+
+	// the data tries nodes should have been persisted
+	err := adb.RecreateTrie(concurrentRootHash)
+	require.Nil(t, err)
+	accnt, err := adb.GetExistingAccount([]byte("address7"))
+	require.Nil(t, err)
+	value, _, err := accnt.(state.UserAccountHandler).RetrieveValue([]byte("key3"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value7-3"), value)
+}
+
+func createAccountsDBWithFlatState(t *testing.T) (*state.AccountsDB, state.FlatStateHandler) {
+	flatState, err := flatStateLayer.NewFlatState(flatStateLayer.ArgsFlatState{
+		AccountsCacheCapacity:        100,
+		DataTriesValuesCacheCapacity: 100,
+	})
+	require.Nil(t, err)
+
+	adb, _, _ := getDefaultStateComponentsWithFlatState(testscommon.NewSnapshotPruningStorerMock(), flatState)
+
+	return adb, flatState
+}
+
+func saveAccountWithBalance(t *testing.T, adb *state.AccountsDB, address []byte, balance int64) []byte {
+	accnt, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+	userAccount := accnt.(state.UserAccountHandler)
+	_ = userAccount.SubFromBalance(userAccount.GetBalance())
+	_ = userAccount.AddToBalance(big.NewInt(balance))
+	err = adb.SaveAccount(userAccount)
+	require.Nil(t, err)
+
+	accountBytes, err := (&marshallerMock.MarshalizerMock{}).Marshal(userAccount)
+	require.Nil(t, err)
+
+	return accountBytes
+}
+
+func requireBalance(t *testing.T, adb *state.AccountsDB, address []byte, balance int64) {
+	accnt, err := adb.GetExistingAccount(address)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(balance), accnt.(state.UserAccountHandler).GetBalance())
+}
+
+func TestAccountsDB_FlatState(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+
+	t.Run("head state reads should be saved in and served from the flat state", func(t *testing.T) {
+		t.Parallel()
+
+		adb, flatState := createAccountsDBWithFlatState(t)
+		_ = saveAccountWithBalance(t, adb, address, 10)
+		rootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		requireBalance(t, adb, address, 10)
+		_, err = adb.GetExistingAccount([]byte("missing address"))
+		assert.Equal(t, state.ErrAccNotFound, err)
+
+		accountBytes, found := flatState.GetAccount(rootHash, address)
+		assert.True(t, found)
+		assert.NotEmpty(t, accountBytes)
+		accountBytes, found = flatState.GetAccount(rootHash, []byte("missing address"))
+		assert.True(t, found)
+		assert.Nil(t, accountBytes)
+
+		// a value held only by the flat state proves that the trie is not walked
+		otherAccountBytes := saveAccountWithBalance(t, adb, []byte("other address"), 42)
+		_ = adb.RevertToSnapshot(0)
+		flatState.PutAccount(rootHash, address, otherAccountBytes)
+		requireBalance(t, adb, address, 42)
+	})
+	t.Run("modified accounts should not be served from the flat state until committed", func(t *testing.T) {
+		t.Parallel()
+
+		adb, flatState := createAccountsDBWithFlatState(t)
+		_ = saveAccountWithBalance(t, adb, address, 10)
+		rootHash, _ := adb.Commit()
+		requireBalance(t, adb, address, 10)
+
+		_ = saveAccountWithBalance(t, adb, address, 15)
+		requireBalance(t, adb, address, 15)
+		accountBytes, _ := flatState.GetAccount(rootHash, address)
+		assert.NotEmpty(t, accountBytes)
+
+		newRootHash, err := adb.Commit()
+		require.Nil(t, err)
+		_, found := flatState.GetAccount(newRootHash, address)
+		assert.False(t, found)
+
+		requireBalance(t, adb, address, 15)
+		_, found = flatState.GetAccount(newRootHash, address)
+		assert.True(t, found)
+	})
+	t.Run("removed accounts should not be served from the flat state", func(t *testing.T) {
+		t.Parallel()
+
+		adb, _ := createAccountsDBWithFlatState(t)
+		_ = saveAccountWithBalance(t, adb, address, 10)
+		_, _ = adb.Commit()
+		requireBalance(t, adb, address, 10)
+
+		err := adb.RemoveAccount(address)
+		require.Nil(t, err)
+		_, err = adb.GetExistingAccount(address)
+		assert.Equal(t, state.ErrAccNotFound, err)
+
+		_, _ = adb.Commit()
+		_, err = adb.GetExistingAccount(address)
+		assert.Equal(t, state.ErrAccNotFound, err)
+	})
+	t.Run("revert and recreate should not serve the reverted values", func(t *testing.T) {
+		t.Parallel()
+
+		adb, flatState := createAccountsDBWithFlatState(t)
+		_ = saveAccountWithBalance(t, adb, address, 10)
+		rootHash, _ := adb.Commit()
+		requireBalance(t, adb, address, 10)
+
+		_ = saveAccountWithBalance(t, adb, address, 15)
+		requireBalance(t, adb, address, 15)
+		err := adb.RevertToSnapshot(0)
+		require.Nil(t, err)
+		requireBalance(t, adb, address, 10)
+
+		_ = saveAccountWithBalance(t, adb, address, 20)
+		newRootHash, _ := adb.Commit()
+		requireBalance(t, adb, address, 20)
+
+		err = adb.RecreateTrie(rootHash)
+		require.Nil(t, err)
+		requireBalance(t, adb, address, 10)
+		_, found := flatState.GetAccount(newRootHash, address)
+		assert.False(t, found)
+	})
+	t.Run("data tries values should be served from the flat state", func(t *testing.T) {
+		t.Parallel()
+
+		adb, flatState := createAccountsDBWithFlatState(t)
+		accnt, _ := adb.LoadAccount(address)
+		_ = accnt.(state.UserAccountHandler).SaveKeyValue([]byte("key"), []byte("value"))
+		_ = adb.SaveAccount(accnt)
+		_, err := adb.Commit()
+		require.Nil(t, err)
+
+		accnt, _ = adb.GetExistingAccount(address)
+		userAccount := accnt.(state.UserAccountHandler)
+		value, depth, err := userAccount.RetrieveValue([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		_, flatStateDepth, found := flatState.GetDataTrieValue(address, userAccount.GetRootHash(), []byte("key"))
+		assert.True(t, found)
+		assert.Equal(t, depth, flatStateDepth)
+
+		_ = userAccount.SaveKeyValue([]byte("key"), []byte("new value"))
+		_ = adb.SaveAccount(userAccount)
+		_, err = adb.Commit()
+		require.Nil(t, err)
+
+		accnt, _ = adb.GetExistingAccount(address)
+		value, _, err = accnt.(state.UserAccountHandler).RetrieveValue([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("new value"), value)
+	})
+}
+
 // ------- RecreateTrie
 
 func TestAccountsDB_RecreateTrieMalfunctionTrieShouldErr(t *testing.T) {
@@ -2451,15 +2746,16 @@ func TestAccountsDB_SetSyncerAndStartSnapshotIfNeeded(t *testing.T) {
 
 		args := createMockAccountsDBArgs()
 		args.SnapshotsManager, _ = state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-			ProcessingMode:       common.ImportDb,
-			Marshaller:           &marshallerMock.MarshalizerMock{},
-			AddressConverter:     &testscommon.PubkeyConverterMock{},
-			ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-			StateMetrics:         &stateMock.StateMetricsStub{},
-			AccountFactory:       args.AccountFactory,
-			ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-			LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-			StateStatsHandler:    statistics.NewStateStatistics(),
+			ProcessingMode:           common.ImportDb,
+			Marshaller:               &marshallerMock.MarshalizerMock{},
+			AddressConverter:         &testscommon.PubkeyConverterMock{},
+			ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+			StateMetrics:             &stateMock.StateMetricsStub{},
+			AccountFactory:           args.AccountFactory,
+			ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+			LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+			StateStatsHandler:        statistics.NewStateStatistics(),
+			PortableSnapshotExporter: &stateMock.PortableSnapshotExporterStub{},
 		})
 		args.Trie = trieStub
 
@@ -3070,3 +3366,43 @@ func testAccountLoadInParallel(
 
 	wg.Wait()
 }
+
+func BenchmarkAccountsDB_CommitWithCommitWorkers(b *testing.B) {
+	numAccounts := 2000
+	numKeysPerAccount := 20
+	for _, numCommitWorkers := range []uint{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("%d commit workers", numCommitWorkers), func(b *testing.B) {
+			adb, _, _ := getDefaultStateComponentsWithCommitWorkers(
+				testscommon.NewSnapshotPruningStorerMock(),
+				&enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+				numCommitWorkers,
+			)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				modifyAccountsDataTries(b, adb, i, numAccounts, numKeysPerAccount)
+				b.StartTimer()
+
+				_, err := adb.Commit()
+				require.Nil(b, err)
+			}
+		})
+	}
+}
+
+func modifyAccountsDataTries(b *testing.B, adb state.AccountsAdapter, round int, numAccounts int, numKeysPerAccount int) {
+	for i := 0; i < numAccounts; i++ {
+		accnt, err := adb.LoadAccount([]byte(fmt.Sprintf("address%d", i)))
+		require.Nil(b, err)
+
+		userAccount := accnt.(state.UserAccountHandler)
+		for j := 0; j < numKeysPerAccount; j++ {
+			err = userAccount.SaveKeyValue([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d-%d", round, j)))
+			require.Nil(b, err)
+		}
+
+		err = adb.SaveAccount(userAccount)
+		require.Nil(b, err)
+	}
+}
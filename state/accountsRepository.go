@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/kalyan3104/k-chain-core-go/core/check"
@@ -64,6 +65,30 @@ func (repository *accountsRepository) GetCodeWithBlockInfo(codeHash []byte, opti
 	return accountsAdapter.GetCodeWithBlockInfo(codeHash, convertedOptions)
 }
 
+// GetAllLeaves will push on the provided channels all the main trie leaves for the root hash defined in the query option.
+// If the query option does not define a root hash, the root hash of the selected accounts wrapper will be used
+func (repository *accountsRepository) GetAllLeaves(
+	leavesChannels *common.TrieIteratorChannels,
+	ctx context.Context,
+	options api.AccountQueryOptions,
+	trieLeafParser common.TrieLeafParser,
+) error {
+	accountsAdapter, err := repository.selectStateAccounts(options)
+	if err != nil {
+		return err
+	}
+
+	rootHash := options.BlockRootHash
+	if len(rootHash) == 0 {
+		rootHash, err = accountsAdapter.RootHash()
+		if err != nil {
+			return err
+		}
+	}
+
+	return accountsAdapter.GetAllLeaves(leavesChannels, ctx, rootHash, trieLeafParser)
+}
+
 func (repository *accountsRepository) selectStateAccounts(options api.AccountQueryOptions) (AccountsAdapterAPI, error) {
 	if len(options.BlockRootHash) > 0 {
 		return repository.historicalStateAccountsWrapper, nil
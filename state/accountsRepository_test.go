@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -214,6 +215,71 @@ func TestAccountsRepository_GetCodeWithBlockInfo(t *testing.T) {
 	})
 }
 
+func TestAccountsRepository_GetAllLeaves(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	leavesChannels := &common.TrieIteratorChannels{}
+
+	t.Run("on historical state should use the provided root hash", func(t *testing.T) {
+		var providedRootHash []byte
+		args := createMockArgsAccountsRepository()
+		args.HistoricalStateAccountsWrapper = &mockState.AccountsStub{
+			GetAllLeavesCalled: func(channels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+				assert.True(t, channels == leavesChannels) // pointer testing
+				providedRootHash = rootHash
+				return nil
+			},
+		}
+		repository, _ := state.NewAccountsRepository(args)
+
+		err := repository.GetAllLeaves(leavesChannels, context.Background(), testApiOptOnHistorical, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, testApiOptOnHistorical.BlockRootHash, providedRootHash)
+	})
+	t.Run("on final state should use the root hash of the accounts wrapper", func(t *testing.T) {
+		var providedRootHash []byte
+		args := createMockArgsAccountsRepository()
+		args.FinalStateAccountsWrapper = &mockState.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return []byte("final root hash"), nil
+			},
+			GetAllLeavesCalled: func(channels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+				providedRootHash = rootHash
+				return nil
+			},
+		}
+		repository, _ := state.NewAccountsRepository(args)
+
+		err := repository.GetAllLeaves(leavesChannels, context.Background(), testApiOptOnFinal, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("final root hash"), providedRootHash)
+	})
+	t.Run("root hash of the accounts wrapper errors should error", func(t *testing.T) {
+		args := createMockArgsAccountsRepository()
+		args.CurrentStateAccountsWrapper = &mockState.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return nil, expectedErr
+			},
+			GetAllLeavesCalled: func(channels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		repository, _ := state.NewAccountsRepository(args)
+
+		err := repository.GetAllLeaves(leavesChannels, context.Background(), testApiOptOnCurrent, nil)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("on start of epoch should error", func(t *testing.T) {
+		args := createMockArgsAccountsRepository()
+		repository, _ := state.NewAccountsRepository(args)
+
+		err := repository.GetAllLeaves(leavesChannels, context.Background(), testApiOptOnStartOfEpoch, nil)
+		assert.Equal(t, state.ErrFunctionalityNotImplemented, err)
+	})
+}
+
 func TestAccountsRepository_GetCurrentStateAccountsWrapper(t *testing.T) {
 	t.Parallel()
 
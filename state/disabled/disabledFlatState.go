@@ -0,0 +1,49 @@
+package disabled
+
+import (
+	"github.com/kalyan3104/k-chain-go/state"
+)
+
+type disabledFlatState struct {
+}
+
+// NewDisabledFlatState creates a new disabled flat state, all the reads being served by the tries
+func NewDisabledFlatState() state.FlatStateHandler {
+	return &disabledFlatState{}
+}
+
+// GetAccount returns false for this implementation
+func (d *disabledFlatState) GetAccount(_ []byte, _ []byte) ([]byte, bool) {
+	return nil, false
+}
+
+// PutAccount does nothing for this implementation
+func (d *disabledFlatState) PutAccount(_ []byte, _ []byte, _ []byte) {
+}
+
+// GetDataTrieValue returns false for this implementation
+func (d *disabledFlatState) GetDataTrieValue(_ []byte, _ []byte, _ []byte) ([]byte, uint32, bool) {
+	return nil, 0, false
+}
+
+// PutDataTrieValue does nothing for this implementation
+func (d *disabledFlatState) PutDataTrieValue(_ []byte, _ []byte, _ []byte, _ []byte, _ uint32) {
+}
+
+// Commit does nothing for this implementation
+func (d *disabledFlatState) Commit(_ []byte, _ []byte, _ [][]byte) {
+}
+
+// Reset does nothing for this implementation
+func (d *disabledFlatState) Reset(_ []byte) {
+}
+
+// IsEnabled returns false for this implementation
+func (d *disabledFlatState) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledFlatState) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/state"
+)
+
+type disabledPortableSnapshotExporter struct {
+}
+
+// NewDisabledPortableSnapshotExporter creates a new disabled portable snapshot exporter
+func NewDisabledPortableSnapshotExporter() state.PortableSnapshotExporter {
+	return &disabledPortableSnapshotExporter{}
+}
+
+// ExportSnapshot returns nil for this implementation
+func (d *disabledPortableSnapshotExporter) ExportSnapshot(_ []byte, _ uint32, _ common.StorageManager) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledPortableSnapshotExporter) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -165,3 +165,9 @@ var ErrValidatorsDifferentShards = errors.New("validators are not in the same sh
 
 // ErrValidatorNotFound signals that a validator was not found
 var ErrValidatorNotFound = errors.New("validator not found")
+
+// ErrNilPortableSnapshotExporter signals that a nil portable snapshot exporter has been provided
+var ErrNilPortableSnapshotExporter = errors.New("nil portable snapshot exporter")
+
+// ErrNilFlatState signals that a nil flat state has been provided
+var ErrNilFlatState = errors.New("nil flat state")
@@ -7,6 +7,7 @@ import (
 	"github.com/kalyan3104/k-chain-core-go/core/check"
 	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/state"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	"github.com/kalyan3104/k-chain-go/testscommon"
 	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
 	mockState "github.com/kalyan3104/k-chain-go/testscommon/state"
@@ -28,6 +29,7 @@ func createMockAccountsArgs() state.ArgsAccountsDB {
 		StoragePruningManager: &mockState.StoragePruningManagerStub{},
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      &mockState.SnapshotsManagerStub{},
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 }
 
@@ -0,0 +1,9 @@
+package flatState
+
+import "errors"
+
+// ErrInvalidAccountsCacheCapacity signals that an invalid accounts cache capacity was provided
+var ErrInvalidAccountsCacheCapacity = errors.New("invalid accounts cache capacity")
+
+// ErrInvalidDataTriesValuesCacheCapacity signals that an invalid data tries values cache capacity was provided
+var ErrInvalidDataTriesValuesCacheCapacity = errors.New("invalid data tries values cache capacity")
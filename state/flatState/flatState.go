@@ -0,0 +1,182 @@
+package flatState
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/cache"
+)
+
+var _ state.FlatStateHandler = (*flatState)(nil)
+
+// ArgsFlatState holds the arguments needed to create a flat state
+type ArgsFlatState struct {
+	AccountsCacheCapacity        uint32
+	DataTriesValuesCacheCapacity uint32
+}
+
+type accountEntry struct {
+	accountBytes []byte
+}
+
+type dataTrieValueEntry struct {
+	dataTrieRootHash []byte
+	value            []byte
+	depth            uint32
+}
+
+type flatState struct {
+	mutState        sync.RWMutex
+	rootHash        []byte
+	accounts        storage.Cacher
+	dataTriesValues storage.Cacher
+}
+
+// NewFlatState creates a new flat state, a key-value layer kept beside the accounts trie which serves the head
+// state reads without walking the tries. The accounts are kept for the root hash of the head state and are
+// dropped as soon as they are modified, while the data tries values are kept together with the data trie root
+// hash they were read from, so they are never served for another version of the data trie
+func NewFlatState(args ArgsFlatState) (*flatState, error) {
+	if args.AccountsCacheCapacity == 0 {
+		return nil, ErrInvalidAccountsCacheCapacity
+	}
+	if args.DataTriesValuesCacheCapacity == 0 {
+		return nil, ErrInvalidDataTriesValuesCacheCapacity
+	}
+
+	accounts, err := cache.NewLRUCache(int(args.AccountsCacheCapacity))
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the accounts cache", err)
+	}
+	dataTriesValues, err := cache.NewLRUCache(int(args.DataTriesValuesCacheCapacity))
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the data tries values cache", err)
+	}
+
+	return &flatState{
+		accounts:        accounts,
+		dataTriesValues: dataTriesValues,
+	}, nil
+}
+
+// GetAccount returns the account bytes held for the given address, if the provided root hash is the one of the
+// state held by the flat state. A nil account bytes slice means that the account does not exist in that state
+func (fs *flatState) GetAccount(rootHash []byte, address []byte) ([]byte, bool) {
+	fs.mutState.RLock()
+	defer fs.mutState.RUnlock()
+
+	if !fs.isCurrentRootHash(rootHash) {
+		return nil, false
+	}
+
+	value, found := fs.accounts.Get(address)
+	if !found {
+		return nil, false
+	}
+	entry, ok := value.(*accountEntry)
+	if !ok {
+		return nil, false
+	}
+
+	return entry.accountBytes, true
+}
+
+// PutAccount saves the account bytes read from the trie, if the provided root hash is the one of the state held by
+// the flat state
+func (fs *flatState) PutAccount(rootHash []byte, address []byte, accountBytes []byte) {
+	fs.mutState.Lock()
+	defer fs.mutState.Unlock()
+
+	if !fs.isCurrentRootHash(rootHash) {
+		return
+	}
+
+	fs.accounts.Put(address, &accountEntry{accountBytes: accountBytes}, len(address)+len(accountBytes))
+}
+
+// GetDataTrieValue returns the value and the depth held for the given key of the account's data trie, if it was
+// read from the data trie having the provided root hash
+func (fs *flatState) GetDataTrieValue(address []byte, dataTrieRootHash []byte, key []byte) ([]byte, uint32, bool) {
+	value, found := fs.dataTriesValues.Get(createDataTrieValueKey(address, key))
+	if !found {
+		return nil, 0, false
+	}
+	entry, ok := value.(*dataTrieValueEntry)
+	if !ok || !bytes.Equal(entry.dataTrieRootHash, dataTrieRootHash) {
+		return nil, 0, false
+	}
+
+	return entry.value, entry.depth, true
+}
+
+// PutDataTrieValue saves the value and the depth read for the given key from the account's data trie having the
+// provided root hash
+func (fs *flatState) PutDataTrieValue(address []byte, dataTrieRootHash []byte, key []byte, value []byte, depth uint32) {
+	if len(dataTrieRootHash) == 0 {
+		return
+	}
+
+	entry := &dataTrieValueEntry{
+		dataTrieRootHash: dataTrieRootHash,
+		value:            value,
+		depth:            depth,
+	}
+	valueKey := createDataTrieValueKey(address, key)
+	fs.dataTriesValues.Put(valueKey, entry, len(valueKey)+len(dataTrieRootHash)+len(value))
+}
+
+// Commit moves the flat state to the new root hash. If the old root hash is the one held by the flat state, only
+// the modified accounts are dropped, otherwise all the accounts are dropped
+func (fs *flatState) Commit(oldRootHash []byte, newRootHash []byte, modifiedAddresses [][]byte) {
+	fs.mutState.Lock()
+	defer fs.mutState.Unlock()
+
+	if !fs.isCurrentRootHash(oldRootHash) {
+		fs.accounts.Clear()
+		fs.rootHash = newRootHash
+		return
+	}
+
+	for _, address := range modifiedAddresses {
+		fs.accounts.Remove(address)
+	}
+	fs.rootHash = newRootHash
+}
+
+// Reset moves the flat state to the provided root hash, dropping all the accounts if the root hash differs from
+// the one held by the flat state
+func (fs *flatState) Reset(rootHash []byte) {
+	fs.mutState.Lock()
+	defer fs.mutState.Unlock()
+
+	if fs.isCurrentRootHash(rootHash) {
+		return
+	}
+
+	fs.accounts.Clear()
+	fs.rootHash = rootHash
+}
+
+func (fs *flatState) isCurrentRootHash(rootHash []byte) bool {
+	return len(rootHash) > 0 && bytes.Equal(fs.rootHash, rootHash)
+}
+
+func createDataTrieValueKey(address []byte, key []byte) []byte {
+	valueKey := make([]byte, 0, len(address)+len(key))
+	valueKey = append(valueKey, address...)
+
+	return append(valueKey, key...)
+}
+
+// IsEnabled returns true for this implementation
+func (fs *flatState) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fs *flatState) IsInterfaceNil() bool {
+	return fs == nil
+}
@@ -0,0 +1,187 @@
+package flatState
+
+import (
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsFlatState() ArgsFlatState {
+	return ArgsFlatState{
+		AccountsCacheCapacity:        100,
+		DataTriesValuesCacheCapacity: 100,
+	}
+}
+
+func TestNewFlatState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid accounts cache capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFlatState()
+		args.AccountsCacheCapacity = 0
+		fs, err := NewFlatState(args)
+		assert.True(t, check.IfNil(fs))
+		assert.Equal(t, ErrInvalidAccountsCacheCapacity, err)
+	})
+	t.Run("invalid data tries values cache capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFlatState()
+		args.DataTriesValuesCacheCapacity = 0
+		fs, err := NewFlatState(args)
+		assert.True(t, check.IfNil(fs))
+		assert.Equal(t, ErrInvalidDataTriesValuesCacheCapacity, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fs, err := NewFlatState(createMockArgsFlatState())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(fs))
+		assert.True(t, fs.IsEnabled())
+	})
+}
+
+func TestFlatState_Accounts(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := []byte("address")
+	accountBytes := []byte("account")
+
+	t.Run("accounts should not be kept before the first commit or reset", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.PutAccount(nil, address, accountBytes)
+
+		_, found := fs.GetAccount(nil, address)
+		assert.False(t, found)
+	})
+	t.Run("accounts should be served only for the current root hash", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.Reset(rootHash)
+		fs.PutAccount([]byte("other root hash"), address, accountBytes)
+		_, found := fs.GetAccount(rootHash, address)
+		assert.False(t, found)
+
+		fs.PutAccount(rootHash, address, accountBytes)
+		value, found := fs.GetAccount(rootHash, address)
+		assert.True(t, found)
+		assert.Equal(t, accountBytes, value)
+
+		_, found = fs.GetAccount([]byte("other root hash"), address)
+		assert.False(t, found)
+	})
+	t.Run("missing accounts should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.Reset(rootHash)
+		fs.PutAccount(rootHash, address, nil)
+
+		value, found := fs.GetAccount(rootHash, address)
+		assert.True(t, found)
+		assert.Nil(t, value)
+	})
+	t.Run("commit from the current root hash should drop only the modified accounts", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.Reset(rootHash)
+		fs.PutAccount(rootHash, address, accountBytes)
+		fs.PutAccount(rootHash, []byte("modified address"), accountBytes)
+
+		newRootHash := []byte("new root hash")
+		fs.Commit(rootHash, newRootHash, [][]byte{[]byte("modified address")})
+
+		value, found := fs.GetAccount(newRootHash, address)
+		assert.True(t, found)
+		assert.Equal(t, accountBytes, value)
+		_, found = fs.GetAccount(newRootHash, []byte("modified address"))
+		assert.False(t, found)
+		_, found = fs.GetAccount(rootHash, address)
+		assert.False(t, found)
+	})
+	t.Run("commit from another root hash should drop all the accounts", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.Reset(rootHash)
+		fs.PutAccount(rootHash, address, accountBytes)
+
+		newRootHash := []byte("new root hash")
+		fs.Commit([]byte("other root hash"), newRootHash, nil)
+
+		_, found := fs.GetAccount(newRootHash, address)
+		assert.False(t, found)
+	})
+	t.Run("reset should drop the accounts only if the root hash differs", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.Reset(rootHash)
+		fs.PutAccount(rootHash, address, accountBytes)
+
+		fs.Reset(rootHash)
+		_, found := fs.GetAccount(rootHash, address)
+		assert.True(t, found)
+
+		fs.Reset([]byte("other root hash"))
+		fs.Reset(rootHash)
+		_, found = fs.GetAccount(rootHash, address)
+		assert.False(t, found)
+	})
+}
+
+func TestFlatState_DataTriesValues(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	dataTrieRootHash := []byte("data trie root hash")
+	key := []byte("key")
+	value := []byte("value")
+
+	t.Run("values should be served only for the data trie root hash they were read from", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.PutDataTrieValue(address, dataTrieRootHash, key, value, 3)
+
+		recoveredValue, depth, found := fs.GetDataTrieValue(address, dataTrieRootHash, key)
+		assert.True(t, found)
+		assert.Equal(t, value, recoveredValue)
+		assert.Equal(t, uint32(3), depth)
+
+		_, _, found = fs.GetDataTrieValue(address, []byte("other data trie root hash"), key)
+		assert.False(t, found)
+		_, _, found = fs.GetDataTrieValue([]byte("other address"), dataTrieRootHash, key)
+		assert.False(t, found)
+	})
+	t.Run("values should not be kept for empty data trie root hashes", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.PutDataTrieValue(address, nil, key, value, 1)
+
+		_, _, found := fs.GetDataTrieValue(address, nil, key)
+		assert.False(t, found)
+	})
+	t.Run("values should survive the commit and the reset", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		fs.PutDataTrieValue(address, dataTrieRootHash, key, value, 1)
+		fs.Commit([]byte("root hash"), []byte("new root hash"), [][]byte{address})
+		fs.Reset([]byte("other root hash"))
+
+		recoveredValue, _, found := fs.GetDataTrieValue(address, dataTrieRootHash, key)
+		assert.True(t, found)
+		assert.Equal(t, value, recoveredValue)
+	})
+}
@@ -0,0 +1,38 @@
+package flatState
+
+import (
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/state"
+)
+
+var _ state.FlatStateHandler = (*readOnlyFlatState)(nil)
+
+type readOnlyFlatState struct {
+	state.FlatStateHandler
+}
+
+// NewReadOnlyFlatState creates a flat state wrapper which can not move the wrapped flat state to another root hash.
+// It is used by the accounts adapters reading the state, which share the flat state with the accounts adapter
+// processing the blocks, the only one allowed to commit or reset it
+func NewReadOnlyFlatState(flatState state.FlatStateHandler) (*readOnlyFlatState, error) {
+	if check.IfNil(flatState) {
+		return nil, state.ErrNilFlatState
+	}
+
+	return &readOnlyFlatState{
+		FlatStateHandler: flatState,
+	}, nil
+}
+
+// Commit does nothing for this implementation
+func (rofs *readOnlyFlatState) Commit(_ []byte, _ []byte, _ [][]byte) {
+}
+
+// Reset does nothing for this implementation
+func (rofs *readOnlyFlatState) Reset(_ []byte) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rofs *readOnlyFlatState) IsInterfaceNil() bool {
+	return rofs == nil
+}
@@ -0,0 +1,50 @@
+package flatState
+
+import (
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReadOnlyFlatState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil flat state should error", func(t *testing.T) {
+		t.Parallel()
+
+		rofs, err := NewReadOnlyFlatState(nil)
+		assert.True(t, check.IfNil(rofs))
+		assert.Equal(t, state.ErrNilFlatState, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := NewFlatState(createMockArgsFlatState())
+		rofs, err := NewReadOnlyFlatState(fs)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rofs))
+		assert.True(t, rofs.IsEnabled())
+	})
+}
+
+func TestReadOnlyFlatState_ShouldNotMoveTheFlatState(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := []byte("address")
+	accountBytes := []byte("account")
+
+	fs, _ := NewFlatState(createMockArgsFlatState())
+	fs.Reset(rootHash)
+	rofs, _ := NewReadOnlyFlatState(fs)
+
+	rofs.PutAccount(rootHash, address, accountBytes)
+	rofs.Commit(rootHash, []byte("new root hash"), [][]byte{address})
+	rofs.Reset([]byte("other root hash"))
+
+	value, found := fs.GetAccount(rootHash, address)
+	assert.True(t, found)
+	assert.Equal(t, accountBytes, value)
+}
@@ -0,0 +1,98 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	vmcommon "github.com/kalyan3104/k-chain-vm-common-go"
+)
+
+// flatStateDataTrie serves the reads of an account's data trie from the flat state, as long as the data trie is not
+// modified. The values are saved in the flat state together with the data trie root hash, so they are never served
+// for another version of the data trie. The depth is kept as well, as it is used when computing the gas
+type flatStateDataTrie struct {
+	DataTrie
+	address          []byte
+	dataTrieRootHash []byte
+	flatState        FlatStateHandler
+	mutDataTrie      sync.RWMutex
+	isModified       bool
+}
+
+func newFlatStateDataTrie(dataTrie DataTrie, address []byte, dataTrieRootHash []byte, flatState FlatStateHandler) *flatStateDataTrie {
+	return &flatStateDataTrie{
+		DataTrie:         dataTrie,
+		address:          address,
+		dataTrieRootHash: dataTrieRootHash,
+		flatState:        flatState,
+	}
+}
+
+// Get returns the value and the depth of the given key, from the flat state if the data trie was not modified
+func (fsdt *flatStateDataTrie) Get(key []byte) ([]byte, uint32, error) {
+	fsdt.mutDataTrie.RLock()
+	defer fsdt.mutDataTrie.RUnlock()
+
+	if fsdt.isModified {
+		return fsdt.DataTrie.Get(key)
+	}
+
+	value, depth, found := fsdt.flatState.GetDataTrieValue(fsdt.address, fsdt.dataTrieRootHash, key)
+	if found {
+		return value, depth, nil
+	}
+
+	value, depth, err := fsdt.DataTrie.Get(key)
+	if err != nil {
+		return nil, depth, err
+	}
+
+	fsdt.flatState.PutDataTrieValue(fsdt.address, fsdt.dataTrieRootHash, key, value, depth)
+
+	return value, depth, nil
+}
+
+// Update updates the value of the given key in the data trie
+func (fsdt *flatStateDataTrie) Update(key []byte, value []byte) error {
+	fsdt.mutDataTrie.Lock()
+	defer fsdt.mutDataTrie.Unlock()
+
+	fsdt.isModified = true
+
+	return fsdt.DataTrie.Update(key, value)
+}
+
+// UpdateWithVersion updates the value of the given key in the data trie, using the given version
+func (fsdt *flatStateDataTrie) UpdateWithVersion(key []byte, value []byte, version core.TrieNodeVersion) error {
+	fsdt.mutDataTrie.Lock()
+	defer fsdt.mutDataTrie.Unlock()
+
+	fsdt.isModified = true
+
+	return fsdt.DataTrie.UpdateWithVersion(key, value, version)
+}
+
+// Delete removes the given key from the data trie
+func (fsdt *flatStateDataTrie) Delete(key []byte) error {
+	fsdt.mutDataTrie.Lock()
+	defer fsdt.mutDataTrie.Unlock()
+
+	fsdt.isModified = true
+
+	return fsdt.DataTrie.Delete(key)
+}
+
+// CollectLeavesForMigration migrates the data trie leaves, which modifies the data trie
+func (fsdt *flatStateDataTrie) CollectLeavesForMigration(args vmcommon.ArgsMigrateDataTrieLeaves) error {
+	fsdt.mutDataTrie.Lock()
+	defer fsdt.mutDataTrie.Unlock()
+
+	fsdt.isModified = true
+
+	return fsdt.DataTrie.CollectLeavesForMigration(args)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fsdt *flatStateDataTrie) IsInterfaceNil() bool {
+	return fsdt == nil
+}
@@ -0,0 +1,100 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/kalyan3104/k-chain-go/common"
+)
+
+// flatStateTracker keeps, for one accounts adapter, the main trie together with the root hash it was recreated from
+// or committed to and the addresses modified since, which are no longer served from or saved in the flat state
+type flatStateTracker struct {
+	flatState         FlatStateHandler
+	mutTracker        sync.RWMutex
+	mainTrie          common.Trie
+	rootHash          []byte
+	modifiedAddresses map[string]struct{}
+	generation        uint64
+}
+
+func newFlatStateTracker(flatState FlatStateHandler, mainTrie common.Trie) *flatStateTracker {
+	return &flatStateTracker{
+		flatState:         flatState,
+		mainTrie:          mainTrie,
+		modifiedAddresses: make(map[string]struct{}),
+	}
+}
+
+// getAccount returns the account bytes from the flat state together with the generation of the tracker, which
+// has to be provided when saving the account bytes read from the trie after a miss
+func (fst *flatStateTracker) getAccount(address []byte, mainTrie common.Trie) ([]byte, bool, uint64) {
+	fst.mutTracker.RLock()
+	defer fst.mutTracker.RUnlock()
+
+	if !fst.canUseFlatState(address, mainTrie) {
+		return nil, false, fst.generation
+	}
+
+	accountBytes, found := fst.flatState.GetAccount(fst.rootHash, address)
+
+	return accountBytes, found, fst.generation
+}
+
+// putAccount saves the account bytes read from the trie, unless the account was modified or the trie was committed
+// or recreated after the read started
+func (fst *flatStateTracker) putAccount(address []byte, accountBytes []byte, mainTrie common.Trie, generation uint64) {
+	fst.mutTracker.RLock()
+	defer fst.mutTracker.RUnlock()
+
+	if generation != fst.generation || !fst.canUseFlatState(address, mainTrie) {
+		return
+	}
+
+	fst.flatState.PutAccount(fst.rootHash, address, accountBytes)
+}
+
+// canUseFlatState returns false for the modified accounts and for the reads done on a main trie replaced meanwhile
+func (fst *flatStateTracker) canUseFlatState(address []byte, mainTrie common.Trie) bool {
+	if mainTrie != fst.mainTrie {
+		return false
+	}
+
+	_, isModified := fst.modifiedAddresses[string(address)]
+
+	return !isModified
+}
+
+// markModified has to be called before the account is changed in the trie
+func (fst *flatStateTracker) markModified(address []byte) {
+	fst.mutTracker.Lock()
+	fst.modifiedAddresses[string(address)] = struct{}{}
+	fst.mutTracker.Unlock()
+}
+
+func (fst *flatStateTracker) commit(newRootHash []byte) {
+	fst.mutTracker.Lock()
+	defer fst.mutTracker.Unlock()
+
+	modifiedAddresses := make([][]byte, 0, len(fst.modifiedAddresses))
+	for address := range fst.modifiedAddresses {
+		modifiedAddresses = append(modifiedAddresses, []byte(address))
+	}
+
+	fst.flatState.Commit(fst.rootHash, newRootHash, modifiedAddresses)
+	fst.setRootHash(newRootHash)
+}
+
+func (fst *flatStateTracker) recreate(mainTrie common.Trie, rootHash []byte) {
+	fst.mutTracker.Lock()
+	defer fst.mutTracker.Unlock()
+
+	fst.flatState.Reset(rootHash)
+	fst.mainTrie = mainTrie
+	fst.setRootHash(rootHash)
+}
+
+func (fst *flatStateTracker) setRootHash(rootHash []byte) {
+	fst.rootHash = rootHash
+	fst.modifiedAddresses = make(map[string]struct{})
+	fst.generation++
+}
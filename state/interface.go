@@ -104,6 +104,27 @@ type SnapshotsManager interface {
 	IsInterfaceNil() bool
 }
 
+// PortableSnapshotExporter defines the methods for the component able to export the state found at a root hash
+// as a portable snapshot file
+type PortableSnapshotExporter interface {
+	ExportSnapshot(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error
+	IsInterfaceNil() bool
+}
+
+// FlatStateHandler defines the flat key-value layer kept beside the accounts state trie. The accounts are valid
+// for a single root hash, the one of the head state, while the data tries values are valid for the data trie root
+// hash they were read from
+type FlatStateHandler interface {
+	GetAccount(rootHash []byte, address []byte) ([]byte, bool)
+	PutAccount(rootHash []byte, address []byte, accountBytes []byte)
+	GetDataTrieValue(address []byte, dataTrieRootHash []byte, key []byte) ([]byte, uint32, bool)
+	PutDataTrieValue(address []byte, dataTrieRootHash []byte, key []byte, value []byte, depth uint32)
+	Commit(oldRootHash []byte, newRootHash []byte, modifiedAddresses [][]byte)
+	Reset(rootHash []byte)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
 // StateMetrics defines the methods for the state metrics
 type StateMetrics interface {
 	UpdateMetricsOnSnapshotStart()
@@ -134,6 +155,7 @@ type AccountsDBSyncer interface {
 type AccountsRepository interface {
 	GetAccountWithBlockInfo(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error)
 	GetCodeWithBlockInfo(codeHash []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error)
+	GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error
 	GetCurrentStateAccountsWrapper() AccountsAdapterAPI
 	Close() error
 	IsInterfaceNil() bool
@@ -105,15 +105,16 @@ func TestNewPeerAccountsDB_SnapshotState(t *testing.T) {
 	}
 
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           &marshallerMock.MarshalizerMock{},
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &testState.StateMetricsStub{},
-		AccountFactory:       args.AccountFactory,
-		ChannelsProvider:     iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &testState.StateMetricsStub{},
+		AccountFactory:           args.AccountFactory,
+		ChannelsProvider:         iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &testState.PortableSnapshotExporterStub{},
 	})
 	args.SnapshotsManager = snapshotsManager
 
@@ -374,15 +375,16 @@ func TestPeerAccountsDB_SetSyncerAndStartSnapshotIfNeededMarksActiveDB(t *testin
 
 		args := createMockAccountsDBArgs()
 		args.SnapshotsManager, _ = state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-			ProcessingMode:       common.ImportDb,
-			Marshaller:           &marshallerMock.MarshalizerMock{},
-			AddressConverter:     &testscommon.PubkeyConverterMock{},
-			ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-			StateMetrics:         &testState.StateMetricsStub{},
-			AccountFactory:       args.AccountFactory,
-			ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-			LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-			StateStatsHandler:    statistics.NewStateStatistics(),
+			ProcessingMode:           common.ImportDb,
+			Marshaller:               &marshallerMock.MarshalizerMock{},
+			AddressConverter:         &testscommon.PubkeyConverterMock{},
+			ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+			StateMetrics:             &testState.StateMetricsStub{},
+			AccountFactory:           args.AccountFactory,
+			ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+			LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+			StateStatsHandler:        statistics.NewStateStatistics(),
+			PortableSnapshotExporter: &testState.PortableSnapshotExporterStub{},
 		})
 		args.Trie = trieStub
 		adb, _ := state.NewPeerAccountsDB(args)
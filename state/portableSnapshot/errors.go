@@ -0,0 +1,63 @@
+package portableSnapshot
+
+import "errors"
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilWriter signals that a nil writer was provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNilReader signals that a nil reader was provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrNilMetadata signals that nil metadata was provided
+var ErrNilMetadata = errors.New("nil metadata")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilAccountFactory signals that a nil account factory was provided
+var ErrNilAccountFactory = errors.New("nil account factory")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler was provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager was provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrEmptyExportDirectory signals that an empty export directory was provided
+var ErrEmptyExportDirectory = errors.New("empty export directory")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size was provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrWriterClosed signals that the snapshot writer was already closed
+var ErrWriterClosed = errors.New("snapshot writer closed")
+
+// ErrInvalidSnapshotFormat signals that the data is not a portable state snapshot
+var ErrInvalidSnapshotFormat = errors.New("invalid portable snapshot format")
+
+// ErrUnsupportedSnapshotVersion signals that the portable snapshot was written with an unsupported format version
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported portable snapshot version")
+
+// ErrInvalidChunk signals that an invalid chunk was found in the portable snapshot
+var ErrInvalidChunk = errors.New("invalid portable snapshot chunk")
+
+// ErrChunkHashMismatch signals that the hash of a chunk does not match its content
+var ErrChunkHashMismatch = errors.New("portable snapshot chunk hash mismatch")
+
+// ErrTruncatedSnapshot signals that the portable snapshot ended before its end chunk
+var ErrTruncatedSnapshot = errors.New("truncated portable snapshot")
+
+// ErrCountsMismatch signals that the counts found in the end chunk do not match the read data
+var ErrCountsMismatch = errors.New("portable snapshot counts mismatch")
+
+// ErrRootHashMismatch signals that the portable snapshot does not hold the state of the expected root hash
+var ErrRootHashMismatch = errors.New("portable snapshot root hash mismatch")
+
+// ErrRootHashNotInEpochStartMetaBlock signals that the root hash is not one of the epoch start meta block root hashes
+var ErrRootHashNotInEpochStartMetaBlock = errors.New("root hash not found in the epoch start meta block")
@@ -0,0 +1,47 @@
+package portableSnapshot
+
+import (
+	"fmt"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+)
+
+const (
+	// FormatVersion is the version of the portable snapshot format written by this package
+	FormatVersion = uint32(1)
+
+	// FileExtension is the extension used for the portable snapshot files
+	FileExtension = ".ksnp"
+
+	// DefaultMaxChunkSizeInBytes is the default maximum size of the trie nodes chunks
+	DefaultMaxChunkSizeInBytes = uint32(4 * 1024 * 1024)
+
+	formatMagic = "KSNP"
+
+	chunkTypeMetadata  = byte(1)
+	chunkTypeTrieNodes = byte(2)
+	chunkTypeEnd       = byte(3)
+
+	uint32Size      = 4
+	uint64Size      = 8
+	headerSize      = len(formatMagic) + uint32Size
+	chunkHeaderSize = 1 + uint32Size
+	endPayloadSize  = 2 * uint64Size
+
+	// a trie node bigger than the chunk size is written alone in its chunk, so the readers accept chunks
+	// bigger than the configured size, up to this limit
+	maxChunkPayloadSize = 256 * 1024 * 1024
+)
+
+// Metadata holds the information about the state found in a portable snapshot
+type Metadata struct {
+	ShardID             uint32 `json:"shardId"`
+	Epoch               uint32 `json:"epoch"`
+	RootHash            []byte `json:"rootHash"`
+	EpochStartMetaBlock []byte `json:"epochStartMetaBlock"`
+}
+
+// FileName returns the name of the portable snapshot file holding the state of the given shard and epoch
+func FileName(shardID uint32, epoch uint32) string {
+	return fmt.Sprintf("state_%s_epoch_%d%s", core.GetShardIDString(shardID), epoch, FileExtension)
+}
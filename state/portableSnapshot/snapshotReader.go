@@ -0,0 +1,225 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-go/common"
+)
+
+type snapshotReader struct {
+	reader        io.Reader
+	hasher        hashing.Hasher
+	metadata      Metadata
+	numChunksRead uint64
+	isDone        bool
+}
+
+// NewSnapshotReader creates a reader of portable snapshots. The header and the metadata chunk are read and
+// verified directly, so the metadata is available before reading the trie nodes
+func NewSnapshotReader(reader io.Reader, hasher hashing.Hasher) (*snapshotReader, error) {
+	if reader == nil {
+		return nil, ErrNilReader
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	sr := &snapshotReader{
+		reader: reader,
+		hasher: hasher,
+	}
+
+	err := sr.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	chunkType, payload, err := sr.readChunk()
+	if err != nil {
+		return nil, err
+	}
+	if chunkType != chunkTypeMetadata {
+		return nil, fmt.Errorf("%w: expected the metadata chunk, got chunk type %d", ErrInvalidChunk, chunkType)
+	}
+
+	err = json.Unmarshal(payload, &sr.metadata)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidChunk, err.Error())
+	}
+
+	return sr, nil
+}
+
+func (sr *snapshotReader) readHeader() error {
+	header := make([]byte, headerSize)
+	_, err := io.ReadFull(sr.reader, header)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSnapshotFormat, err.Error())
+	}
+	if string(header[:len(formatMagic)]) != formatMagic {
+		return ErrInvalidSnapshotFormat
+	}
+
+	version := binary.BigEndian.Uint32(header[len(formatMagic):])
+	if version != FormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedSnapshotVersion, version)
+	}
+
+	return nil
+}
+
+func (sr *snapshotReader) readChunk() (byte, []byte, error) {
+	chunkHeader := make([]byte, chunkHeaderSize)
+	_, err := io.ReadFull(sr.reader, chunkHeader)
+	if err != nil {
+		return 0, nil, sr.wrapReadError(err)
+	}
+
+	payloadSize := binary.BigEndian.Uint32(chunkHeader[1:])
+	if payloadSize > maxChunkPayloadSize {
+		return 0, nil, fmt.Errorf("%w: chunk %d has %d bytes", ErrInvalidChunk, sr.numChunksRead, payloadSize)
+	}
+
+	chunk := make([]byte, chunkHeaderSize+int(payloadSize))
+	copy(chunk, chunkHeader)
+	_, err = io.ReadFull(sr.reader, chunk[chunkHeaderSize:])
+	if err != nil {
+		return 0, nil, sr.wrapReadError(err)
+	}
+
+	chunkHash := make([]byte, sr.hasher.Size())
+	_, err = io.ReadFull(sr.reader, chunkHash)
+	if err != nil {
+		return 0, nil, sr.wrapReadError(err)
+	}
+	if !bytes.Equal(chunkHash, sr.hasher.Compute(string(chunk))) {
+		return 0, nil, fmt.Errorf("%w for chunk %d", ErrChunkHashMismatch, sr.numChunksRead)
+	}
+
+	sr.numChunksRead++
+
+	return chunk[0], chunk[chunkHeaderSize:], nil
+}
+
+func (sr *snapshotReader) wrapReadError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w after %d chunks", ErrTruncatedSnapshot, sr.numChunksRead)
+	}
+
+	return err
+}
+
+// Metadata returns the metadata of the portable snapshot
+func (sr *snapshotReader) Metadata() Metadata {
+	return sr.metadata
+}
+
+// ReadTrieNodes calls the handler for each trie node found in the snapshot. All the chunks are verified against
+// their hashes and the read counts are verified against the end chunk. The handler might be called for the nodes
+// of the verified chunks even if an error is returned afterwards, so the caller should discard all the handled
+// nodes on error
+func (sr *snapshotReader) ReadTrieNodes(handler func(encodedNode []byte) error) error {
+	if sr.isDone {
+		return fmt.Errorf("%w: the trie nodes were already read", ErrInvalidChunk)
+	}
+	sr.isDone = true
+
+	numNodeChunks := uint64(0)
+	numNodes := uint64(0)
+	for {
+		chunkType, payload, err := sr.readChunk()
+		if err != nil {
+			return err
+		}
+
+		switch chunkType {
+		case chunkTypeTrieNodes:
+			numChunkNodes, errParse := parseTrieNodes(payload, handler)
+			if errParse != nil {
+				return errParse
+			}
+			numNodeChunks++
+			numNodes += numChunkNodes
+		case chunkTypeEnd:
+			return sr.checkEnd(payload, numNodeChunks, numNodes)
+		default:
+			return fmt.Errorf("%w: unknown chunk type %d", ErrInvalidChunk, chunkType)
+		}
+	}
+}
+
+func parseTrieNodes(payload []byte, handler func(encodedNode []byte) error) (uint64, error) {
+	numNodes := uint64(0)
+	for len(payload) > 0 {
+		if len(payload) < uint32Size {
+			return 0, fmt.Errorf("%w: truncated trie node length", ErrInvalidChunk)
+		}
+
+		nodeSize := binary.BigEndian.Uint32(payload)
+		payload = payload[uint32Size:]
+		if uint64(len(payload)) < uint64(nodeSize) {
+			return 0, fmt.Errorf("%w: truncated trie node", ErrInvalidChunk)
+		}
+
+		err := handler(payload[:nodeSize])
+		if err != nil {
+			return 0, err
+		}
+
+		payload = payload[nodeSize:]
+		numNodes++
+	}
+
+	return numNodes, nil
+}
+
+func (sr *snapshotReader) checkEnd(payload []byte, numNodeChunks uint64, numNodes uint64) error {
+	if len(payload) != endPayloadSize {
+		return fmt.Errorf("%w: invalid end chunk", ErrInvalidChunk)
+	}
+
+	expectedNumNodeChunks := binary.BigEndian.Uint64(payload)
+	expectedNumNodes := binary.BigEndian.Uint64(payload[uint64Size:])
+	if expectedNumNodeChunks != numNodeChunks || expectedNumNodes != numNodes {
+		return fmt.Errorf("%w: expected %d chunks and %d trie nodes, read %d chunks and %d trie nodes",
+			ErrCountsMismatch, expectedNumNodeChunks, expectedNumNodes, numNodeChunks, numNodes)
+	}
+
+	// nothing should follow the end chunk
+	_, err := io.ReadFull(sr.reader, make([]byte, 1))
+	if err != io.EOF {
+		return fmt.Errorf("%w: data found after the end chunk", ErrInvalidChunk)
+	}
+
+	return nil
+}
+
+// ImportTrieNodes verifies that the snapshot holds the state of the expected root hash and stores all its trie
+// nodes in the trie storage manager, each one under its hash. It returns the number of imported trie nodes
+func (sr *snapshotReader) ImportTrieNodes(expectedRootHash []byte, trieStorageManager common.StorageManager) (uint64, error) {
+	if check.IfNil(trieStorageManager) {
+		return 0, ErrNilTrieStorageManager
+	}
+	if !bytes.Equal(sr.metadata.RootHash, expectedRootHash) {
+		return 0, fmt.Errorf("%w: expected %x, snapshot has %x", ErrRootHashMismatch, expectedRootHash, sr.metadata.RootHash)
+	}
+
+	numNodes := uint64(0)
+	err := sr.ReadTrieNodes(func(encodedNode []byte) error {
+		numNodes++
+		nodeHash := sr.hasher.Compute(string(encodedNode))
+		return trieStorageManager.Put(nodeHash, encodedNode)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numNodes, nil
+}
@@ -0,0 +1,239 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-go/testscommon/hashingMocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/storageManager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSnapshot(tb testing.TB, hasher hashing.Hasher, nodes [][]byte, maxChunkSize uint32) []byte {
+	buff := &bytes.Buffer{}
+	sw, err := NewSnapshotWriter(buff, hasher, createTestMetadata(), maxChunkSize)
+	require.Nil(tb, err)
+
+	for _, node := range nodes {
+		err = sw.AddTrieNode(node)
+		require.Nil(tb, err)
+	}
+
+	err = sw.Close()
+	require.Nil(tb, err)
+
+	return buff.Bytes()
+}
+
+func createTestNodes(numNodes int) [][]byte {
+	nodes := make([][]byte, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		nodes = append(nodes, []byte(fmt.Sprintf("trie node %d", i)))
+	}
+
+	return nodes
+}
+
+func readAllTrieNodes(sr *snapshotReader) ([][]byte, error) {
+	nodes := make([][]byte, 0)
+	err := sr.ReadTrieNodes(func(encodedNode []byte) error {
+		nodes = append(nodes, encodedNode)
+		return nil
+	})
+
+	return nodes, err
+}
+
+func TestNewSnapshotReader(t *testing.T) {
+	t.Parallel()
+
+	hasher := &hashingMocks.HasherMock{}
+	snapshot := createTestSnapshot(t, hasher, createTestNodes(10), 50)
+
+	t.Run("nil reader should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(nil, hasher)
+		assert.Nil(t, sr)
+		assert.Equal(t, ErrNilReader, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(bytes.NewReader(snapshot), nil)
+		assert.Nil(t, sr)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("invalid magic should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidSnapshot := append([]byte("ABCD"), snapshot[len(formatMagic):]...)
+		sr, err := NewSnapshotReader(bytes.NewReader(invalidSnapshot), hasher)
+		assert.Nil(t, sr)
+		assert.Equal(t, ErrInvalidSnapshotFormat, err)
+	})
+	t.Run("short data should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(bytes.NewReader([]byte("KS")), hasher)
+		assert.Nil(t, sr)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFormat))
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidSnapshot := append([]byte{}, snapshot...)
+		invalidSnapshot[headerSize-1]++
+		sr, err := NewSnapshotReader(bytes.NewReader(invalidSnapshot), hasher)
+		assert.Nil(t, sr)
+		assert.True(t, errors.Is(err, ErrUnsupportedSnapshotVersion))
+	})
+	t.Run("altered metadata should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidSnapshot := append([]byte{}, snapshot...)
+		invalidSnapshot[headerSize+chunkHeaderSize+1]++
+		sr, err := NewSnapshotReader(bytes.NewReader(invalidSnapshot), hasher)
+		assert.Nil(t, sr)
+		assert.True(t, errors.Is(err, ErrChunkHashMismatch))
+	})
+	t.Run("should read the metadata", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		require.Nil(t, err)
+		assert.Equal(t, *createTestMetadata(), sr.Metadata())
+	})
+}
+
+func TestSnapshotReader_ReadTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	hasher := &hashingMocks.HasherMock{}
+	nodes := createTestNodes(100)
+	snapshot := createTestSnapshot(t, hasher, nodes, 64)
+
+	t.Run("should read all the nodes", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		readNodes, err := readAllTrieNodes(sr)
+		require.Nil(t, err)
+		assert.Equal(t, nodes, readNodes)
+
+		_, err = readAllTrieNodes(sr)
+		assert.True(t, errors.Is(err, ErrInvalidChunk))
+	})
+	t.Run("snapshot without nodes should work", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(createTestSnapshot(t, hasher, nil, 64)), hasher)
+		readNodes, err := readAllTrieNodes(sr)
+		require.Nil(t, err)
+		assert.Empty(t, readNodes)
+	})
+	t.Run("handler error should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		err := sr.ReadTrieNodes(func(encodedNode []byte) error {
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("altered node should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidSnapshot := append([]byte{}, snapshot...)
+		invalidSnapshot[len(invalidSnapshot)/2]++
+		sr, _ := NewSnapshotReader(bytes.NewReader(invalidSnapshot), hasher)
+		_, err := readAllTrieNodes(sr)
+		assert.True(t, errors.Is(err, ErrChunkHashMismatch))
+	})
+	t.Run("truncated snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot[:len(snapshot)-10]), hasher)
+		_, err := readAllTrieNodes(sr)
+		assert.True(t, errors.Is(err, ErrTruncatedSnapshot))
+	})
+	t.Run("missing chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		sw, _ := NewSnapshotWriter(buff, hasher, createTestMetadata(), 64)
+		sw.numNodes = 3
+		sw.numNodeChunks = 1
+		_ = sw.Close()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(buff.Bytes()), hasher)
+		_, err := readAllTrieNodes(sr)
+		assert.True(t, errors.Is(err, ErrCountsMismatch))
+	})
+	t.Run("data after the end chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidSnapshot := append(append([]byte{}, snapshot...), 0)
+		sr, _ := NewSnapshotReader(bytes.NewReader(invalidSnapshot), hasher)
+		_, err := readAllTrieNodes(sr)
+		assert.True(t, errors.Is(err, ErrInvalidChunk))
+	})
+}
+
+func TestSnapshotReader_ImportTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	hasher := &hashingMocks.HasherMock{}
+	nodes := createTestNodes(10)
+	snapshot := createTestSnapshot(t, hasher, nodes, 64)
+
+	t.Run("nil trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		numNodes, err := sr.ImportTrieNodes(createTestMetadata().RootHash, nil)
+		assert.Zero(t, numNodes)
+		assert.Equal(t, ErrNilTrieStorageManager, err)
+	})
+	t.Run("different root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		numNodes, err := sr.ImportTrieNodes([]byte("another root hash"), &storageManager.StorageManagerStub{})
+		assert.Zero(t, numNodes)
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	})
+	t.Run("put error should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		numNodes, err := sr.ImportTrieNodes(createTestMetadata().RootHash, &storageManager.StorageManagerStub{
+			PutCalled: func(_ []byte, _ []byte) error {
+				return expectedErr
+			},
+		})
+		assert.Zero(t, numNodes)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should store each node under its hash", func(t *testing.T) {
+		t.Parallel()
+
+		storedNodes := make(map[string][]byte)
+		sr, _ := NewSnapshotReader(bytes.NewReader(snapshot), hasher)
+		numNodes, err := sr.ImportTrieNodes(createTestMetadata().RootHash, &storageManager.StorageManagerStub{
+			PutCalled: func(key []byte, val []byte) error {
+				storedNodes[string(key)] = val
+				return nil
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(len(nodes)), numNodes)
+		for _, node := range nodes {
+			assert.Equal(t, node, storedNodes[string(hasher.Compute(string(node)))])
+		}
+	})
+}
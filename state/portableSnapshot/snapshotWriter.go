@@ -0,0 +1,153 @@
+package portableSnapshot
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+)
+
+type snapshotWriter struct {
+	writer        io.Writer
+	hasher        hashing.Hasher
+	maxChunkSize  int
+	pendingNodes  []byte
+	numNodeChunks uint64
+	numNodes      uint64
+	isClosed      bool
+}
+
+// NewSnapshotWriter creates a writer of portable snapshots. The header and the metadata chunk are written
+// directly, the trie nodes being grouped in chunks of at most maxChunkSizeInBytes bytes
+func NewSnapshotWriter(
+	writer io.Writer,
+	hasher hashing.Hasher,
+	metadata *Metadata,
+	maxChunkSizeInBytes uint32,
+) (*snapshotWriter, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if metadata == nil {
+		return nil, ErrNilMetadata
+	}
+	if maxChunkSizeInBytes == 0 || maxChunkSizeInBytes > maxChunkPayloadSize {
+		return nil, ErrInvalidMaxChunkSize
+	}
+
+	sw := &snapshotWriter{
+		writer:       writer,
+		hasher:       hasher,
+		maxChunkSize: int(maxChunkSizeInBytes),
+		pendingNodes: make([]byte, 0),
+	}
+
+	err := sw.writeHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	metadataBuff, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sw.writeChunk(chunkTypeMetadata, metadataBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+func (sw *snapshotWriter) writeHeader() error {
+	header := make([]byte, 0, headerSize)
+	header = append(header, formatMagic...)
+	header = binary.BigEndian.AppendUint32(header, FormatVersion)
+
+	_, err := sw.writer.Write(header)
+	return err
+}
+
+// AddTrieNode adds the encoded trie node to the snapshot
+func (sw *snapshotWriter) AddTrieNode(encodedNode []byte) error {
+	if sw.isClosed {
+		return ErrWriterClosed
+	}
+
+	nodeSize := uint32Size + len(encodedNode)
+	if len(sw.pendingNodes) > 0 && len(sw.pendingNodes)+nodeSize > sw.maxChunkSize {
+		err := sw.flushPendingNodes()
+		if err != nil {
+			return err
+		}
+	}
+
+	sw.pendingNodes = binary.BigEndian.AppendUint32(sw.pendingNodes, uint32(len(encodedNode)))
+	sw.pendingNodes = append(sw.pendingNodes, encodedNode...)
+	sw.numNodes++
+
+	return nil
+}
+
+func (sw *snapshotWriter) flushPendingNodes() error {
+	if len(sw.pendingNodes) == 0 {
+		return nil
+	}
+
+	err := sw.writeChunk(chunkTypeTrieNodes, sw.pendingNodes)
+	if err != nil {
+		return err
+	}
+
+	sw.numNodeChunks++
+	sw.pendingNodes = sw.pendingNodes[:0]
+
+	return nil
+}
+
+// writeChunk writes the chunk type, the payload length, the payload and the hash computed over all of them
+func (sw *snapshotWriter) writeChunk(chunkType byte, payload []byte) error {
+	chunk := make([]byte, 0, chunkHeaderSize+len(payload))
+	chunk = append(chunk, chunkType)
+	chunk = binary.BigEndian.AppendUint32(chunk, uint32(len(payload)))
+	chunk = append(chunk, payload...)
+
+	_, err := sw.writer.Write(chunk)
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.writer.Write(sw.hasher.Compute(string(chunk)))
+	return err
+}
+
+// NumTrieNodes returns the number of trie nodes added to the snapshot
+func (sw *snapshotWriter) NumTrieNodes() uint64 {
+	return sw.numNodes
+}
+
+// Close writes the pending trie nodes and the end chunk. The underlying writer is not closed
+func (sw *snapshotWriter) Close() error {
+	if sw.isClosed {
+		return ErrWriterClosed
+	}
+
+	err := sw.flushPendingNodes()
+	if err != nil {
+		return err
+	}
+
+	sw.isClosed = true
+
+	endPayload := make([]byte, 0, endPayloadSize)
+	endPayload = binary.BigEndian.AppendUint64(endPayload, sw.numNodeChunks)
+	endPayload = binary.BigEndian.AppendUint64(endPayload, sw.numNodes)
+
+	return sw.writeChunk(chunkTypeEnd, endPayload)
+}
@@ -0,0 +1,152 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+type failingWriter struct {
+	numWritesBeforeFail int
+}
+
+func (fw *failingWriter) Write(p []byte) (int, error) {
+	if fw.numWritesBeforeFail == 0 {
+		return 0, expectedErr
+	}
+
+	fw.numWritesBeforeFail--
+	return len(p), nil
+}
+
+func createTestMetadata() *Metadata {
+	return &Metadata{
+		ShardID:             1,
+		Epoch:               7,
+		RootHash:            []byte("root hash"),
+		EpochStartMetaBlock: []byte("meta block"),
+	}
+}
+
+func TestNewSnapshotWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil writer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sw, err := NewSnapshotWriter(nil, &hashingMocks.HasherMock{}, createTestMetadata(), 100)
+		assert.Nil(t, sw)
+		assert.Equal(t, ErrNilWriter, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		sw, err := NewSnapshotWriter(&bytes.Buffer{}, nil, createTestMetadata(), 100)
+		assert.Nil(t, sw)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil metadata should error", func(t *testing.T) {
+		t.Parallel()
+
+		sw, err := NewSnapshotWriter(&bytes.Buffer{}, &hashingMocks.HasherMock{}, nil, 100)
+		assert.Nil(t, sw)
+		assert.Equal(t, ErrNilMetadata, err)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		sw, err := NewSnapshotWriter(&bytes.Buffer{}, &hashingMocks.HasherMock{}, createTestMetadata(), 0)
+		assert.Nil(t, sw)
+		assert.Equal(t, ErrInvalidMaxChunkSize, err)
+
+		sw, err = NewSnapshotWriter(&bytes.Buffer{}, &hashingMocks.HasherMock{}, createTestMetadata(), maxChunkPayloadSize+1)
+		assert.Nil(t, sw)
+		assert.Equal(t, ErrInvalidMaxChunkSize, err)
+	})
+	t.Run("header write error should error", func(t *testing.T) {
+		t.Parallel()
+
+		sw, err := NewSnapshotWriter(&failingWriter{}, &hashingMocks.HasherMock{}, createTestMetadata(), 100)
+		assert.Nil(t, sw)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should write the header and the metadata chunk", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		sw, err := NewSnapshotWriter(buff, &hashingMocks.HasherMock{}, createTestMetadata(), 100)
+		require.Nil(t, err)
+		assert.NotNil(t, sw)
+
+		data := buff.Bytes()
+		assert.Equal(t, formatMagic, string(data[:len(formatMagic)]))
+		assert.Equal(t, FormatVersion, binary.BigEndian.Uint32(data[len(formatMagic):]))
+		assert.Equal(t, chunkTypeMetadata, data[headerSize])
+	})
+}
+
+func TestSnapshotWriter_AddTrieNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should group the nodes in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		sw, _ := NewSnapshotWriter(buff, &hashingMocks.HasherMock{}, createTestMetadata(), 20)
+		sizeAfterMetadata := buff.Len()
+
+		// each node takes 4 + 6 bytes, so 2 nodes fit in a chunk
+		for i := 0; i < 5; i++ {
+			err := sw.AddTrieNode([]byte("node-0"))
+			require.Nil(t, err)
+		}
+		assert.Equal(t, uint64(5), sw.NumTrieNodes())
+		assert.Equal(t, uint64(2), sw.numNodeChunks)
+
+		chunkSize := chunkHeaderSize + 20 + (&hashingMocks.HasherMock{}).Size()
+		assert.Equal(t, sizeAfterMetadata+2*chunkSize, buff.Len())
+	})
+	t.Run("node bigger than the chunk size should be written in its own chunk", func(t *testing.T) {
+		t.Parallel()
+
+		sw, _ := NewSnapshotWriter(&bytes.Buffer{}, &hashingMocks.HasherMock{}, createTestMetadata(), 20)
+
+		_ = sw.AddTrieNode([]byte("node"))
+		err := sw.AddTrieNode(bytes.Repeat([]byte("a"), 50))
+		require.Nil(t, err)
+		_ = sw.AddTrieNode([]byte("node"))
+		assert.Equal(t, uint64(2), sw.numNodeChunks)
+
+		_ = sw.Close()
+		assert.Equal(t, uint64(3), sw.numNodeChunks)
+	})
+	t.Run("closed writer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sw, _ := NewSnapshotWriter(&bytes.Buffer{}, &hashingMocks.HasherMock{}, createTestMetadata(), 20)
+		_ = sw.Close()
+
+		err := sw.AddTrieNode([]byte("node"))
+		assert.Equal(t, ErrWriterClosed, err)
+		err = sw.Close()
+		assert.Equal(t, ErrWriterClosed, err)
+	})
+	t.Run("write error should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer := &failingWriter{numWritesBeforeFail: 3}
+		sw, _ := NewSnapshotWriter(writer, &hashingMocks.HasherMock{}, createTestMetadata(), 20)
+
+		_ = sw.AddTrieNode([]byte("node-0"))
+		_ = sw.AddTrieNode([]byte("node-1"))
+		err := sw.AddTrieNode([]byte("node-2"))
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,327 @@
+package portableSnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/core/check"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-core-go/hashing"
+	"github.com/kalyan3104/k-chain-core-go/marshal"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/common/errChan"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/state/parsers"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/trie"
+	"github.com/kalyan3104/k-chain-go/trie/keyBuilder"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/portableSnapshot")
+
+const tempFileSuffix = ".tmp"
+
+// ArgsStateSnapshotExporter holds the arguments needed to create a state snapshot exporter
+type ArgsStateSnapshotExporter struct {
+	ExportDirectory      string
+	MaxChunkSizeInBytes  uint32
+	MaxTrieLevelInMemory uint
+	MetaBlockStorer      storage.Storer
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	AccountFactory       state.AccountFactory
+	EnableEpochsHandler  common.EnableEpochsHandler
+}
+
+type stateSnapshotExporter struct {
+	exportDirectory      string
+	maxChunkSizeInBytes  uint32
+	maxTrieLevelInMemory uint
+	metaBlockStorer      storage.Storer
+	marshaller           marshal.Marshalizer
+	hasher               hashing.Hasher
+	accountFactory       state.AccountFactory
+	enableEpochsHandler  common.EnableEpochsHandler
+}
+
+// NewStateSnapshotExporter creates a new state snapshot exporter, which writes the complete accounts state of
+// an epoch start root hash, together with the epoch start meta block, in a portable snapshot file
+func NewStateSnapshotExporter(args ArgsStateSnapshotExporter) (*stateSnapshotExporter, error) {
+	if len(args.ExportDirectory) == 0 {
+		return nil, ErrEmptyExportDirectory
+	}
+	if args.MaxChunkSizeInBytes == 0 || args.MaxChunkSizeInBytes > maxChunkPayloadSize {
+		return nil, ErrInvalidMaxChunkSize
+	}
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AccountFactory) {
+		return nil, ErrNilAccountFactory
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	return &stateSnapshotExporter{
+		exportDirectory:      args.ExportDirectory,
+		maxChunkSizeInBytes:  args.MaxChunkSizeInBytes,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		metaBlockStorer:      args.MetaBlockStorer,
+		marshaller:           args.Marshaller,
+		hasher:               args.Hasher,
+		accountFactory:       args.AccountFactory,
+		enableEpochsHandler:  args.EnableEpochsHandler,
+	}, nil
+}
+
+// ExportSnapshot writes the main trie and all the data tries of the given root hash in a portable snapshot file.
+// The root hash must be one of the root hashes of the epoch start meta block of the given epoch. The file is
+// written under a temporary name and renamed only after being completely written
+func (sse *stateSnapshotExporter) ExportSnapshot(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error {
+	if check.IfNil(trieStorageManager) {
+		return ErrNilTrieStorageManager
+	}
+
+	metadata, err := sse.createMetadata(rootHash, epoch)
+	if err != nil {
+		return err
+	}
+
+	tr, err := trie.NewTrie(trieStorageManager, sse.marshaller, sse.hasher, sse.enableEpochsHandler, sse.maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+	mainTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(sse.exportDirectory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	filePath := filepath.Join(sse.exportDirectory, FileName(metadata.ShardID, epoch))
+	tempFilePath := filePath + tempFileSuffix
+	numNodes, err := sse.writeSnapshotFile(tempFilePath, metadata, mainTrie)
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+
+	err = os.Rename(tempFilePath, filePath)
+	if err != nil {
+		return err
+	}
+
+	log.Info("exported portable state snapshot", "file", filePath, "epoch", epoch,
+		"root hash", rootHash, "num trie nodes", numNodes)
+
+	return nil
+}
+
+func (sse *stateSnapshotExporter) createMetadata(rootHash []byte, epoch uint32) (*Metadata, error) {
+	metaBlockBuff, err := sse.metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the epoch start meta block for epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = sse.marshaller.Unmarshal(metaBlock, metaBlockBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := getShardIDForRootHash(metaBlock, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metadata{
+		ShardID:             shardID,
+		Epoch:               epoch,
+		RootHash:            rootHash,
+		EpochStartMetaBlock: metaBlockBuff,
+	}, nil
+}
+
+// getShardIDForRootHash returns the shard having the given root hash in the epoch start meta block. The shards
+// state is snapshotted on the scheduled root hash, if any
+func getShardIDForRootHash(metaBlock *block.MetaBlock, rootHash []byte) (uint32, error) {
+	if bytes.Equal(metaBlock.GetRootHash(), rootHash) {
+		return core.MetachainShardId, nil
+	}
+
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		shardRootHash := shardData.RootHash
+		if shardData.GetScheduledRootHash() != nil {
+			shardRootHash = shardData.GetScheduledRootHash()
+		}
+		if bytes.Equal(shardRootHash, rootHash) {
+			return shardData.ShardID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: root hash %x, epoch %d", ErrRootHashNotInEpochStartMetaBlock, rootHash, metaBlock.GetEpoch())
+}
+
+func (sse *stateSnapshotExporter) writeSnapshotFile(filePath string, metadata *Metadata, mainTrie common.Trie) (uint64, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	bufferedWriter := bufio.NewWriter(file)
+	numNodes, err := sse.writeSnapshot(bufferedWriter, metadata, mainTrie)
+	if err == nil {
+		err = bufferedWriter.Flush()
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+
+	errClose := file.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return numNodes, errClose
+}
+
+func (sse *stateSnapshotExporter) writeSnapshot(bufferedWriter *bufio.Writer, metadata *Metadata, mainTrie common.Trie) (uint64, error) {
+	writer, err := NewSnapshotWriter(bufferedWriter, sse.hasher, metadata, sse.maxChunkSizeInBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	err = writeTrieNodes(mainTrie, writer)
+	if err != nil {
+		return 0, err
+	}
+
+	dataTriesRootHashes, err := sse.getDataTriesRootHashes(mainTrie, metadata.RootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		dataTrie, errRecreate := mainTrie.Recreate(dataTrieRootHash)
+		if errRecreate != nil {
+			return 0, errRecreate
+		}
+
+		err = writeTrieNodes(dataTrie, writer)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return writer.NumTrieNodes(), nil
+}
+
+func writeTrieNodes(tr common.Trie, writer *snapshotWriter) error {
+	hashes, err := tr.GetAllHashes()
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		encodedNode, errGet := tr.GetSerializedNode(hash)
+		if errGet != nil {
+			return errGet
+		}
+
+		err = writer.AddTrieNode(encodedNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDataTriesRootHashes returns the distinct root hashes of the accounts data tries, as accounts might share
+// the same data trie
+func (sse *stateSnapshotExporter) getDataTriesRootHashes(mainTrie common.Trie, rootHash []byte) ([][]byte, error) {
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := mainTrie.GetAllLeavesOnChannel(
+		iteratorChannels,
+		context.Background(),
+		rootHash,
+		keyBuilder.NewKeyBuilder(),
+		parsers.NewMainTrieLeafParser(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHashes := make([][]byte, 0)
+	addedRootHashes := make(map[string]struct{})
+	for leaf := range iteratorChannels.LeavesChan {
+		dataTrieRootHash := sse.getDataTrieRootHash(leaf)
+		if len(dataTrieRootHash) == 0 {
+			continue
+		}
+
+		_, isAdded := addedRootHashes[string(dataTrieRootHash)]
+		if isAdded {
+			continue
+		}
+
+		addedRootHashes[string(dataTrieRootHash)] = struct{}{}
+		rootHashes = append(rootHashes, dataTrieRootHash)
+	}
+
+	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return rootHashes, nil
+}
+
+func (sse *stateSnapshotExporter) getDataTrieRootHash(leaf core.KeyValueHolder) []byte {
+	account, err := sse.accountFactory.CreateAccount(leaf.Key())
+	if err != nil {
+		return nil
+	}
+
+	err = sse.marshaller.Unmarshal(account, leaf.Value())
+	if err != nil {
+		// leaves holding code are not accounts
+		return nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	return userAccount.GetRootHash()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sse *stateSnapshotExporter) IsInterfaceNil() bool {
+	return sse == nil
+}
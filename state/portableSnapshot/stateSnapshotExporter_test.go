@@ -0,0 +1,281 @@
+package portableSnapshot
+
+import (
+	"bufio"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-core-go/data/block"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/state"
+	"github.com/kalyan3104/k-chain-go/state/disabled"
+	"github.com/kalyan3104/k-chain-go/state/factory"
+	disabledPruning "github.com/kalyan3104/k-chain-go/state/storagePruningManager/disabled"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/kalyan3104/k-chain-go/testscommon/hashingMocks"
+	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
+	storageStubs "github.com/kalyan3104/k-chain-go/testscommon/storage"
+	"github.com/kalyan3104/k-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateSnapshotExporter(t *testing.T) ArgsStateSnapshotExporter {
+	marshaller := &marshallerMock.MarshalizerMock{}
+	hasher := &hashingMocks.HasherMock{}
+	enableEpochsHandler := &enableEpochsHandlerMock.EnableEpochsHandlerStub{}
+	accountFactory, _ := factory.NewAccountCreator(factory.ArgsAccountCreator{
+		Hasher:              hasher,
+		Marshaller:          marshaller,
+		EnableEpochsHandler: enableEpochsHandler,
+	})
+
+	return ArgsStateSnapshotExporter{
+		ExportDirectory:      t.TempDir(),
+		MaxChunkSizeInBytes:  256,
+		MaxTrieLevelInMemory: 5,
+		MetaBlockStorer:      testscommon.CreateMemUnit(),
+		Marshaller:           marshaller,
+		Hasher:               hasher,
+		AccountFactory:       accountFactory,
+		EnableEpochsHandler:  enableEpochsHandler,
+	}
+}
+
+func createTestTrieStorageManager(t *testing.T) common.StorageManager {
+	trieStorageManager, err := trie.NewTrieStorageManager(storageStubs.GetStorageManagerArgs())
+	require.Nil(t, err)
+
+	return trieStorageManager
+}
+
+func createTestAccountsDB(t *testing.T, args ArgsStateSnapshotExporter, trieStorageManager common.StorageManager) *state.AccountsDB {
+	tr, err := trie.NewTrie(trieStorageManager, args.Marshaller, args.Hasher, args.EnableEpochsHandler, args.MaxTrieLevelInMemory)
+	require.Nil(t, err)
+
+	adb, err := state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  tr,
+		Hasher:                args.Hasher,
+		Marshaller:            args.Marshaller,
+		AccountFactory:        args.AccountFactory,
+		StoragePruningManager: disabledPruning.NewDisabledStoragePruningManager(),
+		AddressConverter:      &testscommon.PubkeyConverterMock{},
+		SnapshotsManager:      disabled.NewDisabledSnapshotsManager(),
+		FlatState:             disabled.NewDisabledFlatState(),
+	})
+	require.Nil(t, err)
+
+	return adb
+}
+
+// createTestState creates accounts with and without data tries
+func createTestState(t *testing.T, adb *state.AccountsDB) []byte {
+	keyValues := map[string]string{
+		"address-000000000000000000000001": "key1",
+		"address-000000000000000000000002": "key2",
+		"address-000000000000000000000003": "key2",
+		"address-000000000000000000000004": "",
+	}
+	for address, key := range keyValues {
+		account, err := adb.LoadAccount([]byte(address))
+		require.Nil(t, err)
+
+		userAccount := account.(state.UserAccountHandler)
+		if len(key) > 0 {
+			err = userAccount.SaveKeyValue([]byte(key), []byte("value of "+key))
+			require.Nil(t, err)
+		}
+		_ = userAccount.AddToBalance(big.NewInt(1))
+
+		err = adb.SaveAccount(userAccount)
+		require.Nil(t, err)
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func saveEpochStartMetaBlock(t *testing.T, args ArgsStateSnapshotExporter, metaBlock *block.MetaBlock) []byte {
+	metaBlockBuff, err := args.Marshaller.Marshal(metaBlock)
+	require.Nil(t, err)
+
+	err = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(metaBlock.Epoch)), metaBlockBuff)
+	require.Nil(t, err)
+
+	return metaBlockBuff
+}
+
+func TestNewStateSnapshotExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty export directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.ExportDirectory = ""
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrEmptyExportDirectory, err)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.MaxChunkSizeInBytes = 0
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrInvalidMaxChunkSize, err)
+	})
+	t.Run("nil meta block storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.MetaBlockStorer = nil
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.Marshaller = nil
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.Hasher = nil
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil account factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.AccountFactory = nil
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrNilAccountFactory, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		args.EnableEpochsHandler = nil
+		sse, err := NewStateSnapshotExporter(args)
+		assert.Nil(t, sse)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sse, err := NewStateSnapshotExporter(createMockArgsStateSnapshotExporter(t))
+		assert.Nil(t, err)
+		assert.False(t, sse.IsInterfaceNil())
+	})
+}
+
+func TestStateSnapshotExporter_ExportSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		sse, _ := NewStateSnapshotExporter(createMockArgsStateSnapshotExporter(t))
+		err := sse.ExportSnapshot([]byte("root hash"), 1, nil)
+		assert.Equal(t, ErrNilTrieStorageManager, err)
+	})
+	t.Run("missing epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		sse, _ := NewStateSnapshotExporter(createMockArgsStateSnapshotExporter(t))
+		err := sse.ExportSnapshot([]byte("root hash"), 1, createTestTrieStorageManager(t))
+		assert.NotNil(t, err)
+	})
+	t.Run("root hash not in the epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		saveEpochStartMetaBlock(t, args, &block.MetaBlock{Epoch: 1, RootHash: []byte("meta root hash")})
+
+		sse, _ := NewStateSnapshotExporter(args)
+		err := sse.ExportSnapshot([]byte("root hash"), 1, createTestTrieStorageManager(t))
+		assert.True(t, errors.Is(err, ErrRootHashNotInEpochStartMetaBlock))
+
+		files, _ := os.ReadDir(args.ExportDirectory)
+		assert.Empty(t, files)
+	})
+	t.Run("should export the main trie and the data tries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateSnapshotExporter(t)
+		trieStorageManager := createTestTrieStorageManager(t)
+		rootHash := createTestState(t, createTestAccountsDB(t, args, trieStorageManager))
+		metaBlockBuff := saveEpochStartMetaBlock(t, args, &block.MetaBlock{
+			Epoch:    4,
+			RootHash: []byte("meta root hash"),
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{
+					{ShardID: 0, RootHash: []byte("shard 0 root hash")},
+					{ShardID: 1, RootHash: []byte("shard 1 root hash"), ScheduledRootHash: rootHash},
+				},
+			},
+		})
+
+		sse, _ := NewStateSnapshotExporter(args)
+		err := sse.ExportSnapshot(rootHash, 4, trieStorageManager)
+		require.Nil(t, err)
+
+		files, _ := os.ReadDir(args.ExportDirectory)
+		require.Equal(t, 1, len(files))
+		assert.Equal(t, "state_1_epoch_4"+FileExtension, files[0].Name())
+
+		file, err := os.Open(filepath.Join(args.ExportDirectory, files[0].Name()))
+		require.Nil(t, err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		sr, err := NewSnapshotReader(bufio.NewReader(file), args.Hasher)
+		require.Nil(t, err)
+		assert.Equal(t, Metadata{
+			ShardID:             1,
+			Epoch:               4,
+			RootHash:            rootHash,
+			EpochStartMetaBlock: metaBlockBuff,
+		}, sr.Metadata())
+
+		importedTrieStorageManager := createTestTrieStorageManager(t)
+		_, err = sr.ImportTrieNodes(rootHash, importedTrieStorageManager)
+		require.Nil(t, err)
+
+		adb := createTestAccountsDB(t, args, importedTrieStorageManager)
+		err = adb.RecreateTrie(rootHash)
+		require.Nil(t, err)
+
+		for _, address := range []string{"address-000000000000000000000002", "address-000000000000000000000003"} {
+			account, errGet := adb.GetExistingAccount([]byte(address))
+			require.Nil(t, errGet)
+
+			value, _, errGet := account.(state.UserAccountHandler).RetrieveValue([]byte("key2"))
+			require.Nil(t, errGet)
+			assert.Equal(t, []byte("value of key2"), value)
+		}
+
+		allTries, err := adb.RecreateAllTries(rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, 4, len(allTries))
+	})
+}
@@ -26,6 +26,7 @@ type ArgsNewSnapshotsManager struct {
 	ChannelsProvider         IteratorChannelsProvider
 	StateStatsHandler        StateStatsHandler
 	LastSnapshotMarker       LastSnapshotMarker
+	PortableSnapshotExporter PortableSnapshotExporter
 }
 
 type snapshotsManager struct {
@@ -43,6 +44,7 @@ type snapshotsManager struct {
 	channelsProvider     IteratorChannelsProvider
 	accountFactory       AccountFactory
 	stateStatsHandler    StateStatsHandler
+	portableExporter     PortableSnapshotExporter
 	mutex                sync.RWMutex
 }
 
@@ -72,6 +74,9 @@ func NewSnapshotsManager(args ArgsNewSnapshotsManager) (*snapshotsManager, error
 	if check.IfNil(args.LastSnapshotMarker) {
 		return nil, ErrNilLastSnapshotMarker
 	}
+	if check.IfNil(args.PortableSnapshotExporter) {
+		return nil, ErrNilPortableSnapshotExporter
+	}
 
 	return &snapshotsManager{
 		isSnapshotInProgress:     atomic.Flag{},
@@ -88,6 +93,7 @@ func NewSnapshotsManager(args ArgsNewSnapshotsManager) (*snapshotsManager, error
 		accountFactory:           args.AccountFactory,
 		stateStatsHandler:        args.StateStatsHandler,
 		lastSnapshotMarker:       args.LastSnapshotMarker,
+		portableExporter:         args.PortableSnapshotExporter,
 	}, nil
 }
 
@@ -364,6 +370,18 @@ func (sm *snapshotsManager) processSnapshotCompletion(
 	log.Debug("set activeDB in epoch", "epoch", epoch)
 	errPut := trieStorageManager.PutInEpochWithoutCache([]byte(common.ActiveDBKey), []byte(common.ActiveDBVal), epoch)
 	handleLoggingWhenError("error while putting active DB value into main storer", errPut)
+
+	sm.exportPortableSnapshot(rootHash, epoch, trieStorageManager)
+}
+
+// exportPortableSnapshot exports the complete state that has just been snapshotted. The pruning is buffered
+// during the export so the exported trie nodes are not removed while being read
+func (sm *snapshotsManager) exportPortableSnapshot(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) {
+	trieStorageManager.EnterPruningBufferingMode()
+	defer trieStorageManager.ExitPruningBufferingMode()
+
+	err := sm.portableExporter.ExportSnapshot(rootHash, epoch, trieStorageManager)
+	handleLoggingWhenError("error while exporting the portable state snapshot", err)
 }
 
 func (sm *snapshotsManager) printStorageStatistics() {
@@ -33,6 +33,7 @@ func getDefaultSnapshotManagerArgs() state.ArgsNewSnapshotsManager {
 		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		StateStatsHandler:        disabled.NewStateStatistics(),
 		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		PortableSnapshotExporter: &stateTest.PortableSnapshotExporterStub{},
 	}
 }
 
@@ -119,6 +120,16 @@ func TestNewSnapshotsManager(t *testing.T) {
 		assert.Nil(t, sm)
 		assert.Equal(t, state.ErrNilLastSnapshotMarker, err)
 	})
+	t.Run("nil portable snapshot exporter", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSnapshotManagerArgs()
+		args.PortableSnapshotExporter = nil
+
+		sm, err := state.NewSnapshotsManager(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, state.ErrNilPortableSnapshotExporter, err)
+	})
 	t.Run("ok", func(t *testing.T) {
 		t.Parallel()
 
@@ -487,7 +498,14 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 
 		expectedErr := errors.New("some error")
 
-		sm, _ := state.NewSnapshotsManager(getDefaultSnapshotManagerArgs())
+		args := getDefaultSnapshotManagerArgs()
+		args.PortableSnapshotExporter = &stateTest.PortableSnapshotExporterStub{
+			ExportSnapshotCalled: func(_ []byte, _ uint32, _ common.StorageManager) error {
+				assert.Fail(t, "should not have exported the portable snapshot")
+				return nil
+			},
+		}
+		sm, _ := state.NewSnapshotsManager(args)
 		tsm := &storageManager.StorageManagerStub{
 			GetLatestStorageEpochCalled: func() (uint32, error) {
 				return 5, nil
@@ -512,9 +530,19 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 
 		putInEpochWithoutCacheCalled := false
 		removeFromAllActiveEpochsCalled := false
+		exportSnapshotCalled := false
 
 		args := getDefaultSnapshotManagerArgs()
 		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		args.PortableSnapshotExporter = &stateTest.PortableSnapshotExporterStub{
+			ExportSnapshotCalled: func(providedRootHash []byte, providedEpoch uint32, _ common.StorageManager) error {
+				assert.True(t, putInEpochWithoutCacheCalled)
+				assert.Equal(t, rootHash, providedRootHash)
+				assert.Equal(t, epoch, providedEpoch)
+				exportSnapshotCalled = true
+				return nil
+			},
+		}
 		sm, _ := state.NewSnapshotsManager(args)
 		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
 		tsm := &storageManager.StorageManagerStub{
@@ -550,5 +578,6 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 
 		assert.True(t, putInEpochWithoutCacheCalled)
 		assert.True(t, removeFromAllActiveEpochsCalled)
+		assert.True(t, exportSnapshotCalled)
 	})
 }
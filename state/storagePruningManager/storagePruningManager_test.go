@@ -7,6 +7,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/common/statistics"
 	"github.com/kalyan3104/k-chain-go/config"
 	"github.com/kalyan3104/k-chain-go/state"
+	disabledState "github.com/kalyan3104/k-chain-go/state/disabled"
 	"github.com/kalyan3104/k-chain-go/state/factory"
 	"github.com/kalyan3104/k-chain-go/state/iteratorChannelsProvider"
 	"github.com/kalyan3104/k-chain-go/state/lastSnapshotMarker"
@@ -46,15 +47,16 @@ func getDefaultTrieAndAccountsDbAndStoragePruningManager() (common.Trie, *state.
 	accCreator, _ := factory.NewAccountCreator(argsAccCreator)
 
 	snapshotsManager, _ := state.NewSnapshotsManager(state.ArgsNewSnapshotsManager{
-		ProcessingMode:       common.Normal,
-		Marshaller:           marshaller,
-		AddressConverter:     &testscommon.PubkeyConverterMock{},
-		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
-		StateMetrics:         &testStorage.StateMetricsStub{},
-		AccountFactory:       accCreator,
-		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
-		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
-		StateStatsHandler:    statistics.NewStateStatistics(),
+		ProcessingMode:           common.Normal,
+		Marshaller:               marshaller,
+		AddressConverter:         &testscommon.PubkeyConverterMock{},
+		ProcessStatusHandler:     &testscommon.ProcessStatusHandlerStub{},
+		StateMetrics:             &testStorage.StateMetricsStub{},
+		AccountFactory:           accCreator,
+		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
+		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		StateStatsHandler:        statistics.NewStateStatistics(),
+		PortableSnapshotExporter: &testStorage.PortableSnapshotExporterStub{},
 	})
 
 	argsAccountsDB := state.ArgsAccountsDB{
@@ -65,6 +67,7 @@ func getDefaultTrieAndAccountsDbAndStoragePruningManager() (common.Trie, *state.
 		StoragePruningManager: spm,
 		AddressConverter:      &testscommon.PubkeyConverterMock{},
 		SnapshotsManager:      snapshotsManager,
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -1,6 +1,7 @@
 package syncer
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -34,6 +35,8 @@ type baseAccountsSyncer struct {
 	userAccountsSyncStatisticsHandler common.SizeSyncStatisticsHandler
 	appStatusHandler                  core.AppStatusHandler
 	enableEpochsHandler               common.EnableEpochsHandler
+	checkpointsStorer                 storage.Persister
+	timeBetweenCheckpoints            time.Duration
 
 	trieSyncerVersion int
 	numTriesSynced    int32
@@ -41,6 +44,7 @@ type baseAccountsSyncer struct {
 }
 
 const timeBetweenStatisticsPrints = time.Second * 2
+const syncTargetRootHashKey = "syncTargetRootHash"
 
 // ArgsNewBaseAccountsSyncer defines the arguments needed for the new account syncer
 type ArgsNewBaseAccountsSyncer struct {
@@ -57,6 +61,8 @@ type ArgsNewBaseAccountsSyncer struct {
 	MaxHardCapForMissingNodes         int
 	TrieSyncerVersion                 int
 	CheckNodesOnDisk                  bool
+	CheckpointsStorer                 storage.Persister
+	TimeBetweenCheckpoints            time.Duration
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -87,6 +93,9 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if args.MaxHardCapForMissingNodes < 1 {
 		return state.ErrInvalidMaxHardCapForMissingNodes
 	}
+	if check.IfNil(args.CheckpointsStorer) {
+		return trie.ErrNilSyncCheckpointsStorer
+	}
 
 	return trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
 }
@@ -115,6 +124,8 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		MaxHardCapForMissingNodes: b.maxHardCapForMissingNodes,
 		CheckNodesOnDisk:          b.checkNodesOnDisk,
 		LeavesChan:                leavesChan,
+		CheckpointsStorer:         b.checkpointsStorer,
+		TimeBetweenCheckpoints:    b.timeBetweenCheckpoints,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
 	if err != nil {
@@ -133,6 +144,48 @@ func (b *baseAccountsSyncer) syncMainTrie(
 	return nil
 }
 
+// prepareCheckpoints keeps the saved trie sync checkpoints only if they were created while syncing the same root hash.
+// It returns true if a previous sync of the provided root hash was interrupted
+func (b *baseAccountsSyncer) prepareCheckpoints(rootHash []byte) bool {
+	if b.timeBetweenCheckpoints <= 0 {
+		return false
+	}
+
+	targetRootHash, err := b.checkpointsStorer.Get([]byte(syncTargetRootHashKey))
+	if err == nil && bytes.Equal(targetRootHash, rootHash) {
+		log.Debug("resuming the interrupted trie sync", "name", b.name, "root hash", rootHash)
+		return true
+	}
+
+	b.removeCheckpoints()
+
+	err = b.checkpointsStorer.Put([]byte(syncTargetRootHashKey), rootHash)
+	if err != nil {
+		log.Warn("cannot save the trie sync target root hash", "name", b.name, "error", err)
+	}
+
+	return false
+}
+
+func (b *baseAccountsSyncer) removeCheckpoints() {
+	if b.timeBetweenCheckpoints <= 0 {
+		return
+	}
+
+	keys := make([][]byte, 0)
+	b.checkpointsStorer.RangeKeys(func(key []byte, _ []byte) bool {
+		keys = append(keys, bytes.Clone(key))
+		return true
+	})
+
+	for _, key := range keys {
+		err := b.checkpointsStorer.Remove(key)
+		if err != nil {
+			log.Debug("cannot remove trie sync checkpoint", "name", b.name, "key", key, "error", err)
+		}
+	}
+}
+
 func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context) {
 	lastDataReceived := uint64(0)
 	peakDataReceived := uint64(0)
@@ -12,6 +12,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/testscommon/marshallerMock"
 	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
 	"github.com/kalyan3104/k-chain-go/testscommon/storageManager"
+	"github.com/kalyan3104/k-chain-go/trie"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +31,7 @@ func getDefaultBaseAccSyncerArgs() syncer.ArgsNewBaseAccountsSyncer {
 		MaxHardCapForMissingNodes:         100,
 		TrieSyncerVersion:                 3,
 		CheckNodesOnDisk:                  false,
+		CheckpointsStorer:                 testscommon.NewMemDbMock(),
 	}
 }
 
@@ -108,6 +110,15 @@ func TestBaseAccountsSyncer_CheckArgs(t *testing.T) {
 		require.Equal(t, state.ErrInvalidMaxHardCapForMissingNodes, err)
 	})
 
+	t.Run("nil checkpoints storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultBaseAccSyncerArgs()
+		args.CheckpointsStorer = nil
+		err := syncer.CheckBaseAccountsSyncerArgs(args)
+		require.Equal(t, trie.ErrNilSyncCheckpointsStorer, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -32,6 +32,7 @@ func getDefaultBaseAccSyncerArgs() ArgsNewBaseAccountsSyncer {
 		TrieSyncerVersion:                 2,
 		CheckNodesOnDisk:                  false,
 		EnableEpochsHandler:               &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		CheckpointsStorer:                 testscommon.NewMemDbMock(),
 	}
 }
 
@@ -16,7 +16,9 @@ import (
 	"github.com/kalyan3104/k-chain-go/process/factory"
 	"github.com/kalyan3104/k-chain-go/state"
 	"github.com/kalyan3104/k-chain-go/state/accounts"
+	"github.com/kalyan3104/k-chain-go/state/parsers"
 	"github.com/kalyan3104/k-chain-go/trie"
+	"github.com/kalyan3104/k-chain-go/trie/keyBuilder"
 	logger "github.com/kalyan3104/k-chain-logger-go"
 )
 
@@ -93,6 +95,8 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		userAccountsSyncStatisticsHandler: args.UserAccountsSyncStatisticsHandler,
 		appStatusHandler:                  args.AppStatusHandler,
 		enableEpochsHandler:               args.EnableEpochsHandler,
+		checkpointsStorer:                 args.CheckpointsStorer,
+		timeBetweenCheckpoints:            args.TimeBetweenCheckpoints,
 	}
 
 	u := &userAccountsSyncer{
@@ -124,6 +128,8 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 
 	go u.printStatisticsAndUpdateMetrics(ctx)
 
+	isResumed := u.prepareCheckpoints(rootHash)
+
 	leavesChannels := &common.TrieIteratorChannels{
 		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelSyncCapacity),
 		ErrChan:    errChan.NewErrChanWrapper(),
@@ -143,7 +149,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 		wgSyncMainTrie.Done()
 	}()
 
-	err := u.syncAccountDataTries(leavesChannels, ctx)
+	err := u.syncAccountDataTries(leavesChannels, ctx, u.checkNodesOnDisk)
 	if err != nil {
 		return err
 	}
@@ -155,6 +161,15 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 		return err
 	}
 
+	if isResumed {
+		err = u.syncDataTriesOfResumedMainTrie(rootHash, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	u.removeCheckpoints()
+
 	storageMarker.MarkStorerAsSyncedAndActive(u.trieStorageManager)
 
 	log.Debug("main trie and data tries synced", "main trie root hash", rootHash, "num data tries", len(u.dataTries))
@@ -162,7 +177,38 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 	return nil
 }
 
-func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, address []byte, ctx context.Context) error {
+// syncDataTriesOfResumedMainTrie makes sure that the data tries of the accounts processed before the main trie sync was
+// interrupted are complete. The data trie nodes that are already on disk will not be requested again
+func (u *userAccountsSyncer) syncDataTriesOfResumedMainTrie(rootHash []byte, ctx context.Context) error {
+	mainTrie, err := trie.NewTrie(u.trieStorageManager, u.marshalizer, u.hasher, u.enableEpochsHandler, u.maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelSyncCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = mainTrie.GetAllLeavesOnChannel(
+		leavesChannels,
+		ctx,
+		rootHash,
+		keyBuilder.NewDisabledKeyBuilder(),
+		parsers.NewMainTrieLeafParser(),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = u.syncAccountDataTries(leavesChannels, ctx, true)
+	if err != nil {
+		return err
+	}
+
+	return leavesChannels.ErrChan.ReadFromChanNonBlocking()
+}
+
+func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, address []byte, ctx context.Context, checkNodesOnDisk bool) error {
 	u.syncerMutex.Lock()
 	_, ok := u.dataTries[string(rootHash)]
 	if ok {
@@ -173,7 +219,7 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, address []byte, ctx c
 	u.dataTries[string(rootHash)] = struct{}{}
 	u.syncerMutex.Unlock()
 
-	trieSyncer, err := u.createAndStartSyncer(ctx, rootHash, u.checkNodesOnDisk)
+	trieSyncer, err := u.createAndStartSyncer(ctx, rootHash, checkNodesOnDisk)
 	if err != nil {
 		return err
 	}
@@ -201,6 +247,8 @@ func (u *userAccountsSyncer) createAndStartSyncer(
 		MaxHardCapForMissingNodes: u.maxHardCapForMissingNodes,
 		CheckNodesOnDisk:          checkNodesOnDisk,
 		LeavesChan:                nil, // not used for data tries
+		CheckpointsStorer:         u.checkpointsStorer,
+		TimeBetweenCheckpoints:    u.timeBetweenCheckpoints,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
 	if err != nil {
@@ -239,6 +287,7 @@ func (u *userAccountsSyncer) updateDataTrieStatistics(trieSyncer trie.TrieSyncer
 func (u *userAccountsSyncer) syncAccountDataTries(
 	leavesChannels *common.TrieIteratorChannels,
 	ctx context.Context,
+	checkNodesOnDisk bool,
 ) error {
 	if leavesChannels == nil {
 		return trie.ErrNilTrieIteratorChannels
@@ -274,7 +323,7 @@ func (u *userAccountsSyncer) syncAccountDataTries(
 			defer u.throttler.EndProcessing()
 
 			log.Trace("sync data trie", "roothash", trieRootHash)
-			err := u.syncDataTrie(trieRootHash, address, ctx)
+			err := u.syncDataTrie(trieRootHash, address, ctx, checkNodesOnDisk)
 			if err != nil {
 				leavesChannels.ErrChan.WriteInChanNonBlocking(err)
 			}
@@ -51,6 +51,8 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		userAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 		appStatusHandler:                  args.AppStatusHandler,
 		enableEpochsHandler:               args.EnableEpochsHandler,
+		checkpointsStorer:                 args.CheckpointsStorer,
+		timeBetweenCheckpoints:            args.TimeBetweenCheckpoints,
 	}
 
 	u := &validatorAccountsSyncer{
@@ -80,6 +82,8 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker co
 
 	go v.printStatisticsAndUpdateMetrics(ctx)
 
+	v.prepareCheckpoints(rootHash)
+
 	err := v.syncMainTrie(
 		rootHash,
 		factory.ValidatorTrieNodesTopic,
@@ -90,6 +94,8 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker co
 		return err
 	}
 
+	v.removeCheckpoints()
+
 	storageMarker.MarkStorerAsSyncedAndActive(v.trieStorageManager)
 
 	return nil
@@ -3,6 +3,7 @@ package syncer_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/state"
@@ -103,6 +104,47 @@ func TestValidatorAccountsSyncer_SyncAccounts(t *testing.T) {
 		err = v.SyncAccounts(key, storageMarker.NewDisabledStorageMarker())
 		require.Nil(t, err)
 	})
+
+	t.Run("should remove the checkpoints after the sync", func(t *testing.T) {
+		t.Parallel()
+
+		args := syncer.ArgsNewValidatorAccountsSyncer{
+			ArgsNewBaseAccountsSyncer: getDefaultBaseAccSyncerArgs(),
+		}
+
+		serializedLeafNode := getSerializedTrieNode(key, args.Marshalizer, args.Hasher)
+		itn, err := trie.NewInterceptedTrieNode(serializedLeafNode, args.Hasher)
+		require.Nil(t, err)
+
+		args.TrieStorageManager = &storageManager.StorageManagerStub{
+			GetCalled: func(b []byte) ([]byte, error) {
+				return serializedLeafNode, nil
+			},
+		}
+
+		cacher := testscommon.NewCacherMock()
+		cacher.Put(key, itn, 0)
+		args.Cacher = cacher
+
+		checkpointsStorer := testscommon.NewMemDbMock()
+		_ = checkpointsStorer.Put([]byte("syncTargetRootHash"), []byte("another root hash"))
+		_ = checkpointsStorer.Put([]byte("checkpoint"), []byte("checkpoint of another root hash"))
+		args.CheckpointsStorer = checkpointsStorer
+		args.TimeBetweenCheckpoints = time.Minute
+
+		v, err := syncer.NewValidatorAccountsSyncer(args)
+		require.Nil(t, err)
+
+		err = v.SyncAccounts(key, storageMarker.NewDisabledStorageMarker())
+		require.Nil(t, err)
+
+		numKeys := 0
+		checkpointsStorer.RangeKeys(func(_ []byte, _ []byte) bool {
+			numKeys++
+			return true
+		})
+		require.Zero(t, numKeys)
+	})
 }
 
 func TestValidatorAccountsSyncer_IsInterfaceNil(t *testing.T) {
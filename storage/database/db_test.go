@@ -3,6 +3,7 @@ package database
 import (
 	"testing"
 
+	"github.com/kalyan3104/k-chain-go/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,3 +72,23 @@ func TestNewSerialDB(t *testing.T) {
 		_ = instance.Close()
 	})
 }
+
+func TestNewPebbleDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewPebbleDB(t.TempDir(), 1, 1, 0)
+		assert.Nil(t, instance)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewPebbleDB(t.TempDir(), 1, 1, 1)
+		assert.NotNil(t, instance)
+		assert.Nil(t, err)
+		_ = instance.Close()
+	})
+}
@@ -0,0 +1,307 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/kalyan3104/k-chain-go/storage"
+	logger "github.com/kalyan3104/k-chain-logger-go"
+)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// the persisters are used behind storage units that hold their own caches, so only a small block cache is used
+const pebbleBlockCacheSizeInBytes = 8 * 1024 * 1024
+
+var log = logger.GetOrCreate("storage/database")
+
+// PebbleDB is a persister backed by a Pebble key-value store. The writes are applied directly in the database
+// and are synced on disk once every batchDelaySeconds or after maxBatchSize writes, mirroring the levelDB persister
+type PebbleDB struct {
+	mutDb             sync.RWMutex
+	db                *pebble.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	mutUnsynced       sync.Mutex
+	numUnsyncedWrites int
+	cancel            context.CancelFunc
+}
+
+// NewPebbleDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewPebbleDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*PebbleDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	blockCache := pebble.NewCache(pebbleBlockCacheSizeInBytes)
+	defer blockCache.Unref()
+
+	options := &pebble.Options{
+		Cache:        blockCache,
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{path: path},
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &PebbleDB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		cancel:            cancel,
+	}
+
+	go dbStore.syncTimeoutHandle(ctx)
+
+	log.Debug("opened pebble db persister", "path", path)
+
+	return dbStore, nil
+}
+
+func (s *PebbleDB) syncTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			err := s.syncWrites()
+			if err != nil && !errors.Is(err, storage.ErrDBIsClosed) {
+				log.Warn("pebble syncWrites", "path", s.path, "error", err.Error())
+			}
+		case <-ctx.Done():
+			log.Debug("closing the timed sync handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// syncWrites will flush the write-ahead log on disk
+func (s *PebbleDB) syncWrites() error {
+	s.mutUnsynced.Lock()
+	defer s.mutUnsynced.Unlock()
+
+	if s.numUnsyncedWrites == 0 {
+		return nil
+	}
+
+	err := s.executeOnOpenedDb(func(db *pebble.DB) error {
+		return db.LogData(nil, pebble.Sync)
+	})
+	if err != nil {
+		return err
+	}
+	s.numUnsyncedWrites = 0
+
+	return nil
+}
+
+func (s *PebbleDB) updateUnsyncedWritesWithIncrement() error {
+	s.mutUnsynced.Lock()
+	s.numUnsyncedWrites++
+	shouldSync := s.numUnsyncedWrites >= s.maxBatchSize
+	s.mutUnsynced.Unlock()
+
+	if !shouldSync {
+		return nil
+	}
+
+	return s.syncWrites()
+}
+
+// executeOnOpenedDb calls the handler while guaranteeing that the database will not be closed concurrently,
+// as pebble does not allow any operation on a closed database
+func (s *PebbleDB) executeOnOpenedDb(handler func(db *pebble.DB) error) error {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	return handler(s.db)
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *PebbleDB) Put(key, val []byte) error {
+	err := s.executeOnOpenedDb(func(db *pebble.DB) error {
+		return db.Set(key, val, pebble.NoSync)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.updateUnsyncedWritesWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *PebbleDB) Get(key []byte) ([]byte, error) {
+	var data []byte
+	err := s.executeOnOpenedDb(func(db *pebble.DB) error {
+		value, closer, errGet := db.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		// the returned value is only valid until the closer is called
+		data = make([]byte, len(value))
+		copy(data, value)
+
+		return closer.Close()
+	})
+	if err == pebble.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *PebbleDB) Has(key []byte) error {
+	err := s.executeOnOpenedDb(func(db *pebble.DB) error {
+		_, closer, errGet := db.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		return closer.Close()
+	})
+	if err == pebble.ErrNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *PebbleDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := s.executeOnOpenedDb(func(db *pebble.DB) error {
+		iterator, errIter := db.NewIter(nil)
+		if errIter != nil {
+			return errIter
+		}
+
+		for valid := iterator.First(); valid; valid = iterator.Next() {
+			key := iterator.Key()
+			clonedKey := make([]byte, len(key))
+			copy(clonedKey, key)
+
+			val := iterator.Value()
+			clonedVal := make([]byte, len(val))
+			copy(clonedVal, val)
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				break
+			}
+		}
+
+		return iterator.Close()
+	})
+	if err != nil && err != storage.ErrDBIsClosed {
+		log.Warn("pebble RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Remove removes the data associated to the given key
+func (s *PebbleDB) Remove(key []byte) error {
+	err := s.executeOnOpenedDb(func(db *pebble.DB) error {
+		return db.Delete(key, pebble.NoSync)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.updateUnsyncedWritesWithIncrement()
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *PebbleDB) Close() error {
+	_ = s.syncWrites()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Destroy removes the storage medium stored data
+func (s *PebbleDB) Destroy() error {
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *PebbleDB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+func (s *PebbleDB) makeDbPointerNilReturningLast() *pebble.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *PebbleDB) IsInterfaceNil() bool {
+	return s == nil
+}
+
+// pebbleLogger redirects the pebble internal logs to the node's logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs the pebble informative messages at trace level
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace(fmt.Sprintf(format, args...), "path", pl.path)
+}
+
+// Fatalf logs the pebble fatal messages at error level. It does not panic, so that a storage unit failure
+// does not bring the whole node down
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	log.Error(fmt.Sprintf(format, args...), "path", pl.path)
+}
@@ -0,0 +1,204 @@
+package database_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDB(tb testing.TB, path string) *database.PebbleDB {
+	persister, err := database.NewPebbleDB(path, 2, 100, 10)
+	require.Nil(tb, err)
+
+	return persister
+}
+
+func TestPebbleDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	persister := createPebbleDB(t, t.TempDir())
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	err := persister.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := persister.Get(key)
+	require.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, persister.Has(key))
+
+	err = persister.Remove(key)
+	require.Nil(t, err)
+
+	recovered, err = persister.Get(key)
+	assert.Nil(t, recovered)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, persister.Has(key))
+}
+
+func TestPebbleDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	persister := createPebbleDB(t, t.TempDir())
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	}
+	for key, val := range keysVals {
+		_ = persister.Put([]byte(key), val)
+	}
+
+	t.Run("nil handler should not panic", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			if r != nil {
+				assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+			}
+		}()
+
+		persister.RangeKeys(nil)
+	})
+	t.Run("should iterate all keys", func(t *testing.T) {
+		recovered := make(map[string][]byte)
+		persister.RangeKeys(func(key []byte, value []byte) bool {
+			recovered[string(key)] = value
+			return true
+		})
+
+		assert.Equal(t, keysVals, recovered)
+	})
+	t.Run("should stop when the handler returns false", func(t *testing.T) {
+		numKeys := 0
+		persister.RangeKeys(func(key []byte, value []byte) bool {
+			numKeys++
+			return false
+		})
+
+		assert.Equal(t, 1, numKeys)
+	})
+}
+
+func TestPebbleDB_CloseShouldPersistAndReject(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	persister := createPebbleDB(t, dir)
+
+	key, val := []byte("key"), []byte("value")
+	_ = persister.Put(key, val)
+
+	err := persister.Close()
+	require.Nil(t, err)
+
+	assert.Equal(t, storage.ErrDBIsClosed, persister.Put(key, val))
+	assert.Equal(t, storage.ErrDBIsClosed, persister.Has(key))
+	assert.Equal(t, storage.ErrDBIsClosed, persister.Remove(key))
+	_, err = persister.Get(key)
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+	assert.Nil(t, persister.Close())
+
+	persister = createPebbleDB(t, dir)
+	recovered, err := persister.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	_ = persister.Close()
+}
+
+func TestPebbleDB_Destroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("opened persister", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		persister := createPebbleDB(t, dir)
+		_ = persister.Put([]byte("key"), []byte("value"))
+
+		err := persister.Destroy()
+		require.Nil(t, err)
+
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("closed persister", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		persister := createPebbleDB(t, dir)
+		_ = persister.Close()
+
+		err := persister.DestroyClosed()
+		require.Nil(t, err)
+
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestPebbleDB_ConcurrentOperationsWithClose(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+		}
+	}()
+
+	persister := createPebbleDB(t, t.TempDir())
+
+	numOperations := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key%d", idx))
+			switch idx % 5 {
+			case 0:
+				_ = persister.Put(key, key)
+			case 1:
+				_, _ = persister.Get(key)
+			case 2:
+				_ = persister.Has(key)
+			case 3:
+				_ = persister.Remove(key)
+			case 4:
+				persister.RangeKeys(func(key []byte, value []byte) bool {
+					return true
+				})
+			}
+		}(i)
+
+		if i == numOperations/2 {
+			_ = persister.Close()
+		}
+	}
+	wg.Wait()
+}
+
+func TestPebbleDB_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var persister *database.PebbleDB
+	assert.True(t, persister.IsInterfaceNil())
+
+	persister = createPebbleDB(t, t.TempDir())
+	assert.False(t, persister.IsInterfaceNil())
+	_ = persister.Close()
+}
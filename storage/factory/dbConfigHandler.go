@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/kalyan3104/k-chain-core-go/core"
 	"github.com/kalyan3104/k-chain-go/config"
+	"github.com/kalyan3104/k-chain-go/storage/storageunit"
 )
 
 const (
-	dbConfigFileName = "config.toml"
-	defaultType      = "LvlDBSerial"
+	dbConfigFileName        = "config.toml"
+	defaultType             = "LvlDBSerial"
+	pebbleOptionsFilePrefix = "OPTIONS-"
 )
 
 var (
@@ -55,7 +58,7 @@ func (dh *dbConfigHandler) GetDBConfig(path string) (*config.DBConfig, error) {
 	empty := checkIfDirIsEmpty(path)
 	if !empty {
 		dbConfig := &config.DBConfig{
-			Type:              defaultType,
+			Type:              getDefaultTypeForDir(path),
 			BatchDelaySeconds: dh.batchDelaySeconds,
 			MaxBatchSize:      dh.maxBatchSize,
 			MaxOpenFiles:      dh.maxOpenFiles,
@@ -153,6 +156,23 @@ func checkIfDirIsEmpty(path string) bool {
 	return false
 }
 
+// getDefaultTypeForDir returns the type of an existing database directory that has no config file. Only pebble
+// writes OPTIONS files, all the other directories being considered LevelDB directories
+func getDefaultTypeForDir(path string) string {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return defaultType
+	}
+
+	for _, file := range files {
+		if strings.HasPrefix(file.Name(), pebbleOptionsFilePrefix) {
+			return string(storageunit.PebbleDB)
+		}
+	}
+
+	return defaultType
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dh *dbConfigHandler) IsInterfaceNil() bool {
 	return dh == nil
@@ -90,6 +90,28 @@ func TestDBConfigHandler_GetDBConfig(t *testing.T) {
 		require.Nil(t, err)
 		require.Equal(t, expectedDBConfig, conf)
 	})
+	t.Run("not empty dir with pebble files, load default provided config with pebble type", func(t *testing.T) {
+		t.Parallel()
+
+		testConfig := createDefaultDBConfig()
+		pf := factory.NewDBConfigHandler(testConfig)
+
+		dirPath := t.TempDir()
+
+		f, _ := os.Create(path.Join(dirPath, "OPTIONS-000003"))
+		_ = f.Close()
+
+		expectedDBConfig := &config.DBConfig{
+			Type:              "PebbleDB",
+			BatchDelaySeconds: testConfig.BatchDelaySeconds,
+			MaxBatchSize:      testConfig.MaxBatchSize,
+			MaxOpenFiles:      testConfig.MaxOpenFiles,
+		}
+
+		conf, err := pf.GetDBConfig(dirPath)
+		require.Nil(t, err)
+		require.Equal(t, expectedDBConfig, conf)
+	})
 	t.Run("empty config.toml file, load default db config", func(t *testing.T) {
 		t.Parallel()
 
@@ -109,6 +109,22 @@ func TestPersisterCreator_CreateBasePersister(t *testing.T) {
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*leveldb.SerialDB"))
 	})
 
+	t.Run("pebbledb", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultBasePersisterConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.CreateBasePersister(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*database.PebbleDB"))
+		_ = p.Close()
+	})
+
 	t.Run("memorydb", func(t *testing.T) {
 		t.Parallel()
 
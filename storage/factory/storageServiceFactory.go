@@ -527,6 +527,19 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.MiniblocksMetadataUnit, miniblocksMetadataPruningStorer)
 
+	// Create the eventsByAddress (PRUNING) storer
+	eventsByAddressConfig := psf.generalConfig.DbLookupExtensions.EventsByAddressStorageConfig
+	eventsByAddressPruningStorerArgs, err := psf.createPruningStorerArgs(eventsByAddressConfig, disabled.NewDisabledCustomDatabaseRemover())
+	if err != nil {
+		return err
+	}
+	eventsByAddressPruningStorer, err := psf.createPruningPersister(eventsByAddressPruningStorerArgs)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.EventsByAddressStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.EventsByAddressUnit, eventsByAddressPruningStorer)
+
 	// Create the miniblocksHashByTxHash (STATIC) storer
 	miniblockHashByTxHashConfig := psf.generalConfig.DbLookupExtensions.MiniblockHashByTxHashStorageConfig
 	miniblockHashByTxHashDbConfig := GetDBFromConfig(miniblockHashByTxHashConfig.DB)
@@ -573,6 +586,29 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.RoundHdrHashDataUnit, blockHashByRoundUnit)
 
+	// Create the timestampToNonce (STATIC) storer
+	timestampToNonceConfig := psf.generalConfig.DbLookupExtensions.TimestampToNonceStorageConfig
+	timestampToNonceDBConfig := GetDBFromConfig(timestampToNonceConfig.DB)
+	timestampToNonceDBConfig.FilePath = psf.pathManager.PathForStatic(shardID, timestampToNonceConfig.DB.FilePath)
+	timestampToNonceCacherConfig := GetCacherFromConfig(timestampToNonceConfig.Cache)
+
+	dbConfigHandlerInstance = NewDBConfigHandler(timestampToNonceConfig.DB)
+	timestampToNoncePersisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return err
+	}
+
+	timestampToNonceUnit, err := storageunit.NewStorageUnitFromConf(
+		timestampToNonceCacherConfig,
+		timestampToNonceDBConfig,
+		timestampToNoncePersisterCreator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.TimestampToNonceStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.TimestampToNonceUnit, timestampToNonceUnit)
+
 	// Create the epochByHash (STATIC) storer
 	epochByHashConfig := psf.generalConfig.DbLookupExtensions.EpochByHashStorageConfig
 	epochByHashDbConfig := GetDBFromConfig(epochByHashConfig.DB)
@@ -24,6 +24,9 @@ type NodeHandlerMock struct {
 	SetKeyValueForAddressCalled   func(addressBytes []byte, state map[string]string) error
 	SetStateForAddressCalled      func(address []byte, state *dtos.AddressState) error
 	RemoveAccountCalled           func(address []byte) error
+	TakeSnapshotCalled            func(id uint64) error
+	RevertToSnapshotCalled        func(id uint64) error
+	ReleaseSnapshotCalled         func(id uint64)
 	CloseCalled                   func() error
 }
 
@@ -132,6 +135,31 @@ func (mock *NodeHandlerMock) RemoveAccount(address []byte) error {
 	return nil
 }
 
+// TakeSnapshot -
+func (mock *NodeHandlerMock) TakeSnapshot(id uint64) error {
+	if mock.TakeSnapshotCalled != nil {
+		return mock.TakeSnapshotCalled(id)
+	}
+
+	return nil
+}
+
+// RevertToSnapshot -
+func (mock *NodeHandlerMock) RevertToSnapshot(id uint64) error {
+	if mock.RevertToSnapshotCalled != nil {
+		return mock.RevertToSnapshotCalled(id)
+	}
+
+	return nil
+}
+
+// ReleaseSnapshot -
+func (mock *NodeHandlerMock) ReleaseSnapshot(id uint64) {
+	if mock.ReleaseSnapshotCalled != nil {
+		mock.ReleaseSnapshotCalled(id)
+	}
+}
+
 // Close -
 func (mock *NodeHandlerMock) Close() error {
 	if mock.CloseCalled != nil {
@@ -0,0 +1,125 @@
+package chainSimulator
+
+import (
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/dtos"
+	"github.com/kalyan3104/k-chain-go/node/chainSimulator/process"
+)
+
+// SimulatorHandlerMock -
+type SimulatorHandlerMock struct {
+	GenerateBlocksCalled                    func(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReachedCalled func(targetEpoch int32) error
+	SetStateMultipleCalled                  func(stateSlice []*dtos.AddressState) error
+	GetInitialWalletKeysCalled              func() *dtos.InitialWalletKeys
+	GetRestAPIInterfacesCalled              func() map[uint32]string
+	GetNodeHandlerCalled                    func(shardID uint32) process.NodeHandler
+	StartBlocksProductionCalled             func(config dtos.BlocksProductionConfig) error
+	PauseBlocksProductionCalled             func() error
+	ResumeBlocksProductionCalled            func() error
+	StopBlocksProductionCalled              func() error
+	GetBlocksProductionStatusCalled         func() dtos.BlocksProductionStatus
+}
+
+// GenerateBlocks -
+func (mock *SimulatorHandlerMock) GenerateBlocks(numOfBlocks int) error {
+	if mock.GenerateBlocksCalled != nil {
+		return mock.GenerateBlocksCalled(numOfBlocks)
+	}
+
+	return nil
+}
+
+// GenerateBlocksUntilEpochIsReached -
+func (mock *SimulatorHandlerMock) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
+	if mock.GenerateBlocksUntilEpochIsReachedCalled != nil {
+		return mock.GenerateBlocksUntilEpochIsReachedCalled(targetEpoch)
+	}
+
+	return nil
+}
+
+// SetStateMultiple -
+func (mock *SimulatorHandlerMock) SetStateMultiple(stateSlice []*dtos.AddressState) error {
+	if mock.SetStateMultipleCalled != nil {
+		return mock.SetStateMultipleCalled(stateSlice)
+	}
+
+	return nil
+}
+
+// GetInitialWalletKeys -
+func (mock *SimulatorHandlerMock) GetInitialWalletKeys() *dtos.InitialWalletKeys {
+	if mock.GetInitialWalletKeysCalled != nil {
+		return mock.GetInitialWalletKeysCalled()
+	}
+
+	return nil
+}
+
+// GetRestAPIInterfaces -
+func (mock *SimulatorHandlerMock) GetRestAPIInterfaces() map[uint32]string {
+	if mock.GetRestAPIInterfacesCalled != nil {
+		return mock.GetRestAPIInterfacesCalled()
+	}
+
+	return nil
+}
+
+// GetNodeHandler -
+func (mock *SimulatorHandlerMock) GetNodeHandler(shardID uint32) process.NodeHandler {
+	if mock.GetNodeHandlerCalled != nil {
+		return mock.GetNodeHandlerCalled(shardID)
+	}
+
+	return nil
+}
+
+// StartBlocksProduction -
+func (mock *SimulatorHandlerMock) StartBlocksProduction(config dtos.BlocksProductionConfig) error {
+	if mock.StartBlocksProductionCalled != nil {
+		return mock.StartBlocksProductionCalled(config)
+	}
+
+	return nil
+}
+
+// PauseBlocksProduction -
+func (mock *SimulatorHandlerMock) PauseBlocksProduction() error {
+	if mock.PauseBlocksProductionCalled != nil {
+		return mock.PauseBlocksProductionCalled()
+	}
+
+	return nil
+}
+
+// ResumeBlocksProduction -
+func (mock *SimulatorHandlerMock) ResumeBlocksProduction() error {
+	if mock.ResumeBlocksProductionCalled != nil {
+		return mock.ResumeBlocksProductionCalled()
+	}
+
+	return nil
+}
+
+// StopBlocksProduction -
+func (mock *SimulatorHandlerMock) StopBlocksProduction() error {
+	if mock.StopBlocksProductionCalled != nil {
+		return mock.StopBlocksProductionCalled()
+	}
+
+	return nil
+}
+
+// GetBlocksProductionStatus -
+func (mock *SimulatorHandlerMock) GetBlocksProductionStatus() dtos.BlocksProductionStatus {
+	if mock.GetBlocksProductionStatusCalled != nil {
+		return mock.GetBlocksProductionStatusCalled()
+	}
+
+	return dtos.BlocksProductionStatus{}
+}
+
+// IsInterfaceNil -
+func (mock *SimulatorHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
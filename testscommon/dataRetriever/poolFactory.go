@@ -15,6 +15,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/storage/cache"
 	storageFactory "github.com/kalyan3104/k-chain-go/storage/factory"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
 	"github.com/kalyan3104/k-chain-go/testscommon/txcachemocks"
 	"github.com/kalyan3104/k-chain-go/trie/factory"
 )
@@ -45,6 +46,7 @@ func CreateTxPool(numShards uint32, selfShard uint32) (dataRetriever.ShardedData
 				MinimumGasPrice:      200000000000,
 				GasProcessingDivisor: 100,
 			},
+			AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
 		},
 	)
 }
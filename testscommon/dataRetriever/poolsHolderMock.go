@@ -14,6 +14,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/storage"
 	"github.com/kalyan3104/k-chain-go/storage/cache"
 	"github.com/kalyan3104/k-chain-go/storage/storageunit"
+	"github.com/kalyan3104/k-chain-go/testscommon/statusHandler"
 	"github.com/kalyan3104/k-chain-go/testscommon/txcachemocks"
 )
 
@@ -54,7 +55,8 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 				MinimumGasPrice:      200000000000,
 				GasProcessingDivisor: 100,
 			},
-			NumberOfShards: 1,
+			AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+			NumberOfShards:   1,
 		},
 	)
 	panicIfError("NewPoolsHolderMock", err)
@@ -17,6 +17,8 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetDCDTSupplyCalled                func(token string) (*dcdtSupply.SupplyDCDT, error)
+	GetEventsCalled                    func(query dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error)
+	GetBlockNonceByTimestampCalled     func(timestamp uint64) (uint64, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -89,6 +91,24 @@ func (hp *HistoryRepositoryStub) GetDCDTSupply(token string) (*dcdtSupply.Supply
 	return nil, nil
 }
 
+// GetEvents -
+func (hp *HistoryRepositoryStub) GetEvents(query dblookupext.EventsQuery) (*dblookupext.EventsQueryResult, error) {
+	if hp.GetEventsCalled != nil {
+		return hp.GetEventsCalled(query)
+	}
+
+	return nil, nil
+}
+
+// GetBlockNonceByTimestamp -
+func (hp *HistoryRepositoryStub) GetBlockNonceByTimestamp(timestamp uint64) (uint64, error) {
+	if hp.GetBlockNonceByTimestampCalled != nil {
+		return hp.GetBlockNonceByTimestampCalled(timestamp)
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -16,6 +16,7 @@ type StateComponentsMock struct {
 	Tries                    common.TriesHolder
 	StorageManagers          map[string]common.StorageManager
 	MissingNodesNotifier     common.MissingTrieNodesNotifier
+	FlatStateHandler         state.FlatStateHandler
 }
 
 // NewStateComponentsMockFromRealComponent -
@@ -28,6 +29,7 @@ func NewStateComponentsMockFromRealComponent(stateComponents factory.StateCompon
 		Tries:                stateComponents.TriesContainer(),
 		StorageManagers:      stateComponents.TrieStorageManagers(),
 		MissingNodesNotifier: stateComponents.MissingTrieNodesNotifier(),
+		FlatStateHandler:     stateComponents.FlatState(),
 	}
 }
 
@@ -89,6 +91,11 @@ func (scm *StateComponentsMock) MissingTrieNodesNotifier() common.MissingTrieNod
 	return scm.MissingNodesNotifier
 }
 
+// FlatState -
+func (scm *StateComponentsMock) FlatState() state.FlatStateHandler {
+	return scm.FlatStateHandler
+}
+
 // IsInterfaceNil -
 func (scm *StateComponentsMock) IsInterfaceNil() bool {
 	return scm == nil
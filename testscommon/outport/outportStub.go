@@ -11,6 +11,8 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating)
 	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
 	HasDriversCalled            func() bool
+	SubscribeDriverCalled       func(driver outport.Driver) error
+	UnsubscribeDriverCalled     func(driver outport.Driver) error
 }
 
 // SaveBlock -
@@ -70,7 +72,20 @@ func (as *OutportStub) SaveRoundsInfo(_ *outportcore.RoundsInfo) {
 }
 
 // SubscribeDriver -
-func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
+func (as *OutportStub) SubscribeDriver(driver outport.Driver) error {
+	if as.SubscribeDriverCalled != nil {
+		return as.SubscribeDriverCalled(driver)
+	}
+
+	return nil
+}
+
+// UnsubscribeDriver -
+func (as *OutportStub) UnsubscribeDriver(driver outport.Driver) error {
+	if as.UnsubscribeDriverCalled != nil {
+		return as.UnsubscribeDriverCalled(driver)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package state
 
 import (
+	"context"
+
 	"github.com/kalyan3104/k-chain-core-go/data/api"
 	"github.com/kalyan3104/k-chain-go/common"
 	"github.com/kalyan3104/k-chain-go/state"
@@ -11,6 +13,7 @@ import (
 type AccountsRepositoryStub struct {
 	GetAccountWithBlockInfoCalled        func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error)
 	GetCodeWithBlockInfoCalled           func(codeHash []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error)
+	GetAllLeavesCalled                   func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error
 	GetCurrentStateAccountsWrapperCalled func() state.AccountsAdapterAPI
 	CloseCalled                          func() error
 }
@@ -33,6 +36,15 @@ func (stub *AccountsRepositoryStub) GetCodeWithBlockInfo(codeHash []byte, option
 	return nil, nil, nil
 }
 
+// GetAllLeaves -
+func (stub *AccountsRepositoryStub) GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, options api.AccountQueryOptions, trieLeafParser common.TrieLeafParser) error {
+	if stub.GetAllLeavesCalled != nil {
+		return stub.GetAllLeavesCalled(leavesChannels, ctx, options, trieLeafParser)
+	}
+
+	return nil
+}
+
 // GetCurrentStateAccountsWrapper -
 func (stub *AccountsRepositoryStub) GetCurrentStateAccountsWrapper() state.AccountsAdapterAPI {
 	if stub.GetCurrentStateAccountsWrapperCalled != nil {
@@ -0,0 +1,24 @@
+package state
+
+import (
+	"github.com/kalyan3104/k-chain-go/common"
+)
+
+// PortableSnapshotExporterStub -
+type PortableSnapshotExporterStub struct {
+	ExportSnapshotCalled func(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error
+}
+
+// ExportSnapshot -
+func (stub *PortableSnapshotExporterStub) ExportSnapshot(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error {
+	if stub.ExportSnapshotCalled != nil {
+		return stub.ExportSnapshotCalled(rootHash, epoch, trieStorageManager)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PortableSnapshotExporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,23 @@
+package state
+
+import (
+	"github.com/kalyan3104/k-chain-go/state"
+)
+
+// SimulationAccountsStub -
+type SimulationAccountsStub struct {
+	AccountsStub
+	SetAccountsSourceCalled func(accountsDB state.AccountsAdapter)
+}
+
+// SetAccountsSource -
+func (sas *SimulationAccountsStub) SetAccountsSource(accountsDB state.AccountsAdapter) {
+	if sas.SetAccountsSourceCalled != nil {
+		sas.SetAccountsSourceCalled(accountsDB)
+	}
+}
+
+// IsInterfaceNil -
+func (sas *SimulationAccountsStub) IsInterfaceNil() bool {
+	return sas == nil
+}
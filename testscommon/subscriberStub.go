@@ -0,0 +1,52 @@
+package testscommon
+
+import "github.com/kalyan3104/k-chain-go/common"
+
+// SubscriberStub -
+type SubscriberStub struct {
+	SubscribeCalled   func(request common.SubscriptionRequest) (string, error)
+	UnsubscribeCalled func(subscriptionID string) error
+	EventsCalled      func() <-chan *common.SubscriptionEvent
+	CloseCalled       func() error
+}
+
+// Subscribe -
+func (ss *SubscriberStub) Subscribe(request common.SubscriptionRequest) (string, error) {
+	if ss.SubscribeCalled != nil {
+		return ss.SubscribeCalled(request)
+	}
+
+	return "", nil
+}
+
+// Unsubscribe -
+func (ss *SubscriberStub) Unsubscribe(subscriptionID string) error {
+	if ss.UnsubscribeCalled != nil {
+		return ss.UnsubscribeCalled(subscriptionID)
+	}
+
+	return nil
+}
+
+// Events -
+func (ss *SubscriberStub) Events() <-chan *common.SubscriptionEvent {
+	if ss.EventsCalled != nil {
+		return ss.EventsCalled()
+	}
+
+	return nil
+}
+
+// Close -
+func (ss *SubscriberStub) Close() error {
+	if ss.CloseCalled != nil {
+		return ss.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ss *SubscriberStub) IsInterfaceNil() bool {
+	return ss == nil
+}
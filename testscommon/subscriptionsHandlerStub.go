@@ -0,0 +1,32 @@
+package testscommon
+
+import "github.com/kalyan3104/k-chain-go/common"
+
+// SubscriptionsHandlerStub -
+type SubscriptionsHandlerStub struct {
+	CreateSubscriberCalled func() (common.SubscriberHandler, error)
+	CloseCalled            func() error
+}
+
+// CreateSubscriber -
+func (shs *SubscriptionsHandlerStub) CreateSubscriber() (common.SubscriberHandler, error) {
+	if shs.CreateSubscriberCalled != nil {
+		return shs.CreateSubscriberCalled()
+	}
+
+	return &SubscriberStub{}, nil
+}
+
+// Close -
+func (shs *SubscriptionsHandlerStub) Close() error {
+	if shs.CloseCalled != nil {
+		return shs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (shs *SubscriptionsHandlerStub) IsInterfaceNil() bool {
+	return shs == nil
+}
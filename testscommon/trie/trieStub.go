@@ -28,6 +28,8 @@ type TrieStub struct {
 	GetAllLeavesOnChannelCalled     func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder, trieLeafParser common.TrieLeafParser) error
 	GetProofCalled                  func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled               func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProofCalled             func(keys [][]byte) ([][]byte, [][]byte, error)
+	VerifyMultiProofCalled          func(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error)
 	GetStorageManagerCalled         func() common.StorageManager
 	GetSerializedNodeCalled         func(bytes []byte) ([]byte, error)
 	GetOldRootCalled                func() []byte
@@ -63,6 +65,24 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (ts *TrieStub) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error) {
+	if ts.VerifyMultiProofCalled != nil {
+		return ts.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return false, nil, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder, trieLeafParser common.TrieLeafParser) error {
 	if ts.GetAllLeavesOnChannelCalled != nil {
@@ -0,0 +1,32 @@
+package txcachemocks
+
+import (
+	"github.com/kalyan3104/k-chain-storage-go/txcache"
+)
+
+// TxCacheStub -
+type TxCacheStub struct {
+	SelectTransactionsWithBandwidthCalled func(numRequested int, batchSizePerSender int, bandwidthPerSender uint64) []*txcache.WrappedTransaction
+	NotifyAccountNonceCalled              func(accountKey []byte, nonce uint64)
+}
+
+// SelectTransactionsWithBandwidth -
+func (stub *TxCacheStub) SelectTransactionsWithBandwidth(numRequested int, batchSizePerSender int, bandwidthPerSender uint64) []*txcache.WrappedTransaction {
+	if stub.SelectTransactionsWithBandwidthCalled != nil {
+		return stub.SelectTransactionsWithBandwidthCalled(numRequested, batchSizePerSender, bandwidthPerSender)
+	}
+
+	return make([]*txcache.WrappedTransaction, 0)
+}
+
+// NotifyAccountNonce -
+func (stub *TxCacheStub) NotifyAccountNonce(accountKey []byte, nonce uint64) {
+	if stub.NotifyAccountNonceCalled != nil {
+		stub.NotifyAccountNonceCalled(accountKey, nonce)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TxCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
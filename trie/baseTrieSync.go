@@ -3,6 +3,8 @@ package trie
 import (
 	"sync"
 	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
 )
 
 type baseSyncTrie struct {
@@ -10,6 +12,7 @@ type baseSyncTrie struct {
 	numTrieNodes  uint64
 	numLeaves     uint64
 	numBytes      uint64
+	numLarge      uint64
 	duration      time.Duration
 }
 
@@ -19,6 +22,9 @@ func (bst *baseSyncTrie) updateStats(bytesToAdd uint64, element node) {
 	bst.mutStatistics.Lock()
 	bst.numBytes += bytesToAdd
 	bst.numTrieNodes++
+	if bytesToAdd > core.MaxBufferSizeToSendTrieNodes {
+		bst.numLarge++
+	}
 	if isLeaf {
 		bst.numLeaves++
 	}
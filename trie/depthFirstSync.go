@@ -32,6 +32,7 @@ type depthFirstTrieSyncer struct {
 	nodes                     *trieNodesHandler
 	requestedHashes           map[string]*request
 	leavesChan                chan core.KeyValueHolder
+	checkpointer              *syncCheckpointer
 }
 
 // NewDepthFirstTrieSyncer creates a new instance of trieSyncer that uses the depth-first algorithm
@@ -60,6 +61,7 @@ func NewDepthFirstTrieSyncer(arg ArgTrieSyncer) (*depthFirstTrieSyncer, error) {
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
 		checkNodesOnDisk:          arg.CheckNodesOnDisk,
 		leavesChan:                arg.LeavesChan,
+		checkpointer:              newSyncCheckpointer(arg.CheckpointsStorer, arg.TimeBetweenCheckpoints),
 	}
 
 	return d, nil
@@ -83,7 +85,7 @@ func (d *depthFirstTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 
 	d.rootHash = rootHash
 
-	d.nodes.addInitialRootHash(string(rootHash))
+	d.addInitialMissingHashes(rootHash)
 	d.requestedHashes = make(map[string]*request)
 
 	timeStart := time.Now()
@@ -98,9 +100,12 @@ func (d *depthFirstTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 		}
 		if isSynced {
 			d.trieSyncStatistics.SetNumMissing(d.rootHash, 0)
+			d.checkpointer.finish()
 			return nil
 		}
 
+		d.checkpointer.saveIfNeeded(d.createCheckpoint)
+
 		select {
 		case <-time.After(d.waitTimeBetweenChecks):
 			continue
@@ -110,6 +115,30 @@ func (d *depthFirstTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	}
 }
 
+func (d *depthFirstTrieSyncer) addInitialMissingHashes(rootHash []byte) {
+	checkpoint, found := d.checkpointer.start(rootHash)
+	if !found {
+		d.nodes.addInitialRootHash(string(rootHash))
+		return
+	}
+
+	checkpoint.restoreStatistics(&d.baseSyncTrie, d.trieSyncStatistics)
+	for _, hash := range checkpoint.hashes {
+		d.nodes.addInitialRootHash(string(hash))
+	}
+}
+
+// createCheckpoint builds the checkpoint from the current frontier, keeping the depth-first order. The existing nodes
+// were not yet committed, so they will be treated as missing on resume
+func (d *depthFirstTrieSyncer) createCheckpoint() *syncCheckpoint {
+	hashes := make([][]byte, 0, len(d.nodes.hashesOrder))
+	for _, hash := range d.nodes.hashesOrder {
+		hashes = append(hashes, []byte(hash))
+	}
+
+	return newSyncCheckpoint(&d.baseSyncTrie, hashes)
+}
+
 func (d *depthFirstTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
 	if d.timeoutHandler.IsTimeout() {
 		return false, ErrTrieSyncTimeout
@@ -209,3 +209,38 @@ func TestDepthFirstTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testi
 		require.Equal(t, keyVal, val)
 	}
 }
+
+func TestDepthFirstTrieSyncer_StartSyncingShouldResumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	arg, roothash := prepareResumedSync(t, numKeysValues)
+
+	d, _ := NewDepthFirstTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := d.StartSyncing(roothash, ctx)
+	require.Nil(t, err)
+
+	requireResumedSyncFinished(t, arg, d, roothash, numKeysValues)
+}
+
+func TestDepthFirstTrieSyncer_StartSyncingShouldSaveCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	roothash := bytes.Repeat([]byte{1}, len(common.EmptyTrieHash))
+	arg := createMockArgument(time.Minute)
+	arg.TimeBetweenCheckpoints = time.Nanosecond
+
+	d, _ := NewDepthFirstTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second)
+	defer cancelFunc()
+
+	err := d.StartSyncing(roothash, ctx)
+	require.Equal(t, core.ErrContextClosing, err)
+
+	checkpoint, found := newSyncCheckpointer(arg.CheckpointsStorer, time.Minute).start(roothash)
+	require.True(t, found)
+	require.Equal(t, [][]byte{roothash}, checkpoint.hashes)
+}
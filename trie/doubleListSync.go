@@ -44,6 +44,7 @@ type doubleListTrieSyncer struct {
 	missingHashes             map[string]struct{}
 	requestedHashes           map[string]*request
 	leavesChan                chan core.KeyValueHolder
+	checkpointer              *syncCheckpointer
 }
 
 // NewDoubleListTrieSyncer creates a new instance of trieSyncer that uses 2 list for keeping the "margin" nodes.
@@ -75,6 +76,7 @@ func NewDoubleListTrieSyncer(arg ArgTrieSyncer) (*doubleListTrieSyncer, error) {
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
 		checkNodesOnDisk:          arg.CheckNodesOnDisk,
 		leavesChan:                arg.LeavesChan,
+		checkpointer:              newSyncCheckpointer(arg.CheckpointsStorer, arg.TimeBetweenCheckpoints),
 	}
 
 	return d, nil
@@ -101,7 +103,7 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	d.rootFound = false
 	d.rootHash = rootHash
 
-	d.missingHashes[string(rootHash)] = struct{}{}
+	d.addInitialMissingHashes(rootHash)
 
 	timeStart := time.Now()
 	defer func() {
@@ -115,9 +117,12 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 		}
 		if isSynced {
 			d.trieSyncStatistics.SetNumMissing(d.rootHash, 0)
+			d.checkpointer.finish()
 			return nil
 		}
 
+		d.checkpointer.saveIfNeeded(d.createCheckpoint)
+
 		select {
 		case <-time.After(d.waitTimeBetweenChecks):
 			continue
@@ -127,6 +132,33 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	}
 }
 
+func (d *doubleListTrieSyncer) addInitialMissingHashes(rootHash []byte) {
+	checkpoint, found := d.checkpointer.start(rootHash)
+	if !found {
+		d.missingHashes[string(rootHash)] = struct{}{}
+		return
+	}
+
+	checkpoint.restoreStatistics(&d.baseSyncTrie, d.trieSyncStatistics)
+	for _, hash := range checkpoint.hashes {
+		d.missingHashes[string(hash)] = struct{}{}
+	}
+}
+
+// createCheckpoint builds the checkpoint from the current frontier. The existing nodes were not yet committed, so
+// they will be treated as missing on resume
+func (d *doubleListTrieSyncer) createCheckpoint() *syncCheckpoint {
+	hashes := make([][]byte, 0, len(d.missingHashes)+len(d.existingNodes))
+	for hash := range d.missingHashes {
+		hashes = append(hashes, []byte(hash))
+	}
+	for hash := range d.existingNodes {
+		hashes = append(hashes, []byte(hash))
+	}
+
+	return newSyncCheckpoint(&d.baseSyncTrie, hashes)
+}
+
 func (d *doubleListTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
 	if d.timeoutHandler.IsTimeout() {
 		return false, ErrTrieSyncTimeout
@@ -289,3 +289,19 @@ func TestDoubleListTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testi
 		require.Equal(t, keyVal, val)
 	}
 }
+
+func TestDoubleListTrieSyncer_StartSyncingShouldResumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	arg, roothash := prepareResumedSync(t, numKeysValues)
+
+	d, _ := NewDoubleListTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := d.StartSyncing(roothash, ctx)
+	require.Nil(t, err)
+
+	requireResumedSyncFinished(t, arg, d, roothash, numKeysValues)
+}
@@ -123,3 +123,9 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrNilSyncCheckpointsStorer signals that a nil trie sync checkpoints storer has been provided
+var ErrNilSyncCheckpointsStorer = errors.New("nil trie sync checkpoints storer")
+
+// ErrInvalidSyncCheckpoint signals that a trie sync checkpoint could not be decoded
+var ErrInvalidSyncCheckpoint = errors.New("invalid trie sync checkpoint")
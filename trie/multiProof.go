@@ -0,0 +1,146 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+)
+
+// GetMultiProof computes a single Merkle proof for all the given keys. The nodes shared between the keys paths
+// are added only once. For each key, the returned values hold the value found in the trie or nil if the key is
+// absent, case in which the proof holds the nodes showing that the key path ends before reaching a matching leaf
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proof := make([][]byte, 0)
+	addedNodes := make(map[string]struct{})
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, errCollect := tr.collectProofNodes(key, func(encodedNode []byte) {
+			_, isAdded := addedNodes[string(encodedNode)]
+			if isAdded {
+				return
+			}
+
+			addedNodes[string(encodedNode)] = struct{}{}
+			proof = append(proof, encodedNode)
+		})
+		if errCollect != nil {
+			return nil, nil, errCollect
+		}
+
+		values = append(values, value)
+	}
+
+	return proof, values, nil
+}
+
+func (tr *patriciaMerkleTrie) collectProofNodes(key []byte, addNode func(encodedNode []byte)) ([]byte, error) {
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for {
+		encodedNode, err := currentNode.getEncodedNode()
+		if err != nil {
+			return nil, err
+		}
+		addNode(encodedNode)
+		value := currentNode.getValue()
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage)
+		if errors.Is(err, ErrNodeNotFound) {
+			// the key path ends in the last added node, proving that the key is absent
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if currentNode == nil {
+			return value, nil
+		}
+	}
+}
+
+// VerifyMultiProof verifies the given multi-proof and returns, for each key, the proven value or nil if the proof
+// shows that the key is absent. It returns false if the proof misses any of the nodes needed for a key
+func (tr *patriciaMerkleTrie) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error) {
+	tr.mutOperation.RLock()
+	defer tr.mutOperation.RUnlock()
+
+	return tr.verifyMultiProof(rootHash, keys, proof)
+}
+
+func (tr *patriciaMerkleTrie) verifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error) {
+	nodesByHash := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		nodesByHash[string(tr.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		isProven, value, err := tr.verifyKeyInMultiProof(rootHash, keyBytesToHex(key), nodesByHash)
+		if err != nil {
+			return false, nil, err
+		}
+		if !isProven {
+			return false, nil, nil
+		}
+
+		values = append(values, value)
+	}
+
+	return true, values, nil
+}
+
+func (tr *patriciaMerkleTrie) verifyKeyInMultiProof(rootHash []byte, hexKey []byte, nodesByHash map[string][]byte) (bool, []byte, error) {
+	wantHash := rootHash
+	for {
+		encodedNode, found := nodesByHash[string(wantHash)]
+		if !found {
+			return false, nil, nil
+		}
+
+		n, err := decodeNode(encodedNode, tr.marshalizer, tr.hasher)
+		if err != nil {
+			return false, nil, err
+		}
+
+		switch typedNode := n.(type) {
+		case *leafNode:
+			if bytes.Equal(hexKey, typedNode.Key) {
+				return true, typedNode.Value, nil
+			}
+
+			return true, nil, nil
+		case *extensionNode:
+			keyTooShort := len(hexKey) < len(typedNode.Key)
+			if keyTooShort || !bytes.Equal(typedNode.Key, hexKey[:len(typedNode.Key)]) {
+				return true, nil, nil
+			}
+
+			wantHash = typedNode.EncodedChild
+			hexKey = hexKey[len(typedNode.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 || childPosOutOfRange(hexKey[firstByte]) {
+				return false, nil, nil
+			}
+
+			wantHash = typedNode.EncodedChildren[hexKey[firstByte]]
+			if len(wantHash) == 0 {
+				return true, nil, nil
+			}
+			hexKey = hexKey[1:]
+		default:
+			return false, nil, ErrInvalidNode
+		}
+	}
+}
@@ -898,6 +898,105 @@ func TestPatriciaMerkleTrie_GetAndVerifyProof(t *testing.T) {
 	}
 }
 
+func TestPatriciaMerkleTrie_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		proof, values, err := tr.GetMultiProof([][]byte{[]byte("dog")})
+		assert.Nil(t, proof)
+		assert.Nil(t, values)
+		assert.Equal(t, trie.ErrNilNode, err)
+	})
+	t.Run("shared nodes should be added once", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		keys := [][]byte{[]byte("doe"), []byte("dog"), []byte("ddog")}
+		proof, values, err := tr.GetMultiProof(keys)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("reindeer"), []byte("puppy"), []byte("cat")}, values)
+
+		uniqueNodes := make(map[string]struct{})
+		for _, key := range keys {
+			singleProof, _, _ := tr.GetProof(key)
+			for _, encodedNode := range singleProof {
+				uniqueNodes[string(encodedNode)] = struct{}{}
+			}
+		}
+		assert.Equal(t, len(uniqueNodes), len(proof))
+	})
+	t.Run("absent keys should have nil values", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		proof, values, err := tr.GetMultiProof([][]byte{[]byte("dog"), []byte("cat"), []byte("doge")})
+		require.Nil(t, err)
+		assert.NotEmpty(t, proof)
+		assert.Equal(t, [][]byte{[]byte("puppy"), nil, nil}, values)
+	})
+}
+
+func TestPatriciaMerkleTrie_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("present and absent keys should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		rootHash, _ := tr.RootHash()
+
+		keys := make([][]byte, 0)
+		expectedValues := make([][]byte, 0)
+		for i := 0; i < len(values); i += 10 {
+			keys = append(keys, values[i], []byte("absent"+strconv.Itoa(i)))
+			expectedValues = append(expectedValues, values[i], nil)
+		}
+
+		proof, provenValues, err := tr.GetMultiProof(keys)
+		require.Nil(t, err)
+		assert.Equal(t, expectedValues, provenValues)
+
+		ok, verifiedValues, err := tr.VerifyMultiProof(rootHash, keys, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, expectedValues, verifiedValues)
+	})
+	t.Run("missing proof nodes should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		rootHash, _ := tr.RootHash()
+		keys := [][]byte{[]byte("doe"), []byte("dog")}
+		proof, _, _ := tr.GetMultiProof(keys)
+
+		ok, values, err := tr.VerifyMultiProof(rootHash, keys, proof[:len(proof)-1])
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, values)
+
+		ok, _, err = tr.VerifyMultiProof(rootHash, keys, nil)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("proof from a different trie should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		tr1 := initTrie()
+		tr2 := initTrie()
+		_ = tr2.Update([]byte("dog"), []byte("wolf"))
+		rootHash, _ := tr1.RootHash()
+		keys := [][]byte{[]byte("dog")}
+		proof, _, _ := tr2.GetMultiProof(keys)
+
+		ok, _, err := tr1.VerifyMultiProof(rootHash, keys, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
+
 func dumpTrieContents(tr common.Trie, values [][]byte) {
 	fmt.Println(tr.String())
 	for _, val := range values {
@@ -31,3 +31,8 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies the given multi-proof, returning the proven value of each key or nil for the absent ones
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, [][]byte, error) {
+	return mpv.trie.VerifyMultiProof(rootHash, keys, proof)
+}
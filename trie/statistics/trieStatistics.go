@@ -14,11 +14,12 @@ type trieStatistics struct {
 	address  string
 	rootHash []byte
 
-	maxTrieDepth   uint32
-	branchNodes    *nodesStatistics
-	extensionNodes *nodesStatistics
-	leafNodes      *nodesStatistics
-	migrationStats map[core.TrieNodeVersion]uint64
+	maxTrieDepth     uint32
+	branchNodes      *nodesStatistics
+	extensionNodes   *nodesStatistics
+	leafNodes        *nodesStatistics
+	migrationStats   map[core.TrieNodeVersion]uint64
+	numNodesPerDepth map[uint32]uint64
 
 	mutex sync.RWMutex
 }
@@ -46,7 +47,8 @@ func NewTrieStatistics() *trieStatistics {
 			nodesSize: 0,
 			numNodes:  0,
 		},
-		migrationStats: make(map[core.TrieNodeVersion]uint64),
+		migrationStats:   make(map[core.TrieNodeVersion]uint64),
+		numNodesPerDepth: make(map[uint32]uint64),
 	}
 }
 
@@ -87,6 +89,7 @@ func (ts *trieStatistics) AddAccountInfo(address string, rootHash []byte) {
 func (ts *trieStatistics) collectNodeStatistics(level int, size uint64, nodeStats *nodesStatistics) {
 	nodeStats.numNodes++
 	nodeStats.nodesSize += size
+	ts.numNodesPerDepth[uint32(level)]++
 
 	if uint32(level) > ts.maxTrieDepth {
 		ts.maxTrieDepth = uint32(level)
@@ -186,6 +189,19 @@ func (ts *trieStatistics) GetLeavesMigrationStats() map[core.TrieNodeVersion]uin
 	return migrationStatsMap
 }
 
+// GetNumNodesPerDepth will return the number of nodes found on each trie depth
+func (ts *trieStatistics) GetNumNodesPerDepth() map[uint32]uint64 {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	numNodesPerDepth := make(map[uint32]uint64, len(ts.numNodesPerDepth))
+	for depth, numNodes := range ts.numNodesPerDepth {
+		numNodesPerDepth[depth] = numNodes
+	}
+
+	return numNodesPerDepth
+}
+
 // MergeTriesStatistics will merge the given statistics with the current statistics
 func (ts *trieStatistics) MergeTriesStatistics(statsToBeMerged common.TrieStatisticsHandler) {
 	ts.mutex.Lock()
@@ -207,6 +223,10 @@ func (ts *trieStatistics) MergeTriesStatistics(statsToBeMerged common.TrieStatis
 	for version, numLeaves := range statsToBeMerged.GetLeavesMigrationStats() {
 		ts.migrationStats[version] += numLeaves
 	}
+
+	for depth, numNodes := range statsToBeMerged.GetNumNodesPerDepth() {
+		ts.numNodesPerDepth[depth] += numNodes
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
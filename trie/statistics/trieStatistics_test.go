@@ -59,6 +59,24 @@ func TestTrieStatistics_AddLeafNode(t *testing.T) {
 	assert.Equal(t, uint64(1), ts.migrationStats[1])
 }
 
+func TestTrieStatistics_GetNumNodesPerDepth(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddBranchNode(0, 10)
+	ts.AddExtensionNode(1, 10)
+	ts.AddBranchNode(2, 10)
+	ts.AddLeafNode(3, 10, 0)
+	ts.AddLeafNode(3, 10, 1)
+	ts.AddLeafNode(1, 10, 0)
+
+	numNodesPerDepth := ts.GetNumNodesPerDepth()
+	assert.Equal(t, map[uint32]uint64{0: 1, 1: 2, 2: 1, 3: 2}, numNodesPerDepth)
+
+	numNodesPerDepth[0] = 100
+	assert.Equal(t, uint64(1), ts.GetNumNodesPerDepth()[0])
+}
+
 func TestTrieStatistics_AddAccountInfo(t *testing.T) {
 	t.Parallel()
 
@@ -140,6 +158,7 @@ func TestTrieStatistics_MergeTriesStatistics(t *testing.T) {
 	assert.Equal(t, uint64(2), ts.GetNumLeafNodes())
 	assert.Equal(t, uint64(1), ts.GetLeavesMigrationStats()[0])
 	assert.Equal(t, uint64(1), ts.GetLeavesMigrationStats()[1])
+	assert.Equal(t, map[uint32]uint64{1: 1, 2: 1, 3: 2}, ts.GetNumNodesPerDepth())
 
 	newTs = NewTrieStatistics()
 	newTs.AddLeafNode(4, leafSize, 0)
@@ -60,6 +60,8 @@ type ArgTrieSyncer struct {
 	CheckNodesOnDisk          bool
 	TimeoutHandler            TimeoutHandler
 	LeavesChan                chan core.KeyValueHolder
+	CheckpointsStorer         storage.Persister
+	TimeBetweenCheckpoints    time.Duration
 }
 
 // NewTrieSyncer creates a new instance of trieSyncer
@@ -122,6 +124,9 @@ func checkArguments(arg ArgTrieSyncer) error {
 	if arg.MaxHardCapForMissingNodes < 1 {
 		return fmt.Errorf("%w provided: %v", ErrInvalidMaxHardCapForMissingNodes, arg.MaxHardCapForMissingNodes)
 	}
+	if check.IfNil(arg.CheckpointsStorer) {
+		return ErrNilSyncCheckpointsStorer
+	}
 
 	return nil
 }
@@ -0,0 +1,194 @@
+package trie
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/storage"
+)
+
+const (
+	syncCheckpointKeyPrefix     = "trieSyncCheckpoint_"
+	syncCheckpointNumCounters   = 4
+	syncCheckpointCounterLength = 8
+	syncCheckpointHashLength    = 4
+)
+
+// syncCheckpoint holds the frontier of a trie sync process (the hashes of the nodes that still need to be processed)
+// together with the statistics gathered while processing the nodes that are not part of the frontier
+type syncCheckpoint struct {
+	numTrieNodes uint64
+	numLeaves    uint64
+	numBytes     uint64
+	numLarge     uint64
+	hashes       [][]byte
+}
+
+// syncCheckpointer periodically saves the progress of a trie sync process so that it can be resumed after a restart.
+// The persisted checkpoint lags one interval behind the sync process: this way, all the trie nodes that were processed
+// before the checkpoint was created had enough time to be flushed by the trie storage
+type syncCheckpointer struct {
+	storer                 storage.Persister
+	timeBetweenCheckpoints time.Duration
+	key                    []byte
+	lastCheckpointTime     time.Time
+	pendingCheckpoint      []byte
+}
+
+func newSyncCheckpointer(storer storage.Persister, timeBetweenCheckpoints time.Duration) *syncCheckpointer {
+	return &syncCheckpointer{
+		storer:                 storer,
+		timeBetweenCheckpoints: timeBetweenCheckpoints,
+	}
+}
+
+func (sc *syncCheckpointer) isEnabled() bool {
+	return sc.timeBetweenCheckpoints > 0
+}
+
+// start prepares the checkpointer for the provided root hash and returns the previously saved checkpoint, if any
+func (sc *syncCheckpointer) start(rootHash []byte) (*syncCheckpoint, bool) {
+	sc.key = append([]byte(syncCheckpointKeyPrefix), rootHash...)
+	sc.lastCheckpointTime = time.Now()
+	sc.pendingCheckpoint = nil
+
+	if !sc.isEnabled() {
+		return nil, false
+	}
+
+	buff, err := sc.storer.Get(sc.key)
+	if err != nil {
+		return nil, false
+	}
+
+	checkpoint, err := decodeSyncCheckpoint(buff)
+	if err != nil {
+		log.Debug("syncCheckpointer.start: invalid checkpoint, the trie will be synced from the root",
+			"root hash", rootHash, "error", err)
+		return nil, false
+	}
+	if len(checkpoint.hashes) == 0 {
+		return nil, false
+	}
+
+	log.Debug("resuming trie sync from checkpoint",
+		"root hash", rootHash,
+		"num frontier hashes", len(checkpoint.hashes),
+		"num trie nodes", checkpoint.numTrieNodes,
+		"size", core.ConvertBytes(checkpoint.numBytes))
+
+	return checkpoint, true
+}
+
+// saveIfNeeded persists the checkpoint created on the previous call and keeps the newly created one as pending
+func (sc *syncCheckpointer) saveIfNeeded(createCheckpoint func() *syncCheckpoint) {
+	if !sc.isEnabled() {
+		return
+	}
+	if time.Since(sc.lastCheckpointTime) < sc.timeBetweenCheckpoints {
+		return
+	}
+	sc.lastCheckpointTime = time.Now()
+
+	if len(sc.pendingCheckpoint) > 0 {
+		err := sc.storer.Put(sc.key, sc.pendingCheckpoint)
+		if err != nil {
+			log.Debug("syncCheckpointer.saveIfNeeded: cannot save checkpoint", "key", sc.key, "error", err)
+		}
+	}
+
+	sc.pendingCheckpoint = createCheckpoint().encode()
+}
+
+// finish removes the saved checkpoint as the trie is fully synced
+func (sc *syncCheckpointer) finish() {
+	sc.pendingCheckpoint = nil
+	if !sc.isEnabled() {
+		return
+	}
+
+	err := sc.storer.Remove(sc.key)
+	if err != nil {
+		log.Debug("syncCheckpointer.finish: cannot remove checkpoint", "key", sc.key, "error", err)
+	}
+}
+
+func (checkpoint *syncCheckpoint) restoreStatistics(bst *baseSyncTrie, statistics common.SizeSyncStatisticsHandler) {
+	bst.mutStatistics.Lock()
+	bst.numTrieNodes = checkpoint.numTrieNodes
+	bst.numLeaves = checkpoint.numLeaves
+	bst.numBytes = checkpoint.numBytes
+	bst.numLarge = checkpoint.numLarge
+	bst.mutStatistics.Unlock()
+
+	statistics.AddNumProcessed(int(checkpoint.numTrieNodes))
+	statistics.AddNumLarge(int(checkpoint.numLarge))
+	statistics.AddNumBytesReceived(checkpoint.numBytes)
+}
+
+func newSyncCheckpoint(bst *baseSyncTrie, hashes [][]byte) *syncCheckpoint {
+	bst.mutStatistics.RLock()
+	defer bst.mutStatistics.RUnlock()
+
+	return &syncCheckpoint{
+		numTrieNodes: bst.numTrieNodes,
+		numLeaves:    bst.numLeaves,
+		numBytes:     bst.numBytes,
+		numLarge:     bst.numLarge,
+		hashes:       hashes,
+	}
+}
+
+func (checkpoint *syncCheckpoint) encode() []byte {
+	size := syncCheckpointNumCounters * syncCheckpointCounterLength
+	for _, hash := range checkpoint.hashes {
+		size += syncCheckpointHashLength + len(hash)
+	}
+
+	buff := make([]byte, 0, size)
+	buff = binary.BigEndian.AppendUint64(buff, checkpoint.numTrieNodes)
+	buff = binary.BigEndian.AppendUint64(buff, checkpoint.numLeaves)
+	buff = binary.BigEndian.AppendUint64(buff, checkpoint.numBytes)
+	buff = binary.BigEndian.AppendUint64(buff, checkpoint.numLarge)
+	for _, hash := range checkpoint.hashes {
+		buff = binary.BigEndian.AppendUint32(buff, uint32(len(hash)))
+		buff = append(buff, hash...)
+	}
+
+	return buff
+}
+
+func decodeSyncCheckpoint(buff []byte) (*syncCheckpoint, error) {
+	countersLength := syncCheckpointNumCounters * syncCheckpointCounterLength
+	if len(buff) < countersLength {
+		return nil, ErrInvalidSyncCheckpoint
+	}
+
+	checkpoint := &syncCheckpoint{
+		numTrieNodes: binary.BigEndian.Uint64(buff),
+		numLeaves:    binary.BigEndian.Uint64(buff[8:]),
+		numBytes:     binary.BigEndian.Uint64(buff[16:]),
+		numLarge:     binary.BigEndian.Uint64(buff[24:]),
+		hashes:       make([][]byte, 0),
+	}
+
+	buff = buff[countersLength:]
+	for len(buff) > 0 {
+		if len(buff) < syncCheckpointHashLength {
+			return nil, ErrInvalidSyncCheckpoint
+		}
+
+		hashLength := int(binary.BigEndian.Uint32(buff))
+		buff = buff[syncCheckpointHashLength:]
+		if hashLength == 0 || len(buff) < hashLength {
+			return nil, ErrInvalidSyncCheckpoint
+		}
+
+		checkpoint.hashes = append(checkpoint.hashes, buff[:hashLength])
+		buff = buff[hashLength:]
+	}
+
+	return checkpoint, nil
+}
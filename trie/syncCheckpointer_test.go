@@ -0,0 +1,240 @@
+package trie
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kalyan3104/k-chain-core-go/core"
+	"github.com/kalyan3104/k-chain-go/common"
+	"github.com/kalyan3104/k-chain-go/storage"
+	"github.com/kalyan3104/k-chain-go/testscommon"
+	"github.com/kalyan3104/k-chain-go/trie/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSyncCheckpointKey(rootHash []byte) []byte {
+	return append([]byte(syncCheckpointKeyPrefix), rootHash...)
+}
+
+// prepareResumedSync creates the arguments of a trie syncer that can only finish the sync by resuming from a checkpoint:
+// the root node is already saved and it will never be provided by the request handler
+func prepareResumedSync(t *testing.T, numKeysValues int) (ArgTrieSyncer, []byte) {
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Minute)
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, [][]byte{rootHash})
+	arg.LeavesChan = make(chan core.KeyValueHolder, numKeysValues+10)
+	arg.TimeBetweenCheckpoints = time.Minute
+
+	rootNode := trSource.(*patriciaMerkleTrie).root
+	childrenHashes, _, err := rootNode.loadChildren(func(_ []byte) (node, error) {
+		return nil, errors.New("not loaded")
+	})
+	require.Nil(t, err)
+
+	serializedRoot, err := trSource.GetSerializedNode(rootHash)
+	require.Nil(t, err)
+	err = arg.DB.Put(rootHash, serializedRoot)
+	require.Nil(t, err)
+
+	checkpoint := &syncCheckpoint{
+		numTrieNodes: 1,
+		numBytes:     uint64(len(serializedRoot)),
+		hashes:       childrenHashes,
+	}
+	err = arg.CheckpointsStorer.Put(createSyncCheckpointKey(rootHash), checkpoint.encode())
+	require.Nil(t, err)
+
+	return arg, rootHash
+}
+
+func requireResumedSyncFinished(t *testing.T, arg ArgTrieSyncer, syncer TrieSyncer, rootHash []byte, numKeysValues int) {
+	tsm, _ := arg.DB.(*trieStorageManager)
+	db, _ := tsm.mainStorer.(storage.Persister)
+	tr, _ := createInMemoryTrieFromDB(db)
+	tr, _ = tr.Recreate(rootHash)
+	require.NotNil(t, tr)
+
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasherMock.Compute(fmt.Sprintf("%d", i))
+		val, _, err := tr.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+	}
+
+	assert.Equal(t, uint64(numKeysValues), syncer.NumLeaves())
+	assert.Equal(t, int(syncer.NumTrieNodes()), arg.TrieSyncStatistics.NumProcessed())
+	assert.Equal(t, syncer.NumBytes(), arg.TrieSyncStatistics.NumBytesReceived())
+
+	_, err := arg.CheckpointsStorer.Get(createSyncCheckpointKey(rootHash))
+	assert.NotNil(t, err)
+}
+
+func TestSyncCheckpoint_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checkpoint := &syncCheckpoint{
+			numTrieNodes: 10,
+			numLeaves:    6,
+			numBytes:     1024,
+			numLarge:     1,
+			hashes:       [][]byte{[]byte("hash1"), []byte("hash22"), []byte("hash333")},
+		}
+
+		decoded, err := decodeSyncCheckpoint(checkpoint.encode())
+		require.Nil(t, err)
+		require.Equal(t, checkpoint, decoded)
+	})
+	t.Run("empty frontier should work", func(t *testing.T) {
+		t.Parallel()
+
+		checkpoint := &syncCheckpoint{
+			numTrieNodes: 10,
+			hashes:       make([][]byte, 0),
+		}
+
+		decoded, err := decodeSyncCheckpoint(checkpoint.encode())
+		require.Nil(t, err)
+		require.Equal(t, checkpoint, decoded)
+	})
+	t.Run("invalid buffers should error", func(t *testing.T) {
+		t.Parallel()
+
+		checkpoint := &syncCheckpoint{
+			hashes: [][]byte{[]byte("hash1")},
+		}
+		buff := checkpoint.encode()
+
+		_, err := decodeSyncCheckpoint(buff[:10])
+		require.Equal(t, ErrInvalidSyncCheckpoint, err)
+
+		_, err = decodeSyncCheckpoint(buff[:len(buff)-1])
+		require.Equal(t, ErrInvalidSyncCheckpoint, err)
+
+		_, err = decodeSyncCheckpoint(append(buff, 0, 0))
+		require.Equal(t, ErrInvalidSyncCheckpoint, err)
+	})
+}
+
+func TestSyncCheckpointer(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("rootHash")
+	createCheckpoint := func(hashes ...string) func() *syncCheckpoint {
+		return func() *syncCheckpoint {
+			checkpoint := &syncCheckpoint{
+				hashes: make([][]byte, 0, len(hashes)),
+			}
+			for _, hash := range hashes {
+				checkpoint.hashes = append(checkpoint.hashes, []byte(hash))
+			}
+
+			return checkpoint
+		}
+	}
+
+	t.Run("disabled checkpointer should not save or load", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		_ = storer.Put(createSyncCheckpointKey(rootHash), createCheckpoint("hash")().encode())
+
+		sc := newSyncCheckpointer(storer, 0)
+		checkpoint, found := sc.start(rootHash)
+		assert.Nil(t, checkpoint)
+		assert.False(t, found)
+
+		sc.saveIfNeeded(func() *syncCheckpoint {
+			assert.Fail(t, "should have not created a checkpoint")
+			return nil
+		})
+		sc.finish()
+
+		_, err := storer.Get(createSyncCheckpointKey(rootHash))
+		assert.Nil(t, err)
+	})
+	t.Run("the saved checkpoint should lag one interval behind", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		sc := newSyncCheckpointer(storer, time.Nanosecond)
+		_, found := sc.start(rootHash)
+		require.False(t, found)
+
+		sc.saveIfNeeded(createCheckpoint("hash1"))
+		_, err := storer.Get(createSyncCheckpointKey(rootHash))
+		require.NotNil(t, err)
+
+		sc.saveIfNeeded(createCheckpoint("hash2", "hash3"))
+		checkpoint, found := newSyncCheckpointer(storer, time.Nanosecond).start(rootHash)
+		require.True(t, found)
+		require.Equal(t, [][]byte{[]byte("hash1")}, checkpoint.hashes)
+
+		sc.saveIfNeeded(createCheckpoint("hash4"))
+		checkpoint, found = newSyncCheckpointer(storer, time.Nanosecond).start(rootHash)
+		require.True(t, found)
+		require.Equal(t, [][]byte{[]byte("hash2"), []byte("hash3")}, checkpoint.hashes)
+
+		sc.finish()
+		_, found = newSyncCheckpointer(storer, time.Nanosecond).start(rootHash)
+		require.False(t, found)
+	})
+	t.Run("should not save before the interval passes", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		sc := newSyncCheckpointer(storer, time.Hour)
+		_, _ = sc.start(rootHash)
+
+		sc.saveIfNeeded(func() *syncCheckpoint {
+			assert.Fail(t, "should have not created a checkpoint")
+			return nil
+		})
+	})
+	t.Run("invalid checkpoint should not be loaded", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		_ = storer.Put(createSyncCheckpointKey(rootHash), []byte("invalid"))
+
+		checkpoint, found := newSyncCheckpointer(storer, time.Minute).start(rootHash)
+		assert.Nil(t, checkpoint)
+		assert.False(t, found)
+	})
+}
+
+func TestSyncCheckpoint_RestoreStatistics(t *testing.T) {
+	t.Parallel()
+
+	checkpoint := &syncCheckpoint{
+		numTrieNodes: 10,
+		numLeaves:    6,
+		numBytes:     1024,
+		numLarge:     1,
+	}
+	bst := &baseSyncTrie{}
+	trieSyncStatistics := statistics.NewTrieSyncStatistics()
+
+	checkpoint.restoreStatistics(bst, trieSyncStatistics)
+
+	assert.Equal(t, uint64(10), bst.NumTrieNodes())
+	assert.Equal(t, uint64(6), bst.NumLeaves())
+	assert.Equal(t, uint64(1024), bst.NumBytes())
+	assert.Equal(t, 10, trieSyncStatistics.NumProcessed())
+	assert.Equal(t, 1, trieSyncStatistics.NumLarge())
+	assert.Equal(t, uint64(1024), trieSyncStatistics.NumBytesReceived())
+
+	newCheckpoint := newSyncCheckpoint(bst, [][]byte{common.EmptyTrieHash})
+	assert.Equal(t, checkpoint.numLarge, newCheckpoint.numLarge)
+	assert.Equal(t, checkpoint.numBytes, newCheckpoint.numBytes)
+	assert.Equal(t, [][]byte{common.EmptyTrieHash}, newCheckpoint.hashes)
+}
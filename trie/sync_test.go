@@ -42,6 +42,7 @@ func createMockArgument(timeout time.Duration) ArgTrieSyncer {
 		TimeoutHandler:            testscommon.NewTimeoutHandlerMock(timeout),
 		MaxHardCapForMissingNodes: 500,
 		LeavesChan:                make(chan core.KeyValueHolder, 100),
+		CheckpointsStorer:         testscommon.NewMemDbMock(),
 	}
 }
 
@@ -147,6 +148,17 @@ func TestNewTrieSyncer(t *testing.T) {
 		assert.True(t, errors.Is(err, ErrInvalidMaxHardCapForMissingNodes))
 	})
 
+	t.Run("nil checkpoints storer", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgument(time.Minute)
+		arg.CheckpointsStorer = nil
+
+		ts, err := NewTrieSyncer(arg)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrNilSyncCheckpointsStorer, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -13,6 +13,7 @@ import (
 	"github.com/kalyan3104/k-chain-go/sharding"
 	"github.com/kalyan3104/k-chain-go/state/syncer"
 	"github.com/kalyan3104/k-chain-go/storage"
+	storageDisabled "github.com/kalyan3104/k-chain-go/storage/disabled"
 	"github.com/kalyan3104/k-chain-go/trie"
 	"github.com/kalyan3104/k-chain-go/trie/statistics"
 	"github.com/kalyan3104/k-chain-go/update"
@@ -158,6 +159,7 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabled.NewAppStatusHandler(),
 			EnableEpochsHandler:               a.enableEpochsHandler,
+			CheckpointsStorer:                 storageDisabled.NewPersister(),
 		},
 		ShardId:                shardId,
 		Throttler:              thr,
@@ -188,6 +190,7 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabled.NewAppStatusHandler(),
 			EnableEpochsHandler:               a.enableEpochsHandler,
+			CheckpointsStorer:                 storageDisabled.NewPersister(),
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
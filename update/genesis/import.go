@@ -420,6 +420,7 @@ func (si *stateImport) getAccountsDB(accType Type, shardID uint32, accountFactor
 				StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 				AddressConverter:      si.addressConverter,
 				SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+				FlatState:             disabledState.NewDisabledFlatState(),
 			}
 			accountsDB, errCreate := state.NewAccountsDB(argsAccountDB)
 			if errCreate != nil {
@@ -443,6 +444,7 @@ func (si *stateImport) getAccountsDB(accType Type, shardID uint32, accountFactor
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		AddressConverter:      si.addressConverter,
 		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+		FlatState:             disabledState.NewDisabledFlatState(),
 	}
 	accountsDB, err = state.NewAccountsDB(argsAccountDB)
 	si.accountDBsMap[shardID] = accountsDB
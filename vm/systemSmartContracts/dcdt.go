@@ -44,7 +44,6 @@ const canCreateMultiShard = "canCreateMultiShard"
 const upgradeProperties = "upgradeProperties"
 
 const conversionBase = 10
-const metaDCDT = "MetaDCDT"
 
 type dcdt struct {
 	eei                    vm.SystemEI
@@ -432,7 +431,7 @@ func (e *dcdt) registerMetaDCDT(args *vmcommon.ContractCallInput) vmcommon.Retur
 		big.NewInt(0),
 		numOfDecimals,
 		args.Arguments[3:],
-		[]byte(metaDCDT))
+		[]byte(common.MetaDCDT))
 	if err != nil {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -443,7 +442,7 @@ func (e *dcdt) registerMetaDCDT(args *vmcommon.ContractCallInput) vmcommon.Retur
 	logEntry := &vmcommon.LogEntry{
 		Identifier: []byte(args.Function),
 		Address:    args.CallerAddr,
-		Topics:     [][]byte{tokenIdentifier, args.Arguments[0], args.Arguments[1], []byte(metaDCDT), big.NewInt(int64(numOfDecimals)).Bytes()},
+		Topics:     [][]byte{tokenIdentifier, args.Arguments[0], args.Arguments[1], []byte(common.MetaDCDT), big.NewInt(int64(numOfDecimals)).Bytes()},
 	}
 	e.eei.AddLogEntry(logEntry)
 
@@ -535,7 +534,7 @@ func getAllRolesForTokenType(tokenType string) ([][]byte, error) {
 	switch tokenType {
 	case core.NonFungibleDCDT:
 		return [][]byte{[]byte(core.DCDTRoleNFTCreate), []byte(core.DCDTRoleNFTBurn), []byte(core.DCDTRoleNFTUpdateAttributes), []byte(core.DCDTRoleNFTAddURI)}, nil
-	case core.SemiFungibleDCDT, metaDCDT:
+	case core.SemiFungibleDCDT, common.MetaDCDT:
 		return [][]byte{[]byte(core.DCDTRoleNFTCreate), []byte(core.DCDTRoleNFTBurn), []byte(core.DCDTRoleNFTAddQuantity)}, nil
 	case core.FungibleDCDT:
 		return [][]byte{[]byte(core.DCDTRoleLocalMint), []byte(core.DCDTRoleLocalBurn)}, nil
@@ -545,14 +544,14 @@ func getAllRolesForTokenType(tokenType string) ([][]byte, error) {
 }
 
 func getTokenType(compressed []byte) (bool, []byte, error) {
-	// TODO: might extract the compressed constants to core, alongside metaDCDT
+	// TODO: might extract the compressed constants to core, alongside common.MetaDCDT
 	switch string(compressed) {
 	case "NFT":
 		return false, []byte(core.NonFungibleDCDT), nil
 	case "SFT":
 		return false, []byte(core.SemiFungibleDCDT), nil
 	case "META":
-		return true, []byte(metaDCDT), nil
+		return true, []byte(common.MetaDCDT), nil
 	case "FNG":
 		return true, []byte(core.FungibleDCDT), nil
 	}
@@ -588,7 +587,7 @@ func (e *dcdt) changeSFTToMetaDCDT(args *vmcommon.ContractCallInput) vmcommon.Re
 		return vmcommon.UserError
 	}
 
-	token.TokenType = []byte(metaDCDT)
+	token.TokenType = []byte(common.MetaDCDT)
 	token.NumDecimals = numOfDecimals
 	err := e.saveToken(args.Arguments[0], token)
 	if err != nil {
@@ -599,7 +598,7 @@ func (e *dcdt) changeSFTToMetaDCDT(args *vmcommon.ContractCallInput) vmcommon.Re
 	logEntry := &vmcommon.LogEntry{
 		Identifier: []byte(args.Function),
 		Address:    args.CallerAddr,
-		Topics:     [][]byte{args.Arguments[0], token.TokenName, token.TickerName, []byte(metaDCDT), args.Arguments[1]},
+		Topics:     [][]byte{args.Arguments[0], token.TokenName, token.TickerName, []byte(common.MetaDCDT), args.Arguments[1]},
 	}
 	e.eei.AddLogEntry(logEntry)
 
@@ -1579,7 +1578,7 @@ func (e *dcdt) checkSpecialRolesAccordingToTokenType(args [][]byte, token *DCDTD
 		return validateRoles(args, e.isSpecialRoleValidForNonFungible)
 	case core.SemiFungibleDCDT:
 		return validateRoles(args, e.isSpecialRoleValidForSemiFungible)
-	case metaDCDT:
+	case common.MetaDCDT:
 		isCheckMetaDCDTOnRolesFlagEnabled := e.enableEpochsHandler.IsFlagEnabled(common.ManagedCryptoAPIsFlag)
 		if isCheckMetaDCDTOnRolesFlagEnabled {
 			return validateRoles(args, e.isSpecialRoleValidForSemiFungible)
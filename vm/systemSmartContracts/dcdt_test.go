@@ -4092,7 +4092,7 @@ func TestDcdt_ExecuteChangeSFTToMetaDCDT(t *testing.T) {
 
 	token, _ := e.getExistingToken(vmInput.Arguments[0])
 	assert.Equal(t, token.NumDecimals, uint32(10))
-	assert.Equal(t, token.TokenType, []byte(metaDCDT))
+	assert.Equal(t, token.TokenType, []byte(common.MetaDCDT))
 }
 
 func TestDcdt_ExecuteIssueSFTAndChangeSFTToMetaDCDT(t *testing.T) {
@@ -4126,7 +4126,7 @@ func TestDcdt_ExecuteIssueSFTAndChangeSFTToMetaDCDT(t *testing.T) {
 
 	token, _ = e.getExistingToken(fullTicker)
 	assert.Equal(t, token.NumDecimals, uint32(10))
-	assert.Equal(t, token.TokenType, []byte(metaDCDT))
+	assert.Equal(t, token.TokenType, []byte(common.MetaDCDT))
 
 	output = e.Execute(vmInput)
 	assert.Equal(t, vmcommon.UserError, output)
@@ -4260,7 +4260,7 @@ func TestDcdt_ExecuteRegisterAndSetMetaDCDTShouldSetType(t *testing.T) {
 
 	registerAndSetAllRolesWithTypeCheck(t, []byte("NFT"), []byte(core.NonFungibleDCDT))
 	registerAndSetAllRolesWithTypeCheck(t, []byte("SFT"), []byte(core.SemiFungibleDCDT))
-	registerAndSetAllRolesWithTypeCheck(t, []byte("META"), []byte(metaDCDT))
+	registerAndSetAllRolesWithTypeCheck(t, []byte("META"), []byte(common.MetaDCDT))
 	registerAndSetAllRolesWithTypeCheck(t, []byte("FNG"), []byte(core.FungibleDCDT))
 }
 
@@ -4430,11 +4430,11 @@ func TestDcdt_CheckRolesOnMetaDCDT(t *testing.T) {
 	args.Eei = eei
 	e, _ := NewDCDTSmartContract(args)
 
-	err := e.checkSpecialRolesAccordingToTokenType([][]byte{[]byte("random")}, &DCDTDataV2{TokenType: []byte(metaDCDT)})
+	err := e.checkSpecialRolesAccordingToTokenType([][]byte{[]byte("random")}, &DCDTDataV2{TokenType: []byte(common.MetaDCDT)})
 	assert.Nil(t, err)
 
 	enableEpochsHandler.AddActiveFlags(common.ManagedCryptoAPIsFlag)
-	err = e.checkSpecialRolesAccordingToTokenType([][]byte{[]byte("random")}, &DCDTDataV2{TokenType: []byte(metaDCDT)})
+	err = e.checkSpecialRolesAccordingToTokenType([][]byte{[]byte("random")}, &DCDTDataV2{TokenType: []byte(common.MetaDCDT)})
 	assert.Equal(t, err, vm.ErrInvalidArgument)
 }
 